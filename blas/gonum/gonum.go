@@ -19,6 +19,10 @@ type Implementation struct{}
 const (
 	blockSize   = 64 // b x b matrix
 	minParBlock = 4  // minimum number of blocks needed to go parallel
+
+	// minParFlops is the minimum number of floating point operations
+	// needed for other Level 2 and Level 3 routines to go parallel.
+	minParFlops = 1 << 20
 )
 
 // blocks returns the number of divisions of the dimension length with the given
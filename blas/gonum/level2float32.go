@@ -299,12 +299,7 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 	}
 
 	// Form y = alpha * A * x + y
-	if tA == blas.NoTrans {
-		f32.GemvN(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, y, uintptr(incY))
-		return
-	}
-	// Cases where a is transposed.
-	f32.GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, y, uintptr(incY))
+	sgemvParallel(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
 }
 
 // Strmv performs one of the matrix-vector operations
@@ -694,6 +689,12 @@ func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda
 		return
 	}
 
+	ssymvParallel(ul, n, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// ssymvSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func ssymvSerial(ul blas.Uplo, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
 	// Set up start points
 	var kx, ky int
 	if incX < 0 {
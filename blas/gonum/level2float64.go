@@ -291,12 +291,7 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 	}
 
 	// Form y = alpha * A * x + y
-	if tA == blas.NoTrans {
-		f64.GemvN(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, y, uintptr(incY))
-		return
-	}
-	// Cases where a is transposed.
-	f64.GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, y, uintptr(incY))
+	dgemvParallel(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
 }
 
 // Dtrmv performs one of the matrix-vector operations
@@ -680,6 +675,12 @@ func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda
 		return
 	}
 
+	dsymvParallel(ul, n, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// dsymvSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func dsymvSerial(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	// Set up start points
 	var kx, ky int
 	if incX < 0 {
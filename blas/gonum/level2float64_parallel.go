@@ -0,0 +1,72 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// dgemvParallel computes Dgemv by partitioning y into blocks, which are
+// computed concurrently from the corresponding rows of A if A is not
+// transposed and from the corresponding columns of A otherwise.
+func dgemvParallel(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	if tA == blas.NoTrans {
+		if incY < 0 || !goParallel(m, 2*m*n) {
+			f64.GemvN(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, y, uintptr(incY))
+			return
+		}
+		parallelFor(m, blockSize, func(lo, hi int) {
+			f64.GemvN(uintptr(hi-lo), uintptr(n), alpha, a[lo*lda:], uintptr(lda), x, uintptr(incX), beta, y[lo*incY:], uintptr(incY))
+		})
+		return
+	}
+	// Cases where a is transposed.
+	if incY < 0 || !goParallel(n, 2*m*n) {
+		f64.GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, uintptr(incX), beta, y, uintptr(incY))
+		return
+	}
+	parallelFor(n, blockSize, func(lo, hi int) {
+		f64.GemvT(uintptr(m), uintptr(hi-lo), alpha, a[lo:], uintptr(lda), x, uintptr(incX), beta, y[lo*incY:], uintptr(incY))
+	})
+}
+
+// dsymvParallel computes Dsymv by partitioning y into blocks which are
+// computed concurrently. For each block the symmetric diagonal block of A is
+// handled by dsymvSerial and the rectangular blocks of the stored triangle
+// to the left and right of it by the general kernels.
+func dsymvParallel(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	if incX < 0 || incY < 0 || !goParallel(n, 2*n*n) {
+		dsymvSerial(ul, n, alpha, a, lda, x, incX, beta, y, incY)
+		return
+	}
+	parallelFor(n, blockSize, func(lo, hi int) {
+		nb := hi - lo
+		yb := y[lo*incY:]
+		dsymvSerial(ul, nb, alpha, a[lo*lda+lo:], lda, x[lo*incX:], incX, beta, yb, incY)
+		if alpha == 0 {
+			return
+		}
+		if ul == blas.Upper {
+			// The rows lo:hi of A left of the diagonal block are the
+			// transpose of the stored columns lo:hi above it.
+			if lo > 0 {
+				f64.GemvT(uintptr(lo), uintptr(nb), alpha, a[lo:], uintptr(lda), x, uintptr(incX), 1, yb, uintptr(incY))
+			}
+			if hi < n {
+				f64.GemvN(uintptr(nb), uintptr(n-hi), alpha, a[lo*lda+hi:], uintptr(lda), x[hi*incX:], uintptr(incX), 1, yb, uintptr(incY))
+			}
+			return
+		}
+		if lo > 0 {
+			f64.GemvN(uintptr(nb), uintptr(lo), alpha, a[lo*lda:], uintptr(lda), x, uintptr(incX), 1, yb, uintptr(incY))
+		}
+		// The rows lo:hi of A right of the diagonal block are the
+		// transpose of the stored columns lo:hi below it.
+		if hi < n {
+			f64.GemvT(uintptr(n-hi), uintptr(nb), alpha, a[hi*lda+lo:], uintptr(lda), x[hi*incX:], uintptr(incX), 1, yb, uintptr(incY))
+		}
+	})
+}
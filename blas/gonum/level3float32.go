@@ -81,6 +81,12 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		}
 		return
 	}
+	strsmParallel(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// strsmSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func strsmSerial(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
 	nonUnit := d == blas.NonUnit
 	if s == blas.Left {
 		if tA == blas.NoTrans {
@@ -298,6 +304,12 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 		return
 	}
 
+	ssymmParallel(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// ssymmSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func ssymmSerial(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
 	isUpper := ul == blas.Upper
 	if s == blas.Left {
 		for i := 0; i < m; i++ {
@@ -449,6 +461,12 @@ func (Implementation) Ssyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 		}
 		return
 	}
+	ssyrkParallel(ul, tA, n, k, alpha, a, lda, beta, c, ldc)
+}
+
+// ssyrkSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func ssyrkSerial(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
 	if tA == blas.NoTrans {
 		if ul == blas.Upper {
 			for i := 0; i < n; i++ {
@@ -787,6 +805,12 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		return
 	}
 
+	strmmParallel(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// strmmSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func strmmSerial(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
 	nonUnit := d == blas.NonUnit
 	if s == blas.Left {
 		if tA == blas.NoTrans {
@@ -77,6 +77,12 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		}
 		return
 	}
+	dtrsmParallel(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// dtrsmSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func dtrsmSerial(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
 	nonUnit := d == blas.NonUnit
 	if s == blas.Left {
 		if tA == blas.NoTrans {
@@ -292,6 +298,12 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 		return
 	}
 
+	dsymmParallel(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// dsymmSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func dsymmSerial(s blas.Side, ul blas.Uplo, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
 	isUpper := ul == blas.Upper
 	if s == blas.Left {
 		for i := 0; i < m; i++ {
@@ -441,6 +453,12 @@ func (Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 		}
 		return
 	}
+	dsyrkParallel(ul, tA, n, k, alpha, a, lda, beta, c, ldc)
+}
+
+// dsyrkSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func dsyrkSerial(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
 	if tA == blas.NoTrans {
 		if ul == blas.Upper {
 			for i := 0; i < n; i++ {
@@ -775,6 +793,12 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		return
 	}
 
+	dtrmmParallel(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// dtrmmSerial is the serial implementation of the exported routine after the
+// argument checks and the quick returns.
+func dtrmmSerial(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
 	nonUnit := d == blas.NonUnit
 	if s == blas.Left {
 		if tA == blas.NoTrans {
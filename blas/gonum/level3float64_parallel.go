@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+)
+
+// dtrsmParallel computes Dtrsm by partitioning B into blocks of columns if A
+// is on the left or into blocks of rows if A is on the right. The blocks are
+// independent and are solved concurrently.
+func dtrsmParallel(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+	if s == blas.Left {
+		if !goParallel(n, m*m*n) {
+			dtrsmSerial(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+			return
+		}
+		parallelFor(n, blockSize, func(lo, hi int) {
+			dtrsmSerial(s, ul, tA, d, m, hi-lo, alpha, a, lda, b[lo:], ldb)
+		})
+		return
+	}
+	if !goParallel(m, m*n*n) {
+		dtrsmSerial(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+		return
+	}
+	parallelFor(m, blockSize, func(lo, hi int) {
+		dtrsmSerial(s, ul, tA, d, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb)
+	})
+}
+
+// dsymmParallel computes Dsymm by partitioning B and C into blocks of columns
+// if A is on the left or into blocks of rows if A is on the right. The blocks
+// are independent and are computed concurrently.
+func dsymmParallel(s blas.Side, ul blas.Uplo, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	if s == blas.Left {
+		if !goParallel(n, 2*m*m*n) {
+			dsymmSerial(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+			return
+		}
+		parallelFor(n, blockSize, func(lo, hi int) {
+			dsymmSerial(s, ul, m, hi-lo, alpha, a, lda, b[lo:], ldb, beta, c[lo:], ldc)
+		})
+		return
+	}
+	if !goParallel(m, 2*m*n*n) {
+		dsymmSerial(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+		return
+	}
+	parallelFor(m, blockSize, func(lo, hi int) {
+		dsymmSerial(s, ul, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb, beta, c[lo*ldc:], ldc)
+	})
+}
+
+// dsyrkParallel computes Dsyrk by partitioning the referenced triangle of C
+// into square tiles. The diagonal tiles are computed by dsyrkSerial and the
+// off-diagonal tiles by dgemmSerial, all of them concurrently.
+func dsyrkParallel(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
+	if !goParallel(n, n*n*k) {
+		dsyrkSerial(ul, tA, n, k, alpha, a, lda, beta, c, ldc)
+		return
+	}
+
+	// aBlock returns the rows of op(A) starting at i.
+	aBlock := func(i int) []float64 {
+		if tA == blas.NoTrans {
+			return a[i*lda:]
+		}
+		return a[i:]
+	}
+	nt := blocks(n, blockSize)
+	type tile struct{ i, j int }
+	tiles := make([]tile, 0, nt*(nt+1)/2)
+	for i := 0; i < nt; i++ {
+		for j := 0; j < nt; j++ {
+			if (ul == blas.Upper && i <= j) || (ul == blas.Lower && j <= i) {
+				tiles = append(tiles, tile{i * blockSize, j * blockSize})
+			}
+		}
+	}
+	parallelFor(len(tiles), 1, func(lo, hi int) {
+		for _, t := range tiles[lo:hi] {
+			ib := min(blockSize, n-t.i)
+			jb := min(blockSize, n-t.j)
+			if t.i == t.j {
+				dsyrkSerial(ul, tA, ib, k, alpha, aBlock(t.i), lda, beta, c[t.i*ldc+t.i:], ldc)
+				continue
+			}
+			for i := t.i; i < t.i+ib; i++ {
+				ctmp := c[i*ldc+t.j : i*ldc+t.j+jb]
+				if beta == 0 {
+					for j := range ctmp {
+						ctmp[j] = 0
+					}
+				} else if beta != 1 {
+					for j := range ctmp {
+						ctmp[j] *= beta
+					}
+				}
+			}
+			dgemmSerial(tA != blas.NoTrans, tA == blas.NoTrans, ib, jb, k,
+				aBlock(t.i), lda, aBlock(t.j), lda, c[t.i*ldc+t.j:], ldc, alpha)
+		}
+	})
+}
+
+// dtrmmParallel computes Dtrmm by partitioning B into blocks of columns if A
+// is on the left or into blocks of rows if A is on the right. The blocks are
+// independent and are computed concurrently.
+func dtrmmParallel(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+	if s == blas.Left {
+		if !goParallel(n, m*m*n) {
+			dtrmmSerial(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+			return
+		}
+		parallelFor(n, blockSize, func(lo, hi int) {
+			dtrmmSerial(s, ul, tA, d, m, hi-lo, alpha, a, lda, b[lo:], ldb)
+		})
+		return
+	}
+	if !goParallel(m, m*n*n) {
+		dtrmmSerial(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+		return
+	}
+	parallelFor(m, blockSize, func(lo, hi int) {
+		dtrmmSerial(s, ul, tA, d, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb)
+	})
+}
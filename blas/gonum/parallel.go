@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// maxWorkers is the limit on the number of goroutines used by a single call
+// to a parallel routine. Zero means runtime.GOMAXPROCS(0).
+var maxWorkers atomic.Int64
+
+// SetMaxWorkers sets the maximum number of goroutines used by a single call to
+// a parallel Level 2 or Level 3 routine of Implementation and returns the
+// previous limit. If n is zero, the limit is runtime.GOMAXPROCS(0) at the time
+// of the call, which is the default. If n is one, all routines run serially in
+// the calling goroutine. SetMaxWorkers will panic if n is negative.
+//
+// SetMaxWorkers allows programs to share the available CPUs between the BLAS
+// routines and their own goroutines. It is safe for concurrent use and takes
+// effect for the calls that start after it returns.
+func SetMaxWorkers(n int) (prev int) {
+	if n < 0 {
+		panic("blas: negative number of workers")
+	}
+	return int(maxWorkers.Swap(int64(n)))
+}
+
+// workers returns the maximum number of goroutines for a parallel computation.
+func workers() int {
+	if n := maxWorkers.Load(); n > 0 {
+		return int(n)
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// goParallel returns whether a computation with the given number of floating
+// point operations that can be partitioned along a dimension of length n
+// should be run concurrently.
+func goParallel(n, flops int) bool {
+	return flops >= minParFlops && blocks(n, blockSize) >= minParBlock && workers() > 1
+}
+
+// parallelFor calls fn(lo, hi) for the consecutive ranges [lo, hi) of length at
+// most size that partition [0, n). The calls run concurrently on at most
+// workers() goroutines and parallelFor returns when all of them have finished.
+func parallelFor(n, size int, fn func(lo, hi int)) {
+	nb := blocks(n, size)
+	w := min(workers(), nb)
+	if w <= 1 {
+		for lo := 0; lo < n; lo += size {
+			fn(lo, min(lo+size, n))
+		}
+		return
+	}
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(w)
+	for i := 0; i < w; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				b := int(next.Add(1) - 1)
+				if b >= nb {
+					return
+				}
+				lo := b * size
+				fn(lo, min(lo+size, n))
+			}
+		}()
+	}
+	wg.Wait()
+}
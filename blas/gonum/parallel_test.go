@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// withWorkers runs fn with the worker limit set to n.
+func withWorkers(n int, fn func()) {
+	prev := SetMaxWorkers(n)
+	defer SetMaxWorkers(prev)
+	fn()
+}
+
+func randomNormSlice(n int, rnd *rand.Rand) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+// triangularSlice returns a well-conditioned n×n triangular matrix in a slice
+// with the given stride.
+func triangularSlice(n, lda int, rnd *rand.Rand) []float64 {
+	a := make([]float64, n*lda)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a[i*lda+j] = rnd.NormFloat64() / float64(n)
+		}
+		a[i*lda+i] = 2 + rnd.Float64()
+	}
+	return a
+}
+
+func TestSetMaxWorkers(t *testing.T) {
+	prev := SetMaxWorkers(3)
+	if workers() != 3 {
+		t.Errorf("unexpected number of workers: got %d, want 3", workers())
+	}
+	if got := SetMaxWorkers(prev); got != 3 {
+		t.Errorf("unexpected previous limit: got %d, want 3", got)
+	}
+	if workers() < 1 {
+		t.Errorf("invalid default number of workers %d", workers())
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected panic for negative number of workers")
+			}
+		}()
+		SetMaxWorkers(-1)
+	}()
+}
+
+func TestLevel3Parallel(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{300, 400}, {513, 257}, {70, 600}, {600, 70}} {
+		m, n := dims[0], dims[1]
+		for _, s := range []blas.Side{blas.Left, blas.Right} {
+			k := n
+			if s == blas.Left {
+				k = m
+			}
+			lda := k + 3
+			ldb := n + 5
+			for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+				a := triangularSlice(k, lda, rnd)
+				b := randomNormSlice(m*ldb, rnd)
+				for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+					for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+						name := fmt.Sprintf("m=%d,n=%d,side=%c,uplo=%c,trans=%c,diag=%c", m, n, s, ul, tA, d)
+
+						want := append([]float64(nil), b...)
+						dtrsmSerial(s, ul, tA, d, m, n, 1.5, a, lda, want, ldb)
+						got := append([]float64(nil), b...)
+						withWorkers(4, func() { Implementation{}.Dtrsm(s, ul, tA, d, m, n, 1.5, a, lda, got, ldb) })
+						if !floats.EqualApprox(got, want, 1e-12) {
+							t.Errorf("%s: Dtrsm parallel and serial results differ", name)
+						}
+
+						want = append(want[:0], b...)
+						dtrmmSerial(s, ul, tA, d, m, n, 1.5, a, lda, want, ldb)
+						got = append(got[:0], b...)
+						withWorkers(4, func() { Implementation{}.Dtrmm(s, ul, tA, d, m, n, 1.5, a, lda, got, ldb) })
+						if !floats.EqualApprox(got, want, 1e-12) {
+							t.Errorf("%s: Dtrmm parallel and serial results differ", name)
+						}
+					}
+				}
+
+				name := fmt.Sprintf("m=%d,n=%d,side=%c,uplo=%c", m, n, s, ul)
+				ldc := n + 2
+				c := randomNormSlice(m*ldc, rnd)
+				want := append([]float64(nil), c...)
+				dsymmSerial(s, ul, m, n, -0.5, a, lda, b, ldb, 2, want, ldc)
+				got := append([]float64(nil), c...)
+				withWorkers(4, func() { Implementation{}.Dsymm(s, ul, m, n, -0.5, a, lda, b, ldb, 2, got, ldc) })
+				if !floats.EqualApprox(got, want, 1e-12) {
+					t.Errorf("%s: Dsymm parallel and serial results differ", name)
+				}
+			}
+		}
+	}
+
+	for _, dims := range [][2]int{{300, 100}, {513, 20}, {260, 700}} {
+		n, k := dims[0], dims[1]
+		for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, beta := range []float64{0, 1, -0.5} {
+					name := fmt.Sprintf("n=%d,k=%d,uplo=%c,trans=%c,beta=%v", n, k, ul, tA, beta)
+					row, col := k, n
+					if tA == blas.NoTrans {
+						row, col = n, k
+					}
+					lda := col + 1
+					a := randomNormSlice(row*lda, rnd)
+					ldc := n + 4
+					c := randomNormSlice(n*ldc, rnd)
+					want := append([]float64(nil), c...)
+					dsyrkSerial(ul, tA, n, k, 0.7, a, lda, beta, want, ldc)
+					got := append([]float64(nil), c...)
+					withWorkers(4, func() { Implementation{}.Dsyrk(ul, tA, n, k, 0.7, a, lda, beta, got, ldc) })
+					if !floats.EqualApprox(got, want, 1e-12) {
+						t.Errorf("%s: Dsyrk parallel and serial results differ", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestLevel2Parallel(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{1000, 1100}, {1500, 700}, {300, 4000}} {
+		m, n := dims[0], dims[1]
+		lda := n + 3
+		a := randomNormSlice(m*lda, rnd)
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			lenX, lenY := n, m
+			if tA != blas.NoTrans {
+				lenX, lenY = m, n
+			}
+			for _, inc := range [][2]int{{1, 1}, {2, 3}, {-2, 1}, {1, -1}} {
+				incX, incY := inc[0], inc[1]
+				name := fmt.Sprintf("m=%d,n=%d,trans=%c,incX=%d,incY=%d", m, n, tA, incX, incY)
+				x := randomNormSlice((lenX-1)*max(incX, -incX)+1, rnd)
+				y := randomNormSlice((lenY-1)*max(incY, -incY)+1, rnd)
+				want := append([]float64(nil), y...)
+				withWorkers(1, func() { Implementation{}.Dgemv(tA, m, n, 1.5, a, lda, x, incX, -0.5, want, incY) })
+				got := append([]float64(nil), y...)
+				withWorkers(4, func() { Implementation{}.Dgemv(tA, m, n, 1.5, a, lda, x, incX, -0.5, got, incY) })
+				if !floats.EqualApprox(got, want, 1e-12) {
+					t.Errorf("%s: Dgemv parallel and serial results differ", name)
+				}
+			}
+		}
+	}
+
+	for _, n := range []int{800, 1025} {
+		lda := n + 2
+		a := randomNormSlice(n*lda, rnd)
+		for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, inc := range [][2]int{{1, 1}, {3, 2}, {-1, 1}} {
+				incX, incY := inc[0], inc[1]
+				for _, beta := range []float64{0, 1, 2} {
+					name := fmt.Sprintf("n=%d,uplo=%c,incX=%d,incY=%d,beta=%v", n, ul, incX, incY, beta)
+					x := randomNormSlice((n-1)*max(incX, -incX)+1, rnd)
+					y := randomNormSlice((n-1)*max(incY, -incY)+1, rnd)
+					want := append([]float64(nil), y...)
+					dsymvSerial(ul, n, 0.3, a, lda, x, incX, beta, want, incY)
+					got := append([]float64(nil), y...)
+					withWorkers(4, func() { Implementation{}.Dsymv(ul, n, 0.3, a, lda, x, incX, beta, got, incY) })
+					if !floats.EqualApprox(got, want, 1e-12) {
+						t.Errorf("%s: Dsymv parallel and serial results differ", name)
+					}
+				}
+			}
+		}
+	}
+}
@@ -134,11 +134,29 @@ cat level2float64.go \
 | gofmt -r 'f64.GemvT -> f32.GemvT' \
 | gofmt -r 'Implementation{}.Dscal -> Implementation{}.Sscal' \
 \
+| gofmt -r 'dgemvParallel -> sgemvParallel' \
+| gofmt -r 'dsymvParallel -> ssymvParallel' \
+| gofmt -r 'dsymvSerial -> ssymvSerial' \
+\
 | sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
       -e 's_^// D_// S_' \
+      -e 's_^// d_// s_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level2float32.go
 
+echo Generating level2float32_parallel.go
+echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > level2float32_parallel.go
+cat level2float64_parallel.go \
+| gofmt -r 'float64 -> float32' \
+\
+| gofmt -r 'f64.GemvN -> f32.GemvN' \
+| gofmt -r 'f64.GemvT -> f32.GemvT' \
+\
+| sed -e 's_D\(gemv\|symv\)_S\1_g' \
+      -e 's_d\(gemv\|symv\)\(Serial\|Parallel\)_s\1\2_g' \
+      -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
+>> level2float32_parallel.go
+
 echo Generating level2cmplx64.go
 echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > level2cmplx64.go
 cat level2cmplx128.go \
@@ -174,11 +192,30 @@ cat level3float64.go \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
 | gofmt -r 'f64.ScalUnitary -> f32.ScalUnitary' \
 \
+| gofmt -r 'dtrsmParallel -> strsmParallel' \
+| gofmt -r 'dtrsmSerial -> strsmSerial' \
+| gofmt -r 'dsymmParallel -> ssymmParallel' \
+| gofmt -r 'dsymmSerial -> ssymmSerial' \
+| gofmt -r 'dsyrkParallel -> ssyrkParallel' \
+| gofmt -r 'dsyrkSerial -> ssyrkSerial' \
+| gofmt -r 'dtrmmParallel -> strmmParallel' \
+| gofmt -r 'dtrmmSerial -> strmmSerial' \
+\
 | sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNINGF32\1S\2_" \
       -e 's_^// D_// S_' \
+      -e 's_^// d_// s_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level3float32.go
 
+echo Generating level3float32_parallel.go
+echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > level3float32_parallel.go
+cat level3float64_parallel.go \
+| gofmt -r 'float64 -> float32' \
+\
+| sed -e 's_D\(trsm\|symm\|syrk\|trmm\)_S\1_g' \
+      -e 's_d\(trsm\|symm\|syrk\|trmm\|gemm\)\(Serial\|Parallel\)_s\1\2_g' \
+>> level3float32_parallel.go
+
 echo Generating sgemm.go
 echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > sgemm.go
 cat dgemm.go \
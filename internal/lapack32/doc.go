@@ -0,0 +1,9 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lapack32 provides single precision versions of the LAPACK routines
+// used by gonum/mat and gonum/mat32.
+//
+// All matrices are stored in row-major order.
+package lapack32 // import "gonum.org/v1/gonum/internal/lapack32"
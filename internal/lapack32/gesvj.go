@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+const (
+	// gesvjMaxSweep is the maximum number of sweeps performed by Sgesvj.
+	gesvjMaxSweep = 30
+	// eps is the machine epsilon of single precision.
+	eps = 0x1p-24
+)
+
+// Sgesvj computes the singular value decomposition of the m×n matrix A with
+// m ≤ n using one-sided Jacobi rotations applied to the rows of A,
+//
+//	A = Q * Σ * Vᵀ,
+//
+// where Q is an m×m orthogonal matrix, Σ is an m×m diagonal matrix and the
+// rows of Vᵀ are orthonormal.
+//
+// On return, s contains the singular values in decreasing order and the rows
+// of a contain the corresponding rows of Vᵀ. If q is not nil, it is overwritten
+// with Q.
+//
+// The dot products used to test for orthogonality are accumulated in double
+// precision, so the singular values are computed to high relative accuracy.
+// Sgesvj returns whether the rotations converged.
+func Sgesvj(m, n int, a []float32, lda int, s []float32, q []float32, ldq int) (ok bool) {
+	if m > n {
+		panic("lapack32: m > n")
+	}
+	if q != nil {
+		for i := 0; i < m; i++ {
+			row := q[i*ldq : i*ldq+m]
+			for j := range row {
+				row[j] = 0
+			}
+			row[i] = 1
+		}
+	}
+	tol := float64(m) * eps
+	for sweep := 0; sweep < gesvjMaxSweep; sweep++ {
+		rotated := false
+		for p := 0; p < m-1; p++ {
+			ap := blas32.Vector{N: n, Data: a[p*lda:], Inc: 1}
+			for r := p + 1; r < m; r++ {
+				ar := blas32.Vector{N: n, Data: a[r*lda:], Inc: 1}
+				alpha := blas32.DDot(ap, ap)
+				beta := blas32.DDot(ar, ar)
+				gamma := blas32.DDot(ap, ar)
+				if math.Abs(gamma) <= tol*math.Sqrt(alpha)*math.Sqrt(beta) {
+					continue
+				}
+				rotated = true
+				zeta := (beta - alpha) / (2 * gamma)
+				t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Hypot(1, zeta))
+				c := 1 / math.Sqrt(1+t*t)
+				sn := c * t
+				blas32.Rot(n, ap, ar, float32(c), float32(-sn))
+				if q != nil {
+					blas32.Rot(m,
+						blas32.Vector{N: m, Data: q[p:], Inc: ldq},
+						blas32.Vector{N: m, Data: q[r:], Inc: ldq},
+						float32(c), float32(-sn))
+				}
+			}
+		}
+		if !rotated {
+			ok = true
+			break
+		}
+	}
+
+	for i := 0; i < m; i++ {
+		ai := blas32.Vector{N: n, Data: a[i*lda:], Inc: 1}
+		s[i] = float32(math.Sqrt(blas32.DDot(ai, ai)))
+	}
+
+	// Sort the singular values into decreasing order.
+	for i := 0; i < m-1; i++ {
+		k := i
+		for j := i + 1; j < m; j++ {
+			if s[j] > s[k] {
+				k = j
+			}
+		}
+		if k == i {
+			continue
+		}
+		s[i], s[k] = s[k], s[i]
+		blas32.Swap(blas32.Vector{N: n, Data: a[i*lda:], Inc: 1}, blas32.Vector{N: n, Data: a[k*lda:], Inc: 1})
+		if q != nil {
+			blas32.Swap(blas32.Vector{N: m, Data: q[i:], Inc: ldq}, blas32.Vector{N: m, Data: q[k:], Inc: ldq})
+		}
+	}
+
+	// Normalize the rows, completing an orthonormal set for the rows
+	// corresponding to zero singular values.
+	for i := 0; i < m; i++ {
+		ai := blas32.Vector{N: n, Data: a[i*lda:], Inc: 1}
+		if s[i] != 0 {
+			blas32.Scal(1/s[i], ai)
+			continue
+		}
+		completeRow(i, n, a, lda)
+	}
+	return ok
+}
+
+// completeRow overwrites row i of the matrix in a with a unit vector that is
+// orthogonal to the orthonormal rows above it.
+func completeRow(i, n int, a []float32, lda int) {
+	ai := blas32.Vector{N: n, Data: a[i*lda:], Inc: 1}
+	for k := 0; k < n; k++ {
+		for j := range a[i*lda : i*lda+n] {
+			a[i*lda+j] = 0
+		}
+		a[i*lda+k] = 1
+		// Orthogonalize twice for numerical stability.
+		for range 2 {
+			for j := 0; j < i; j++ {
+				aj := blas32.Vector{N: n, Data: a[j*lda:], Inc: 1}
+				blas32.Axpy(-blas32.Dot(aj, ai), aj, ai)
+			}
+		}
+		if nrm := blas32.Nrm2(ai); nrm > 0.5 {
+			blas32.Scal(1/nrm, ai)
+			return
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// getrfBlock is the block size used by Sgetrf.
+const getrfBlock = 64
+
+// Sgetrf computes the LU factorization of the n×n matrix A with partial
+// pivoting using a blocked right-looking algorithm. On return, a contains the
+// factors L and U and ipiv contains the row interchanges as in
+// lapack.Float64.Dgetrf. Sgetrf returns whether A is nonsingular.
+func Sgetrf(n int, a []float32, lda int, ipiv []int) (ok bool) {
+	ok = true
+	for j := 0; j < n; j += getrfBlock {
+		jb := min(n-j, getrfBlock)
+
+		// Factor the panel A[j:n, j:j+jb].
+		if !sgetf2(n-j, jb, a[j*lda+j:], lda, ipiv[j:j+jb]) {
+			ok = false
+		}
+
+		// Apply the interchanges to the columns outside the panel.
+		for i := j; i < j+jb; i++ {
+			ipiv[i] += j
+			p := ipiv[i]
+			if p == i {
+				continue
+			}
+			ri := a[i*lda : i*lda+n]
+			rp := a[p*lda : p*lda+n]
+			for k := 0; k < j; k++ {
+				ri[k], rp[k] = rp[k], ri[k]
+			}
+			for k := j + jb; k < n; k++ {
+				ri[k], rp[k] = rp[k], ri[k]
+			}
+		}
+
+		if j+jb < n {
+			// Compute the block row of U and update the trailing
+			// submatrix.
+			rest := n - j - jb
+			u12 := blas32.General{Rows: jb, Cols: rest, Data: a[j*lda+j+jb:], Stride: lda}
+			blas32.Trsm(blas.Left, blas.NoTrans, 1,
+				blas32.Triangular{Uplo: blas.Lower, Diag: blas.Unit, N: jb, Data: a[j*lda+j:], Stride: lda},
+				u12)
+			blas32.Gemm(blas.NoTrans, blas.NoTrans, -1,
+				blas32.General{Rows: rest, Cols: jb, Data: a[(j+jb)*lda+j:], Stride: lda},
+				u12,
+				1, blas32.General{Rows: rest, Cols: rest, Data: a[(j+jb)*lda+j+jb:], Stride: lda})
+		}
+	}
+	return ok
+}
+
+// sgetf2 computes the LU factorization of the m×n matrix A with partial
+// pivoting using the unblocked algorithm. The row interchanges are stored in
+// ipiv relative to the first row of A. sgetf2 returns whether all pivots are
+// nonzero.
+func sgetf2(m, n int, a []float32, lda int, ipiv []int) (ok bool) {
+	ok = true
+	for j := 0; j < min(m, n); j++ {
+		p := j + blas32.Iamax(blas32.Vector{N: m - j, Data: a[j*lda+j:], Inc: lda})
+		ipiv[j] = p
+		if a[p*lda+j] == 0 {
+			ok = false
+			continue
+		}
+		if p != j {
+			blas32.Swap(blas32.Vector{N: n, Data: a[j*lda:], Inc: 1}, blas32.Vector{N: n, Data: a[p*lda:], Inc: 1})
+		}
+		if j < m-1 {
+			blas32.Scal(1/a[j*lda+j], blas32.Vector{N: m - j - 1, Data: a[(j+1)*lda+j:], Inc: lda})
+		}
+		if j < m-1 && j < n-1 {
+			blas32.Ger(-1,
+				blas32.Vector{N: m - j - 1, Data: a[(j+1)*lda+j:], Inc: lda},
+				blas32.Vector{N: n - j - 1, Data: a[j*lda+j+1:], Inc: 1},
+				blas32.General{Rows: m - j - 1, Cols: n - j - 1, Data: a[(j+1)*lda+j+1:], Stride: lda})
+		}
+	}
+	return ok
+}
+
+// Sgetrs solves a system of equations using the LU factorization of the n×n
+// matrix A computed by Sgetrf. If trans is blas.NoTrans, Sgetrs solves
+//
+//	A * X = B
+//
+// and otherwise it solves
+//
+//	Aᵀ * X = B.
+//
+// On return, b contains the solution X.
+func Sgetrs(trans blas.Transpose, n, nrhs int, lu []float32, lda int, ipiv []int, b []float32, ldb int) {
+	bm := blas32.General{Rows: n, Cols: nrhs, Data: b, Stride: ldb}
+	l := blas32.Triangular{Uplo: blas.Lower, Diag: blas.Unit, N: n, Data: lu, Stride: lda}
+	u := blas32.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: n, Data: lu, Stride: lda}
+	if trans == blas.NoTrans {
+		for i := 0; i < n; i++ {
+			swapRows(b, ldb, nrhs, i, ipiv[i])
+		}
+		blas32.Trsm(blas.Left, blas.NoTrans, 1, l, bm)
+		blas32.Trsm(blas.Left, blas.NoTrans, 1, u, bm)
+		return
+	}
+	blas32.Trsm(blas.Left, blas.Trans, 1, u, bm)
+	blas32.Trsm(blas.Left, blas.Trans, 1, l, bm)
+	for i := n - 1; i >= 0; i-- {
+		swapRows(b, ldb, nrhs, i, ipiv[i])
+	}
+}
+
+// swapRows swaps rows i and p of the matrix with n columns stored in b.
+func swapRows(b []float32, ldb, n, i, p int) {
+	if p == i {
+		return
+	}
+	bi := b[i*ldb : i*ldb+n]
+	bp := b[p*ldb : p*ldb+n]
+	for k := range bi {
+		bi[k], bp[k] = bp[k], bi[k]
+	}
+}
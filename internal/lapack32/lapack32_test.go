@@ -0,0 +1,254 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// randGeneral returns an r×c matrix with random elements and the given
+// stride.
+func randGeneral(r, c, stride int, rnd *rand.Rand) []float32 {
+	a := make([]float32, r*stride)
+	for i := range a {
+		a[i] = float32(rnd.NormFloat64())
+	}
+	return a
+}
+
+// to64 returns the r×c matrix stored in a as a double precision matrix.
+func to64(r, c int, a []float32, lda int) blas64.General {
+	g := blas64.General{Rows: r, Cols: c, Stride: c, Data: make([]float64, r*c)}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			g.Data[i*c+j] = float64(a[i*lda+j])
+		}
+	}
+	return g
+}
+
+// maxDiff returns the largest absolute difference between the elements of a
+// and b.
+func maxDiff(a, b blas64.General) float64 {
+	var d float64
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			d = math.Max(d, math.Abs(a.Data[i*a.Stride+j]-b.Data[i*b.Stride+j]))
+		}
+	}
+	return d
+}
+
+func TestSgetrfSgetrs(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 63, 64, 65, 150} {
+		for _, lda := range []int{n, n + 3} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				const nrhs = 3
+				a := randGeneral(n, n, lda, rnd)
+				x := randGeneral(n, nrhs, nrhs, rnd)
+				b := blas64.General{Rows: n, Cols: nrhs, Stride: nrhs, Data: make([]float64, n*nrhs)}
+				blas64.Gemm(trans, blas.NoTrans, 1, to64(n, n, a, lda), to64(n, nrhs, x, nrhs), 0, b)
+				rhs := make([]float32, n*nrhs)
+				for i, v := range b.Data {
+					rhs[i] = float32(v)
+				}
+
+				lu := append([]float32(nil), a...)
+				ipiv := make([]int, n)
+				if !Sgetrf(n, lu, lda, ipiv) {
+					t.Errorf("n=%d lda=%d: unexpected singular matrix", n, lda)
+					continue
+				}
+				for i, p := range ipiv {
+					if p < i || n <= p {
+						t.Errorf("n=%d lda=%d: invalid pivot %d at %d", n, lda, p, i)
+					}
+				}
+				Sgetrs(trans, n, nrhs, lu, lda, ipiv, rhs, nrhs)
+				got := to64(n, nrhs, rhs, nrhs)
+				want := to64(n, nrhs, x, nrhs)
+				if d := maxDiff(got, want); d > 1e-2 {
+					t.Errorf("n=%d lda=%d trans=%v: unexpected solution error %v", n, lda, trans, d)
+				}
+			}
+		}
+	}
+
+	a := []float32{1, 2, 2, 4}
+	if Sgetrf(2, a, 2, make([]int, 2)) {
+		t.Error("unexpected success for singular matrix")
+	}
+}
+
+func TestSpotrfSpotrs(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 63, 64, 65, 150} {
+		for _, lda := range []int{n, n + 3} {
+			// Construct a well-conditioned symmetric positive definite
+			// matrix A = Gᵀ*G + n*I.
+			g := to64(n, n, randGeneral(n, n, n, rnd), n)
+			spd := blas64.General{Rows: n, Cols: n, Stride: n, Data: make([]float64, n*n)}
+			blas64.Gemm(blas.Trans, blas.NoTrans, 1, g, g, 0, spd)
+			a := make([]float32, n*lda)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a[i*lda+j] = float32(spd.Data[i*n+j])
+				}
+				a[i*lda+i] += float32(n)
+				// The lower triangle must not be referenced.
+				for j := 0; j < i; j++ {
+					a[i*lda+j] = float32(math.NaN())
+				}
+			}
+			aCopy := append([]float32(nil), a...)
+
+			if !Spotrf(n, a, lda) {
+				t.Errorf("n=%d lda=%d: unexpected failure", n, lda)
+				continue
+			}
+			u := to64(n, n, a, lda)
+			for i := 0; i < n; i++ {
+				for j := 0; j < i; j++ {
+					u.Data[i*n+j] = 0
+				}
+			}
+			utu := blas64.General{Rows: n, Cols: n, Stride: n, Data: make([]float64, n*n)}
+			blas64.Gemm(blas.Trans, blas.NoTrans, 1, u, u, 0, utu)
+			var d float64
+			for i := 0; i < n; i++ {
+				for j := i; j < n; j++ {
+					d = math.Max(d, math.Abs(utu.Data[i*n+j]-float64(aCopy[i*lda+j])))
+				}
+			}
+			if d > 1e-4*float64(n) {
+				t.Errorf("n=%d lda=%d: unexpected reconstruction error %v", n, lda, d)
+			}
+
+			const nrhs = 2
+			x := randGeneral(n, nrhs, nrhs, rnd)
+			for i := 0; i < n; i++ {
+				for j := 0; j < i; j++ {
+					aCopy[i*lda+j] = aCopy[j*lda+i]
+				}
+			}
+			b := blas64.General{Rows: n, Cols: nrhs, Stride: nrhs, Data: make([]float64, n*nrhs)}
+			blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, to64(n, n, aCopy, lda), to64(n, nrhs, x, nrhs), 0, b)
+			rhs := make([]float32, n*nrhs)
+			for i, v := range b.Data {
+				rhs[i] = float32(v)
+			}
+			Spotrs(n, nrhs, a, lda, rhs, nrhs)
+			if d := maxDiff(to64(n, nrhs, rhs, nrhs), to64(n, nrhs, x, nrhs)); d > 1e-4 {
+				t.Errorf("n=%d lda=%d: unexpected solution error %v", n, lda, d)
+			}
+		}
+	}
+
+	a := []float32{1, 2, 2, 1}
+	if Spotrf(2, a, 2) {
+		t.Error("unexpected success for indefinite matrix")
+	}
+}
+
+func TestSgesvj(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{m: 1, n: 1, rank: 1},
+		{m: 1, n: 4, rank: 1},
+		{m: 3, n: 3, rank: 3},
+		{m: 4, n: 7, rank: 4},
+		{m: 10, n: 25, rank: 10},
+		{m: 6, n: 8, rank: 3},
+		{m: 5, n: 5, rank: 0},
+		{m: 30, n: 40, rank: 30},
+	} {
+		name := fmt.Sprintf("m=%d n=%d rank=%d", test.m, test.n, test.rank)
+		m, n := test.m, test.n
+		lda := n + 2
+		// Construct A with the requested rank as a product of random
+		// m×rank and rank×n matrices.
+		a := make([]float32, m*lda)
+		if test.rank > 0 {
+			l := to64(m, test.rank, randGeneral(m, test.rank, test.rank, rnd), test.rank)
+			r := to64(test.rank, n, randGeneral(test.rank, n, n, rnd), n)
+			prod := blas64.General{Rows: m, Cols: n, Stride: n, Data: make([]float64, m*n)}
+			blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, l, r, 0, prod)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					a[i*lda+j] = float32(prod.Data[i*n+j])
+				}
+			}
+		}
+		a64 := to64(m, n, a, lda)
+
+		s := make([]float32, m)
+		q := make([]float32, m*m)
+		if !Sgesvj(m, n, a, lda, s, q, m) {
+			t.Errorf("%s: unexpected failure to converge", name)
+			continue
+		}
+
+		// Compare the singular values with the double precision values.
+		want := make([]float64, m)
+		work := make([]float64, 1)
+		acopy := blas64.General{Rows: m, Cols: n, Stride: n, Data: append([]float64(nil), a64.Data...)}
+		lapack64.Gesvd(lapack.SVDNone, lapack.SVDNone, acopy, blas64.General{Stride: 1}, blas64.General{Stride: 1}, want, work, -1)
+		work = make([]float64, int(work[0]))
+		lapack64.Gesvd(lapack.SVDNone, lapack.SVDNone, acopy, blas64.General{Stride: 1}, blas64.General{Stride: 1}, want, work, len(work))
+		if !sort.SliceIsSorted(s, func(i, j int) bool { return s[i] > s[j] }) {
+			t.Errorf("%s: singular values not sorted: %v", name, s)
+		}
+		scale := math.Max(want[0], 1)
+		for i := range want {
+			if math.Abs(float64(s[i])-want[i]) > 1e-5*scale {
+				t.Errorf("%s: unexpected singular value %d: got %v, want %v", name, i, s[i], want[i])
+			}
+		}
+
+		// Check that Q and the rows of Vᵀ are orthonormal.
+		vt := to64(m, n, a, lda)
+		qm := to64(m, m, q, m)
+		for _, g := range []struct {
+			name string
+			a    blas64.General
+		}{{"Vᵀ", vt}, {"Q", qm}} {
+			prod := blas64.General{Rows: m, Cols: m, Stride: m, Data: make([]float64, m*m)}
+			blas64.Gemm(blas.NoTrans, blas.Trans, 1, g.a, g.a, 0, prod)
+			for i := 0; i < m; i++ {
+				prod.Data[i*m+i]--
+			}
+			if d := maxDiff(prod, blas64.General{Rows: m, Cols: m, Stride: m, Data: make([]float64, m*m)}); d > 1e-5 {
+				t.Errorf("%s: %s not orthonormal: max error %v", name, g.name, d)
+			}
+		}
+
+		// Check the reconstruction A = Q * Σ * Vᵀ.
+		for i := 0; i < m; i++ {
+			for j := 0; j < m; j++ {
+				qm.Data[i*m+j] *= float64(s[j])
+			}
+		}
+		rec := blas64.General{Rows: m, Cols: n, Stride: n, Data: make([]float64, m*n)}
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, qm, vt, 0, rec)
+		if d := maxDiff(rec, a64); d > 1e-5*scale {
+			t.Errorf("%s: unexpected reconstruction error %v", name, d)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/internal/math32"
+)
+
+// potrfBlock is the block size used by Spotrf.
+const potrfBlock = 64
+
+// Spotrf computes the Cholesky factorization
+//
+//	A = Uᵀ * U
+//
+// of the n×n symmetric positive definite matrix A using a blocked algorithm.
+// Only the upper triangle of a is referenced, and on return it contains U.
+// Spotrf returns whether A is positive definite. If A is not positive
+// definite, a is left in an unspecified state.
+func Spotrf(n int, a []float32, lda int) (ok bool) {
+	for j := 0; j < n; j += potrfBlock {
+		jb := min(n-j, potrfBlock)
+		akk := blas32.Symmetric{Uplo: blas.Upper, N: jb, Data: a[j*lda+j:], Stride: lda}
+		if j > 0 {
+			// Update the diagonal block with the rows of U above it.
+			blas32.Syrk(blas.Trans, -1,
+				blas32.General{Rows: j, Cols: jb, Data: a[j:], Stride: lda},
+				1, akk)
+		}
+		if !spotf2(jb, a[j*lda+j:], lda) {
+			return false
+		}
+		if j+jb < n {
+			rest := n - j - jb
+			akr := blas32.General{Rows: jb, Cols: rest, Data: a[j*lda+j+jb:], Stride: lda}
+			if j > 0 {
+				blas32.Gemm(blas.Trans, blas.NoTrans, -1,
+					blas32.General{Rows: j, Cols: jb, Data: a[j:], Stride: lda},
+					blas32.General{Rows: j, Cols: rest, Data: a[j+jb:], Stride: lda},
+					1, akr)
+			}
+			blas32.Trsm(blas.Left, blas.Trans, 1,
+				blas32.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: jb, Data: a[j*lda+j:], Stride: lda},
+				akr)
+		}
+	}
+	return true
+}
+
+// spotf2 computes the Cholesky factorization of the n×n symmetric positive
+// definite matrix A using the unblocked algorithm, storing U in the upper
+// triangle of a. spotf2 returns whether A is positive definite.
+func spotf2(n int, a []float32, lda int) (ok bool) {
+	for j := 0; j < n; j++ {
+		col := blas32.Vector{N: j, Data: a[j:], Inc: lda}
+		ajj := a[j*lda+j] - blas32.Dot(col, col)
+		if ajj <= 0 || math32.IsNaN(ajj) {
+			return false
+		}
+		ajj = math32.Sqrt(ajj)
+		a[j*lda+j] = ajj
+		if j < n-1 {
+			row := blas32.Vector{N: n - j - 1, Data: a[j*lda+j+1:], Inc: 1}
+			if j > 0 {
+				blas32.Gemv(blas.Trans, -1,
+					blas32.General{Rows: j, Cols: n - j - 1, Data: a[j+1:], Stride: lda},
+					col, 1, row)
+			}
+			blas32.Scal(1/ajj, row)
+		}
+	}
+	return true
+}
+
+// Spotrs solves
+//
+//	A * X = B
+//
+// using the Cholesky factorization A = Uᵀ * U computed by Spotrf. On return,
+// b contains the solution X.
+func Spotrs(n, nrhs int, u []float32, ldu int, b []float32, ldb int) {
+	t := blas32.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: n, Data: u, Stride: ldu}
+	bm := blas32.General{Rows: n, Cols: nrhs, Data: b, Stride: ldb}
+	blas32.Trsm(blas.Left, blas.Trans, 1, t, bm)
+	blas32.Trsm(blas.Left, blas.NoTrans, 1, t, bm)
+}
@@ -14,3 +14,11 @@ func BenchmarkDgeev(b *testing.B)  { testlapack.DgeevBenchmark(b, impl) }
 func BenchmarkDlangb(b *testing.B) { testlapack.DlangbBenchmark(b, impl) }
 func BenchmarkDlantb(b *testing.B) { testlapack.DlantbBenchmark(b, impl) }
 func BenchmarkDlaqr5(b *testing.B) { testlapack.Dlaqr5Benchmark(b, impl) }
+
+func BenchmarkDgetrf(b *testing.B) { testlapack.DgetrfBenchmark(b, impl) }
+func BenchmarkDpotrf(b *testing.B) { testlapack.DpotrfBenchmark(b, impl) }
+func BenchmarkDgeqrf(b *testing.B) { testlapack.DgeqrfBenchmark(b, impl) }
+
+func BenchmarkParallelDgetrf(b *testing.B) { testlapack.DgetrfBenchmark(b, Parallel{}) }
+func BenchmarkParallelDpotrf(b *testing.B) { testlapack.DpotrfBenchmark(b, Parallel{}) }
+func BenchmarkParallelDgeqrf(b *testing.B) { testlapack.DgeqrfBenchmark(b, Parallel{}) }
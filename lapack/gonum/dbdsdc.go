@@ -0,0 +1,500 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// dbdsdcSmall is the size of the subproblems in Dbdsdc that are solved
+// directly by Dbdsqr.
+const dbdsdcSmall = 25
+
+// Dbdsdc computes the singular value decomposition of an n×n upper or lower
+// bidiagonal matrix B
+//
+//	B = U * S * Vᵀ
+//
+// using the divide and conquer method, where S is a diagonal matrix of the
+// singular values of B, and U and V are orthogonal matrices of the left and
+// right singular vectors of B.
+//
+// uplo specifies whether B is upper or lower bidiagonal.
+//
+// compq specifies whether the singular vectors are computed. If
+// compq == lapack.SVDCompExplicit, u and vt contain on return the left and
+// right singular vectors of B, stored as the columns of U and the rows of Vᵀ,
+// respectively. If compq == lapack.SVDCompNone, u and vt are not referenced.
+//
+// d, on entry, contains the diagonal elements of B. On exit, d contains the
+// singular values of B in decreasing order. d must have length n and Dbdsdc
+// will panic otherwise.
+//
+// e, on entry, contains the off-diagonal elements of B and is overwritten
+// during the call to Dbdsdc. e must have length n-1 and Dbdsdc will panic
+// otherwise.
+//
+// work must have length at least max(1, 4*n) if compq == lapack.SVDCompNone
+// and at least max(1, 3*n*n+12*n) otherwise. iwork must have length at least
+// 8*n.
+//
+// Dbdsdc returns whether the decomposition was successful. The decomposition
+// can fail only when Dbdsqr fails on one of the subproblems.
+//
+// Dbdsdc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dbdsdc(uplo blas.Uplo, compq lapack.SVDComp, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool) {
+	vectors := compq == lapack.SVDCompExplicit
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case compq != lapack.SVDCompNone && !vectors:
+		panic(badSVDComp)
+	case n < 0:
+		panic(nLT0)
+	case ldu < 1, vectors && ldu < n:
+		panic(badLdU)
+	case ldvt < 1, vectors && ldvt < n:
+		panic(badLdVT)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	lwmin := 4 * n
+	if vectors {
+		lwmin = 3*n*n + 12*n
+	}
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case vectors && len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case vectors && len(vt) < (n-1)*ldvt+n:
+		panic(shortVT)
+	case len(work) < lwmin:
+		panic(shortWork)
+	case len(iwork) < 8*n:
+		panic(shortIWork)
+	}
+
+	if !vectors {
+		return impl.Dbdsqr(uplo, n, 0, 0, 0, d, e, nil, 1, nil, 1, nil, 1, work)
+	}
+
+	if n == 1 {
+		u[0] = 1
+		vt[0] = math.Copysign(1, d[0])
+		d[0] = math.Abs(d[0])
+		return true
+	}
+
+	if n <= dbdsdcSmall {
+		impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+		impl.Dlaset(blas.All, n, n, 0, 1, vt, ldvt)
+		return impl.Dbdsqr(uplo, n, n, n, 0, d, e, vt, ldvt, u, ldu, nil, 1, work)
+	}
+
+	// Scale the matrix to unit norm.
+	orgnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+	if orgnrm == 0 {
+		impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+		impl.Dlaset(blas.All, n, n, 0, 1, vt, ldvt)
+		return true
+	}
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n-1, 1, e, 1)
+
+	impl.Dlaset(blas.All, n, n, 0, 0, u, ldu)
+	impl.Dlaset(blas.All, n, n, 0, 0, vt, ldvt)
+	if uplo == blas.Upper {
+		ok = impl.dbdsdcUpper(n, 0, d, e, u, ldu, vt, ldvt, work, iwork)
+	} else {
+		// The transpose of a lower bidiagonal matrix is upper bidiagonal
+		// with the same diagonal and off-diagonal elements, so the roles
+		// of the left and right singular vectors are swapped.
+		ok = impl.dbdsdcUpper(n, 0, d, e, vt, ldvt, u, ldu, work, iwork)
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				u[i*ldu+j], u[j*ldu+i] = u[j*ldu+i], u[i*ldu+j]
+				vt[i*ldvt+j], vt[j*ldvt+i] = vt[j*ldvt+i], vt[i*ldvt+j]
+			}
+		}
+	}
+	impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+	return ok
+}
+
+// dbdsdcUpper computes the singular value decomposition of the n×(n+sqre)
+// upper bidiagonal matrix B with diagonal d and off-diagonal e, where sqre
+// is 0 or 1. If sqre == 1, e[n-1] is the element B[n-1,n] of the extra
+// column. On entry, the n×n block of u and the (n+sqre)×(n+sqre) block of vt
+// must be zero. On return, d contains the singular values in decreasing
+// order, the columns of u contain the left singular vectors, and the rows of
+// vt contain the right singular vectors. When sqre == 1, the last row of vt
+// spans the null space of B.
+//
+// B is split at row n/2 into an upper and lower block whose decompositions
+// are computed recursively and merged by dbdsdcMerge.
+func (impl Implementation) dbdsdcUpper(n, sqre int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool) {
+	if n <= dbdsdcSmall {
+		m := n + sqre
+		impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+		impl.Dlaset(blas.All, m, m, 0, 1, vt, ldvt)
+		uplo := blas.Upper
+		if sqre == 1 {
+			// Rotate the extra column out of B from the right. This
+			// makes the leading n×n block lower bidiagonal and the last
+			// column zero.
+			bi := blas64.Implementation()
+			for i := 0; i < n; i++ {
+				c, s, r := impl.Dlartg(d[i], e[i])
+				d[i] = r
+				if i < n-1 {
+					e[i] = s * d[i+1]
+					d[i+1] *= c
+				}
+				bi.Drot(m, vt[i*ldvt:], 1, vt[(i+1)*ldvt:], 1, c, s)
+			}
+			uplo = blas.Lower
+		}
+		return impl.Dbdsqr(uplo, n, m, n, 0, d, e, vt, ldvt, u, ldu, nil, 1, work)
+	}
+
+	nl := n / 2
+	nr := n - nl - 1
+	alpha := d[nl]
+	beta := e[nl]
+	if !impl.dbdsdcUpper(nl, 1, d, e, u, ldu, vt, ldvt, work, iwork) {
+		return false
+	}
+	if !impl.dbdsdcUpper(nr, sqre, d[nl+1:], e[nl+1:], u[(nl+1)*ldu+nl+1:], ldu, vt[(nl+1)*ldvt+nl+1:], ldvt, work, iwork) {
+		return false
+	}
+	impl.dbdsdcMerge(n, nl, sqre, alpha, beta, d, u, ldu, vt, ldvt, work, iwork)
+	return true
+}
+
+// dbdsdcMerge computes the singular value decomposition of the n×(n+sqre)
+// upper bidiagonal matrix
+//
+//	B = [B_1    0 ]
+//	    [α*e_l β*e_f]
+//	    [ 0    B_2]
+//
+// where B_1 is nl×(nl+1), B_2 is (n-nl-1)×(n-nl-1+sqre), and e_l and e_f are
+// the last and first unit vectors of matching size. On entry, d, u and vt
+// hold the singular value decompositions of B_1 and B_2 as computed by
+// dbdsdcUpper, with singular values in decreasing order.
+//
+// Applying the singular vectors of B_1 and B_2 reduces B to a matrix that is
+// zero except for its diagonal and a dense row z. After deflation, the
+// singular values of this matrix are the roots of the secular equation solved
+// by dlasd4, and the singular vectors are computed from the formula of Gu and
+// Eisenstat which ensures their orthogonality.
+func (impl Implementation) dbdsdcMerge(n, nl, sqre int, alpha, beta float64, d, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) {
+	bi := blas64.Implementation()
+	m := n + sqre
+
+	z := work[:m]
+	dd := work[m : 2*m]
+	ds := work[2*m : 2*m+n]
+	zs := work[2*m+n : 2*m+2*n]
+	dl := work[2*m+2*n : 2*m+3*n]
+	zl := work[2*m+3*n : 2*m+4*n]
+	sigma := work[2*m+4*n : 2*m+5*n]
+	delta := work[2*m+5*n : 2*m+6*n]
+	sum := work[2*m+6*n : 2*m+7*n]
+	w := work[2*m+7*n:]
+	g := w[:n*n]
+	x := w[n*n : 2*n*n]
+	tmp := w[2*n*n : 2*n*n+n*m]
+	perm := iwork[:n]
+	nd := iwork[n : 2*n]
+	df := iwork[2*n : 3*n]
+
+	// The middle row of B in the basis of the right singular vectors of
+	// B_1 and B_2 is formed from the last column of V_1ᵀ and the first
+	// column of V_2ᵀ.
+	u[nl*ldu+nl] = 1
+	for j := 0; j <= nl; j++ {
+		z[j] = alpha * vt[j*ldvt+nl]
+		dd[j] = d[j]
+	}
+	for j := nl + 1; j < m; j++ {
+		z[j] = beta * vt[j*ldvt+nl+1]
+	}
+	copy(dd[nl+1:n], d[nl+1:n])
+	dd[nl] = 0
+	if sqre == 1 {
+		// Combine the two columns corresponding to the null spaces of
+		// B_1 and B_2 so that z[n] becomes zero.
+		dd[n] = 0
+		r := math.Hypot(z[nl], z[n])
+		c, s := 1.0, 0.0
+		if r != 0 {
+			c = z[nl] / r
+			s = z[n] / r
+		}
+		z[nl] = r
+		z[n] = 0
+		bi.Drot(m, vt[nl*ldvt:], 1, vt[n*ldvt:], 1, c, s)
+	}
+
+	// Order the columns so that the one with the zero diagonal comes first
+	// followed by the singular values of B_1 and B_2 in increasing order.
+	// Because the columns of u correspond to the rows of the reduced
+	// matrix with the same index, perm applies to both u and vt.
+	perm[0] = nl
+	for i, j, k := nl-1, n-1, 1; k < n; k++ {
+		if j == nl || (i >= 0 && d[i] <= d[j]) {
+			perm[k] = i
+			i--
+		} else {
+			perm[k] = j
+			j--
+		}
+	}
+	for k, p := range perm[:n] {
+		ds[k] = dd[p]
+		zs[k] = z[p]
+	}
+
+	// Determine the deflation tolerance.
+	var dmax float64
+	for k := 1; k < n; k++ {
+		dmax = math.Max(dmax, math.Abs(ds[k]))
+	}
+	tol := 8 * dlamchE * math.Max(dmax, math.Max(math.Abs(alpha), math.Abs(beta)))
+
+	// The column with the zero diagonal is never deflated.
+	if math.Abs(zs[0]) <= tol {
+		zs[0] = tol
+	}
+
+	// Deflate singular values with small components of z and pairs of
+	// close singular values. The latter are deflated by a Givens rotation
+	// that zeros one of the components of z.
+	nd[0] = 0
+	k := 1
+	var ndf int
+	pj := -1
+	for j := 1; j < n; j++ {
+		if math.Abs(zs[j]) <= tol {
+			df[ndf] = j
+			ndf++
+			continue
+		}
+		if pj < 0 {
+			// Keep the smallest non-deflated singular value away from
+			// the pole at zero.
+			ds[j] = math.Max(ds[j], tol/2)
+			pj = j
+			continue
+		}
+		if ds[j]-ds[pj] <= tol {
+			s := zs[pj]
+			c := zs[j]
+			tau := impl.Dlapy2(c, s)
+			c /= tau
+			s = -s / tau
+			zs[j] = tau
+			zs[pj] = 0
+			bi.Drot(n, u[perm[pj]:], ldu, u[perm[j]:], ldu, c, s)
+			bi.Drot(m, vt[perm[pj]*ldvt:], 1, vt[perm[j]*ldvt:], 1, c, s)
+			df[ndf] = pj
+			ndf++
+		} else {
+			nd[k] = pj
+			k++
+		}
+		pj = j
+	}
+	if pj >= 0 {
+		nd[k] = pj
+		k++
+	}
+	for j := 0; j < k; j++ {
+		dl[j] = ds[nd[j]]
+		zl[j] = zs[nd[j]]
+	}
+	dl[0] = 0
+
+	// Solve the secular equation. g[i*k+j] holds dl[i]^2 - sigma[j]^2,
+	// which is computed without cancellation.
+	g = g[:k*k]
+	x = x[:k*k]
+	for j := 0; j < k; j++ {
+		sigma[j] = dlasd4(k, j, dl, zl, delta, sum)
+		for i := 0; i < k; i++ {
+			g[i*k+j] = delta[i] * sum[i]
+		}
+	}
+
+	// Recompute z so that the computed singular values are the exact
+	// singular values of a nearby problem.
+	for i := 0; i < k; i++ {
+		v := g[i*k+i]
+		for j := 0; j < k; j++ {
+			if j != i {
+				v *= g[i*k+j] / ((dl[i] - dl[j]) * (dl[i] + dl[j]))
+			}
+		}
+		zl[i] = math.Copysign(math.Sqrt(math.Abs(v)), zl[i])
+	}
+
+	// Form the left singular vectors of the reduced matrix and update the
+	// left singular vectors of B.
+	for j := 0; j < k; j++ {
+		x[j] = -1
+		for i := 1; i < k; i++ {
+			x[i*k+j] = dl[i] * zl[i] / g[i*k+j]
+		}
+		nrm := bi.Dnrm2(k, x[j:], k)
+		bi.Dscal(k, 1/nrm, x[j:], k)
+	}
+	for j := 0; j < k; j++ {
+		bi.Dcopy(n, u[perm[nd[j]]:], ldu, tmp[j:], n)
+	}
+	for j := 0; j < ndf; j++ {
+		bi.Dcopy(n, u[perm[df[j]]:], ldu, tmp[k+j:], n)
+	}
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, n, k, k, 1, tmp, n, x, k, 0, u, ldu)
+	if ndf > 0 {
+		impl.Dlacpy(blas.All, n, ndf, tmp[k:], n, u[k:], ldu)
+	}
+
+	// Form the right singular vectors of the reduced matrix and update the
+	// right singular vectors of B.
+	for j := 0; j < k; j++ {
+		for i := 0; i < k; i++ {
+			x[i*k+j] = zl[i] / g[i*k+j]
+		}
+		nrm := bi.Dnrm2(k, x[j:], k)
+		bi.Dscal(k, 1/nrm, x[j:], k)
+	}
+	for j := 0; j < k; j++ {
+		bi.Dcopy(m, vt[perm[nd[j]]*ldvt:], 1, tmp[j*m:], 1)
+	}
+	for j := 0; j < ndf; j++ {
+		bi.Dcopy(m, vt[perm[df[j]]*ldvt:], 1, tmp[(k+j)*m:], 1)
+	}
+	bi.Dgemm(blas.Trans, blas.NoTrans, k, m, k, 1, x, k, tmp, m, 0, vt, ldvt)
+	if ndf > 0 {
+		impl.Dlacpy(blas.All, ndf, m, tmp[k*m:], m, vt[k*ldvt:], ldvt)
+	}
+
+	copy(d[:k], sigma[:k])
+	for j := 0; j < ndf; j++ {
+		d[k+j] = ds[df[j]]
+	}
+
+	// Sort the singular values into decreasing order.
+	for i := 0; i < n-1; i++ {
+		p := i
+		for j := i + 1; j < n; j++ {
+			if d[j] > d[p] {
+				p = j
+			}
+		}
+		if p != i {
+			d[i], d[p] = d[p], d[i]
+			bi.Dswap(n, u[i:], ldu, u[p:], ldu)
+			bi.Dswap(m, vt[i*ldvt:], 1, vt[p*ldvt:], 1)
+		}
+	}
+}
+
+// dlasd4 finds the j-th root of the secular equation
+//
+//	f(σ) = 1 + \sum_i z_i^2 / (d_i^2 - σ^2) = 0
+//
+// where d holds k values in strictly increasing order with d_0 = 0. The root
+// lies in the interval (d_j, d_{j+1}), or (d_{k-1}, sqrt(d_{k-1}^2 + zᵀz))
+// when j == k-1. dlasd4 returns the root and stores d_i - σ into delta[i] and
+// d_i + σ into sum[i]. To avoid cancellation, the root is computed as an
+// offset from the closest pole using a safeguarded Newton iteration.
+func dlasd4(k, j int, d, z, delta, sum []float64) float64 {
+	const maxit = 400
+
+	var org int
+	var lo, hi float64
+	if j == k-1 {
+		org = j
+		var zz float64
+		for i := 0; i < k; i++ {
+			zz += z[i] * z[i]
+		}
+		hi = zz / (math.Sqrt(d[j]*d[j]+zz) + d[j])
+	} else {
+		// Determine which pole the root is closest to from the sign of
+		// f at the midpoint of the interval.
+		mid := (d[j+1] - d[j]) / 2
+		f := 1.0
+		for i := 0; i < k; i++ {
+			f += z[i] * z[i] / (((d[i] - d[j]) - mid) * ((d[i] + d[j]) + mid))
+		}
+		if f >= 0 {
+			org = j
+			hi = mid
+		} else {
+			org = j + 1
+			lo = -mid
+		}
+	}
+	for i := 0; i < k; i++ {
+		delta[i] = d[i] - d[org]
+		sum[i] = d[i] + d[org]
+	}
+
+	// f is increasing in the interval, so the root is bracketed by lo and
+	// hi. Newton steps are taken while they stay within the bracket and
+	// reduce it sufficiently, and bisection steps otherwise.
+	eps := dlamchE
+	tau := (lo + hi) / 2
+	prev := math.Inf(1)
+	for it := 0; it < maxit; it++ {
+		sigma := d[org] + tau
+		f := 1.0
+		var df float64
+		for i := 0; i < k; i++ {
+			t := 1 / ((delta[i] - tau) * (sum[i] + tau))
+			w := z[i] * z[i] * t
+			f += w
+			df += w * t
+		}
+		df *= 2 * sigma
+		if f == 0 {
+			break
+		}
+		if f < 0 {
+			lo = tau
+		} else {
+			hi = tau
+		}
+		width := hi - lo
+		if width <= 2*eps*math.Max(math.Abs(lo), math.Abs(hi)) {
+			break
+		}
+		next := tau - f/df
+		if next <= lo || next >= hi || width > prev/2 {
+			next = (lo + hi) / 2
+		}
+		prev = width
+		tau = next
+	}
+	for i := 0; i < k; i++ {
+		delta[i] -= tau
+		sum[i] += tau
+	}
+	return d[org] + tau
+}
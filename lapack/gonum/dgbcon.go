@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgbcon estimates and returns the reciprocal of the condition number of the
+// n×n band matrix A with kl sub-diagonals and ku super-diagonals, in either the
+// 1-norm or the ∞-norm, using the LU factorization computed by Dgbtrf. See the
+// documentation for Dgbtrf for a description of the storage format of ab and
+// ipiv.
+//
+// An estimate is obtained for norm(A⁻¹), and the reciprocal of the condition
+// number rcond is computed as
+//
+//	rcond 1 / ( norm(A) * norm(A⁻¹) ).
+//
+// If n is zero, rcond is always 1.
+//
+// anorm is the 1-norm or the ∞-norm of the original matrix A. anorm must be
+// non-negative, otherwise Dgbcon will panic. If anorm is 0 or infinity, Dgbcon
+// returns 0. If anorm is NaN, Dgbcon returns NaN.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dgbcon will panic.
+func (impl Implementation) Dgbcon(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case anorm < 0:
+		panic(negANorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	// Quick return if possible.
+	switch {
+	case anorm == 0:
+		return 0
+	case math.IsNaN(anorm):
+		// Propagate NaN.
+		return anorm
+	case math.IsInf(anorm, 1):
+		return 0
+	}
+
+	bi := blas64.Implementation()
+	var rcond, ainvnm float64
+	var kase int
+	var normin bool
+	isave := new([3]int)
+	onenrm := norm == lapack.MaxColumnSum
+	smlnum := dlamchS
+	kase1 := 2
+	if onenrm {
+		kase1 = 1
+	}
+	kd := kl + ku
+	x := work[:n]
+	cnorm := work[2*n : 3*n]
+	for {
+		ainvnm, kase = impl.Dlacn2(n, work[n:2*n], x, iwork, ainvnm, kase, isave)
+		if kase == 0 {
+			if ainvnm != 0 {
+				rcond = (1 / ainvnm) / anorm
+			}
+			return rcond
+		}
+		var scale float64
+		if kase == kase1 {
+			// Multiply by inv(L).
+			if kl > 0 {
+				for j := 0; j < n-1; j++ {
+					lm := min(kl, n-1-j)
+					jp := ipiv[j]
+					t := x[jp]
+					if jp != j {
+						x[jp] = x[j]
+						x[j] = t
+					}
+					bi.Daxpy(lm, -t, ab[(j+1)*ldab+kl-1:], ldab-1, x[j+1:], 1)
+				}
+			}
+			// Multiply by inv(U).
+			scale = impl.Dlatbs(blas.Upper, blas.NoTrans, blas.NonUnit, normin, n, kd, ab[kl:], ldab, x, cnorm)
+		} else {
+			// Multiply by inv(Uᵀ).
+			scale = impl.Dlatbs(blas.Upper, blas.Trans, blas.NonUnit, normin, n, kd, ab[kl:], ldab, x, cnorm)
+			// Multiply by inv(Lᵀ).
+			if kl > 0 {
+				for j := n - 2; j >= 0; j-- {
+					lm := min(kl, n-1-j)
+					x[j] -= bi.Ddot(lm, ab[(j+1)*ldab+kl-1:], ldab-1, x[j+1:], 1)
+					if jp := ipiv[j]; jp != j {
+						x[jp], x[j] = x[j], x[jp]
+					}
+				}
+			}
+		}
+		normin = true
+		if scale != 1 {
+			ix := bi.Idamax(n, x, 1)
+			if scale == 0 || scale < math.Abs(x[ix])*smlnum {
+				return rcond
+			}
+			impl.Drscl(n, scale, x, 1)
+		}
+	}
+}
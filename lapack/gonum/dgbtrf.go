@@ -0,0 +1,122 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// Dgbtrf computes an LU factorization of an m×n band matrix A with kl
+// sub-diagonals and ku super-diagonals using partial pivoting with row
+// interchanges.
+//
+// The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is a unit lower triangular matrix with at
+// most kl non-zero elements below the diagonal in each column, and U is an
+// upper triangular band matrix with kl+ku super-diagonals.
+//
+// The band storage scheme is illustrated below when m = n = 6, kl = 2 and
+// ku = 1. On entry, the first kl+ku+1 columns of each row of ab contain the
+// band of A stored as in a BLAS band matrix with kl sub-diagonals and ku
+// super-diagonals, and the last kl columns, marked with +, need not be set:
+//
+//	On entry:                          On return:
+//	 *   *  a00 a01  +   +              *   *  u00 u01 u02 u03
+//	 *  a10 a11 a12  +   +              *  m10 u11 u12 u13 u14
+//	a20 a21 a22 a23  +   +             m20 m21 u22 u23 u24 u25
+//	a31 a32 a33 a34  +   *             m31 m32 u33 u34 u35  *
+//	a42 a43 a44 a45  *   *             m42 m43 u44 u45  *   *
+//	a53 a54 a55  *   *   *             m53 m54 u55  *   *   *
+//
+// On return, U is stored in ab as an upper triangular band matrix with kl+ku
+// super-diagonals starting at column kl, and the multipliers used during the
+// factorization are stored below the diagonal. Element (i,j) of the band
+// is therefore stored in ab[i*ldab+kl+j-i]. Array elements marked * are not
+// used by the routine. ldab must be at least 2*kl+ku+1.
+//
+// ipiv contains a sequence of row interchanges: row i of the matrix was
+// interchanged with row ipiv[i] after the first i columns were eliminated.
+// Unlike Dgetrf, later interchanges are not applied to the multipliers of
+// earlier columns. ipiv must have length min(m,n), and Dgbtrf will panic
+// otherwise. ipiv is zero-indexed.
+//
+// Dgbtrf returns whether the matrix A is nonsingular. The factorization will
+// be computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equations.
+func (Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (min(m, n+kl)-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	// kv is the number of super-diagonals of U.
+	kv := ku + kl
+
+	// Zero the fill-in elements above the band of A.
+	for i := 0; i < min(m, n); i++ {
+		for j := i + ku + 1; j <= min(i+kv, n-1); j++ {
+			ab[i*ldab+kl+j-i] = 0
+		}
+	}
+
+	bi := blas64.Implementation()
+	ok = true
+	// ju is the index of the last column affected by the current stage of
+	// the factorization.
+	var ju int
+	for j := 0; j < mn; j++ {
+		// Column j of A below the diagonal is stored with stride ldab-1.
+		km := min(kl, m-1-j)
+		var jp int
+		if km > 0 {
+			jp = bi.Idamax(km+1, ab[j*ldab+kl:], ldab-1)
+		}
+		ipiv[j] = j + jp
+		if ab[(j+jp)*ldab+kl-jp] == 0 {
+			// The pivot is zero so the column is already eliminated.
+			ok = false
+			continue
+		}
+		ju = max(ju, min(j+ku+jp, n-1))
+		if jp != 0 {
+			// Rows j and j+jp are contiguous between columns j and ju.
+			bi.Dswap(ju-j+1, ab[(j+jp)*ldab+kl-jp:], 1, ab[j*ldab+kl:], 1)
+		}
+		if km > 0 {
+			// Compute the multipliers and update the trailing
+			// submatrix, whose rows are stored with stride ldab-1.
+			bi.Dscal(km, 1/ab[j*ldab+kl], ab[(j+1)*ldab+kl-1:], ldab-1)
+			if ju > j {
+				bi.Dger(km, ju-j, -1,
+					ab[(j+1)*ldab+kl-1:], ldab-1,
+					ab[j*ldab+kl+1:], 1,
+					ab[(j+1)*ldab+kl:], ldab-1)
+			}
+		}
+	}
+	return ok
+}
@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgbtrs solves a system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+//
+// with an n×n band matrix A with kl sub-diagonals and ku super-diagonals using
+// the LU factorization computed by Dgbtrf. See the documentation for Dgbtrf for
+// a description of the storage format of ab and ipiv.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, it is
+// overwritten with the solution matrix X.
+func (Implementation) Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	bi := blas64.Implementation()
+	kd := kl + ku
+	if trans == blas.NoTrans {
+		// Solve L * Y = B, applying the row interchanges and the
+		// multipliers of each column in turn.
+		if kl > 0 {
+			for j := 0; j < n-1; j++ {
+				lm := min(kl, n-1-j)
+				if p := ipiv[j]; p != j {
+					bi.Dswap(nrhs, b[p*ldb:], 1, b[j*ldb:], 1)
+				}
+				bi.Dger(lm, nrhs, -1, ab[(j+1)*ldab+kl-1:], ldab-1, b[j*ldb:], 1, b[(j+1)*ldb:], ldb)
+			}
+		}
+		// Solve U * X = Y, overwriting B with X.
+		for j := 0; j < nrhs; j++ {
+			bi.Dtbsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, kd, ab[kl:], ldab, b[j:], ldb)
+		}
+		return
+	}
+
+	// Solve Uᵀ * Y = B, overwriting B with Y.
+	for j := 0; j < nrhs; j++ {
+		bi.Dtbsv(blas.Upper, blas.Trans, blas.NonUnit, n, kd, ab[kl:], ldab, b[j:], ldb)
+	}
+	// Solve Lᵀ * X = Y, applying the multipliers and the row interchanges
+	// in reverse order.
+	if kl > 0 {
+		for j := n - 2; j >= 0; j-- {
+			lm := min(kl, n-1-j)
+			bi.Dgemv(blas.Trans, lm, nrhs, -1, b[(j+1)*ldb:], ldb, ab[(j+1)*ldab+kl-1:], ldab-1, 1, b[j*ldb:], 1)
+			if p := ipiv[j]; p != j {
+				bi.Dswap(nrhs, b[p*ldb:], 1, b[j*ldb:], 1)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dgeequ computes row and column scalings intended to equilibrate an m×n
+// matrix A and reduce its condition number.
+//
+// On return, r and c contain the row and column scale factors such that the
+// largest element in each row and column of the matrix B with elements
+//
+//	B[i,j] = r[i] * A[i,j] * c[j]
+//
+// has absolute value 1. The scale factors are restricted to the range
+// between the smallest safe number and its reciprocal, and they are not
+// powers of the radix so the scaling may introduce rounding errors.
+//
+// rowcnd is the ratio of the smallest r[i] to the largest r[i]. If rowcnd is
+// at least 0.1 and amax is neither too large nor too small, it is not worth
+// scaling by r. colcnd is the corresponding ratio for c and if it is at least
+// 0.1, it is not worth scaling by c. amax is the absolute value of the largest
+// element of A. If amax is very close to overflow or underflow, A should be
+// scaled.
+//
+// If a row or column of A is exactly zero, ok is false and the returned scale
+// factors and ratios must not be used.
+//
+// r must have length at least m and c must have length at least n, otherwise
+// Dgeequ will panic.
+func (impl Implementation) Dgeequ(m, n int, a []float64, lda int, r, c []float64) (rowcnd, colcnd, amax float64, ok bool) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, 1, 0, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(r) < m:
+		panic(shortR)
+	case len(c) < n:
+		panic(shortC)
+	}
+	r = r[:m]
+	c = c[:n]
+
+	const (
+		smlnum = dlamchS
+		bignum = 1 / smlnum
+	)
+
+	// Compute the row scale factors.
+	for i := 0; i < m; i++ {
+		var rmax float64
+		for _, v := range a[i*lda : i*lda+n] {
+			rmax = math.Max(rmax, math.Abs(v))
+		}
+		r[i] = rmax
+	}
+	rcmin := bignum
+	var rcmax float64
+	for _, v := range r {
+		rcmax = math.Max(rcmax, v)
+		rcmin = math.Min(rcmin, v)
+	}
+	amax = rcmax
+	if rcmin == 0 {
+		// A has a zero row.
+		return 0, 0, amax, false
+	}
+	for i, v := range r {
+		r[i] = 1 / math.Min(math.Max(v, smlnum), bignum)
+	}
+	rowcnd = math.Max(rcmin, smlnum) / math.Min(rcmax, bignum)
+
+	// Compute the column scale factors assuming that the row scaling
+	// has been applied.
+	for j := range c {
+		c[j] = 0
+	}
+	for i := 0; i < m; i++ {
+		for j, v := range a[i*lda : i*lda+n] {
+			c[j] = math.Max(c[j], math.Abs(v)*r[i])
+		}
+	}
+	rcmin = bignum
+	rcmax = 0
+	for _, v := range c {
+		rcmin = math.Min(rcmin, v)
+		rcmax = math.Max(rcmax, v)
+	}
+	if rcmin == 0 {
+		// A has a zero column.
+		return rowcnd, 0, amax, false
+	}
+	for j, v := range c {
+		c[j] = 1 / math.Min(math.Max(v, smlnum), bignum)
+	}
+	colcnd = math.Max(rcmin, smlnum) / math.Min(rcmax, bignum)
+	return rowcnd, colcnd, amax, true
+}
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgerfs improves the computed solution to a system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans,
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n matrix, and provides error bounds and backward error
+// estimates for the solution.
+//
+// a contains the original matrix A and af and ipiv contain its LU
+// factorization as computed by Dgetrf. b contains the n×nrhs right-hand side
+// matrix B. On entry, x contains the solution matrix X as computed by Dgetrs
+// and on return it contains the improved solution.
+//
+// On return, ferr[j] contains an estimated error bound for the j-th solution
+// vector x_j, the j-th column of X,
+//
+//	|x_j - xtrue_j|_∞ / |x_j|_∞ <= ferr[j],
+//
+// where xtrue_j is the true solution. The estimate is almost always a slight
+// overestimate of the true error. berr[j] contains the componentwise relative
+// backward error of x_j, the smallest relative change in any element of A or
+// b_j that makes x_j an exact solution.
+//
+// ferr and berr must have length at least nrhs, work must have length at
+// least 3*n and iwork must have length at least n, otherwise Dgerfs will
+// panic.
+func (impl Implementation) Dgerfs(trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	switch {
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		for j := 0; j < nrhs; j++ {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	const itmax = 5
+	var (
+		nz    = float64(n + 1)
+		eps   = dlamchE
+		safe1 = nz * dlamchS
+		safe2 = safe1 / eps
+	)
+
+	notran := trans == blas.NoTrans
+	transt := blas.Trans
+	if !notran {
+		trans = blas.Trans
+		transt = blas.NoTrans
+	}
+
+	bi := blas64.Implementation()
+	// work[:n] holds |B| + |op(A)|*|X|, work[n:2*n] the residual and
+	// work[2*n:3*n] is used by Dlacn2.
+	absAX := work[:n]
+	res := work[n : 2*n]
+	isave := new([3]int)
+	for j := 0; j < nrhs; j++ {
+		count := 1
+		lstres := 3.0
+		for {
+			// Compute the residual R = B - op(A) * X.
+			bi.Dcopy(n, b[j:], ldb, res, 1)
+			bi.Dgemv(trans, n, n, -1, a, lda, x[j:], ldx, 1, res, 1)
+
+			// Compute the componentwise relative backward error
+			//  max_i |R_i| / (|op(A)|*|X| + |B|)_i.
+			// If a denominator is tiny, a small number is added to
+			// both the numerator and the denominator to avoid
+			// spurious underflow.
+			for i := 0; i < n; i++ {
+				absAX[i] = math.Abs(b[i*ldb+j])
+			}
+			if notran {
+				for i := 0; i < n; i++ {
+					var s float64
+					for k, v := range a[i*lda : i*lda+n] {
+						s += math.Abs(v) * math.Abs(x[k*ldx+j])
+					}
+					absAX[i] += s
+				}
+			} else {
+				for k := 0; k < n; k++ {
+					xk := math.Abs(x[k*ldx+j])
+					for i, v := range a[k*lda : k*lda+n] {
+						absAX[i] += math.Abs(v) * xk
+					}
+				}
+			}
+			var s float64
+			for i, v := range absAX {
+				if v > safe2 {
+					s = math.Max(s, math.Abs(res[i])/v)
+				} else {
+					s = math.Max(s, (math.Abs(res[i])+safe1)/(v+safe1))
+				}
+			}
+			berr[j] = s
+
+			// Stop if the backward error is at the level of
+			// machine precision, if it has not decreased by at
+			// least a factor of 2, or if the maximum number of
+			// steps has been reached.
+			if berr[j] <= eps || 2*berr[j] > lstres || count > itmax {
+				break
+			}
+			// Update the solution and try again.
+			impl.Dgetrs(trans, n, 1, af, ldaf, ipiv, res, 1)
+			bi.Daxpy(n, 1, res, 1, x[j:], ldx)
+			lstres = berr[j]
+			count++
+		}
+
+		// Bound the error in the computed solution using
+		//  |inv(op(A))| * (|R| + nz*eps*(|op(A)|*|X|+|B|)),
+		// where |R| is the absolute value of the residual. The norm
+		// of the matrix inv(op(A))*diag(W) with
+		//  W = |R| + nz*eps*(|op(A)|*|X|+|B|)
+		// is estimated with Dlacn2.
+		for i, v := range absAX {
+			absAX[i] = math.Abs(res[i]) + nz*eps*v
+			if v <= safe2 {
+				absAX[i] += safe1
+			}
+		}
+		ferr[j] = 0
+		kase := 0
+		for {
+			ferr[j], kase = impl.Dlacn2(n, work[2*n:3*n], res, iwork, ferr[j], kase, isave)
+			if kase == 0 {
+				break
+			}
+			if kase == 1 {
+				// Multiply by diag(W)*inv(op(A))ᵀ.
+				impl.Dgetrs(transt, n, 1, af, ldaf, ipiv, res, 1)
+				for i, w := range absAX {
+					res[i] *= w
+				}
+			} else {
+				// Multiply by inv(op(A))*diag(W).
+				for i, w := range absAX {
+					res[i] *= w
+				}
+				impl.Dgetrs(trans, n, 1, af, ldaf, ipiv, res, 1)
+			}
+		}
+
+		// Normalize the error.
+		var xnorm float64
+		for i := 0; i < n; i++ {
+			xnorm = math.Max(xnorm, math.Abs(x[i*ldx+j]))
+		}
+		if xnorm != 0 {
+			ferr[j] /= xnorm
+		}
+	}
+}
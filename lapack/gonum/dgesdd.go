@@ -0,0 +1,395 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgesdd computes the singular value decomposition of the input matrix A
+// using the divide and conquer method.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᵀ
+//
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// For large matrices Dgesdd is usually significantly faster than Dgesvd when
+// the singular vectors are computed.
+//
+// jobz specifies how the singular vectors are computed. The behavior is as
+// follows
+//
+//	jobz == lapack.SVDAll       All m columns of U and all n rows of Vᵀ are
+//	                            returned in u and vt.
+//	jobz == lapack.SVDStore     The first min(m,n) columns of U and rows of
+//	                            Vᵀ are returned in u and vt.
+//	jobz == lapack.SVDOverwrite If m >= n, the first n columns of U are written
+//	                            into a and all rows of Vᵀ are returned in vt.
+//	                            Otherwise, all columns of U are returned in u
+//	                            and the first m rows of Vᵀ are written into a.
+//	jobz == lapack.SVDNone      The singular vectors are not computed.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Dgesdd
+// the data is overwritten. On exit, A contains the appropriate singular vectors
+// if jobz == lapack.SVDOverwrite.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored column-wise. u is of
+// size m×m if jobz == lapack.SVDAll or jobz == lapack.SVDOverwrite and m < n,
+// and of size m×min(m,n) if jobz == lapack.SVDStore. Otherwise u is not used.
+//
+// vt contains the right singular vectors on exit, stored row-wise. vt is of
+// size n×n if jobz == lapack.SVDAll or jobz == lapack.SVDOverwrite and m >= n,
+// and of size min(m,n)×n if jobz == lapack.SVDStore. Otherwise vt is not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. With mn = min(m,n) and mx = max(m,n), lwork must be at least
+//
+//	4*mn + max(mx, 4*mn)                                if jobz == lapack.SVDNone,
+//	4*mn + mn*mn + max(mx, 3*mn*mn+12*mn)               if jobz == lapack.SVDAll or lapack.SVDStore,
+//	4*mn + mn*mn + mx*mn + max(mx, 3*mn*mn+12*mn)       if jobz == lapack.SVDOverwrite.
+//
+// If lwork == -1, instead of performing Dgesdd, the optimal work length will be
+// stored into work[0]. Dgesdd will panic if the working memory has insufficient
+// storage.
+//
+// iwork must have length at least 8*min(m,n).
+//
+// Dgesdd returns whether the decomposition successfully completed.
+func (impl Implementation) Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool) {
+	wantqa := jobz == lapack.SVDAll
+	wantqs := jobz == lapack.SVDStore
+	wantqo := jobz == lapack.SVDOverwrite
+	wantqn := jobz == lapack.SVDNone
+
+	minmn := min(m, n)
+	maxmn := max(m, n)
+
+	// Determine the number of columns of U stored in u and the number of
+	// rows of Vᵀ stored in vt.
+	var ucol, vtrow int
+	switch {
+	case wantqa:
+		ucol, vtrow = m, n
+	case wantqs:
+		ucol, vtrow = minmn, minmn
+	case wantqo && m >= n:
+		vtrow = n
+	case wantqo:
+		ucol = m
+	}
+
+	minwork := 1
+	if minmn > 0 {
+		if wantqn {
+			minwork = 4*minmn + max(maxmn, 4*minmn)
+		} else {
+			minwork = 4*minmn + minmn*minmn + max(maxmn, 3*minmn*minmn+12*minmn)
+			if wantqo {
+				minwork += maxmn * minmn
+			}
+		}
+	}
+	switch {
+	case !wantqa && !wantqs && !wantqo && !wantqn:
+		panic(badSVDJob)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < max(1, ucol):
+		panic(badLdU)
+	case ldvt < 1, vtrow > 0 && ldvt < n:
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// The workspace starts with the off-diagonal elements of the bidiagonal
+	// matrix, the scalar factors of the elementary reflectors of the
+	// bidiagonal reduction and of the QR or LQ factorization, followed by
+	// the triangular factor, a temporary matrix for jobz ==
+	// lapack.SVDOverwrite, and the workspace for the subroutines.
+	ie := 0
+	itauq := minmn
+	itaup := 2 * minmn
+	itau := 3 * minmn
+	ir := 4 * minmn
+	iu := ir
+	if !wantqn {
+		iu += minmn * minmn
+	}
+	nwork := iu
+	if wantqo {
+		nwork += maxmn * minmn
+	}
+	bdspac := 4 * minmn
+	if !wantqn {
+		bdspac = 3*minmn*minmn + 12*minmn
+	}
+
+	// Use the QR or LQ decomposition first if A has sufficiently more rows
+	// than columns or vice versa.
+	mnthr := int(float64(minmn) * 11 / 6)
+
+	maxwrk := max(maxmn, bdspac)
+	if m >= n {
+		if m >= mnthr {
+			impl.Dgeqrf(m, n, a, lda, nil, work, -1)
+			maxwrk = max(maxwrk, int(work[0]))
+			impl.Dgebrd(n, n, a, lda, nil, nil, nil, nil, work, -1)
+			maxwrk = max(maxwrk, int(work[0]))
+			if !wantqn {
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, a, lda, nil, nil, n, work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, nil, nil, n, work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+				impl.Dormqr(blas.Left, blas.NoTrans, m, max(ucol, n), n, a, lda, nil, nil, max(ucol, n), work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+			}
+		} else {
+			impl.Dgebrd(m, n, a, lda, nil, nil, nil, nil, work, -1)
+			maxwrk = max(maxwrk, int(work[0]))
+			if !wantqn {
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, max(ucol, n), n, a, lda, nil, nil, max(ucol, n), work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, nil, nil, n, work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+			}
+		}
+	} else {
+		if n >= mnthr {
+			impl.Dgelqf(m, n, a, lda, nil, work, -1)
+			maxwrk = max(maxwrk, int(work[0]))
+			impl.Dgebrd(m, m, a, lda, nil, nil, nil, nil, work, -1)
+			maxwrk = max(maxwrk, int(work[0]))
+			if !wantqn {
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, a, lda, nil, nil, m, work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, a, lda, nil, nil, m, work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+				impl.Dormlq(blas.Right, blas.NoTrans, max(vtrow, m), n, m, a, lda, nil, nil, n, work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+			}
+		} else {
+			impl.Dgebrd(m, n, a, lda, nil, nil, nil, nil, work, -1)
+			maxwrk = max(maxwrk, int(work[0]))
+			if !wantqn {
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, nil, nil, m, work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, max(vtrow, m), n, m, a, lda, nil, nil, n, work, -1)
+				maxwrk = max(maxwrk, int(work[0]))
+			}
+		}
+	}
+	maxwrk = max(nwork+maxwrk, minwork)
+
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) < minmn:
+		panic(shortS)
+	case ucol > 0 && len(u) < (m-1)*ldu+ucol:
+		panic(shortU)
+	case vtrow > 0 && len(vt) < (vtrow-1)*ldvt+n:
+		panic(shortVT)
+	case len(iwork) < 8*minmn:
+		panic(shortIWork)
+	}
+
+	// Scale A if max element outside range [smlnum, bignum].
+	eps := dlamchE
+	smlnum := math.Sqrt(dlamchS) / eps
+	bignum := 1 / smlnum
+	anrm := impl.Dlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iscl bool
+	if anrm > 0 && anrm < smlnum {
+		iscl = true
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+	} else if anrm > bignum {
+		iscl = true
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+	}
+
+	e := work[ie:itauq]
+	tauq := work[itauq:itaup]
+	taup := work[itaup:itau]
+	tau := work[itau:ir]
+	wrk := work[nwork:lwork]
+	lwrk := lwork - nwork
+
+	if m >= n {
+		// The left singular vectors are formed in uu which is either u
+		// or a temporary matrix if they overwrite A.
+		uu, lduu := u, ldu
+		if wantqo {
+			uu, lduu = work[iu:nwork], n
+		}
+		ncu := n
+		if wantqa {
+			ncu = m
+		}
+
+		if m >= mnthr {
+			// Compute A = Q*R.
+			impl.Dgeqrf(m, n, a, lda, tau, wrk, lwrk)
+			if wantqn {
+				// Bidiagonalize R in A and compute its singular values.
+				impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+				impl.Dgebrd(n, n, a, lda, s, e, tauq, taup, wrk, lwrk)
+				ok = impl.Dbdsdc(blas.Upper, lapack.SVDCompNone, n, s, e, nil, 1, nil, 1, wrk, iwork)
+			} else {
+				// Copy R and bidiagonalize it.
+				r := work[ir:iu]
+				impl.Dlacpy(blas.Upper, n, n, a, lda, r, n)
+				impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, r[n:], n)
+				impl.Dgebrd(n, n, r, n, s, e, tauq, taup, wrk, lwrk)
+
+				// Compute the singular vectors of the bidiagonal matrix
+				// and multiply them by the orthogonal matrices of the
+				// bidiagonal reduction.
+				ok = impl.Dbdsdc(blas.Upper, lapack.SVDCompExplicit, n, s, e, uu, lduu, vt, ldvt, wrk, iwork)
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, r, n, tauq, uu, lduu, wrk, lwrk)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, r, n, taup, vt, ldvt, wrk, lwrk)
+
+				// Multiply [U_R 0; 0 I] by Q to form U.
+				if m > n {
+					impl.Dlaset(blas.All, m-n, n, 0, 0, uu[n*lduu:], lduu)
+				}
+				if ncu > n {
+					impl.Dlaset(blas.All, n, m-n, 0, 0, uu[n:], lduu)
+					impl.Dlaset(blas.All, m-n, m-n, 0, 1, uu[n*lduu+n:], lduu)
+				}
+				impl.Dormqr(blas.Left, blas.NoTrans, m, ncu, n, a, lda, tau, uu, lduu, wrk, lwrk)
+				if wantqo {
+					impl.Dlacpy(blas.All, m, n, uu, lduu, a, lda)
+				}
+			}
+		} else {
+			// Bidiagonalize A.
+			impl.Dgebrd(m, n, a, lda, s, e, tauq, taup, wrk, lwrk)
+			if wantqn {
+				ok = impl.Dbdsdc(blas.Upper, lapack.SVDCompNone, n, s, e, nil, 1, nil, 1, wrk, iwork)
+			} else {
+				// Compute the singular vectors of the bidiagonal matrix
+				// and multiply them by the orthogonal matrices of the
+				// bidiagonal reduction.
+				impl.Dlaset(blas.All, m, ncu, 0, 0, uu, lduu)
+				if ncu > n {
+					impl.Dlaset(blas.All, m-n, m-n, 0, 1, uu[n*lduu+n:], lduu)
+				}
+				ok = impl.Dbdsdc(blas.Upper, lapack.SVDCompExplicit, n, s, e, uu, lduu, vt, ldvt, wrk, iwork)
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, ncu, n, a, lda, tauq, uu, lduu, wrk, lwrk)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, taup, vt, ldvt, wrk, lwrk)
+				if wantqo {
+					impl.Dlacpy(blas.All, m, n, uu, lduu, a, lda)
+				}
+			}
+		}
+	} else {
+		// The right singular vectors are formed in vv which is either vt
+		// or a temporary matrix if they overwrite A.
+		vv, ldvv := vt, ldvt
+		if wantqo {
+			vv, ldvv = work[iu:nwork], n
+		}
+		nrvt := m
+		if wantqa {
+			nrvt = n
+		}
+
+		if n >= mnthr {
+			// Compute A = L*Q.
+			impl.Dgelqf(m, n, a, lda, tau, wrk, lwrk)
+			if wantqn {
+				// Bidiagonalize L in A and compute its singular values.
+				impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+				impl.Dgebrd(m, m, a, lda, s, e, tauq, taup, wrk, lwrk)
+				ok = impl.Dbdsdc(blas.Upper, lapack.SVDCompNone, m, s, e, nil, 1, nil, 1, wrk, iwork)
+			} else {
+				// Copy L and bidiagonalize it.
+				l := work[ir:iu]
+				impl.Dlacpy(blas.Lower, m, m, a, lda, l, m)
+				impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, l[1:], m)
+				impl.Dgebrd(m, m, l, m, s, e, tauq, taup, wrk, lwrk)
+
+				// Compute the singular vectors of the bidiagonal matrix
+				// and multiply them by the orthogonal matrices of the
+				// bidiagonal reduction.
+				ok = impl.Dbdsdc(blas.Upper, lapack.SVDCompExplicit, m, s, e, u, ldu, vv, ldvv, wrk, iwork)
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, l, m, tauq, u, ldu, wrk, lwrk)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, l, m, taup, vv, ldvv, wrk, lwrk)
+
+				// Multiply [Vᵀ_L 0; 0 I] by Q to form Vᵀ.
+				impl.Dlaset(blas.All, m, n-m, 0, 0, vv[m:], ldvv)
+				if nrvt > m {
+					impl.Dlaset(blas.All, n-m, m, 0, 0, vv[m*ldvv:], ldvv)
+					impl.Dlaset(blas.All, n-m, n-m, 0, 1, vv[m*ldvv+m:], ldvv)
+				}
+				impl.Dormlq(blas.Right, blas.NoTrans, nrvt, n, m, a, lda, tau, vv, ldvv, wrk, lwrk)
+				if wantqo {
+					impl.Dlacpy(blas.All, m, n, vv, ldvv, a, lda)
+				}
+			}
+		} else {
+			// Bidiagonalize A. The bidiagonal matrix is lower bidiagonal.
+			impl.Dgebrd(m, n, a, lda, s, e, tauq, taup, wrk, lwrk)
+			if wantqn {
+				ok = impl.Dbdsdc(blas.Lower, lapack.SVDCompNone, m, s, e, nil, 1, nil, 1, wrk, iwork)
+			} else {
+				// Compute the singular vectors of the bidiagonal matrix
+				// and multiply them by the orthogonal matrices of the
+				// bidiagonal reduction.
+				impl.Dlaset(blas.All, nrvt, n, 0, 0, vv, ldvv)
+				if nrvt > m {
+					impl.Dlaset(blas.All, n-m, n-m, 0, 1, vv[m*ldvv+m:], ldvv)
+				}
+				ok = impl.Dbdsdc(blas.Lower, lapack.SVDCompExplicit, m, s, e, u, ldu, vv, ldvv, wrk, iwork)
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, tauq, u, ldu, wrk, lwrk)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, nrvt, n, m, a, lda, taup, vv, ldvv, wrk, lwrk)
+				if wantqo {
+					impl.Dlacpy(blas.All, m, n, vv, ldvv, a, lda)
+				}
+			}
+		}
+	}
+
+	// Undo scaling if necessary.
+	if iscl {
+		if anrm > bignum {
+			impl.Dlascl(lapack.General, 0, 0, bignum, anrm, minmn, 1, s, 1)
+		}
+		if anrm < smlnum {
+			impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, minmn, 1, s, 1)
+		}
+	}
+	work[0] = float64(maxwrk)
+	return ok
+}
@@ -0,0 +1,269 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgesvx uses the LU factorization to compute the solution to a system of
+// linear equations
+//
+//	A * X = B   if trans == blas.NoTrans,
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n matrix and X and B are n×nrhs matrices, and provides
+// error bounds on the solution and a condition estimate.
+//
+// Dgesvx performs the following steps:
+//
+//  1. If fact is lapack.FactEquilibrate, scaling factors are computed by
+//     Dgeequ to equilibrate the system. Depending on the returned
+//     Equilibration, A is overwritten by diag(r)*A, A*diag(c) or
+//     diag(r)*A*diag(c), and B is scaled accordingly.
+//  2. If fact is lapack.FactCompute or lapack.FactEquilibrate, the LU
+//     factorization of the (scaled) matrix A is computed by Dgetrf and stored
+//     into af and ipiv.
+//  3. If the factorization found A to be exactly singular, Dgesvx returns
+//     with ok set to false. Otherwise the reciprocal of the condition number of
+//     A is estimated by Dgecon.
+//  4. The system is solved by Dgetrs and the solution is improved by
+//     iterative refinement using Dgerfs.
+//  5. If equilibration was used, the solution is transformed to that of the
+//     original system.
+//
+// If fact is lapack.FactProvided, af and ipiv must contain the LU
+// factorization of A computed by Dgetrf, and equed must specify the
+// equilibration that was applied to A before the factorization, with the
+// corresponding scale factors in r and c. In this case the elements of r and
+// c that are used must be positive, otherwise Dgesvx will panic. For other
+// values of fact, equed is ignored and r and c are used for output.
+//
+// On return, a and b contain the equilibrated matrix A and the scaled matrix
+// B, x contains the n×nrhs solution matrix X of the original system, and
+// ferr[j] and berr[j] contain the estimated forward error bound and the
+// componentwise relative backward error of the j-th column of X as described
+// in the documentation of Dgerfs. The returned value eq is the equilibration
+// that was applied to A.
+//
+// rcond is the estimate of the reciprocal condition number of the
+// equilibrated matrix A. If rcond is less than the machine precision, the
+// matrix is singular to working precision. rpvgrw is the reciprocal pivot
+// growth factor max_j |A[:,j]|_max / |U[:,j]|_max. If rpvgrw is much less
+// than 1, the stability of the LU factorization could be poor and the
+// solution, condition estimate and error bounds could be unreliable. If the
+// factorization failed, rpvgrw is computed over the leading columns up to and
+// including the first zero pivot.
+//
+// r must have length at least n and c must have length at least n, ferr and
+// berr must have length at least nrhs, work must have length at least 4*n and
+// iwork must have length at least n, otherwise Dgesvx will panic.
+func (impl Implementation) Dgesvx(fact lapack.Fact, trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, equed lapack.Equilibration, r, c, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (eq lapack.Equilibration, rcond, rpvgrw float64, ok bool) {
+	nofact := fact == lapack.FactCompute
+	equil := fact == lapack.FactEquilibrate
+	switch {
+	case !nofact && !equil && fact != lapack.FactProvided:
+		panic(badFact)
+	case fact == lapack.FactProvided && equed != lapack.EquilibrateNone && equed != lapack.EquilibrateRow &&
+		equed != lapack.EquilibrateCol && equed != lapack.EquilibrateBoth:
+		panic(badEquilibration)
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	if nofact || equil {
+		equed = lapack.EquilibrateNone
+	}
+	rowequ := equed == lapack.EquilibrateRow || equed == lapack.EquilibrateBoth
+	colequ := equed == lapack.EquilibrateCol || equed == lapack.EquilibrateBoth
+
+	switch {
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		for j := 0; j < nrhs; j++ {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return equed, 1, 1, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(r) < n:
+		panic(shortR)
+	case len(c) < n:
+		panic(shortC)
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < 4*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	rowcnd, colcnd := 1.0, 1.0
+	if rowequ {
+		rowcnd = scaleRatio(r[:n], badScaleR)
+	}
+	if colequ {
+		colcnd = scaleRatio(c[:n], badScaleC)
+	}
+
+	if equil {
+		// Compute the row and column scalings to equilibrate A.
+		var amax float64
+		var ok bool
+		rowcnd, colcnd, amax, ok = impl.Dgeequ(n, n, a, lda, r[:n], c[:n])
+		if ok {
+			// Equilibrate A.
+			equed = impl.Dlaqge(n, n, a, lda, r[:n], c[:n], rowcnd, colcnd, amax)
+			rowequ = equed == lapack.EquilibrateRow || equed == lapack.EquilibrateBoth
+			colequ = equed == lapack.EquilibrateCol || equed == lapack.EquilibrateBoth
+		}
+	}
+
+	// Scale the right-hand side.
+	notran := trans == blas.NoTrans
+	if notran && rowequ {
+		scaleRows(n, nrhs, b, ldb, r)
+	} else if !notran && colequ {
+		scaleRows(n, nrhs, b, ldb, c)
+	}
+
+	if nofact || equil {
+		// Compute the LU factorization of A.
+		impl.Dlacpy(blas.All, n, n, a, lda, af, ldaf)
+		if !impl.Dgetrf(n, n, af, ldaf, ipiv) {
+			// Compute the reciprocal pivot growth factor of the
+			// leading columns up to the first zero pivot.
+			ncols := n
+			for j := 0; j < n; j++ {
+				if af[j*ldaf+j] == 0 {
+					ncols = j + 1
+					break
+				}
+			}
+			return equed, 0, pivotGrowth(n, ncols, a, lda, af, ldaf), false
+		}
+	}
+
+	// Compute the norm of A and the reciprocal pivot growth factor.
+	norm := lapack.MaxColumnSum
+	if !notran {
+		norm = lapack.MaxRowSum
+	}
+	anorm := impl.Dlange(norm, n, n, a, lda, work)
+	rpvgrw = pivotGrowth(n, n, a, lda, af, ldaf)
+
+	// Estimate the reciprocal of the condition number of A.
+	rcond = impl.Dgecon(norm, n, af, ldaf, anorm, work, iwork)
+
+	if nrhs == 0 {
+		return equed, rcond, rpvgrw, true
+	}
+
+	// Compute the solution X and improve it by iterative refinement.
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, x, ldx)
+	impl.Dgetrs(trans, n, nrhs, af, ldaf, ipiv, x, ldx)
+	impl.Dgerfs(trans, n, nrhs, a, lda, af, ldaf, ipiv, b, ldb, x, ldx, ferr, berr, work, iwork)
+
+	// Transform the solution X to the solution of the original system.
+	if notran && colequ {
+		scaleRows(n, nrhs, x, ldx, c)
+		for j := 0; j < nrhs; j++ {
+			ferr[j] /= colcnd
+		}
+	} else if !notran && rowequ {
+		scaleRows(n, nrhs, x, ldx, r)
+		for j := 0; j < nrhs; j++ {
+			ferr[j] /= rowcnd
+		}
+	}
+	return equed, rcond, rpvgrw, true
+}
+
+// scaleRatio returns the ratio of the smallest to the largest element of the
+// scale factors in s, clamped to the range of safe numbers. scaleRatio panics
+// with msg if an element of s is not positive.
+func scaleRatio(s []float64, msg string) float64 {
+	const (
+		smlnum = dlamchS
+		bignum = 1 / smlnum
+	)
+	smin := bignum
+	var smax float64
+	for _, v := range s {
+		smin = math.Min(smin, v)
+		smax = math.Max(smax, v)
+	}
+	if smin <= 0 {
+		panic(msg)
+	}
+	return math.Max(smin, smlnum) / math.Min(smax, bignum)
+}
+
+// scaleRows multiplies the i-th row of the m×n matrix A by s[i].
+func scaleRows(m, n int, a []float64, lda int, s []float64) {
+	if n == 0 {
+		return
+	}
+	for i := 0; i < m; i++ {
+		row := a[i*lda : i*lda+n]
+		for j := range row {
+			row[j] *= s[i]
+		}
+	}
+}
+
+// pivotGrowth returns the reciprocal pivot growth factor
+//
+//	min_j max_i |A[i,j]| / max_i |U[i,j]|
+//
+// over the first ncols columns of the n×n matrix A and the upper triangular
+// factor U stored in af.
+func pivotGrowth(n, ncols int, a []float64, lda int, af []float64, ldaf int) float64 {
+	rpvgrw := 1.0
+	for j := 0; j < ncols; j++ {
+		var amax, umax float64
+		for i := 0; i < n; i++ {
+			amax = math.Max(amax, math.Abs(a[i*lda+j]))
+		}
+		for i := 0; i <= j; i++ {
+			umax = math.Max(umax, math.Abs(af[i*ldaf+j]))
+		}
+		if umax != 0 {
+			rpvgrw = math.Min(rpvgrw, amax/umax)
+		}
+	}
+	return rpvgrw
+}
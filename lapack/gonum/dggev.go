@@ -0,0 +1,276 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dggev computes the generalized eigenvalues and, optionally, the left and/or
+// right generalized eigenvectors for a pair of n×n real nonsymmetric matrices
+// (A,B).
+//
+// A generalized eigenvalue for a pair of matrices (A,B) is a scalar λ or a
+// ratio alpha/beta = λ, such that A - λ*B is singular. It is usually
+// represented as the pair (alpha,beta), as there is a reasonable
+// interpretation for beta == 0, and even for both being zero.
+//
+// The right eigenvector v_j corresponding to the eigenvalue λ_j of (A,B)
+// satisfies
+//
+//	A * v_j = λ_j * B * v_j,
+//
+// and the left eigenvector u_j corresponding to the eigenvalue λ_j of (A,B)
+// satisfies
+//
+//	u_jᴴ * A = λ_j * u_jᴴ * B,
+//
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// On return, A and B will be overwritten and the left and right eigenvectors
+// will be stored, respectively, in the columns of the n×n matrices VL and VR
+// in the same order as their eigenvalues. If the j-th eigenvalue is real, then
+//
+//	u_j = VL[:,j],
+//	v_j = VR[:,j],
+//
+// and if it is not real, then j and j+1 form a complex conjugate pair and the
+// eigenvectors can be recovered as
+//
+//	u_j     = VL[:,j] + i*VL[:,j+1],
+//	u_{j+1} = VL[:,j] - i*VL[:,j+1],
+//	v_j     = VR[:,j] + i*VR[:,j+1],
+//	v_{j+1} = VR[:,j] - i*VR[:,j+1],
+//
+// where i is the imaginary unit. Each eigenvector is scaled so the largest
+// component has |real part| + |imag. part| = 1.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Dggev will panic.
+//
+// On return, (alphar[j] + alphai[j]*i)/beta[j] will be the generalized
+// eigenvalues. If alphai[j] is zero, then the j-th eigenvalue is real; if
+// positive, then the j-th and (j+1)-st eigenvalues are a complex conjugate
+// pair, with alphai[j+1] negative. The quotients alphar[j]/beta[j] and
+// alphai[j]/beta[j] may easily over- or underflow, and beta[j] may even be
+// zero. Thus, the user should avoid naively computing the ratio. alphar,
+// alphai and beta must have length at least n.
+//
+// Unlike the reference implementation, Dggev does not balance the matrix pair
+// before the reduction to generalized Hessenberg form.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n),
+// otherwise Dggev will panic. For good performance, lwork must generally be
+// larger. On return, the optimal value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Dggev, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// Dggev returns whether the computation was successful. If ok is false, the QZ
+// iteration failed or the eigenvectors could not be computed.
+func (impl Implementation) Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	minwrk := max(1, 8*n)
+	switch {
+	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
+		panic(badLeftEVJob)
+	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
+		panic(badRightEVJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldvl < 1 || (ldvl < n && wantvl):
+		panic(badLdVL)
+	case ldvr < 1 || (ldvr < n && wantvr):
+		panic(badLdVR)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	maxwrk := minwrk
+	impl.Dgeqrf(n, n, b, ldb, nil, work, -1)
+	maxwrk = max(maxwrk, n+int(work[0]))
+	impl.Dormqr(blas.Left, blas.Trans, n, n, n, b, ldb, nil, a, lda, work, -1)
+	maxwrk = max(maxwrk, n+int(work[0]))
+	if wantvl {
+		impl.Dorgqr(n, n, n, vl, ldvl, nil, work, -1)
+		maxwrk = max(maxwrk, n+int(work[0]))
+	}
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(alphar) < n:
+		panic(shortAlphar)
+	case len(alphai) < n:
+		panic(shortAlphai)
+	case len(beta) < n:
+		panic(shortBeta)
+	case wantvl && len(vl) < (n-1)*ldvl+n:
+		panic(shortVL)
+	case wantvr && len(vr) < (n-1)*ldvr+n:
+		panic(shortVR)
+	}
+
+	// Get machine constants.
+	smlnum := math.Sqrt(dlamchS) / dlamchP
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum,bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, n, n, a, lda, nil)
+	var ilascl bool
+	var anrmto float64
+	if 0 < anrm && anrm < smlnum {
+		anrmto = smlnum
+		ilascl = true
+	} else if anrm > bignum {
+		anrmto = bignum
+		ilascl = true
+	}
+	if ilascl {
+		impl.Dlascl(lapack.General, 0, 0, anrm, anrmto, n, n, a, lda)
+	}
+
+	// Scale B if max element outside range [smlnum,bignum].
+	bnrm := impl.Dlange(lapack.MaxAbs, n, n, b, ldb, nil)
+	var ilbscl bool
+	var bnrmto float64
+	if 0 < bnrm && bnrm < smlnum {
+		bnrmto = smlnum
+		ilbscl = true
+	} else if bnrm > bignum {
+		bnrmto = bignum
+		ilbscl = true
+	}
+	if ilbscl {
+		impl.Dlascl(lapack.General, 0, 0, bnrm, bnrmto, n, n, b, ldb)
+	}
+
+	// Reduce B to triangular form (QR decomposition of B) and apply the
+	// orthogonal transformation to A.
+	tau := work[:n]
+	iwrk := n
+	impl.Dgeqrf(n, n, b, ldb, tau, work[iwrk:], lwork-iwrk)
+	impl.Dormqr(blas.Left, blas.Trans, n, n, n, b, ldb, tau, a, lda, work[iwrk:], lwork-iwrk)
+
+	// Initialize VL.
+	compq := lapack.OrthoNone
+	if wantvl {
+		compq = lapack.OrthoPostmul
+		impl.Dlaset(blas.All, n, n, 0, 1, vl, ldvl)
+		if n > 1 {
+			impl.Dlacpy(blas.Lower, n-1, n-1, b[ldb:], ldb, vl[ldvl:], ldvl)
+		}
+		impl.Dorgqr(n, n, n, vl, ldvl, tau, work[iwrk:], lwork-iwrk)
+	}
+
+	// Initialize VR.
+	compz := lapack.OrthoNone
+	if wantvr {
+		compz = lapack.OrthoPostmul
+		impl.Dlaset(blas.All, n, n, 0, 1, vr, ldvr)
+	}
+
+	// Reduce to generalized Hessenberg form.
+	impl.Dgghrd(compq, compz, n, 0, n-1, a, lda, b, ldb, vl, ldvl, vr, ldvr)
+
+	// Perform QZ algorithm, computing Schur vectors if desired.
+	job := lapack.EigenvaluesOnly
+	if wantvl || wantvr {
+		job = lapack.EigenvaluesAndSchur
+	}
+	unconverged := impl.Dhgeqz(job, compq, compz, n, 0, n-1, a, lda, b, ldb, alphar, alphai, beta, vl, ldvl, vr, ldvr, work[iwrk:], lwork-iwrk)
+	if unconverged > 0 {
+		work[0] = float64(maxwrk)
+		return false
+	}
+
+	// Compute eigenvectors.
+	if wantvl || wantvr {
+		side := lapack.EVBoth
+		if !wantvl {
+			side = lapack.EVRight
+		} else if !wantvr {
+			side = lapack.EVLeft
+		}
+		_, ok = impl.Dtgevc(side, lapack.EVAllMulQ, nil, n, a, lda, b, ldb, vl, ldvl, vr, ldvr, n, work[iwrk:])
+		if !ok {
+			work[0] = float64(maxwrk)
+			return false
+		}
+		if wantvl {
+			dggevNormalize(n, alphai, vl, ldvl, smlnum)
+		}
+		if wantvr {
+			dggevNormalize(n, alphai, vr, ldvr, smlnum)
+		}
+	}
+
+	// Undo scaling if necessary.
+	if ilascl {
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, 1, alphar, 1)
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, 1, alphai, 1)
+	}
+	if ilbscl {
+		impl.Dlascl(lapack.General, 0, 0, bnrmto, bnrm, n, 1, beta, 1)
+	}
+
+	work[0] = float64(maxwrk)
+	return true
+}
+
+// dggevNormalize scales the eigenvectors stored in the columns of v so that
+// the largest component of each has |real part| + |imag. part| = 1.
+func dggevNormalize(n int, alphai, v []float64, ldv int, smlnum float64) {
+	bi := blas64.Implementation()
+	for jc := 0; jc < n; jc++ {
+		if alphai[jc] < 0 {
+			continue
+		}
+		var temp float64
+		if alphai[jc] == 0 {
+			for jr := 0; jr < n; jr++ {
+				temp = math.Max(temp, math.Abs(v[jr*ldv+jc]))
+			}
+		} else {
+			for jr := 0; jr < n; jr++ {
+				temp = math.Max(temp, math.Abs(v[jr*ldv+jc])+math.Abs(v[jr*ldv+jc+1]))
+			}
+		}
+		if temp < smlnum {
+			continue
+		}
+		temp = 1 / temp
+		bi.Dscal(n, temp, v[jc:], ldv)
+		if alphai[jc] > 0 {
+			bi.Dscal(n, temp, v[jc+1:], ldv)
+		}
+	}
+}
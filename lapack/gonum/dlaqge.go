@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/lapack"
+
+// Dlaqge equilibrates the m×n matrix A using the row and column scale factors
+// in r and c computed by Dgeequ. The returned value indicates the form of
+// equilibration that was applied:
+//
+//	lapack.EquilibrateNone  A is unchanged,
+//	lapack.EquilibrateRow   A is replaced by diag(r)*A,
+//	lapack.EquilibrateCol   A is replaced by A*diag(c),
+//	lapack.EquilibrateBoth  A is replaced by diag(r)*A*diag(c).
+//
+// rowcnd and colcnd are the ratios of the smallest to the largest scale
+// factor in r and c, and amax is the absolute value of the largest element of
+// A, all as returned by Dgeequ. Row scaling is applied only if rowcnd is less
+// than 0.1 or if amax is close to underflow or overflow, and column scaling
+// only if colcnd is less than 0.1.
+//
+// r must have length at least m and c must have length at least n, otherwise
+// Dlaqge will panic.
+//
+// Dlaqge is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) lapack.Equilibration {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return lapack.EquilibrateNone
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(r) < m:
+		panic(shortR)
+	case len(c) < n:
+		panic(shortC)
+	}
+	r = r[:m]
+	c = c[:n]
+
+	const (
+		thresh = 0.1
+		small  = dlamchS / dlamchP
+		large  = 1 / small
+	)
+
+	if rowcnd >= thresh && amax >= small && amax <= large {
+		if colcnd >= thresh {
+			return lapack.EquilibrateNone
+		}
+		// Column scaling.
+		for i := 0; i < m; i++ {
+			row := a[i*lda : i*lda+n]
+			for j, cj := range c {
+				row[j] *= cj
+			}
+		}
+		return lapack.EquilibrateCol
+	}
+	if colcnd >= thresh {
+		// Row scaling.
+		for i, ri := range r {
+			row := a[i*lda : i*lda+n]
+			for j := range row {
+				row[j] *= ri
+			}
+		}
+		return lapack.EquilibrateRow
+	}
+	// Row and column scaling.
+	for i, ri := range r {
+		row := a[i*lda : i*lda+n]
+		for j, cj := range c {
+			row[j] *= ri * cj
+		}
+	}
+	return lapack.EquilibrateBoth
+}
@@ -0,0 +1,72 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlaqsy equilibrates the n×n symmetric matrix A using the scale factors in s
+// computed by Dpoequ. If the scaling is applied, the triangle of A specified by
+// uplo is replaced by the corresponding triangle of diag(s)*A*diag(s) and
+// lapack.EquilibrateSym is returned. Otherwise A is unchanged and
+// lapack.EquilibrateNone is returned.
+//
+// scond is the ratio of the smallest to the largest scale factor in s and
+// amax is the absolute value of the largest element of A, both as returned by
+// Dpoequ. The scaling is applied only if scond is less than 0.1 or if amax is
+// close to underflow or overflow.
+//
+// s must have length at least n, otherwise Dlaqsy will panic.
+//
+// Dlaqsy is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaqsy(uplo blas.Uplo, n int, a []float64, lda int, s []float64, scond, amax float64) lapack.Equilibration {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return lapack.EquilibrateNone
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(s) < n:
+		panic(shortS)
+	}
+	s = s[:n]
+
+	const (
+		thresh = 0.1
+		small  = dlamchS / dlamchP
+		large  = 1 / small
+	)
+
+	if scond >= thresh && amax >= small && amax <= large {
+		return lapack.EquilibrateNone
+	}
+	if uplo == blas.Upper {
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				a[i*lda+j] *= s[i] * s[j]
+			}
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			for j := 0; j <= i; j++ {
+				a[i*lda+j] *= s[i] * s[j]
+			}
+		}
+	}
+	return lapack.EquilibrateSym
+}
@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dpoequ computes row and column scalings intended to equilibrate an n×n
+// symmetric positive definite matrix A and reduce its condition number with
+// respect to the 2-norm.
+//
+// On return, s contains the scale factors
+//
+//	s[i] = 1/sqrt(A[i,i])
+//
+// chosen so that the scaled matrix B with elements
+//
+//	B[i,j] = s[i] * A[i,j] * s[j]
+//
+// has ones on the diagonal. This choice of s puts the condition number of B
+// within a factor n of the smallest possible condition number over all
+// possible diagonal scalings. Only the diagonal of A is referenced.
+//
+// scond is the ratio of the smallest s[i] to the largest s[i]. If scond is at
+// least 0.1 and amax is neither too large nor too small, it is not worth
+// scaling by s. amax is the absolute value of the largest element of A.
+//
+// If a diagonal element of A is not positive, ok is false and the returned
+// scale factors must not be used.
+//
+// s must have length at least n, otherwise Dpoequ will panic.
+func (impl Implementation) Dpoequ(n int, a []float64, lda int, s []float64) (scond, amax float64, ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1, 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(s) < n:
+		panic(shortS)
+	}
+	s = s[:n]
+
+	// Find the minimum and maximum diagonal elements.
+	smin := a[0]
+	amax = a[0]
+	for i := range s {
+		s[i] = a[i*lda+i]
+		smin = math.Min(smin, s[i])
+		amax = math.Max(amax, s[i])
+	}
+	if smin <= 0 {
+		// A has a non-positive diagonal element.
+		return 0, amax, false
+	}
+	for i, v := range s {
+		s[i] = 1 / math.Sqrt(v)
+	}
+	return math.Sqrt(smin) / math.Sqrt(amax), amax, true
+}
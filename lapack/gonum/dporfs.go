@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dporfs improves the computed solution to a system of linear equations
+//
+//	A * X = B
+//
+// where A is an n×n symmetric positive definite matrix, and provides error
+// bounds and backward error estimates for the solution.
+//
+// a contains the upper or lower triangle of the original matrix A as
+// specified by uplo, and af contains its Cholesky factorization as computed by
+// Dpotrf with the same uplo. b contains the n×nrhs right-hand side matrix B.
+// On entry, x contains the solution matrix X as computed by Dpotrs and on
+// return it contains the improved solution.
+//
+// On return, ferr[j] contains an estimated error bound for the j-th solution
+// vector x_j, the j-th column of X,
+//
+//	|x_j - xtrue_j|_∞ / |x_j|_∞ <= ferr[j],
+//
+// where xtrue_j is the true solution. berr[j] contains the componentwise
+// relative backward error of x_j, the smallest relative change in any element
+// of A or b_j that makes x_j an exact solution.
+//
+// ferr and berr must have length at least nrhs, work must have length at
+// least 3*n and iwork must have length at least n, otherwise Dporfs will
+// panic.
+func (impl Implementation) Dporfs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	switch {
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		for j := 0; j < nrhs; j++ {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	const itmax = 5
+	var (
+		nz    = float64(n + 1)
+		eps   = dlamchE
+		safe1 = nz * dlamchS
+		safe2 = safe1 / eps
+	)
+
+	bi := blas64.Implementation()
+	// work[:n] holds |B| + |A|*|X|, work[n:2*n] the residual and
+	// work[2*n:3*n] is used by Dlacn2.
+	absAX := work[:n]
+	res := work[n : 2*n]
+	isave := new([3]int)
+	for j := 0; j < nrhs; j++ {
+		count := 1
+		lstres := 3.0
+		for {
+			// Compute the residual R = B - A * X.
+			bi.Dcopy(n, b[j:], ldb, res, 1)
+			bi.Dsymv(uplo, n, -1, a, lda, x[j:], ldx, 1, res, 1)
+
+			// Compute the componentwise relative backward error
+			//  max_i |R_i| / (|A|*|X| + |B|)_i.
+			for i := 0; i < n; i++ {
+				absAX[i] = math.Abs(b[i*ldb+j])
+			}
+			if uplo == blas.Upper {
+				for k := 0; k < n; k++ {
+					var s float64
+					xk := math.Abs(x[k*ldx+j])
+					absAX[k] += math.Abs(a[k*lda+k]) * xk
+					for i := k + 1; i < n; i++ {
+						aki := math.Abs(a[k*lda+i])
+						absAX[i] += aki * xk
+						s += aki * math.Abs(x[i*ldx+j])
+					}
+					absAX[k] += s
+				}
+			} else {
+				for k := 0; k < n; k++ {
+					var s float64
+					xk := math.Abs(x[k*ldx+j])
+					for i := 0; i < k; i++ {
+						aki := math.Abs(a[k*lda+i])
+						absAX[i] += aki * xk
+						s += aki * math.Abs(x[i*ldx+j])
+					}
+					absAX[k] += math.Abs(a[k*lda+k])*xk + s
+				}
+			}
+			var s float64
+			for i, v := range absAX {
+				if v > safe2 {
+					s = math.Max(s, math.Abs(res[i])/v)
+				} else {
+					s = math.Max(s, (math.Abs(res[i])+safe1)/(v+safe1))
+				}
+			}
+			berr[j] = s
+
+			if berr[j] <= eps || 2*berr[j] > lstres || count > itmax {
+				break
+			}
+			// Update the solution and try again.
+			impl.Dpotrs(uplo, n, 1, af, ldaf, res, 1)
+			bi.Daxpy(n, 1, res, 1, x[j:], ldx)
+			lstres = berr[j]
+			count++
+		}
+
+		// Bound the error in the computed solution using
+		//  |inv(A)| * (|R| + nz*eps*(|A|*|X|+|B|)),
+		// as in Dgerfs.
+		for i, v := range absAX {
+			absAX[i] = math.Abs(res[i]) + nz*eps*v
+			if v <= safe2 {
+				absAX[i] += safe1
+			}
+		}
+		ferr[j] = 0
+		kase := 0
+		for {
+			ferr[j], kase = impl.Dlacn2(n, work[2*n:3*n], res, iwork, ferr[j], kase, isave)
+			if kase == 0 {
+				break
+			}
+			if kase == 1 {
+				// Multiply by diag(W)*inv(A)ᵀ = diag(W)*inv(A).
+				impl.Dpotrs(uplo, n, 1, af, ldaf, res, 1)
+				for i, w := range absAX {
+					res[i] *= w
+				}
+			} else {
+				// Multiply by inv(A)*diag(W).
+				for i, w := range absAX {
+					res[i] *= w
+				}
+				impl.Dpotrs(uplo, n, 1, af, ldaf, res, 1)
+			}
+		}
+
+		// Normalize the error.
+		var xnorm float64
+		for i := 0; i < n; i++ {
+			xnorm = math.Max(xnorm, math.Abs(x[i*ldx+j]))
+		}
+		if xnorm != 0 {
+			ferr[j] /= xnorm
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dposvx uses the Cholesky factorization to compute the solution to a system
+// of linear equations
+//
+//	A * X = B
+//
+// where A is an n×n symmetric positive definite matrix and X and B are n×nrhs
+// matrices, and provides error bounds on the solution and a condition
+// estimate.
+//
+// Dposvx performs the following steps:
+//
+//  1. If fact is lapack.FactEquilibrate, scaling factors are computed by
+//     Dpoequ to equilibrate the system. If the scaling is worthwhile, A is
+//     overwritten by diag(s)*A*diag(s) and B by diag(s)*B.
+//  2. If fact is lapack.FactCompute or lapack.FactEquilibrate, the Cholesky
+//     factorization of the (scaled) matrix A is computed by Dpotrf and stored
+//     into af.
+//  3. If the leading minor of some order of A is not positive definite,
+//     Dposvx returns with ok set to false. Otherwise the reciprocal of the
+//     condition number of A is estimated by Dpocon.
+//  4. The system is solved by Dpotrs and the solution is improved by
+//     iterative refinement using Dporfs.
+//  5. If equilibration was used, the solution is transformed to that of the
+//     original system.
+//
+// Only the triangle of A and af specified by uplo is referenced.
+//
+// If fact is lapack.FactProvided, af must contain the Cholesky factorization
+// of A computed by Dpotrf, and equed must specify whether A was equilibrated
+// before the factorization, in which case s must contain the positive scale
+// factors, otherwise Dposvx will panic. For other values of fact, equed is
+// ignored and s is used for output.
+//
+// On return, a and b contain the equilibrated matrix A and the scaled matrix
+// B, x contains the n×nrhs solution matrix X of the original system, and
+// ferr[j] and berr[j] contain the estimated forward error bound and the
+// componentwise relative backward error of the j-th column of X as described
+// in the documentation of Dporfs. The returned value eq is either
+// lapack.EquilibrateNone or lapack.EquilibrateSym and rcond is the estimate
+// of the reciprocal condition number of the equilibrated matrix A.
+//
+// s must have length at least n, ferr and berr must have length at least
+// nrhs, work must have length at least 3*n and iwork must have length at least
+// n, otherwise Dposvx will panic.
+func (impl Implementation) Dposvx(fact lapack.Fact, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, equed lapack.Equilibration, s, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (eq lapack.Equilibration, rcond float64, ok bool) {
+	nofact := fact == lapack.FactCompute
+	equil := fact == lapack.FactEquilibrate
+	switch {
+	case !nofact && !equil && fact != lapack.FactProvided:
+		panic(badFact)
+	case fact == lapack.FactProvided && equed != lapack.EquilibrateNone && equed != lapack.EquilibrateSym:
+		panic(badEquilibration)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldaf < max(1, n):
+		panic(badLdAF)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	if nofact || equil {
+		equed = lapack.EquilibrateNone
+	}
+	rcequ := equed == lapack.EquilibrateSym
+
+	switch {
+	case len(ferr) < nrhs:
+		panic(shortFerr)
+	case len(berr) < nrhs:
+		panic(shortBerr)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		for j := 0; j < nrhs; j++ {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return equed, 1, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(af) < (n-1)*ldaf+n:
+		panic(shortAF)
+	case len(s) < n:
+		panic(shortS)
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	scond := 1.0
+	if rcequ {
+		scond = scaleRatio(s[:n], badScaleS)
+	}
+
+	if equil {
+		// Compute the scaling to equilibrate A.
+		var amax float64
+		var ok bool
+		scond, amax, ok = impl.Dpoequ(n, a, lda, s[:n])
+		if ok {
+			// Equilibrate A.
+			equed = impl.Dlaqsy(uplo, n, a, lda, s[:n], scond, amax)
+			rcequ = equed == lapack.EquilibrateSym
+		}
+	}
+
+	// Scale the right-hand side.
+	if rcequ {
+		scaleRows(n, nrhs, b, ldb, s)
+	}
+
+	if nofact || equil {
+		// Compute the Cholesky factorization of A.
+		impl.Dlacpy(uplo, n, n, a, lda, af, ldaf)
+		if !impl.Dpotrf(uplo, n, af, ldaf) {
+			return equed, 0, false
+		}
+	}
+
+	// Estimate the reciprocal of the condition number of A.
+	anorm := impl.Dlansy(lapack.MaxColumnSum, uplo, n, a, lda, work)
+	rcond = impl.Dpocon(uplo, n, af, ldaf, anorm, work, iwork)
+
+	if nrhs == 0 {
+		return equed, rcond, true
+	}
+
+	// Compute the solution X and improve it by iterative refinement.
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, x, ldx)
+	impl.Dpotrs(uplo, n, nrhs, af, ldaf, x, ldx)
+	impl.Dporfs(uplo, n, nrhs, a, lda, af, ldaf, b, ldb, x, ldx, ferr, berr, work, iwork)
+
+	// Transform the solution X to the solution of the original system.
+	if rcequ {
+		scaleRows(n, nrhs, x, ldx, s)
+		for j := 0; j < nrhs; j++ {
+			ferr[j] /= scond
+		}
+	}
+	return equed, rcond, true
+}
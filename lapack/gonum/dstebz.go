@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstebz computes the eigenvalues of a symmetric tridiagonal matrix T that
+// lie in a specified range using bisection.
+//
+// The eigenvalues that are computed depend on rng:
+//   - lapack.EVRangeAll: all eigenvalues are computed;
+//   - lapack.EVRangeValue: the eigenvalues in the half-open interval (vl, vu]
+//     are computed. vl must be less than vu;
+//   - lapack.EVRangeIndex: the il-th through iu-th eigenvalues, counted from
+//     zero in ascending order, are computed. il and iu must satisfy
+//     0 <= il <= iu < n if n > 0, and il == 0 and iu == -1 if n == 0.
+//
+// d and e contain the diagonal and off-diagonal elements of T and must have
+// length n and n-1, respectively.
+//
+// abstol is the absolute tolerance to which each eigenvalue is required. If
+// abstol <= 0, the tolerance ulp*|T| is used where ulp is the relative machine
+// precision.
+//
+// On return, the first m elements of w contain the computed eigenvalues in
+// ascending order. T is split into nsplit unreduced blocks where the
+// off-diagonal elements are negligible. The i-th block consists of the rows
+// and columns isplit[i-1]+1 through isplit[i] with isplit[-1] = -1, and
+// iblock[j] is the index of the block that contains the eigenvalue w[j]. w,
+// iblock and isplit must have length n.
+//
+// work must have length at least max(1,n-1).
+//
+// Dstebz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstebz(rng lapack.EVRange, n int, vl, vu float64, il, iu int, abstol float64, d, e, w []float64, iblock, isplit []int, work []float64) (m, nsplit int) {
+	switch {
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && vl >= vu:
+		panic(badInterval)
+	case rng == lapack.EVRangeIndex && (il < 0 || il > max(0, n-1)):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || iu >= n):
+		panic(badIu)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < n:
+		panic(shortW)
+	case len(iblock) < n:
+		panic(badLenIblock)
+	case len(isplit) < n:
+		panic(badLenIsplit)
+	case len(work) < max(1, n-1):
+		panic(shortWork)
+	}
+
+	safmin := dlamchS
+	ulp := dlamchP
+	rtoli := 2 * ulp
+
+	// Find where T splits and store the squares of the off-diagonal
+	// elements with the negligible elements set to zero.
+	e2 := work[:n-1]
+	pivmin := 1.0
+	for j := 0; j < n-1; j++ {
+		t := e[j] * e[j]
+		if math.Abs(d[j]*d[j+1])*ulp*ulp+safmin > t {
+			isplit[nsplit] = j
+			nsplit++
+			e2[j] = 0
+		} else {
+			e2[j] = t
+			pivmin = math.Max(pivmin, t)
+		}
+	}
+	isplit[nsplit] = n - 1
+	nsplit++
+	pivmin *= safmin
+
+	// Compute the Gershgorin interval that contains all eigenvalues.
+	gl := d[0]
+	gu := d[0]
+	for i := 0; i < n; i++ {
+		var r float64
+		if i > 0 {
+			r += math.Abs(e[i-1])
+		}
+		if i < n-1 {
+			r += math.Abs(e[i])
+		}
+		gl = math.Min(gl, d[i]-r)
+		gu = math.Max(gu, d[i]+r)
+	}
+	tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+	gl -= 2*tnorm*ulp*float64(n) + 2*pivmin
+	gu += 2*tnorm*ulp*float64(n) + 2*pivmin
+
+	atoli := abstol
+	if atoli <= 0 {
+		atoli = ulp * tnorm
+	}
+	converged := func(lo, hi float64) bool {
+		return hi-lo <= math.Max(math.Max(atoli, pivmin), rtoli*math.Max(math.Abs(lo), math.Abs(hi)))
+	}
+
+	// count returns the number of eigenvalues of the block in rows and
+	// columns first through last that are less than x.
+	count := func(first, last int, x float64) int {
+		var c int
+		q := d[first] - x
+		for i := first; ; {
+			if math.Abs(q) < pivmin {
+				q = -pivmin
+			}
+			if q < 0 {
+				c++
+			}
+			i++
+			if i > last {
+				return c
+			}
+			q = d[i] - x - e2[i-1]/q
+		}
+	}
+
+	// Determine the interval [wl,wu) that contains the wanted eigenvalues.
+	// The end points differ from those of (vl,vu] only by the counting of
+	// eigenvalues that are equal to them, which is ambiguous in floating
+	// point.
+	var wl, wu float64
+	switch rng {
+	case lapack.EVRangeAll:
+		wl, wu = gl, gu
+	case lapack.EVRangeValue:
+		wl, wu = math.Max(vl, gl), math.Min(vu, gu)
+		if wl >= wu {
+			return 0, nsplit
+		}
+	case lapack.EVRangeIndex:
+		lo, hi := gl, gu
+		for !converged(lo, hi) {
+			mid := (lo + hi) / 2
+			if count(0, n-1, mid) <= il {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		wl = lo
+		lo, hi = gl, gu
+		for !converged(lo, hi) {
+			mid := (lo + hi) / 2
+			if count(0, n-1, mid) <= iu {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		wu = hi
+	}
+
+	// Compute the eigenvalues of each block by bisection.
+	first := 0
+	for b := 0; b < nsplit; b++ {
+		last := isplit[b]
+		klo := count(first, last, wl)
+		khi := count(first, last, wu)
+		lo := wl
+		for k := klo; k < khi; k++ {
+			// The k-th eigenvalue of the block lies in [lo,wu) and
+			// later eigenvalues are not less than it.
+			a, c := lo, wu
+			for !converged(a, c) {
+				mid := (a + c) / 2
+				if count(first, last, mid) <= k {
+					a = mid
+				} else {
+					c = mid
+				}
+			}
+			w[m] = (a + c) / 2
+			iblock[m] = b
+			m++
+			lo = a
+		}
+		first = last + 1
+	}
+
+	sort.Sort(eigBlocks{w: w[:m], iblock: iblock[:m]})
+
+	if rng == lapack.EVRangeIndex {
+		// Discard the eigenvalues that were found because they are within
+		// the tolerance of the wanted ones.
+		nwl := count(0, n-1, wl)
+		drop := il - nwl
+		want := iu - il + 1
+		if drop > 0 {
+			copy(w, w[drop:m])
+			copy(iblock, iblock[drop:m])
+		}
+		m = want
+	}
+	return m, nsplit
+}
+
+// eigBlocks sorts eigenvalues and their block indices by the eigenvalues.
+type eigBlocks struct {
+	w      []float64
+	iblock []int
+}
+
+func (e eigBlocks) Len() int           { return len(e.w) }
+func (e eigBlocks) Less(i, j int) bool { return e.w[i] < e.w[j] }
+func (e eigBlocks) Swap(i, j int) {
+	e.w[i], e.w[j] = e.w[j], e.w[i]
+	e.iblock[i], e.iblock[j] = e.iblock[j], e.iblock[i]
+}
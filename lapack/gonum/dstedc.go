@@ -0,0 +1,478 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// dstedcSmall is the size of the subproblems in Dstedc that are solved
+// directly by Dsteqr.
+const dstedcSmall = 25
+
+// Dstedc computes all eigenvalues and, optionally, the eigenvectors of a
+// symmetric tridiagonal matrix using the divide and conquer method. The
+// eigenvectors of a full or band symmetric matrix can also be found if Dsytrd,
+// Dsptrd, or Dsbtrd have been used to reduce this matrix to tridiagonal form.
+//
+// d, on entry, contains the diagonal elements of the tridiagonal matrix. On
+// exit, d contains the eigenvalues in ascending order. d must have length n
+// and Dstedc will panic otherwise.
+//
+// e, on entry, contains the off-diagonal elements of the tridiagonal matrix
+// and is overwritten during the call to Dstedc. e must have length n-1 and
+// Dstedc will panic otherwise.
+//
+// z, on entry, contains the n×n orthogonal matrix used in the reduction to
+// tridiagonal form if compz == lapack.EVOrig. On exit, if
+// compz == lapack.EVOrig, z contains the orthonormal eigenvectors of the
+// original symmetric matrix, and if compz == lapack.EVTridiag, z contains the
+// orthonormal eigenvectors of the symmetric tridiagonal matrix. z is not used
+// if compz == lapack.EVCompNone.
+//
+// work must have length at least max(1,lwork) and iwork must have length at
+// least max(1,liwork). If n <= 1 or compz == lapack.EVCompNone, lwork and
+// liwork must be at least 1. Otherwise liwork must be at least 3*n and lwork
+// must be at least
+//
+//	2*n*n + 6*n  if compz == lapack.EVTridiag,
+//	3*n*n + 6*n  if compz == lapack.EVOrig.
+//
+// If lwork == -1 or liwork == -1, instead of computing the eigendecomposition
+// the minimum work lengths are stored into work[0] and iwork[0].
+//
+// Dstedc returns whether the eigendecomposition was successful. The
+// decomposition can fail only when Dsteqr fails on one of the subproblems.
+//
+// Dstedc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstedc(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	switch {
+	case compz != lapack.EVCompNone && compz != lapack.EVTridiag && compz != lapack.EVOrig:
+		panic(badEVComp)
+	case n < 0:
+		panic(nLT0)
+	case ldz < 1, compz != lapack.EVCompNone && ldz < n:
+		panic(badLdZ)
+	}
+
+	lwmin := 1
+	liwmin := 1
+	if n > 1 && compz != lapack.EVCompNone {
+		liwmin = 3 * n
+		if compz == lapack.EVTridiag {
+			lwmin = 2*n*n + 6*n
+		} else {
+			lwmin = 3*n*n + 6*n
+		}
+	}
+	switch {
+	case lwork < lwmin && lwork != -1:
+		panic(badLWork)
+	case liwork < liwmin && liwork != -1:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	if lwork == -1 || liwork == -1 {
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case compz != lapack.EVCompNone && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		if compz == lapack.EVTridiag {
+			z[0] = 1
+		}
+		return true
+	}
+
+	if compz == lapack.EVCompNone {
+		return impl.Dsterf(n, d, e)
+	}
+	if n <= dstedcSmall {
+		return impl.Dsteqr(compz, n, d, e, z, ldz, work)
+	}
+
+	if compz == lapack.EVTridiag {
+		return impl.dstedcTridiag(n, d, e, z, ldz, work, iwork)
+	}
+
+	// Compute the eigenvectors of the tridiagonal matrix into the
+	// workspace and multiply them by the orthogonal matrix in z.
+	q := work[:n*n]
+	if !impl.dstedcTridiag(n, d, e, q, n, work[n*n:], iwork) {
+		return false
+	}
+	tmp := work[n*n : 2*n*n]
+	bi := blas64.Implementation()
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n, 1, z, ldz, q, n, 0, tmp, n)
+	impl.Dlacpy(blas.All, n, n, tmp, n, z, ldz)
+	return true
+}
+
+// dstedcTridiag computes all eigenvalues and eigenvectors of the n×n
+// symmetric tridiagonal matrix with diagonal d and off-diagonal e. The
+// eigenvalues are returned in d in ascending order and the eigenvectors are
+// stored in the columns of z. work must have length at least 2*n*n+6*n and
+// iwork must have length at least 3*n.
+func (impl Implementation) dstedcTridiag(n int, d, e, z []float64, ldz int, work []float64, iwork []int) (ok bool) {
+	impl.Dlaset(blas.All, n, n, 0, 0, z, ldz)
+
+	eps := dlamchE
+	for start := 0; start < n; {
+		// Find the end of the unreduced block that begins at start.
+		end := start
+		for end < n-1 {
+			tiny := eps * math.Sqrt(math.Abs(d[end])) * math.Sqrt(math.Abs(d[end+1]))
+			if math.Abs(e[end]) <= tiny {
+				e[end] = 0
+				break
+			}
+			end++
+		}
+		m := end - start + 1
+		zb := z[start*ldz+start:]
+		switch {
+		case m == 1:
+			zb[0] = 1
+		case m <= dstedcSmall:
+			if !impl.Dsteqr(lapack.EVTridiag, m, d[start:], e[start:], zb, ldz, work) {
+				return false
+			}
+		default:
+			// Scale the block to unit norm.
+			orgnrm := impl.Dlanst(lapack.MaxAbs, m, d[start:], e[start:])
+			impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, m, 1, d[start:], 1)
+			impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, m-1, 1, e[start:], 1)
+			if !impl.dlaed0(m, d[start:], e[start:], zb, ldz, work, iwork) {
+				return false
+			}
+			impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, m, 1, d[start:], 1)
+		}
+		start = end + 1
+	}
+
+	// Sort the eigenvalues into increasing order and the eigenvectors
+	// correspondingly.
+	sortEigenpairs(n, d, z, ldz)
+	return true
+}
+
+// sortEigenpairs sorts the n values in d into increasing order using
+// selection sort and permutes the columns of z accordingly.
+func sortEigenpairs(n int, d, z []float64, ldz int) {
+	bi := blas64.Implementation()
+	for i := 0; i < n-1; i++ {
+		k := i
+		p := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] < p {
+				k = j
+				p = d[j]
+			}
+		}
+		if k != i {
+			d[k] = d[i]
+			d[i] = p
+			bi.Dswap(n, z[i:], ldz, z[k:], ldz)
+		}
+	}
+}
+
+// dlaed0 computes all eigenvalues and eigenvectors of the unreduced n×n
+// symmetric tridiagonal matrix with diagonal d and off-diagonal e using
+// Cuppen's divide and conquer method. On entry, the elements of q must be
+// zero. On return, d contains the eigenvalues in ascending order and the
+// columns of q contain the corresponding eigenvectors.
+//
+// The tridiagonal matrix T is split as
+//
+//	T = [T_1  0 ] + |ρ| * u * uᵀ
+//	    [ 0  T_2]
+//
+// where ρ is the off-diagonal element that couples T_1 and T_2, and the
+// eigendecompositions of T_1 and T_2 are computed recursively and merged by
+// dlaed1.
+func (impl Implementation) dlaed0(n int, d, e, q []float64, ldq int, work []float64, iwork []int) (ok bool) {
+	if n <= dstedcSmall {
+		return impl.Dsteqr(lapack.EVTridiag, n, d, e, q, ldq, work)
+	}
+	n1 := n / 2
+	rho := e[n1-1]
+	d[n1-1] -= math.Abs(rho)
+	d[n1] -= math.Abs(rho)
+	if !impl.dlaed0(n1, d, e, q, ldq, work, iwork) {
+		return false
+	}
+	if !impl.dlaed0(n-n1, d[n1:], e[n1:], q[n1*ldq+n1:], ldq, work, iwork) {
+		return false
+	}
+	impl.dlaed1(n, n1, d, q, ldq, rho, work, iwork)
+	return true
+}
+
+// dlaed1 computes the eigendecomposition of the n×n matrix
+//
+//	Q * (D + |ρ| * u * uᵀ) * Qᵀ
+//
+// where D = diag(d) and Q = diag(Q_1, Q_2) holds the eigenvalues and
+// eigenvectors of the two halves of a tridiagonal matrix split by dlaed0.
+// Q_1 is n1×n1. The elements of d[:n1] and d[n1:] must each be in ascending
+// order. u is the vector with ones in elements n1-1 and n1, the latter negated
+// if ρ < 0. On return, d contains the eigenvalues in ascending order and q
+// the eigenvectors.
+//
+// Eigenpairs that can be determined to working precision by deflation are
+// removed from the problem, the remaining eigenvalues are found as the roots
+// of the secular equation by dlaed4, and the eigenvectors are computed from
+// the Löwner formula of Gu and Eisenstat which ensures their orthogonality.
+func (impl Implementation) dlaed1(n, n1 int, d, q []float64, ldq int, rho float64, work []float64, iwork []int) {
+	bi := blas64.Implementation()
+	n2 := n - n1
+
+	z := work[:n]
+	ds := work[n : 2*n]
+	dl := work[2*n : 3*n]
+	zl := work[3*n : 4*n]
+	lam := work[4*n : 5*n]
+	qtmp := work[6*n : 6*n+n*n]
+	perm := iwork[:n]
+	nd := iwork[n : 2*n]
+	df := iwork[2*n : 3*n]
+
+	// Form the updating vector z from the last row of Q_1 and the first row
+	// of Q_2, normalized to unit length.
+	bi.Dcopy(n1, q[(n1-1)*ldq:], 1, z, 1)
+	bi.Dcopy(n2, q[n1*ldq+n1:], 1, z[n1:], 1)
+	if rho < 0 {
+		bi.Dscal(n2, -1, z[n1:], 1)
+	}
+	bi.Dscal(n, 1/math.Sqrt2, z, 1)
+	rho = math.Abs(2 * rho)
+
+	// Merge the two sorted halves of d.
+	for i, j, k := 0, n1, 0; k < n; k++ {
+		if j == n || (i < n1 && d[i] <= d[j]) {
+			perm[k] = i
+			i++
+		} else {
+			perm[k] = j
+			j++
+		}
+	}
+	zs := lam // The roots are computed only after deflation.
+	for k, p := range perm {
+		ds[k] = d[p]
+		zs[k] = z[p]
+	}
+
+	// Determine the deflation tolerance.
+	var dmax, zmax float64
+	for k := 0; k < n; k++ {
+		dmax = math.Max(dmax, math.Abs(ds[k]))
+		zmax = math.Max(zmax, math.Abs(zs[k]))
+	}
+	tol := 8 * dlamchE * math.Max(dmax, zmax)
+
+	// Deflate eigenvalues with small components of z and pairs of close
+	// eigenvalues. The latter are deflated by a Givens rotation that zeros
+	// one of the components of z.
+	var k, ndf int
+	if rho*zmax <= tol {
+		for j := 0; j < n; j++ {
+			df[j] = j
+		}
+		ndf = n
+	} else {
+		pj := -1
+		for j := 0; j < n; j++ {
+			if rho*math.Abs(zs[j]) <= tol {
+				df[ndf] = j
+				ndf++
+				continue
+			}
+			if pj < 0 {
+				pj = j
+				continue
+			}
+			s := zs[pj]
+			c := zs[j]
+			tau := impl.Dlapy2(c, s)
+			t := ds[j] - ds[pj]
+			c /= tau
+			s = -s / tau
+			if math.Abs(t*c*s) <= tol {
+				zs[j] = tau
+				zs[pj] = 0
+				bi.Drot(n, q[perm[pj]:], ldq, q[perm[j]:], ldq, c, s)
+				t = ds[pj]*c*c + ds[j]*s*s
+				ds[j] = ds[pj]*s*s + ds[j]*c*c
+				ds[pj] = t
+				df[ndf] = pj
+				ndf++
+			} else {
+				nd[k] = pj
+				k++
+			}
+			pj = j
+		}
+		if pj >= 0 {
+			nd[k] = pj
+			k++
+		}
+	}
+
+	// Gather the columns of Q for the non-deflated eigenvalues followed by
+	// those of the deflated ones.
+	for j := 0; j < k; j++ {
+		dl[j] = ds[nd[j]]
+		zl[j] = zs[nd[j]]
+		bi.Dcopy(n, q[perm[nd[j]]:], ldq, qtmp[j:], n)
+	}
+	for j := 0; j < ndf; j++ {
+		d[k+j] = ds[df[j]]
+		bi.Dcopy(n, q[perm[df[j]]:], ldq, qtmp[k+j:], n)
+	}
+
+	if k > 0 {
+		// Solve the secular equation. Column j of s holds the differences
+		// dl[i] - lam[j], which are computed without cancellation.
+		s := work[6*n+n*n : 6*n+n*n+k*k]
+		delta := work[5*n : 6*n]
+		for j := 0; j < k; j++ {
+			lam[j] = dlaed4(k, j, dl, zl, delta, rho)
+			bi.Dcopy(k, delta, 1, s[j:], k)
+		}
+
+		// Recompute z so that the computed eigenvalues are the exact
+		// eigenvalues of a nearby problem.
+		for i := 0; i < k; i++ {
+			w := s[i*k+i]
+			for j := 0; j < k; j++ {
+				if j != i {
+					w *= s[i*k+j] / (dl[i] - dl[j])
+				}
+			}
+			zl[i] = math.Copysign(math.Sqrt(math.Abs(w)), zl[i])
+		}
+
+		// Form the eigenvectors of the secular problem.
+		for j := 0; j < k; j++ {
+			for i := 0; i < k; i++ {
+				s[i*k+j] = zl[i] / s[i*k+j]
+			}
+			nrm := bi.Dnrm2(k, s[j:], k)
+			bi.Dscal(k, 1/nrm, s[j:], k)
+		}
+
+		// Update the eigenvectors of the non-deflated eigenvalues.
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n, k, k, 1, qtmp, n, s, k, 0, q, ldq)
+		copy(d[:k], lam[:k])
+	}
+	impl.Dlacpy(blas.All, n, ndf, qtmp[k:], n, q[k:], ldq)
+
+	sortEigenpairs(n, d, q, ldq)
+}
+
+// dlaed4 finds the j-th root of the secular equation
+//
+//	f(λ) = 1 + ρ * \sum_i z_i^2 / (d_i - λ) = 0
+//
+// where d holds k values in strictly increasing order and ρ > 0. The root
+// lies in the interval (d_j, d_{j+1}), or (d_{k-1}, d_{k-1} + ρ*zᵀz) when
+// j == k-1. dlaed4 returns the root and stores d_i - λ into delta[i]. To
+// avoid cancellation, the root is computed as an offset from the closest
+// pole using a safeguarded Newton iteration.
+func dlaed4(k, j int, d, z, delta []float64, rho float64) float64 {
+	const maxit = 400
+
+	var org int
+	var lo, hi float64
+	if j == k-1 {
+		org = j
+		for i := 0; i < k; i++ {
+			hi += z[i] * z[i]
+		}
+		hi *= rho
+	} else {
+		// Determine which pole the root is closest to from the sign of
+		// f at the midpoint of the interval.
+		mid := (d[j+1] - d[j]) / 2
+		f := 1.0
+		for i := 0; i < k; i++ {
+			f += rho * z[i] * z[i] / ((d[i] - d[j]) - mid)
+		}
+		if f >= 0 {
+			org = j
+			hi = mid
+		} else {
+			org = j + 1
+			lo = -mid
+		}
+	}
+	for i := 0; i < k; i++ {
+		delta[i] = d[i] - d[org]
+	}
+
+	// f is increasing in the interval, so the root is bracketed by lo and
+	// hi. Newton steps are taken while they stay within the bracket and
+	// reduce it sufficiently, and bisection steps otherwise.
+	eps := dlamchE
+	tau := (lo + hi) / 2
+	prev := math.Inf(1)
+	for it := 0; it < maxit; it++ {
+		f := 1.0
+		var df float64
+		for i := 0; i < k; i++ {
+			t := 1 / (delta[i] - tau)
+			w := rho * z[i] * z[i] * t
+			f += w
+			df += w * t
+		}
+		if f == 0 {
+			break
+		}
+		if f < 0 {
+			lo = tau
+		} else {
+			hi = tau
+		}
+		width := hi - lo
+		if width <= 2*eps*math.Max(math.Abs(lo), math.Abs(hi)) {
+			break
+		}
+		next := tau - f/df
+		if next <= lo || next >= hi || width > prev/2 {
+			next = (lo + hi) / 2
+		}
+		prev = width
+		tau = next
+	}
+	for i := 0; i < k; i++ {
+		delta[i] -= tau
+	}
+	return d[org] + tau
+}
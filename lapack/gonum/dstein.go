@@ -0,0 +1,320 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dstein computes the eigenvectors of a symmetric tridiagonal matrix T
+// corresponding to specified eigenvalues using inverse iteration.
+//
+// d and e contain the diagonal and off-diagonal elements of T and must have
+// length n and n-1, respectively.
+//
+// w contains the m eigenvalues for which the eigenvectors are computed, and
+// iblock and isplit describe the unreduced blocks of T as returned by Dstebz.
+// The eigenvalues that belong to the same block must be in ascending order,
+// which is the case for the eigenvalues returned by Dstebz.
+//
+// On return, the columns of the n×m matrix z contain the orthonormal
+// eigenvectors. Eigenvectors of eigenvalues that are closer than 1e-3*|T| are
+// reorthogonalized against each other.
+//
+// work must have length at least 5*n and iwork must have length at least n.
+//
+// Dstein returns whether all eigenvectors converged within the maximum number
+// of iterations.
+//
+// Dstein is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int, z []float64, ldz int, work []float64, iwork []int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case m < 0 || m > n:
+		panic(badMm)
+	case ldz < max(1, m):
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 || m == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < m:
+		panic(shortW)
+	case len(iblock) < m:
+		panic(badLenIblock)
+	case len(isplit) < n:
+		panic(badLenIsplit)
+	case len(z) < (n-1)*ldz+m:
+		panic(shortZ)
+	case len(work) < 5*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	const (
+		maxits = 5
+		extra  = 2
+	)
+
+	bi := blas64.Implementation()
+	eps := dlamchP
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	impl.Dlaset(blas.All, n, m, 0, 0, z, ldz)
+
+	nblk := 0
+	for j := 0; j < m; j++ {
+		nblk = max(nblk, iblock[j]+1)
+	}
+
+	ok = true
+	first := 0
+	for b := 0; b < nblk; b++ {
+		last := isplit[b]
+		blksiz := last - first + 1
+
+		// Compute the norm of the block for the tolerances.
+		var onenrm float64
+		for i := first; i <= last; i++ {
+			v := math.Abs(d[i])
+			if i > first {
+				v += math.Abs(e[i-1])
+			}
+			if i < last {
+				v += math.Abs(e[i])
+			}
+			onenrm = math.Max(onenrm, v)
+		}
+		ortol := 1e-3 * onenrm
+		stpcrt := math.Sqrt(0.1 / float64(blksiz))
+
+		x := work[:blksiz]
+		diag := work[n : n+blksiz]
+		sup := work[2*n : 2*n+blksiz]
+		sub := work[3*n : 3*n+blksiz]
+		sup2 := work[4*n : 4*n+blksiz]
+		piv := iwork[:blksiz]
+
+		var xjm float64
+		jblk := 0
+		gpind := -1
+		for j := 0; j < m; j++ {
+			if iblock[j] != b {
+				continue
+			}
+			jblk++
+			xj := w[j]
+
+			if blksiz == 1 {
+				z[first*ldz+j] = 1
+				xjm = xj
+				continue
+			}
+
+			// Perturb the eigenvalue if it is too close to the previous one
+			// and start a new group of vectors to orthogonalize if the gap
+			// is large enough.
+			if jblk > 1 {
+				pertol := 10 * math.Abs(eps*xj)
+				if xj-xjm < pertol {
+					xj = xjm + pertol
+				}
+				if xj-xjm > ortol {
+					gpind = j
+				}
+			} else {
+				gpind = j
+			}
+
+			for i := range x {
+				x[i] = 2*rnd.Float64() - 1
+			}
+
+			// Factorize T - xj*I.
+			copy(diag, d[first:last+1])
+			copy(sup, e[first:last])
+			copy(sub, e[first:last])
+			tol := dlagtf(blksiz, xj, diag, sup, sub, sup2, piv)
+
+			converged := false
+			var nrmchk int
+			for its := 0; its < maxits; its++ {
+				// Normalize and scale the right-hand side.
+				scl := float64(blksiz) * onenrm * math.Max(eps, math.Abs(diag[blksiz-1])) / bi.Dasum(blksiz, x, 1)
+				bi.Dscal(blksiz, scl, x, 1)
+
+				dlagts(blksiz, diag, sup, sub, sup2, piv, x, tol)
+
+				// Reorthogonalize against the vectors of the group.
+				if gpind != j {
+					for i := gpind; i < j; i++ {
+						if iblock[i] != b {
+							continue
+						}
+						ztr := -bi.Ddot(blksiz, x, 1, z[first*ldz+i:], ldz)
+						bi.Daxpy(blksiz, ztr, z[first*ldz+i:], ldz, x, 1)
+					}
+				}
+
+				// Check the infinity norm of the iterate.
+				jmax := bi.Idamax(blksiz, x, 1)
+				if math.Abs(x[jmax]) < stpcrt {
+					continue
+				}
+				nrmchk++
+				if nrmchk > extra {
+					converged = true
+					break
+				}
+			}
+			if !converged {
+				ok = false
+			}
+
+			// Normalize the vector so that its largest element is positive.
+			scl := 1 / bi.Dnrm2(blksiz, x, 1)
+			if jmax := bi.Idamax(blksiz, x, 1); x[jmax] < 0 {
+				scl = -scl
+			}
+			bi.Dscal(blksiz, scl, x, 1)
+			bi.Dcopy(blksiz, x, 1, z[first*ldz+j:], ldz)
+
+			xjm = xj
+		}
+		first = last + 1
+	}
+	return ok
+}
+
+// dlagtf computes the LU factorization with partial pivoting of the n×n
+// tridiagonal matrix T - λ*I where the diagonal of T is in a and the super-
+// and sub-diagonals are in b and c. On return, a contains the diagonal of U,
+// b and d the first and second super-diagonals of U, c the multipliers of L,
+// and piv[k] is 1 if rows k and k+1 were interchanged. dlagtf returns the
+// tolerance used by dlagts to perturb small pivots.
+func dlagtf(n int, lambda float64, a, b, c, d []float64, piv []int) (tol float64) {
+	a[0] -= lambda
+	if n == 1 {
+		return math.Max(math.Abs(a[0]), 1) * dlamchE
+	}
+	scale1 := math.Abs(a[0]) + math.Abs(b[0])
+	for k := 0; k < n-1; k++ {
+		a[k+1] -= lambda
+		scale2 := math.Abs(c[k]) + math.Abs(a[k+1])
+		if k < n-2 {
+			scale2 += math.Abs(b[k+1])
+		}
+		var piv1 float64
+		if a[k] != 0 {
+			piv1 = math.Abs(a[k]) / scale1
+		}
+		piv[k] = 0
+		switch {
+		case c[k] == 0:
+			scale1 = scale2
+			if k < n-2 {
+				d[k] = 0
+			}
+		case math.Abs(c[k])/scale2 <= piv1:
+			scale1 = scale2
+			c[k] /= a[k]
+			a[k+1] -= c[k] * b[k]
+			if k < n-2 {
+				d[k] = 0
+			}
+		default:
+			piv[k] = 1
+			mult := a[k] / c[k]
+			a[k] = c[k]
+			t := a[k+1]
+			a[k+1] = b[k] - mult*t
+			if k < n-2 {
+				d[k] = b[k+1]
+				b[k+1] = -mult * d[k]
+			}
+			b[k] = t
+			c[k] = mult
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		tol = math.Max(tol, math.Abs(a[k]))
+		if k < n-1 {
+			tol = math.Max(tol, math.Abs(b[k]))
+		}
+		if k < n-2 {
+			tol = math.Max(tol, math.Abs(d[k]))
+		}
+	}
+	tol *= dlamchE
+	if tol == 0 {
+		tol = dlamchE
+	}
+	return tol
+}
+
+// dlagts solves the system (T - λ*I) * x = y in place using the factorization
+// computed by dlagtf. Diagonal elements of U that are too small are perturbed
+// by multiples of tol so that the solution does not overflow.
+func dlagts(n int, a, b, c, d []float64, piv []int, y []float64, tol float64) {
+	sfmin := dlamchS
+	bignum := 1 / sfmin
+
+	for k := 1; k < n; k++ {
+		if piv[k-1] == 0 {
+			y[k] -= c[k-1] * y[k-1]
+		} else {
+			t := y[k-1]
+			y[k-1] = y[k]
+			y[k] = t - c[k-1]*y[k]
+		}
+	}
+
+	for k := n - 1; k >= 0; k-- {
+		t := y[k]
+		if k < n-1 {
+			t -= b[k] * y[k+1]
+		}
+		if k < n-2 {
+			t -= d[k] * y[k+2]
+		}
+		ak := a[k]
+		pert := math.Copysign(tol, ak)
+		for {
+			absak := math.Abs(ak)
+			if absak < 1 {
+				if absak < sfmin {
+					if absak == 0 || math.Abs(t)*sfmin > absak {
+						ak += pert
+						pert *= 2
+						continue
+					}
+					t *= bignum
+					ak *= bignum
+				} else if math.Abs(t) > absak*bignum {
+					ak += pert
+					pert *= 2
+					continue
+				}
+			}
+			break
+		}
+		y[k] = t / ak
+	}
+}
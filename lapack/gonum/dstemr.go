@@ -0,0 +1,1010 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstemr computes selected eigenvalues and, optionally, the eigenvectors of a
+// symmetric tridiagonal matrix T using the method of multiple relatively
+// robust representations (MRRR).
+//
+// The eigenvalues that are computed depend on rng:
+//   - lapack.EVRangeAll: all eigenvalues are computed;
+//   - lapack.EVRangeValue: the eigenvalues in the half-open interval (vl, vu]
+//     are computed. vl must be less than vu;
+//   - lapack.EVRangeIndex: the il-th through iu-th eigenvalues, counted from
+//     zero in ascending order, are computed. il and iu must satisfy
+//     0 <= il <= iu < n if n > 0, and il == 0 and iu == -1 if n == 0.
+//
+// T is split into unreduced blocks where the off-diagonal elements are
+// negligible. For each block, a factorization L*D*Lᵀ of T - σ*I that is
+// definite determines the eigenvalues to high relative accuracy, and the
+// wanted eigenvalues are computed from it by bisection. An eigenvalue whose
+// relative gap to its neighbors is large is refined by Rayleigh quotient
+// iteration and its eigenvector computed by a twisted factorization. For each
+// cluster of eigenvalues with small relative gaps a new representation
+// L*D*Lᵀ - τ*I is computed with τ close to the cluster, in which the relative
+// gaps are larger, and the process is repeated. Each eigenvector costs O(n)
+// operations, and the computed eigenvectors are numerically orthogonal
+// without explicit orthogonalization.
+//
+// d and e contain the diagonal and off-diagonal elements of T and must have
+// length n and n-1, respectively. On exit, d and e are overwritten.
+//
+// On return, m is the number of eigenvalues found and the first m elements of
+// w contain them in ascending order. w must have length at least n. If
+// jobz == lapack.EVCompute, the first m columns of z contain the orthonormal
+// eigenvectors, and the nonzero elements of the i-th eigenvector are in the
+// rows isuppz[2*i] through isuppz[2*i+1]. Otherwise z and isuppz are not
+// referenced. z must have n rows and ldz and the length of isuppz must be at
+// least n and 2*n if rng != lapack.EVRangeIndex, and at least iu-il+1 and
+// 2*(iu-il+1) otherwise.
+//
+// Unlike the reference implementation, Dstemr does not use the dqds algorithm
+// for the initial eigenvalue approximations, and it does not support the
+// workspace query for the number of columns of z.
+//
+// work must have length at least max(1,lwork) and iwork must have length at
+// least max(1,liwork). If jobz == lapack.EVCompute, lwork must be at least
+// max(1,19*n) and liwork at least max(1,5*n), otherwise lwork must be at
+// least max(1,6*n) and liwork at least max(1,4*n). If lwork == -1 or
+// liwork == -1, instead of computing Dstemr the minimum work length is stored
+// into work[0] and the minimum integer work length into iwork[0].
+//
+// Dstemr returns whether the computation was successful. It fails if a
+// cluster of eigenvalues cannot be resolved by a new representation, in which
+// case the eigenvectors may be computed by inverse iteration in Dstein.
+func (impl Implementation) Dstemr(jobz lapack.EVJob, rng lapack.EVRange, n int, d, e []float64, vl, vu float64, il, iu int, w, z []float64, ldz int, isuppz []int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	mmax := n
+	if rng == lapack.EVRangeIndex {
+		mmax = iu - il + 1
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && vl >= vu:
+		panic(badInterval)
+	case rng == lapack.EVRangeIndex && (il < 0 || il > max(0, n-1)):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || iu >= n):
+		panic(badIu)
+	case ldz < 1, wantz && ldz < mmax:
+		panic(badLdZ)
+	}
+
+	lwmin := max(1, 6*n)
+	liwmin := max(1, 4*n)
+	if wantz {
+		lwmin = max(1, 19*n)
+		liwmin = max(1, 5*n)
+	}
+	switch {
+	case lwork < lwmin && lwork != -1:
+		panic(badLWork)
+	case liwork < liwmin && liwork != -1:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	if lwork == -1 || liwork == -1 {
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return 0, true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+mmax:
+		panic(shortZ)
+	case wantz && len(isuppz) < 2*mmax:
+		panic(badLenIsuppz)
+	}
+
+	if n == 1 {
+		if rng == lapack.EVRangeValue && (d[0] <= vl || vu < d[0]) {
+			return 0, true
+		}
+		w[0] = d[0]
+		if wantz {
+			z[0] = 1
+			isuppz[0] = 0
+			isuppz[1] = 0
+		}
+		return 1, true
+	}
+
+	d = d[:n]
+	e = e[:n-1]
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Scale the matrix to the allowable range, if necessary.
+	bi := blas64.Implementation()
+	scale := 1.0
+	tnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+	if tnrm > 0 && tnrm < rmin {
+		scale = rmin / tnrm
+	} else if tnrm > rmax {
+		scale = rmax / tnrm
+	}
+	if scale != 1 {
+		bi.Dscal(n, scale, d, 1)
+		bi.Dscal(n-1, scale, e, 1)
+		vl *= scale
+		vu *= scale
+	}
+
+	// Find where T splits and set the negligible off-diagonal elements to
+	// zero. The i-th block consists of the rows and columns isplit[i-1]+1
+	// through isplit[i] with isplit[-1] = -1.
+	isplit := iwork[:n]
+	blo := iwork[n : 2*n]
+	bhi := iwork[2*n : 3*n]
+	bpos := iwork[3*n : 4*n]
+	var nsplit int
+	pivmin := 1.0
+	for j := 0; j < n-1; j++ {
+		t := e[j] * e[j]
+		if math.Abs(d[j]*d[j+1])*eps*eps+safmin > t {
+			isplit[nsplit] = j
+			nsplit++
+			e[j] = 0
+		} else {
+			pivmin = math.Max(pivmin, t)
+		}
+	}
+	isplit[nsplit] = n - 1
+	nsplit++
+	pivmin *= safmin
+
+	// Compute the Gershgorin interval that contains all eigenvalues.
+	gl, gu := tridiagGershgorin(d, e)
+	tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+	gl -= 2*tnorm*eps*float64(n) + 2*pivmin
+	gu += 2*tnorm*eps*float64(n) + 2*pivmin
+
+	// Determine the interval [wl,wu) that contains the wanted eigenvalues
+	// as in Dstebz.
+	var wl, wu float64
+	switch rng {
+	case lapack.EVRangeAll:
+		wl, wu = gl, gu
+	case lapack.EVRangeValue:
+		wl, wu = math.Max(vl, gl), math.Min(vu, gu)
+		if wl >= wu {
+			return 0, true
+		}
+	case lapack.EVRangeIndex:
+		converged := func(lo, hi float64) bool {
+			return hi-lo <= math.Max(math.Max(eps*tnorm, pivmin), 2*eps*math.Max(math.Abs(lo), math.Abs(hi)))
+		}
+		lo, hi := gl, gu
+		for !converged(lo, hi) {
+			mid := (lo + hi) / 2
+			if tridiagCount(d, e, mid, pivmin) <= il {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		wl = lo
+		lo, hi = gl, gu
+		for !converged(lo, hi) {
+			mid := (lo + hi) / 2
+			if tridiagCount(d, e, mid, pivmin) <= iu {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		wu = hi
+	}
+
+	werr := work[:n]
+	sigma := work[n : 2*n]
+	dr := work[2*n : 3*n]
+	lr := work[3*n : 4*n]
+	ld := work[4*n : 5*n]
+	lld := work[5*n : 6*n]
+
+	// For each block, choose the shift of the root representation and
+	// compute the wanted eigenvalues relative to it. The eigenvalues of the
+	// b-th block are stored in w[bpos[b]:bpos[b]+bhi[b]-blo[b]] and have the
+	// block indices blo[b] through bhi[b]-1.
+	var p int
+	first := 0
+	for b := 0; b < nsplit; b++ {
+		last := isplit[b]
+		nb := last - first + 1
+		db := d[first : last+1]
+		eb := e[first:last]
+		blo[b] = tridiagCount(db, eb, wl, pivmin)
+		bhi[b] = tridiagCount(db, eb, wu, pivmin)
+		bpos[b] = p
+		if blo[b] == bhi[b] {
+			first = last + 1
+			continue
+		}
+		if nb == 1 {
+			sigma[b] = db[0]
+			w[p] = 0
+			werr[p] = 0
+			p++
+			first = last + 1
+			continue
+		}
+		bgl, bgu := tridiagGershgorin(db, eb)
+		spdiam := bgu - bgl
+		left := blo[b]+bhi[b] <= nb
+		sigma[b] = mrrrRoot(db, eb, left, bgl, bgu, pivmin, dr, lr)
+		mrrrProducts(nb, dr, lr, ld, lld)
+		pad := 2*eps*float64(nb)*math.Max(math.Abs(bgl), math.Abs(bgu)) + 2*pivmin
+		for k := blo[b]; k < bhi[b]; k++ {
+			lo, hi := mrrrBracket(nb, dr, lld, k, bgl-sigma[b]-pad, bgu-sigma[b]+pad, spdiam, pivmin)
+			lo, hi = mrrrBisect(nb, dr, lld, k, lo, hi, pivmin)
+			w[p] = (lo + hi) / 2
+			werr[p] = (hi - lo) / 2
+			p++
+		}
+		first = last + 1
+	}
+
+	if rng == lapack.EVRangeIndex {
+		// Discard the eigenvalues that were found because they are within
+		// the tolerance of the wanted ones, as in Dstebz.
+		drop := il - tridiagCount(d, e, wl, pivmin)
+		excess := p - drop - (iu - il + 1)
+		for ; drop > 0; drop-- {
+			bmin := -1
+			for b := 0; b < nsplit; b++ {
+				if blo[b] < bhi[b] && (bmin < 0 || sigma[b]+w[bpos[b]] < sigma[bmin]+w[bpos[bmin]]) {
+					bmin = b
+				}
+			}
+			blo[bmin]++
+			bpos[bmin]++
+		}
+		for ; excess > 0; excess-- {
+			bmax := -1
+			for b := 0; b < nsplit; b++ {
+				if blo[b] < bhi[b] {
+					k := bpos[b] + bhi[b] - blo[b] - 1
+					if bmax < 0 || sigma[b]+w[k] > sigma[bmax]+w[bpos[bmax]+bhi[bmax]-blo[bmax]-1] {
+						bmax = b
+					}
+				}
+			}
+			bhi[bmax]--
+		}
+		// Move the remaining eigenvalues to the front.
+		p = 0
+		for b := 0; b < nsplit; b++ {
+			cnt := bhi[b] - blo[b]
+			if cnt <= 0 {
+				bpos[b] = p
+				continue
+			}
+			copy(w[p:p+cnt], w[bpos[b]:bpos[b]+cnt])
+			copy(werr[p:p+cnt], werr[bpos[b]:bpos[b]+cnt])
+			bpos[b] = p
+			p += cnt
+		}
+	}
+	m = p
+
+	if !wantz {
+		for b := 0; b < nsplit; b++ {
+			for k := bpos[b]; k < bpos[b]+bhi[b]-blo[b]; k++ {
+				w[k] += sigma[b]
+			}
+		}
+		sort.Float64s(w[:m])
+		if scale != 1 {
+			bi.Dscal(m, 1/scale, w, 1)
+		}
+		return m, true
+	}
+
+	// Compute the eigenvectors of each block.
+	first = 0
+	for b := 0; b < nsplit; b++ {
+		last := isplit[b]
+		cnt := bhi[b] - blo[b]
+		if cnt <= 0 {
+			first = last + 1
+			continue
+		}
+		if last == first {
+			c := bpos[b]
+			for i := 0; i < n; i++ {
+				z[i*ldz+c] = 0
+			}
+			z[first*ldz+c] = 1
+			w[c] = sigma[b]
+			isuppz[2*c] = first
+			isuppz[2*c+1] = first
+			first = last + 1
+			continue
+		}
+		if !impl.dlarrv(first, last, d, e, blo[b], cnt, sigma[b], pivmin, w[bpos[b]:], werr[bpos[b]:], z[bpos[b]:], ldz, isuppz[2*bpos[b]:], work[2*n:], iwork[4*n:]) {
+			return 0, false
+		}
+		first = last + 1
+	}
+
+	// Sort the eigenvalues in increasing order together with the
+	// eigenvectors.
+	for i := 0; i < m-1; i++ {
+		k := i
+		for j := i + 1; j < m; j++ {
+			if w[j] < w[k] {
+				k = j
+			}
+		}
+		if k != i {
+			w[i], w[k] = w[k], w[i]
+			bi.Dswap(n, z[i:], ldz, z[k:], ldz)
+			isuppz[2*i], isuppz[2*k] = isuppz[2*k], isuppz[2*i]
+			isuppz[2*i+1], isuppz[2*k+1] = isuppz[2*k+1], isuppz[2*i+1]
+		}
+	}
+	if scale != 1 {
+		bi.Dscal(m, 1/scale, w, 1)
+	}
+	return m, true
+}
+
+// dlarrv computes the eigenvectors of the unreduced block of T in the rows
+// and columns first through last for the cnt eigenvalues with the block
+// indices klo through klo+cnt-1. On entry, w and werr contain the midpoints
+// and half-widths of the intervals that contain the eigenvalues of the root
+// representation of T - sigma*I. On return, w contains the eigenvalues of T,
+// and the first cnt columns of z contain the eigenvectors with the supports
+// stored in isuppz.
+//
+// The unwanted eigenvalues next to the wanted ones are included in the
+// representation tree as long as they belong to the same cluster at the
+// root, so that the relative gaps of the wanted eigenvalues are computed
+// with respect to all of their neighbors. The representation of a cluster
+// is stored in the columns of z of its wanted eigenvalues until their
+// eigenvectors are computed: D in the column of the first one, and L and the
+// shift in the column of the second one or, if there is only one, in work.
+//
+// work must have length at least 17*(last-first+1) and iwork at least
+// last-first+1.
+//
+// dlarrv returns false if a cluster could not be resolved.
+func (impl Implementation) dlarrv(first, last int, d, e []float64, klo, cnt int, sigma, pivmin float64, w, werr, z []float64, ldz int, isuppz []int, work []float64, iwork []int) bool {
+	const (
+		// minrgp is the smallest relative gap of an eigenvalue that is
+		// computed without a new representation.
+		minrgp = 1e-2
+		// maxLevels is the largest depth of the representation tree.
+		maxLevels = 40
+	)
+	eps := dlamchP
+
+	n := len(d)
+	nb := last - first + 1
+	db := d[first : last+1]
+	eb := e[first:last]
+	bgl, bgu := tridiagGershgorin(db, eb)
+	spdiam := bgu - bgl
+	tol := 4 * math.Log(float64(nb)) * eps
+
+	dr := work[:nb]
+	lr := work[nb : 2*nb]
+	ld := work[2*nb : 3*nb]
+	lld := work[3*nb : 4*nb]
+	lplus := work[4*nb : 5*nb]
+	uminus := work[5*nb : 6*nb]
+	s := work[6*nb : 7*nb]
+	p := work[7*nb : 8*nb]
+	zb := work[8*nb : 9*nb]
+	dc := work[9*nb : 10*nb]
+	lc := work[10*nb : 11*nb]
+	zr := work[11*nb : 12*nb]
+	lw := work[12*nb : 13*nb]
+	lwerr := work[13*nb : 14*nb]
+	rgap := work[14*nb : 15*nb]
+	lfirst := work[15*nb : 16*nb]
+	llast := work[16*nb : 17*nb]
+
+	// Recompute the root representation and extend the wanted eigenvalues
+	// by their neighbors that are in the same cluster. The eigenvalues are
+	// stored in lw and lwerr, and the wanted ones have the local indices
+	// nl through nl+cnt-1.
+	mrrrFactor(db, eb, sigma, dr, lr)
+	mrrrProducts(nb, dr, lr, ld, lld)
+	pad := 2*eps*float64(nb)*math.Max(math.Abs(bgl), math.Abs(bgu)) + 2*pivmin
+	lgap0 := spdiam
+	var nl int
+	for k, lo := klo-1, w[0]-werr[0]; k >= 0; k-- {
+		a, b := mrrrBracket(nb, dr, lld, k, bgl-sigma-pad, lo, spdiam, pivmin)
+		a, b = mrrrBisect(nb, dr, lld, k, a, b, pivmin)
+		mid := (a + b) / 2
+		gap := math.Max(0, lo-b)
+		if gap >= minrgp*math.Abs(mid) {
+			lgap0 = gap
+			break
+		}
+		s[nl] = mid
+		p[nl] = (b - a) / 2
+		nl++
+		lo = a
+	}
+	for i := 0; i < nl; i++ {
+		lw[i] = s[nl-1-i]
+		lwerr[i] = p[nl-1-i]
+	}
+	copy(lw[nl:nl+cnt], w[:cnt])
+	copy(lwerr[nl:nl+cnt], werr[:cnt])
+	nx := nl + cnt
+	for k := klo + cnt; ; k++ {
+		if k == nb {
+			rgap[nx-1] = spdiam
+			break
+		}
+		hi := lw[nx-1] + lwerr[nx-1]
+		a, b := mrrrBracket(nb, dr, lld, k, hi, bgu-sigma+pad, spdiam, pivmin)
+		a, b = mrrrBisect(nb, dr, lld, k, a, b, pivmin)
+		rgap[nx-1] = math.Max(0, a-hi)
+		if rgap[nx-1] >= minrgp*math.Abs(lw[nx-1]) {
+			break
+		}
+		lw[nx] = (a + b) / 2
+		lwerr[nx] = (b - a) / 2
+		nx++
+	}
+	for j := 0; j < nx-1; j++ {
+		rgap[j] = math.Max(0, (lw[j+1]-lwerr[j+1])-(lw[j]+lwerr[j]))
+	}
+	leftGap := func(j int) float64 {
+		if j == 0 {
+			return lgap0
+		}
+		return rgap[j-1]
+	}
+	col := func(c int) []float64 {
+		return z[first*ldz+c:]
+	}
+	// store returns where the representation of the cluster with the local
+	// indices c1 through c2 is stored, or ok == false if the cluster has no
+	// wanted eigenvalues.
+	store := func(c1, c2 int) (dst []float64, incd int, lst []float64, incl int, ok bool) {
+		k1 := max(c1, nl) - nl
+		k2 := min(c2, nl+cnt-1) - nl
+		switch {
+		case k1 > k2:
+			return nil, 0, nil, 0, false
+		case k1 < k2:
+			return col(k1), ldz, col(k1 + 1), ldz, true
+		case k1 == 0:
+			return col(k1), ldz, lfirst, 1, true
+		default:
+			return col(k1), ldz, llast, 1, true
+		}
+	}
+
+	// The clusters of the current level of the representation tree are
+	// stored in pairs in cur, and those of the next level in next.
+	cur := iwork[:0]
+	next := iwork[:0]
+	cur = append(cur, 0, nx-1)
+	bi := blas64.Implementation()
+	for level := 0; len(cur) > 0; level++ {
+		if level == maxLevels {
+			return false
+		}
+		ncur := len(cur)
+		next = iwork[ncur:ncur]
+		for k := 0; k < ncur; k += 2 {
+			c1, c2 := cur[k], cur[k+1]
+			shift := sigma
+			if level > 0 {
+				// Load the representation of the cluster.
+				dst, incd, lst, incl, _ := store(c1, c2)
+				bi.Dcopy(nb, dst, incd, dr, 1)
+				bi.Dcopy(nb, lst, incl, lr, 1)
+				shift = lr[nb-1]
+				mrrrProducts(nb, dr, lr, ld, lld)
+			}
+			for j := c1; j <= c2; {
+				jend := j
+				for jend < c2 && rgap[jend] < minrgp*math.Abs(lw[jend]) {
+					jend++
+				}
+				if jend == j {
+					if j < nl || nl+cnt <= j {
+						// The eigenvalue is not wanted.
+						j++
+						continue
+					}
+					// Compute the eigenvector of the singleton.
+					gap := math.Min(leftGap(j), rgap[j])
+					lambda := lw[j]
+					lo := lambda - lwerr[j]
+					hi := lambda + lwerr[j]
+					var isup0, isup1 int
+					var ztz float64
+					for it := 0; it < 10; it++ {
+						var mingma float64
+						mingma, ztz, isup0, isup1 = mrrrTwisted(nb, lambda, dr, lr, ld, lld, pivmin, gap*eps, zb, lplus, uminus, s, p)
+						if math.Abs(mingma) <= tol*gap*math.Sqrt(ztz) {
+							break
+						}
+						corr := mingma / ztz
+						if math.Abs(corr) <= 4*eps*math.Abs(lambda) || lambda+corr < lo || hi < lambda+corr {
+							break
+						}
+						lambda += corr
+					}
+					c := j - nl
+					for i := 0; i < n; i++ {
+						z[i*ldz+c] = 0
+					}
+					zc := col(c)
+					nrm := 1 / math.Sqrt(ztz)
+					for i := isup0; i <= isup1; i++ {
+						zc[i*ldz] = nrm * zb[i]
+					}
+					isuppz[2*c] = first + isup0
+					isuppz[2*c+1] = first + isup1
+					w[c] = shift + lambda
+					j++
+					continue
+				}
+
+				dst, incd, lst, incl, ok := store(j, jend)
+				if !ok {
+					// The cluster has no wanted eigenvalues.
+					j = jend + 1
+					continue
+				}
+				// Compute a new representation for the cluster with a
+				// shift close to one of its ends.
+				tau, ok := mrrrChildShift(nb, lw[j]-lwerr[j], lw[jend]+lwerr[jend], leftGap(j), rgap[jend], dr, lr, ld, lld, spdiam, pivmin, dc, lc, zb, zr, work[4*nb:8*nb])
+				if !ok {
+					return false
+				}
+				lc[nb-1] = shift + tau
+				bi.Dcopy(nb, dc, 1, dst, incd)
+				bi.Dcopy(nb, lc, 1, lst, incl)
+				for i := 0; i < nb-1; i++ {
+					s[i] = dc[i] * lc[i] * lc[i]
+				}
+
+				// Refine the eigenvalues of the cluster in the new
+				// representation.
+				for i := j; i <= jend; i++ {
+					mu := lw[i] - tau
+					slack := lwerr[i] + 4*eps*(math.Abs(mu)+math.Abs(tau)) + pivmin
+					lo, hi := mrrrBracket(nb, dc, s, klo-nl+i, mu-slack, mu+slack, spdiam, pivmin)
+					lo, hi = mrrrBisect(nb, dc, s, klo-nl+i, lo, hi, pivmin)
+					lw[i] = (lo + hi) / 2
+					lwerr[i] = (hi - lo) / 2
+				}
+				for i := j; i < jend; i++ {
+					rgap[i] = math.Max(0, (lw[i+1]-lwerr[i+1])-(lw[i]+lwerr[i]))
+				}
+				next = append(next, j, jend)
+				j = jend + 1
+			}
+		}
+		// Move the clusters of the next level to the front.
+		cur = iwork[:copy(iwork, next)]
+	}
+	return true
+}
+
+// tridiagGershgorin returns the Gershgorin interval that contains the
+// eigenvalues of the symmetric tridiagonal matrix with diagonal d and
+// off-diagonal e.
+func tridiagGershgorin(d, e []float64) (gl, gu float64) {
+	n := len(d)
+	gl = d[0]
+	gu = d[0]
+	for i := 0; i < n; i++ {
+		var r float64
+		if i > 0 {
+			r += math.Abs(e[i-1])
+		}
+		if i < n-1 {
+			r += math.Abs(e[i])
+		}
+		gl = math.Min(gl, d[i]-r)
+		gu = math.Max(gu, d[i]+r)
+	}
+	return gl, gu
+}
+
+// tridiagCount returns the number of eigenvalues less than x of the symmetric
+// tridiagonal matrix with diagonal d and off-diagonal e.
+func tridiagCount(d, e []float64, x, pivmin float64) int {
+	var c int
+	q := d[0] - x
+	for i := 0; ; {
+		if math.Abs(q) < pivmin {
+			q = -pivmin
+		}
+		if q < 0 {
+			c++
+		}
+		i++
+		if i == len(d) {
+			return c
+		}
+		q = d[i] - x - e[i-1]*e[i-1]/q
+	}
+}
+
+// mrrrRoot computes the factorization L*D*Lᵀ of T - sigma*I where T is the
+// unreduced symmetric tridiagonal matrix with diagonal d and off-diagonal e
+// and sigma is just below the smallest eigenvalue of T if left is true, and
+// just above the largest otherwise, so that L*D*Lᵀ is definite. The diagonal
+// of D is stored in dr and the subdiagonal of L in lr. The eigenvalues of T
+// lie in [gl,gu].
+func mrrrRoot(d, e []float64, left bool, gl, gu, pivmin float64, dr, lr []float64) (sigma float64) {
+	eps := dlamchP
+	n := len(d)
+	spdiam := gu - gl
+
+	// Find the extreme eigenvalue by bisection.
+	lo, hi := gl, gu
+	for hi-lo > 4*eps*math.Max(math.Abs(lo), math.Abs(hi))+pivmin {
+		mid := (lo + hi) / 2
+		if mid == lo || mid == hi {
+			break
+		}
+		c := tridiagCount(d, e, mid, pivmin)
+		if (left && c == 0) || (!left && c < n) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	sigma = hi
+	sign := -1.0
+	if left {
+		sigma = lo
+		sign = 1
+	}
+
+	// Move sigma away from the spectrum until the factorization is
+	// definite.
+	delta := math.Max(spdiam*eps*float64(n)+2*pivmin, 2*eps*math.Abs(sigma))
+	sigma -= sign * delta
+	for try := 0; ; try++ {
+		mrrrFactor(d, e, sigma, dr, lr)
+		definite := true
+		for _, v := range dr[:n] {
+			if !(sign*v > 0) || math.IsInf(v, 0) {
+				definite = false
+				break
+			}
+		}
+		if definite || try == 60 {
+			return sigma
+		}
+		sigma -= sign * delta
+		delta *= 2
+	}
+}
+
+// mrrrFactor computes the factorization L*D*Lᵀ of T - sigma*I and stores the
+// diagonal of D in dr and the subdiagonal of L in lr. The elements of D and L
+// are perturbed by a few ulps to break up clusters of nearly equal
+// eigenvalues, for example from glued copies of the same matrix. The
+// perturbation is deterministic so that the factorization can be recomputed.
+func mrrrFactor(d, e []float64, sigma float64, dr, lr []float64) {
+	eps := dlamchP
+	n := len(d)
+	dr[0] = d[0] - sigma
+	for i := 0; i < n-1; i++ {
+		lr[i] = e[i] / dr[i]
+		dr[i+1] = d[i+1] - sigma - lr[i]*e[i]
+	}
+	seed := uint64(1)
+	perturb := func() float64 {
+		seed = seed*6364136223846793005 + 1442695040888963407
+		return 1 + 8*eps*(2*float64(seed>>11)/(1<<53)-1)
+	}
+	for i := 0; i < n-1; i++ {
+		dr[i] *= perturb()
+		lr[i] *= perturb()
+	}
+	dr[n-1] *= perturb()
+}
+
+// mrrrProducts computes ld[i] = dr[i]*lr[i] and lld[i] = dr[i]*lr[i]*lr[i]
+// for i < n-1.
+func mrrrProducts(n int, dr, lr, ld, lld []float64) {
+	for i := 0; i < n-1; i++ {
+		ld[i] = dr[i] * lr[i]
+		lld[i] = ld[i] * lr[i]
+	}
+}
+
+// mrrrCount returns the number of eigenvalues less than tau of L*D*Lᵀ where
+// dr is the diagonal of D and lld[i] = D[i]*L[i]². The count is determined
+// by the signs of the pivots of the stationary qd transform.
+func mrrrCount(n int, dr, lld []float64, tau, pivmin float64) int {
+	var c int
+	s := -tau
+	for i := 0; i < n-1; i++ {
+		dplus := dr[i] + s
+		if math.Abs(dplus) < pivmin {
+			dplus = -pivmin
+		}
+		if dplus < 0 {
+			c++
+		}
+		t := s / dplus
+		if math.IsNaN(t) {
+			// s and dplus are both infinite after a tiny pivot
+			// was replaced by -pivmin.
+			t = 1
+		}
+		s = t*lld[i] - tau
+	}
+	dplus := dr[n-1] + s
+	if math.Abs(dplus) < pivmin {
+		dplus = -pivmin
+	}
+	if dplus < 0 {
+		c++
+	}
+	return c
+}
+
+// mrrrBracket returns an interval [lo,hi) that contains the k-th eigenvalue
+// of L*D*Lᵀ by widening [lo,hi) if necessary.
+func mrrrBracket(n int, dr, lld []float64, k int, lo, hi, spdiam, pivmin float64) (float64, float64) {
+	width := math.Max(hi-lo, dlamchP*spdiam+pivmin)
+	for try := 0; try < 100 && mrrrCount(n, dr, lld, lo, pivmin) > k; try++ {
+		lo -= width
+		width *= 2
+	}
+	width = math.Max(hi-lo, dlamchP*spdiam+pivmin)
+	for try := 0; try < 100 && mrrrCount(n, dr, lld, hi, pivmin) <= k; try++ {
+		hi += width
+		width *= 2
+	}
+	return lo, hi
+}
+
+// mrrrBisect refines the interval [lo,hi) that contains the k-th eigenvalue
+// of L*D*Lᵀ by bisection until its width is small relative to its end
+// points.
+func mrrrBisect(n int, dr, lld []float64, k int, lo, hi, pivmin float64) (float64, float64) {
+	eps := dlamchP
+	for hi-lo > math.Max(4*eps*math.Max(math.Abs(lo), math.Abs(hi)), pivmin) {
+		mid := (lo + hi) / 2
+		if mid == lo || mid == hi {
+			break
+		}
+		if mrrrCount(n, dr, lld, mid, pivmin) <= k {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo, hi
+}
+
+// mrrrTwisted computes an approximate eigenvector z of L*D*Lᵀ for the
+// eigenvalue approximation lambda using the twisted factorization with the
+// smallest twist element mingma. The eigenvector is scaled so that its
+// largest element in the twist index is 1, and ztz is its squared norm. The
+// elements of z outside of [isup0,isup1] are negligible and set to zero.
+func mrrrTwisted(n int, lambda float64, dr, lr, ld, lld []float64, pivmin, gaptol float64, z, lplus, uminus, s, p []float64) (mingma, ztz float64, isup0, isup1 int) {
+	// Compute the stationary transform
+	//  L*D*Lᵀ - lambda*I = L₊*D₊*L₊ᵀ.
+	sv := -lambda
+	for i := 0; i < n-1; i++ {
+		s[i] = sv
+		dplus := dr[i] + sv
+		if math.Abs(dplus) < pivmin {
+			dplus = -pivmin
+		}
+		lplus[i] = ld[i] / dplus
+		t := sv / dplus
+		if math.IsNaN(t) {
+			t = 1
+		}
+		sv = t*lld[i] - lambda
+	}
+	s[n-1] = sv
+
+	// Compute the progressive transform
+	//  L*D*Lᵀ - lambda*I = U₋*D₋*U₋ᵀ.
+	pv := dr[n-1] - lambda
+	p[n-1] = pv
+	for i := n - 2; i >= 0; i-- {
+		dminus := lld[i] + pv
+		if math.Abs(dminus) < pivmin {
+			dminus = -pivmin
+		}
+		uminus[i] = lr[i] * dr[i] / dminus
+		t := pv / dminus
+		if math.IsNaN(t) {
+			t = 1
+		}
+		pv = t*dr[i] - lambda
+		p[i] = pv
+	}
+
+	// Find the twist index r with the smallest element of the twisted
+	// factorization.
+	r := n - 1
+	mingma = s[n-1] + p[n-1] + lambda
+	for i := n - 2; i >= 0; i-- {
+		g := s[i] + p[i] + lambda
+		if math.Abs(g) < math.Abs(mingma) {
+			mingma = g
+			r = i
+		}
+	}
+
+	// Solve for the eigenvector with z[r] = 1.
+	z[r] = 1
+	ztz = 1
+	isup0 = 0
+	for i := r - 1; i >= 0; i-- {
+		if z[i+1] == 0 {
+			// A zero pivot of the stationary transform makes
+			// lplus[i] meaningless, so use the three-term recurrence.
+			z[i] = -(ld[i+1] / ld[i]) * z[i+2]
+		} else {
+			z[i] = -lplus[i] * z[i+1]
+		}
+		if (math.Abs(z[i])+math.Abs(z[i+1]))*math.Abs(ld[i]) < gaptol {
+			z[i] = 0
+			isup0 = i + 1
+			break
+		}
+		ztz += z[i] * z[i]
+	}
+	isup1 = n - 1
+	for i := r; i < n-1; i++ {
+		if z[i] == 0 {
+			z[i+1] = -(ld[i-1] / ld[i]) * z[i-1]
+		} else {
+			z[i+1] = -uminus[i] * z[i]
+		}
+		if (math.Abs(z[i])+math.Abs(z[i+1]))*math.Abs(ld[i]) < gaptol {
+			z[i+1] = 0
+			isup1 = i
+			break
+		}
+		ztz += z[i+1] * z[i+1]
+	}
+	return mingma, ztz, isup0, isup1
+}
+
+// mrrrChildShift computes the factorization L₊*D₊*L₊ᵀ of L*D*Lᵀ - tau*I for a
+// shift tau just outside of the cluster of eigenvalues in [lo,hi] that has
+// the gaps lgap and rgap to its neighbors. The diagonal of D₊ is stored in
+// dplus and the subdiagonal of L₊ in lplus.
+//
+// The new factorization is a relatively robust representation of the
+// eigenvalues of the cluster if the element growth in D₊ is small, or if the
+// growth is small when weighted by the squared elements of the eigenvector of
+// the end of the cluster nearest to tau. zl and zr are overwritten by
+// approximations of these eigenvectors, and work must have length at least
+// 4*n.
+func mrrrChildShift(n int, lo, hi, lgap, rgap float64, dr, lr, ld, lld []float64, spdiam, pivmin float64, dplus, lplus, zl, zr, work []float64) (tau float64, ok bool) {
+	const (
+		// maxGrowth bounds the element growth relative to the spectral
+		// diameter.
+		maxGrowth = 8
+		// maxWeightedGrowth bounds the element growth if the weighted
+		// growth is small.
+		maxWeightedGrowth = 1000
+	)
+	eps := dlamchP
+
+	// Compute the approximate eigenvectors of the ends of the cluster.
+	w0, w1, w2, w3 := work[:n], work[n:2*n], work[2*n:3*n], work[3*n:4*n]
+	ztzl, ztzr := math.NaN(), math.NaN()
+
+	// growth returns the largest element of D₊ for the shift tau and its
+	// growth weighted by z.
+	growth := func(tau float64, z []float64, ztz float64) (g, gz float64) {
+		s := -tau
+		for i := 0; i < n; i++ {
+			dp := dr[i] + s
+			g = math.Max(g, math.Abs(dp))
+			gz += math.Abs(dp) * z[i] * z[i]
+			if i < n-1 {
+				s = ld[i]/dp*lr[i]*s - tau
+			}
+		}
+		if math.IsNaN(g) || math.IsInf(g, 0) {
+			return math.Inf(1), math.Inf(1)
+		}
+		return g, gz / ztz
+	}
+
+	// Try shifts at increasing distances from the cluster until the growth
+	// or the weighted growth is small, otherwise accept the shift with the
+	// smallest growth.
+	width := hi - lo
+	delta := math.Max(4*eps*math.Max(math.Abs(lo), math.Abs(hi)), eps*width)
+	ldmax := math.Max(lgap/4, delta)
+	rdmax := math.Max(rgap/4, delta)
+	bound := maxGrowth * spdiam
+	best := math.Inf(1)
+	ldelta, rdelta := delta, delta
+	for {
+		for k, t := range [2]float64{lo - ldelta, hi + rdelta} {
+			z, ztz := zl, &ztzl
+			if k == 1 {
+				z, ztz = zr, &ztzr
+			}
+			if math.IsNaN(*ztz) {
+				_, *ztz, _, _ = mrrrTwisted(n, (lo+hi)/2+float64(2*k-1)*width/2, dr, lr, ld, lld, pivmin, 0, z, w0, w1, w2, w3)
+			}
+			g, gz := growth(t, z, *ztz)
+			if g <= bound || (gz <= bound && g <= maxWeightedGrowth*spdiam) {
+				return mrrrStqds(n, t, dr, lr, ld, dplus, lplus), true
+			}
+			if g < best {
+				tau, best = t, g
+			}
+		}
+		if ldelta == ldmax && rdelta == rdmax {
+			break
+		}
+		ldelta = math.Min(4*ldelta, ldmax)
+		rdelta = math.Min(4*rdelta, rdmax)
+	}
+	if math.IsInf(best, 0) {
+		return 0, false
+	}
+	return mrrrStqds(n, tau, dr, lr, ld, dplus, lplus), true
+}
+
+// mrrrStqds computes the factorization L₊*D₊*L₊ᵀ of L*D*Lᵀ - tau*I by the
+// stationary qd transform and stores the diagonal of D₊ in dplus and the
+// subdiagonal of L₊ in lplus. It returns tau.
+func mrrrStqds(n int, tau float64, dr, lr, ld, dplus, lplus []float64) float64 {
+	s := -tau
+	for i := 0; i < n-1; i++ {
+		dplus[i] = dr[i] + s
+		lplus[i] = ld[i] / dplus[i]
+		s = lplus[i]*lr[i]*s - tau
+	}
+	dplus[n-1] = dr[n-1] + s
+	return tau
+}
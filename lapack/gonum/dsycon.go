@@ -0,0 +1,88 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dsycon estimates the reciprocal of the condition number of an n×n symmetric
+// matrix A in the 1-norm using the factorization
+//
+//	A = U * D * Uᵀ  if uplo == blas.Upper, or
+//	A = L * D * Lᵀ  if uplo == blas.Lower,
+//
+// computed by Dsytrf. The condition number is computed as
+//
+//	rcond = 1 / (anorm * norm(inv(A)))
+//
+// where norm(inv(A)) is estimated.
+//
+// a and ipiv contain the factorization and the details of the interchanges as
+// returned by Dsytrf. ipiv is zero-indexed.
+//
+// anorm is the 1-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Dsycon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Dsycon will panic otherwise.
+func (impl Implementation) Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case anorm < 0:
+		panic(negANorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(work) < 2*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	if anorm == 0 {
+		return 0
+	}
+
+	// Check that the diagonal matrix D is nonsingular.
+	for i := 0; i < n; i++ {
+		if ipiv[i] >= 0 && a[i*lda+i] == 0 {
+			return 0
+		}
+	}
+
+	// Estimate the 1-norm of the inverse.
+	var (
+		ainvnm float64
+		kase   int
+		isave  [3]int
+	)
+	for {
+		ainvnm, kase = impl.Dlacn2(n, work[n:], work, iwork, ainvnm, kase, &isave)
+		if kase == 0 {
+			break
+		}
+		// Multiply by inv(L*D*Lᵀ) or inv(U*D*Uᵀ).
+		impl.Dsytrs(uplo, n, 1, a, lda, ipiv, work, 1)
+	}
+
+	// Compute the estimate of the reciprocal condition number.
+	if ainvnm == 0 {
+		return 0
+	}
+	return (1 / ainvnm) / anorm
+}
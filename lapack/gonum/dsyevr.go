@@ -0,0 +1,245 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsyevr computes selected eigenvalues and, optionally, the eigenvectors of a
+// real symmetric matrix A.
+//
+// The eigenvalues that are computed depend on rng:
+//   - lapack.EVRangeAll: all eigenvalues are computed;
+//   - lapack.EVRangeValue: the eigenvalues in the half-open interval (vl, vu]
+//     are computed. vl must be less than vu;
+//   - lapack.EVRangeIndex: the il-th through iu-th eigenvalues, counted from
+//     zero in ascending order, are computed. il and iu must satisfy
+//     0 <= il <= iu < n if n > 0, and il == 0 and iu == -1 if n == 0.
+//
+// A is first reduced to tridiagonal form T by Dsytrd. If the eigenvectors are
+// requested, the eigenvalues and eigenvectors of T are computed by the method
+// of multiple relatively robust representations in Dstemr. If Dstemr fails,
+// the eigenvalues are computed by bisection in Dstebz and the eigenvectors by
+// inverse iteration in Dstein. Otherwise, all eigenvalues are computed by
+// Dsterf, and selected eigenvalues by Dstebz.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On exit, the specified triangular region is
+// overwritten.
+//
+// abstol is the absolute tolerance to which each eigenvalue is required when
+// bisection in Dstebz is used. If abstol <= 0, the tolerance ulp*|T| is used
+// where ulp is the relative machine precision. abstol is not used by Dstemr,
+// which computes the eigenvalues to high relative accuracy.
+//
+// On return, m is the number of eigenvalues found and the first m elements of
+// w contain them in ascending order. w must have length at least n. If
+// jobz == lapack.EVCompute, the first m columns of z contain the orthonormal
+// eigenvectors, otherwise z is not referenced. z must have n rows and ldz must
+// be at least n if rng != lapack.EVRangeIndex and at least iu-il+1 otherwise.
+//
+// work must have length at least max(1,lwork) and iwork must have length at
+// least max(1,liwork). If jobz == lapack.EVCompute, lwork must be at least
+// max(1,24*n) and liwork at least max(1,7*n), otherwise lwork must be at least
+// max(1,8*n) and liwork at least max(1,3*n). If lwork == -1 or liwork == -1, instead of
+// computing Dsyevr the optimal work length is stored into work[0] and the
+// minimum integer work length into iwork[0].
+//
+// Dsyevr returns whether the computation was successful.
+func (impl Implementation) Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	mmax := n
+	if rng == lapack.EVRangeIndex {
+		mmax = iu - il + 1
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case rng == lapack.EVRangeValue && vl >= vu:
+		panic(badInterval)
+	case rng == lapack.EVRangeIndex && (il < 0 || il > max(0, n-1)):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || iu >= n):
+		panic(badIu)
+	case ldz < 1, wantz && ldz < mmax:
+		panic(badLdZ)
+	}
+
+	alleig := rng == lapack.EVRangeAll || (rng == lapack.EVRangeIndex && il == 0 && iu == n-1)
+	lwmin := max(1, 8*n)
+	liwmin := max(1, 3*n)
+	if wantz {
+		lwmin = max(1, 24*n)
+		liwmin = max(1, 7*n)
+	}
+	switch {
+	case lwork < lwmin && lwork != -1:
+		panic(badLWork)
+	case liwork < liwmin && liwork != -1:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	var opts string
+	if uplo == blas.Upper {
+		opts = "U"
+	} else {
+		opts = "L"
+	}
+	nb := impl.Ilaenv(1, "DSYTRD", opts, n, -1, -1, -1)
+	lworkopt := max(lwmin, (nb+3)*n)
+	if lwork == -1 || liwork == -1 {
+		work[0] = float64(lworkopt)
+		iwork[0] = liwmin
+		return 0, true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+mmax:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		if rng == lapack.EVRangeValue && (a[0] <= vl || vu < a[0]) {
+			return 0, true
+		}
+		w[0] = a[0]
+		if wantz {
+			z[0] = 1
+		}
+		return 1, true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+		if abstol > 0 {
+			abstol *= sigma
+		}
+		if rng == lapack.EVRangeValue {
+			vl *= sigma
+			vu *= sigma
+		}
+	}
+
+	var indd int
+	inde := indd + n
+	indtau := inde + n
+	indwork := indtau + n
+	llwork := lwork - indwork
+	d := work[indd:inde]
+	e := work[inde:indtau]
+	tau := work[indtau:indwork]
+	impl.Dsytrd(uplo, n, a, lda, d, e, tau, work[indwork:], llwork)
+
+	bi := blas64.Implementation()
+	switch {
+	case alleig && !wantz:
+		bi.Dcopy(n, d, 1, w, 1)
+		ok = impl.Dsterf(n, w, e)
+		if !ok {
+			return 0, false
+		}
+		m = n
+	case wantz:
+		// Dstemr overwrites its input, so keep T for the fallback.
+		dc := work[indwork : indwork+n]
+		ec := work[indwork+n : indwork+2*n]
+		bi.Dcopy(n, d, 1, dc, 1)
+		bi.Dcopy(n-1, e, 1, ec, 1)
+		isuppz := iwork[:2*n]
+		m, ok = impl.Dstemr(jobz, rng, n, dc, ec, vl, vu, il, iu, w, z, ldz, isuppz, work[indwork+2*n:], lwork-indwork-2*n, iwork[2*n:], liwork-2*n)
+		if ok {
+			impl.dormtrLeft(uplo, n, m, a, lda, tau, z, ldz, work[indwork:])
+			break
+		}
+		fallthrough
+	default:
+		iblock := iwork[:n]
+		isplit := iwork[n : 2*n]
+		m, _ = impl.Dstebz(rng, n, vl, vu, il, iu, abstol, d, e, w, iblock, isplit, work[indwork:])
+		ok = true
+		if wantz {
+			ok = impl.Dstein(n, d, e, m, w, iblock, isplit, z, ldz, work[indwork:], iwork[2*n:])
+			impl.dormtrLeft(uplo, n, m, a, lda, tau, z, ldz, work[indwork:])
+		}
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi.Dscal(m, 1/sigma, w, 1)
+	}
+	work[0] = float64(lworkopt)
+	return m, ok
+}
+
+// dormtrLeft overwrites the n×m matrix C with Q * C where Q is the orthogonal
+// matrix defined by the elementary reflectors returned by Dsytrd. work must
+// have length at least m.
+func (impl Implementation) dormtrLeft(uplo blas.Uplo, n, m int, a []float64, lda int, tau, c []float64, ldc int, work []float64) {
+	if n < 2 || m == 0 {
+		return
+	}
+	if uplo == blas.Lower {
+		// Q = H_0 * H_1 * ... * H_{n-2} is the orthogonal factor of the
+		// QR factorization stored below the subdiagonal.
+		impl.Dorm2r(blas.Left, blas.NoTrans, n-1, m, n-1, a[lda:], lda, tau[:n-1], c[ldc:], ldc, work)
+		return
+	}
+	// Q = H_{n-2} * ... * H_1 * H_0 where H_i is defined by the vector v
+	// with v[0:i] stored in A[0:i, i+1], v[i] = 1 and zero elsewhere.
+	for i := 0; i < n-1; i++ {
+		aii := a[i*lda+i+1]
+		a[i*lda+i+1] = 1
+		impl.Dlarf(blas.Left, i+1, m, a[i+1:], lda, tau[i], c, ldc, work)
+		a[i*lda+i+1] = aii
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsygv computes all the eigenvalues and, optionally, the eigenvectors of a
+// real generalized symmetric-definite eigenproblem of the form
+//
+//	A*x = λ*B*x  if itype == 1,
+//	A*B*x = λ*x  if itype == 2,
+//	B*A*x = λ*x  if itype == 3,
+//
+// where A and B are n×n symmetric matrices and B is also positive definite.
+// For other values of itype Dsygv will panic.
+//
+// On entry, a and b contain the elements of the symmetric matrices A and B in
+// the triangular portions specified by uplo. On return, if jobz ==
+// lapack.EVCompute, a contains the matrix Z of eigenvectors, normalized as
+// follows:
+//
+//	Zᵀ*B*Z = I    if itype == 1 or 2,
+//	Zᵀ*inv(B)*Z = I  if itype == 3.
+//
+// If jobz == lapack.EVNone, the triangular portion of a specified by uplo is
+// overwritten. On return, b contains the triangular factor U or L from the
+// Cholesky factorization B = Uᵀ*U or B = L*Lᵀ.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n.
+//
+// work must have length at least max(1,lwork) and lwork must be at least
+// max(1,3*n-1), otherwise Dsygv will panic. For optimal performance lwork
+// should be larger. If lwork == -1, instead of computing Dsygv the optimal
+// work length is stored into work[0].
+//
+// Dsygv returns whether the computation was successful. It returns false if B
+// is not positive definite or if the eigenvalue computation failed to
+// converge.
+func (impl Implementation) Dsygv(itype int, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool) {
+	switch {
+	case itype < 1 || 3 < itype:
+		panic(badItype)
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < max(1, 3*n-1) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	nb := impl.Ilaenv(1, "DSYTRD", string(uplo), n, -1, -1, -1)
+	lworkopt := max(1, 3*n-1, (nb+2)*n)
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(w) < n:
+		panic(shortW)
+	}
+
+	// Form a Cholesky factorization of B.
+	if !impl.Dpotrf(uplo, n, b, ldb) {
+		return false
+	}
+
+	// Transform the problem to standard eigenvalue problem and solve.
+	impl.Dsygst(itype, uplo, n, a, lda, b, ldb)
+	ok = impl.Dsyev(jobz, uplo, n, a, lda, w, work, lwork)
+	if !ok {
+		return false
+	}
+
+	if jobz == lapack.EVCompute {
+		// Backtransform eigenvectors to the original problem.
+		bi := blas64.Implementation()
+		switch itype {
+		case 1, 2:
+			// For A*x = λ*B*x and A*B*x = λ*x, backtransform
+			// eigenvectors: x = inv(L)ᵀ*y or inv(U)*y.
+			trans := blas.Trans
+			if uplo == blas.Upper {
+				trans = blas.NoTrans
+			}
+			bi.Dtrsm(blas.Left, uplo, trans, blas.NonUnit, n, n, 1, b, ldb, a, lda)
+		case 3:
+			// For B*A*x = λ*x, backtransform eigenvectors:
+			// x = L*y or Uᵀ*y.
+			trans := blas.NoTrans
+			if uplo == blas.Upper {
+				trans = blas.Trans
+			}
+			bi.Dtrmm(blas.Left, uplo, trans, blas.NonUnit, n, n, 1, b, ldb, a, lda)
+		}
+	}
+
+	work[0] = float64(lworkopt)
+	return true
+}
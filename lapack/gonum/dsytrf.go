@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dsytrf computes the factorization of an n×n symmetric matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The form of the factorization is
+//
+//	A = U * D * Uᵀ  if uplo == blas.Upper, or
+//	A = L * D * Lᵀ  if uplo == blas.Lower,
+//
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks.
+//
+// On entry, a contains the elements of A in the triangle specified by uplo. On
+// return, a contains the block diagonal matrix D and the multipliers used to
+// obtain the factor U or L.
+//
+// ipiv contains details of the interchanges and the block structure of D and
+// must have length n. ipiv is zero-indexed.
+//
+// If ipiv[k] >= 0, then rows and columns k and ipiv[k] were interchanged and
+// D[k,k] is a 1×1 diagonal block.
+//
+// If uplo == blas.Upper and ipiv[k] = ipiv[k-1] < 0, then rows and columns k-1
+// and -ipiv[k]-1 were interchanged and D[k-1:k+1,k-1:k+1] is a 2×2 diagonal
+// block.
+//
+// If uplo == blas.Lower and ipiv[k] = ipiv[k+1] < 0, then rows and columns k+1
+// and -ipiv[k]-1 were interchanged and D[k:k+2,k:k+2] is a 2×2 diagonal block.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= 1 and this function will panic otherwise. If
+// lwork == -1, instead of performing Dsytrf, the optimal work length will be
+// stored into work[0]. Dsytrf currently uses the unblocked algorithm, so the
+// optimal work length is 1.
+//
+// Dsytrf returns whether D is nonsingular. The factorization is completed
+// even if D is exactly singular, but it must not be used to solve a system of
+// equations in that case.
+func (impl Implementation) Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	work[0] = 1
+	if lwork == -1 {
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	return impl.Dsytf2(uplo, n, a, lda, ipiv)
+}
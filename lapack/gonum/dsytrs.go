@@ -0,0 +1,191 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dsytrs solves a system of linear equations A * X = B with an n×n symmetric
+// matrix A using the factorization
+//
+//	A = U * D * Uᵀ  if uplo == blas.Upper, or
+//	A = L * D * Lᵀ  if uplo == blas.Lower,
+//
+// computed by Dsytrf. B is an n×nrhs matrix.
+//
+// a and ipiv contain the factorization and the details of the interchanges as
+// returned by Dsytrf. ipiv is zero-indexed.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+func (Implementation) Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	bi := blas64.Implementation()
+
+	if uplo == blas.Upper {
+		// Solve A*X = B, where A = U*D*Uᵀ.
+
+		// First solve U*D*X = B, overwriting B with X. k decreases from
+		// n-1 to 0 in steps of 1 or 2.
+		for k := n - 1; k >= 0; {
+			if ipiv[k] >= 0 {
+				// 1×1 diagonal block. Interchange rows k and ipiv[k].
+				if kp := ipiv[k]; kp != k {
+					bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+				}
+				// Multiply by inv(U_k), where U_k is the transformation
+				// stored in column k of A.
+				bi.Dger(k, nrhs, -1, a[k:], lda, b[k*ldb:], 1, b, ldb)
+				// Multiply by the inverse of the diagonal block.
+				bi.Dscal(nrhs, 1/a[k*lda+k], b[k*ldb:], 1)
+				k--
+				continue
+			}
+			// 2×2 diagonal block. Interchange rows k-1 and -ipiv[k]-1.
+			if kp := -ipiv[k] - 1; kp != k-1 {
+				bi.Dswap(nrhs, b[(k-1)*ldb:], 1, b[kp*ldb:], 1)
+			}
+			// Multiply by inv(U_k), where U_k is the transformation
+			// stored in columns k-1 and k of A.
+			bi.Dger(k-1, nrhs, -1, a[k:], lda, b[k*ldb:], 1, b, ldb)
+			bi.Dger(k-1, nrhs, -1, a[k-1:], lda, b[(k-1)*ldb:], 1, b, ldb)
+			// Multiply by the inverse of the diagonal block.
+			akm1k := a[(k-1)*lda+k]
+			akm1 := a[(k-1)*lda+k-1] / akm1k
+			ak := a[k*lda+k] / akm1k
+			denom := akm1*ak - 1
+			for j := 0; j < nrhs; j++ {
+				bkm1 := b[(k-1)*ldb+j] / akm1k
+				bk := b[k*ldb+j] / akm1k
+				b[(k-1)*ldb+j] = (ak*bkm1 - bk) / denom
+				b[k*ldb+j] = (akm1*bk - bkm1) / denom
+			}
+			k -= 2
+		}
+
+		// Next solve Uᵀ*X = B, overwriting B with X. k increases from 0 to
+		// n-1 in steps of 1 or 2.
+		for k := 0; k < n; {
+			if ipiv[k] >= 0 {
+				// 1×1 diagonal block. Multiply by inv(U_kᵀ).
+				bi.Dgemv(blas.Trans, k, nrhs, -1, b, ldb, a[k:], lda, 1, b[k*ldb:], 1)
+				// Interchange rows k and ipiv[k].
+				if kp := ipiv[k]; kp != k {
+					bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+				}
+				k++
+				continue
+			}
+			// 2×2 diagonal block. Multiply by inv(U_{k+1}ᵀ).
+			bi.Dgemv(blas.Trans, k, nrhs, -1, b, ldb, a[k:], lda, 1, b[k*ldb:], 1)
+			bi.Dgemv(blas.Trans, k, nrhs, -1, b, ldb, a[k+1:], lda, 1, b[(k+1)*ldb:], 1)
+			// Interchange rows k and -ipiv[k]-1.
+			if kp := -ipiv[k] - 1; kp != k {
+				bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+			}
+			k += 2
+		}
+		return
+	}
+
+	// Solve A*X = B, where A = L*D*Lᵀ.
+
+	// First solve L*D*X = B, overwriting B with X. k increases from 0 to n-1
+	// in steps of 1 or 2.
+	for k := 0; k < n; {
+		if ipiv[k] >= 0 {
+			// 1×1 diagonal block. Interchange rows k and ipiv[k].
+			if kp := ipiv[k]; kp != k {
+				bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+			}
+			// Multiply by inv(L_k), where L_k is the transformation
+			// stored in column k of A.
+			if k < n-1 {
+				bi.Dger(n-k-1, nrhs, -1, a[(k+1)*lda+k:], lda, b[k*ldb:], 1, b[(k+1)*ldb:], ldb)
+			}
+			// Multiply by the inverse of the diagonal block.
+			bi.Dscal(nrhs, 1/a[k*lda+k], b[k*ldb:], 1)
+			k++
+			continue
+		}
+		// 2×2 diagonal block. Interchange rows k+1 and -ipiv[k]-1.
+		if kp := -ipiv[k] - 1; kp != k+1 {
+			bi.Dswap(nrhs, b[(k+1)*ldb:], 1, b[kp*ldb:], 1)
+		}
+		// Multiply by inv(L_k), where L_k is the transformation stored in
+		// columns k and k+1 of A.
+		if k < n-2 {
+			bi.Dger(n-k-2, nrhs, -1, a[(k+2)*lda+k:], lda, b[k*ldb:], 1, b[(k+2)*ldb:], ldb)
+			bi.Dger(n-k-2, nrhs, -1, a[(k+2)*lda+k+1:], lda, b[(k+1)*ldb:], 1, b[(k+2)*ldb:], ldb)
+		}
+		// Multiply by the inverse of the diagonal block.
+		akm1k := a[(k+1)*lda+k]
+		akm1 := a[k*lda+k] / akm1k
+		ak := a[(k+1)*lda+k+1] / akm1k
+		denom := akm1*ak - 1
+		for j := 0; j < nrhs; j++ {
+			bkm1 := b[k*ldb+j] / akm1k
+			bk := b[(k+1)*ldb+j] / akm1k
+			b[k*ldb+j] = (ak*bkm1 - bk) / denom
+			b[(k+1)*ldb+j] = (akm1*bk - bkm1) / denom
+		}
+		k += 2
+	}
+
+	// Next solve Lᵀ*X = B, overwriting B with X. k decreases from n-1 to 0 in
+	// steps of 1 or 2.
+	for k := n - 1; k >= 0; {
+		if ipiv[k] >= 0 {
+			// 1×1 diagonal block. Multiply by inv(L_kᵀ).
+			if k < n-1 {
+				bi.Dgemv(blas.Trans, n-k-1, nrhs, -1, b[(k+1)*ldb:], ldb, a[(k+1)*lda+k:], lda, 1, b[k*ldb:], 1)
+			}
+			// Interchange rows k and ipiv[k].
+			if kp := ipiv[k]; kp != k {
+				bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+			}
+			k--
+			continue
+		}
+		// 2×2 diagonal block. Multiply by inv(L_{k-1}ᵀ).
+		if k < n-1 {
+			bi.Dgemv(blas.Trans, n-k-1, nrhs, -1, b[(k+1)*ldb:], ldb, a[(k+1)*lda+k:], lda, 1, b[k*ldb:], 1)
+			bi.Dgemv(blas.Trans, n-k-1, nrhs, -1, b[(k+1)*ldb:], ldb, a[(k+1)*lda+k-1:], lda, 1, b[(k-1)*ldb:], 1)
+		}
+		// Interchange rows k and -ipiv[k]-1.
+		if kp := -ipiv[k] - 1; kp != k {
+			bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+		}
+		k -= 2
+	}
+}
@@ -0,0 +1,708 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtgevc computes some or all of the right and/or left eigenvectors of a pair
+// of n×n real matrices (S,P), where S is quasi-triangular and P is upper
+// triangular. Matrix pairs of this type are produced by the generalized Schur
+// factorization of a matrix pair (A,B)
+//
+//	A = Q*S*Zᵀ,  B = Q*P*Zᵀ,
+//
+// as computed by Dhgeqz.
+//
+// The right eigenvector x and the left eigenvector y of (S,P) corresponding to
+// an eigenvalue w are defined by
+//
+//	S*x = w*P*x,  yᴴ*S = w*yᴴ*P.
+//
+// The eigenvalues are not input to this routine, but are computed directly
+// from the diagonal blocks of S and P.
+//
+// This routine returns the matrices X and/or Y of right and left eigenvectors
+// of (S,P), or the products Z*X and/or Q*Y, where Z and Q are input matrices.
+// If Q and Z are the orthogonal factors from the generalized Schur
+// factorization of a matrix pair (A,B), then Z*X and Q*Y are the matrices of
+// right and left eigenvectors of (A,B).
+//
+// If side == lapack.EVRight, only right eigenvectors will be computed.
+// If side == lapack.EVLeft, only left eigenvectors will be computed.
+// If side == lapack.EVBoth, both right and left eigenvectors will be computed.
+// For other values of side, Dtgevc will panic.
+//
+// If howmny == lapack.EVAll, all right and/or left eigenvectors will be
+// computed.
+// If howmny == lapack.EVAllMulQ, all right and/or left eigenvectors will be
+// computed and back-transformed using the matrices in VR and/or VL.
+// If howmny == lapack.EVSelected, right and/or left eigenvectors will be
+// computed as indicated by selected.
+// For other values of howmny, Dtgevc will panic.
+//
+// selected specifies which eigenvectors will be computed. It must have length n
+// if howmny == lapack.EVSelected, and it is not referenced otherwise.
+// If w_j is a real eigenvalue, the corresponding real eigenvector will be
+// computed if selected[j] is true.
+// If w_j and w_{j+1} are a complex conjugate pair of eigenvalues, the
+// corresponding complex eigenvector is computed if either selected[j] or
+// selected[j+1] is true.
+//
+// VL and VR are n×mm matrices. If howmny is lapack.EVAll or lapack.EVAllMulQ,
+// mm must be at least n. If howmny is lapack.EVSelected, mm must be large
+// enough to store the selected eigenvectors. Each selected real eigenvector
+// occupies one column and each selected complex eigenvector occupies two
+// columns. If mm is not sufficiently large, Dtgevc will panic.
+//
+// On entry, if howmny is lapack.EVAllMulQ, it is assumed that VL (if side is
+// lapack.EVLeft or lapack.EVBoth) contains an n×n matrix Q, and that VR (if
+// side is lapack.EVRight or lapack.EVBoth) contains an n×n matrix Z.
+//
+// On return, VL and VR contain the left and right eigenvectors of (S,P), or
+// the back-transformed eigenvectors if howmny is lapack.EVAllMulQ. If howmny
+// is lapack.EVSelected, the eigenvectors specified by selected are stored
+// consecutively in the columns of VL and VR, in the same order as their
+// eigenvalues. VL is not referenced if side == lapack.EVRight, and VR is not
+// referenced if side == lapack.EVLeft.
+//
+// Complex eigenvectors corresponding to a complex eigenvalue are stored in VL
+// and VR in two consecutive columns, the first holding the real part, and the
+// second the imaginary part. The stored eigenvector corresponds to the
+// eigenvalue with positive imaginary part.
+//
+// Each eigenvector is scaled so that the element of largest magnitude has
+// magnitude 1. Here the magnitude of a complex number (x,y) is taken to be
+// |x| + |y|.
+//
+// The 2×2 diagonal blocks of P corresponding to 2×2 blocks of S must be in
+// positive diagonal form as returned by Dhgeqz.
+//
+// work must have length at least 6*n.
+//
+// Dtgevc returns the number of columns in VL and/or VR actually used to store
+// the eigenvectors. ok is false if a 2×2 diagonal block of (S,P) has real
+// eigenvalues, in which case the eigenvectors are not computed.
+//
+// Dtgevc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) (m int, ok bool) {
+	bothv := side == lapack.EVBoth
+	compr := side == lapack.EVRight || bothv
+	compl := side == lapack.EVLeft || bothv
+	switch {
+	case !compr && !compl:
+		panic(badEVSide)
+	case howmny != lapack.EVAll && howmny != lapack.EVAllMulQ && howmny != lapack.EVSelected:
+		panic(badEVHowMany)
+	case n < 0:
+		panic(nLT0)
+	case lds < max(1, n):
+		panic(badLdS)
+	case ldp < max(1, n):
+		panic(badLdP)
+	case mm < 0:
+		panic(mmLT0)
+	case ldvl < 1:
+		panic(badLdVL)
+	case ldvr < 1:
+		panic(badLdVR)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(s) < (n-1)*lds+n:
+		panic(shortS)
+	case len(p) < (n-1)*ldp+n:
+		panic(shortP)
+	case len(work) < 6*n:
+		panic(shortWork)
+	}
+
+	ilall := howmny != lapack.EVSelected
+	ilback := howmny == lapack.EVAllMulQ
+
+	// Count the number of eigenvectors to be computed.
+	if ilall {
+		m = n
+	} else {
+		if len(selected) != n {
+			panic(badLenSelected)
+		}
+		for j := 0; j < n; j++ {
+			if j < n-1 && s[(j+1)*lds+j] != 0 {
+				if selected[j] || selected[j+1] {
+					m += 2
+				}
+				j++
+			} else if selected[j] {
+				m++
+			}
+		}
+	}
+	if mm < m {
+		panic(badMm)
+	}
+
+	// Quick return if no eigenvectors were selected.
+	if m == 0 {
+		return 0, true
+	}
+
+	switch {
+	case compl && ldvl < mm:
+		panic(badLdVL)
+	case compl && len(vl) < (n-1)*ldvl+mm:
+		panic(shortVL)
+	case compr && ldvr < mm:
+		panic(badLdVR)
+	case compr && len(vr) < (n-1)*ldvr+mm:
+		panic(shortVR)
+	}
+
+	const (
+		safmin = dlamchS
+		ulp    = dlamchP
+	)
+	small := safmin * float64(n) / ulp
+	big := 1 / small
+	bignum := 1 / (safmin * float64(n))
+
+	// Compute the 1-norm of each column of the strictly upper triangular part
+	// (that is, excluding all elements belonging to the diagonal blocks) of S
+	// and P to check for possible overflow in the triangular solver.
+	anorm := math.Abs(s[0])
+	if n > 1 {
+		anorm += math.Abs(s[lds])
+	}
+	bnorm := math.Abs(p[0])
+	work[0] = 0
+	work[n] = 0
+	for j := 1; j < n; j++ {
+		iend := j - 1
+		if s[j*lds+j-1] != 0 {
+			iend = j - 2
+		}
+		var temp, temp2 float64
+		for i := 0; i <= iend; i++ {
+			temp += math.Abs(s[i*lds+j])
+		}
+		for i := 0; i < j; i++ {
+			temp2 += math.Abs(p[i*ldp+j])
+		}
+		work[j] = temp
+		work[n+j] = temp2
+		for i := iend + 1; i <= min(j+1, n-1); i++ {
+			temp += math.Abs(s[i*lds+j])
+			temp2 += math.Abs(p[i*ldp+j])
+		}
+		anorm = math.Max(anorm, temp)
+		bnorm = math.Max(bnorm, temp2)
+	}
+	ascale := 1 / math.Max(anorm, safmin)
+	bscale := 1 / math.Max(bnorm, safmin)
+
+	bi := blas64.Implementation()
+
+	// Left eigenvectors.
+	if compl {
+		ieig := 0
+		ilcplx := false
+		for je := 0; je < n; je++ {
+			// Skip this iteration if (a) howmny == lapack.EVSelected and
+			// selected[je] is false, or (b) this would be the second of a
+			// complex pair.
+			if ilcplx {
+				ilcplx = false
+				continue
+			}
+			nw := 1
+			if je < n-1 && s[(je+1)*lds+je] != 0 {
+				ilcplx = true
+				nw = 2
+			}
+			if !ilall {
+				if ilcplx && !selected[je] && !selected[je+1] {
+					continue
+				}
+				if !ilcplx && !selected[je] {
+					continue
+				}
+			}
+
+			// Decide if (a) singular pencil, (b) real eigenvalue, or (c)
+			// complex eigenvalue.
+			if !ilcplx && math.Abs(s[je*lds+je]) <= safmin && math.Abs(p[je*ldp+je]) <= safmin {
+				// Singular matrix pencil: return unit eigenvector.
+				for jr := 0; jr < n; jr++ {
+					vl[jr*ldvl+ieig] = 0
+				}
+				vl[ieig*ldvl+ieig] = 1
+				ieig++
+				continue
+			}
+
+			// Clear vector.
+			for jw := 0; jw < nw; jw++ {
+				for jr := 0; jr < n; jr++ {
+					work[(jw+2)*n+jr] = 0
+				}
+			}
+
+			// Compute coefficients in (a*S - b*P)ᴴ*y = 0, where a is
+			// acoef and b is bcoefr + i*bcoefi.
+			var acoef, bcoefr, bcoefi, acoefa, bcoefa, xmax float64
+			if !ilcplx {
+				// Real eigenvalue.
+				acoef, bcoefr = dtgevcRealCoef(s[je*lds+je], p[je*ldp+je], ascale, bscale, anorm, bnorm, small, big)
+				acoefa = math.Abs(acoef)
+				bcoefa = math.Abs(bcoefr)
+
+				// First component is 1.
+				work[2*n+je] = 1
+				xmax = 1
+			} else {
+				// Complex eigenvalue.
+				acoef, _, bcoefr, _, bcoefi = impl.Dlag2(s[je*lds+je:], lds, p[je*ldp+je:], ldp)
+				bcoefi = -bcoefi
+				if bcoefi == 0 {
+					return m, false
+				}
+
+				// Scale to avoid over/underflow.
+				acoef, bcoefr, bcoefi = dtgevcComplexCoef(acoef, bcoefr, bcoefi, ascale, bscale)
+				acoefa = math.Abs(acoef)
+				bcoefa = math.Abs(bcoefr) + math.Abs(bcoefi)
+
+				// Compute first two components of eigenvector.
+				temp := acoef * s[(je+1)*lds+je]
+				temp2r := acoef*s[je*lds+je] - bcoefr*p[je*ldp+je]
+				temp2i := -bcoefi * p[je*ldp+je]
+				if math.Abs(temp) > math.Abs(temp2r)+math.Abs(temp2i) {
+					work[2*n+je] = 1
+					work[3*n+je] = 0
+					work[2*n+je+1] = -temp2r / temp
+					work[3*n+je+1] = -temp2i / temp
+				} else {
+					work[2*n+je+1] = 1
+					work[3*n+je+1] = 0
+					temp = acoef * s[je*lds+je+1]
+					work[2*n+je] = (bcoefr*p[(je+1)*ldp+je+1] - acoef*s[(je+1)*lds+je+1]) / temp
+					work[3*n+je] = bcoefi * p[(je+1)*ldp+je+1] / temp
+				}
+				xmax = math.Max(math.Abs(work[2*n+je])+math.Abs(work[3*n+je]),
+					math.Abs(work[2*n+je+1])+math.Abs(work[3*n+je+1]))
+			}
+			dmin := math.Max(math.Max(ulp*acoefa*anorm, ulp*bcoefa*bnorm), safmin)
+
+			// Triangular solve of (a*S - b*P)ᵀ*y = 0, rowwise in
+			// (a*S - b*P)ᵀ, or columnwise in (a*S - b*P).
+			il2by2 := false
+			for j := je + nw; j < n; j++ {
+				if il2by2 {
+					il2by2 = false
+					continue
+				}
+				na := 1
+				bdiag := [2]float64{p[j*ldp+j], 0}
+				if j < n-1 && s[(j+1)*lds+j] != 0 {
+					il2by2 = true
+					bdiag[1] = p[(j+1)*ldp+j+1]
+					na = 2
+				}
+
+				// Check whether scaling is necessary for dot products.
+				xscale := 1 / math.Max(1, xmax)
+				temp := math.Max(math.Max(work[j], work[n+j]), acoefa*work[j]+bcoefa*work[n+j])
+				if il2by2 {
+					temp = math.Max(temp, math.Max(math.Max(work[j+1], work[n+j+1]), acoefa*work[j+1]+bcoefa*work[n+j+1]))
+				}
+				if temp > bignum*xscale {
+					for jw := 0; jw < nw; jw++ {
+						bi.Dscal(n-je, xscale, work[(jw+2)*n+je:], 1)
+					}
+					xmax *= xscale
+				}
+
+				// Compute dot products
+				//
+				//	sum = Σ_{k=je}^{j-1} conj(a*S[k,j] - b*P[k,j])*x[k].
+				//
+				// To reduce the op count, this is done as
+				//
+				//	a*conj(Σ S[k,j]*x[k]) - b*conj(Σ P[k,j]*x[k]),
+				//
+				// which may cause underflow problems if S or P are close
+				// to underflow.
+				var sums, sump, sum [4]float64
+				for jw := 0; jw < nw; jw++ {
+					for ja := 0; ja < na; ja++ {
+						for jr := je; jr < j; jr++ {
+							sums[ja*2+jw] += s[jr*lds+j+ja] * work[(jw+2)*n+jr]
+							sump[ja*2+jw] += p[jr*ldp+j+ja] * work[(jw+2)*n+jr]
+						}
+					}
+				}
+				for ja := 0; ja < na; ja++ {
+					if ilcplx {
+						sum[ja*2] = -acoef*sums[ja*2] + bcoefr*sump[ja*2] - bcoefi*sump[ja*2+1]
+						sum[ja*2+1] = -acoef*sums[ja*2+1] + bcoefr*sump[ja*2+1] + bcoefi*sump[ja*2]
+					} else {
+						sum[ja*2] = -acoef*sums[ja*2] + bcoefr*sump[ja*2]
+					}
+				}
+
+				// Solve (a*S - b*P)ᵀ*y = sum with scaling and perturbation
+				// of the denominator.
+				var x [4]float64
+				scale, xnorm, _ := impl.Dlaln2(true, na, nw, dmin, acoef, s[j*lds+j:], lds, bdiag[0], bdiag[1], sum[:], 2, bcoefr, bcoefi, x[:], 2)
+				for jw := 0; jw < nw; jw++ {
+					for ja := 0; ja < na; ja++ {
+						work[(jw+2)*n+j+ja] = x[ja*2+jw]
+					}
+				}
+				if scale < 1 {
+					for jw := 0; jw < nw; jw++ {
+						bi.Dscal(j-je, scale, work[(jw+2)*n+je:], 1)
+					}
+					xmax *= scale
+				}
+				xmax = math.Max(xmax, xnorm)
+			}
+
+			// Copy eigenvector to VL, back transforming if
+			// howmny == lapack.EVAllMulQ.
+			ibeg := je
+			if ilback {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dgemv(blas.NoTrans, n, n-je, 1, vl[je:], ldvl, work[(jw+2)*n+je:], 1, 0, work[(jw+4)*n:(jw+5)*n], 1)
+				}
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, work[(jw+4)*n:], 1, vl[ieig+jw:], ldvl)
+				}
+				ibeg = 0
+			} else {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, work[(jw+2)*n:], 1, vl[ieig+jw:], ldvl)
+				}
+			}
+
+			// Scale eigenvector.
+			dtgevcNormalize(ibeg, n, nw, vl[ieig:], ldvl)
+			ieig += nw
+		}
+	}
+
+	// Right eigenvectors.
+	if compr {
+		ieig := m
+		ilcplx := false
+		for je := n - 1; je >= 0; je-- {
+			// Skip this iteration if (a) howmny == lapack.EVSelected and
+			// selected[je] is false, or (b) this would be the second of a
+			// complex pair.
+			if ilcplx {
+				ilcplx = false
+				continue
+			}
+			nw := 1
+			if je > 0 && s[je*lds+je-1] != 0 {
+				ilcplx = true
+				nw = 2
+			}
+			if !ilall {
+				if ilcplx && !selected[je] && !selected[je-1] {
+					continue
+				}
+				if !ilcplx && !selected[je] {
+					continue
+				}
+			}
+
+			// Decide if (a) singular pencil, (b) real eigenvalue, or (c)
+			// complex eigenvalue.
+			if !ilcplx && math.Abs(s[je*lds+je]) <= safmin && math.Abs(p[je*ldp+je]) <= safmin {
+				// Singular matrix pencil: return unit eigenvector.
+				ieig--
+				for jr := 0; jr < n; jr++ {
+					vr[jr*ldvr+ieig] = 0
+				}
+				vr[ieig*ldvr+ieig] = 1
+				continue
+			}
+
+			// Clear vector.
+			for jw := 0; jw < nw; jw++ {
+				for jr := 0; jr < n; jr++ {
+					work[(jw+2)*n+jr] = 0
+				}
+			}
+
+			// Compute coefficients in (a*S - b*P)*x = 0, where a is acoef
+			// and b is bcoefr + i*bcoefi.
+			var acoef, bcoefr, bcoefi, acoefa, bcoefa, xmax float64
+			if !ilcplx {
+				// Real eigenvalue.
+				acoef, bcoefr = dtgevcRealCoef(s[je*lds+je], p[je*ldp+je], ascale, bscale, anorm, bnorm, small, big)
+				acoefa = math.Abs(acoef)
+				bcoefa = math.Abs(bcoefr)
+
+				// First component is 1.
+				work[2*n+je] = 1
+				xmax = 1
+
+				// Compute contribution from column je of S and P to sum.
+				for jr := 0; jr < je; jr++ {
+					work[2*n+jr] = bcoefr*p[jr*ldp+je] - acoef*s[jr*lds+je]
+				}
+			} else {
+				// Complex eigenvalue.
+				acoef, _, bcoefr, _, bcoefi = impl.Dlag2(s[(je-1)*lds+je-1:], lds, p[(je-1)*ldp+je-1:], ldp)
+				if bcoefi == 0 {
+					return m, false
+				}
+
+				// Scale to avoid over/underflow.
+				acoef, bcoefr, bcoefi = dtgevcComplexCoef(acoef, bcoefr, bcoefi, ascale, bscale)
+				acoefa = math.Abs(acoef)
+				bcoefa = math.Abs(bcoefr) + math.Abs(bcoefi)
+
+				// Compute first two components of eigenvector and
+				// contribution to sums.
+				temp := acoef * s[je*lds+je-1]
+				temp2r := acoef*s[je*lds+je] - bcoefr*p[je*ldp+je]
+				temp2i := -bcoefi * p[je*ldp+je]
+				if math.Abs(temp) >= math.Abs(temp2r)+math.Abs(temp2i) {
+					work[2*n+je] = 1
+					work[3*n+je] = 0
+					work[2*n+je-1] = -temp2r / temp
+					work[3*n+je-1] = -temp2i / temp
+				} else {
+					work[2*n+je-1] = 1
+					work[3*n+je-1] = 0
+					temp = acoef * s[(je-1)*lds+je]
+					work[2*n+je] = (bcoefr*p[(je-1)*ldp+je-1] - acoef*s[(je-1)*lds+je-1]) / temp
+					work[3*n+je] = bcoefi * p[(je-1)*ldp+je-1] / temp
+				}
+				xmax = math.Max(math.Abs(work[2*n+je])+math.Abs(work[3*n+je]),
+					math.Abs(work[2*n+je-1])+math.Abs(work[3*n+je-1]))
+
+				// Compute contribution from columns je and je-1 of S and
+				// P to the sums.
+				creala := acoef * work[2*n+je-1]
+				cimaga := acoef * work[3*n+je-1]
+				crealb := bcoefr*work[2*n+je-1] - bcoefi*work[3*n+je-1]
+				cimagb := bcoefi*work[2*n+je-1] + bcoefr*work[3*n+je-1]
+				cre2a := acoef * work[2*n+je]
+				cim2a := acoef * work[3*n+je]
+				cre2b := bcoefr*work[2*n+je] - bcoefi*work[3*n+je]
+				cim2b := bcoefi*work[2*n+je] + bcoefr*work[3*n+je]
+				for jr := 0; jr < je-1; jr++ {
+					work[2*n+jr] = -creala*s[jr*lds+je-1] + crealb*p[jr*ldp+je-1] - cre2a*s[jr*lds+je] + cre2b*p[jr*ldp+je]
+					work[3*n+jr] = -cimaga*s[jr*lds+je-1] + cimagb*p[jr*ldp+je-1] - cim2a*s[jr*lds+je] + cim2b*p[jr*ldp+je]
+				}
+			}
+			dmin := math.Max(math.Max(ulp*acoefa*anorm, ulp*bcoefa*bnorm), safmin)
+
+			// Columnwise triangular solve of (a*S - b*P)*x = 0.
+			il2by2 := false
+			for j := je - nw; j >= 0; j-- {
+				// If a 2×2 block is in position j-1:j+1, wait until the
+				// next iteration to process it (when it will be j:j+2).
+				if !il2by2 && j > 0 && s[j*lds+j-1] != 0 {
+					il2by2 = true
+					continue
+				}
+				na := 1
+				bdiag := [2]float64{p[j*ldp+j], 0}
+				if il2by2 {
+					na = 2
+					bdiag[1] = p[(j+1)*ldp+j+1]
+				}
+
+				// Compute x[j] (and x[j+1], if 2×2 block).
+				var b, x [4]float64
+				for jw := 0; jw < nw; jw++ {
+					for ja := 0; ja < na; ja++ {
+						b[ja*2+jw] = work[(jw+2)*n+j+ja]
+					}
+				}
+				scale, xnorm, _ := impl.Dlaln2(false, na, nw, dmin, acoef, s[j*lds+j:], lds, bdiag[0], bdiag[1], b[:], 2, bcoefr, bcoefi, x[:], 2)
+				if scale < 1 {
+					for jw := 0; jw < nw; jw++ {
+						bi.Dscal(je+1, scale, work[(jw+2)*n:], 1)
+					}
+				}
+				xmax = math.Max(scale*xmax, xnorm)
+				for jw := 0; jw < nw; jw++ {
+					for ja := 0; ja < na; ja++ {
+						work[(jw+2)*n+j+ja] = x[ja*2+jw]
+					}
+				}
+
+				// w = w + x[j]*(a*S[:,j] - b*P[:,j]) with scaling.
+				if j > 0 {
+					// Check whether scaling is necessary for sum.
+					xscale := 1 / math.Max(1, xmax)
+					temp := acoefa*work[j] + bcoefa*work[n+j]
+					if il2by2 {
+						temp = math.Max(temp, acoefa*work[j+1]+bcoefa*work[n+j+1])
+					}
+					temp = math.Max(temp, math.Max(acoefa, bcoefa))
+					if temp > bignum*xscale {
+						for jw := 0; jw < nw; jw++ {
+							bi.Dscal(je+1, xscale, work[(jw+2)*n:], 1)
+						}
+						xmax *= xscale
+					}
+
+					// Compute the contributions of the off-diagonals of
+					// column j (and j+1, if 2×2 block) of S and P to the
+					// sums.
+					for ja := 0; ja < na; ja++ {
+						if ilcplx {
+							creala := acoef * work[2*n+j+ja]
+							cimaga := acoef * work[3*n+j+ja]
+							crealb := bcoefr*work[2*n+j+ja] - bcoefi*work[3*n+j+ja]
+							cimagb := bcoefi*work[2*n+j+ja] + bcoefr*work[3*n+j+ja]
+							for jr := 0; jr < j; jr++ {
+								work[2*n+jr] += -creala*s[jr*lds+j+ja] + crealb*p[jr*ldp+j+ja]
+								work[3*n+jr] += -cimaga*s[jr*lds+j+ja] + cimagb*p[jr*ldp+j+ja]
+							}
+						} else {
+							creala := acoef * work[2*n+j+ja]
+							crealb := bcoefr * work[2*n+j+ja]
+							for jr := 0; jr < j; jr++ {
+								work[2*n+jr] += -creala*s[jr*lds+j+ja] + crealb*p[jr*ldp+j+ja]
+							}
+						}
+					}
+				}
+				il2by2 = false
+			}
+
+			// Copy eigenvector to VR, back transforming if
+			// howmny == lapack.EVAllMulQ.
+			ieig -= nw
+			iend := je + 1
+			if ilback {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dgemv(blas.NoTrans, n, je+1, 1, vr, ldvr, work[(jw+2)*n:], 1, 0, work[(jw+4)*n:(jw+5)*n], 1)
+				}
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, work[(jw+4)*n:], 1, vr[ieig+jw:], ldvr)
+				}
+				iend = n
+			} else {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, work[(jw+2)*n:], 1, vr[ieig+jw:], ldvr)
+				}
+			}
+
+			// Scale eigenvector.
+			dtgevcNormalize(0, iend, nw, vr[ieig:], ldvr)
+		}
+	}
+	return m, true
+}
+
+// dtgevcRealCoef returns the coefficients a and b of the equation
+// (a*S - b*P)*x = 0 for the real eigenvalue given by the diagonal elements sjj
+// and pjj, scaled to avoid underflow.
+func dtgevcRealCoef(sjj, pjj, ascale, bscale, anorm, bnorm, small, big float64) (acoef, bcoefr float64) {
+	const safmin = dlamchS
+	temp := 1 / math.Max(math.Max(math.Abs(sjj)*ascale, math.Abs(pjj)*bscale), safmin)
+	salfar := (temp * sjj) * ascale
+	sbeta := (temp * pjj) * bscale
+	acoef = sbeta * ascale
+	bcoefr = salfar * bscale
+
+	// Scale to avoid underflow.
+	scale := 1.0
+	lsa := math.Abs(sbeta) >= safmin && math.Abs(acoef) < small
+	lsb := math.Abs(salfar) >= safmin && math.Abs(bcoefr) < small
+	if lsa {
+		scale = (small / math.Abs(sbeta)) * math.Min(anorm, big)
+	}
+	if lsb {
+		scale = math.Max(scale, (small/math.Abs(salfar))*math.Min(bnorm, big))
+	}
+	if lsa || lsb {
+		scale = math.Min(scale, 1/(safmin*math.Max(1, math.Max(math.Abs(acoef), math.Abs(bcoefr)))))
+		if lsa {
+			acoef = ascale * (scale * sbeta)
+		} else {
+			acoef *= scale
+		}
+		if lsb {
+			bcoefr = bscale * (scale * salfar)
+		} else {
+			bcoefr *= scale
+		}
+	}
+	return acoef, bcoefr
+}
+
+// dtgevcComplexCoef scales the coefficients a and b = br + i*bi of the
+// equation (a*S - b*P)*x = 0 for a complex eigenvalue to avoid over- and
+// underflow.
+func dtgevcComplexCoef(acoef, bcoefr, bcoefi, ascale, bscale float64) (float64, float64, float64) {
+	const (
+		safmin = dlamchS
+		ulp    = dlamchP
+	)
+	acoefa := math.Abs(acoef)
+	bcoefa := math.Abs(bcoefr) + math.Abs(bcoefi)
+	scale := 1.0
+	if acoefa*ulp < safmin && acoefa >= safmin {
+		scale = (safmin / ulp) / acoefa
+	}
+	if bcoefa*ulp < safmin && bcoefa >= safmin {
+		scale = math.Max(scale, (safmin/ulp)/bcoefa)
+	}
+	if safmin*acoefa > ascale {
+		scale = ascale / (safmin * acoefa)
+	}
+	if safmin*bcoefa > bscale {
+		scale = math.Min(scale, bscale/(safmin*bcoefa))
+	}
+	if scale != 1 {
+		acoef *= scale
+		bcoefr *= scale
+		bcoefi *= scale
+	}
+	return acoef, bcoefr, bcoefi
+}
+
+// dtgevcNormalize scales the nw columns of v so that the element in rows
+// ibeg:iend of largest magnitude has magnitude 1. The magnitude of a complex
+// element stored in two consecutive columns is taken to be |re| + |im|.
+func dtgevcNormalize(ibeg, iend, nw int, v []float64, ldv int) {
+	const safmin = dlamchS
+	var xmax float64
+	for j := ibeg; j < iend; j++ {
+		if nw == 2 {
+			xmax = math.Max(xmax, math.Abs(v[j*ldv])+math.Abs(v[j*ldv+1]))
+		} else {
+			xmax = math.Max(xmax, math.Abs(v[j*ldv]))
+		}
+	}
+	if xmax > safmin {
+		xscale := 1 / xmax
+		for j := ibeg; j < iend; j++ {
+			for jw := 0; jw < nw; jw++ {
+				v[j*ldv+jw] *= xscale
+			}
+		}
+	}
+}
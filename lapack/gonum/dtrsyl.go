@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dtrsyl solves the real Sylvester matrix equation
+//
+//	op(A)*X + isgn*X*op(B) = scale*C
+//
+// where op(A) = A or Aᵀ, A is an m×m and B an n×n upper quasi-triangular
+// matrix in Schur canonical form as returned by Dhseqr, and C and X are m×n
+// matrices.
+//
+// op(A) is A if trana == blas.NoTrans, and Aᵀ if trana == blas.Trans or
+// blas.ConjTrans. op(B) is defined similarly by tranb.
+//
+// isgn must be 1 or -1, otherwise Dtrsyl will panic.
+//
+// On return, C will be overwritten by the solution X. scale is an output
+// scale factor, 0 < scale <= 1, chosen to avoid overflow in X.
+//
+// If ok is false, A and -isgn*B have common or very close eigenvalues, and
+// perturbed values were used to solve the equation. The solution may then
+// be inaccurate.
+//
+// Dtrsyl is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool) {
+	switch {
+	case trana != blas.NoTrans && trana != blas.Trans && trana != blas.ConjTrans:
+		panic(badTrans)
+	case tranb != blas.NoTrans && tranb != blas.Trans && tranb != blas.ConjTrans:
+		panic(badTrans)
+	case isgn != 1 && isgn != -1:
+		panic(badIsgn)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, m):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+m:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	notrana := trana == blas.NoTrans
+	notranb := tranb == blas.NoTrans
+	sgn := float64(isgn)
+
+	// Determine the diagonal blocks of A and B in the order in which they
+	// have to be processed. If op(A) is upper quasi-triangular, the block
+	// rows of X are computed from the bottom up, otherwise from the top
+	// down. If op(B) is upper quasi-triangular, the block columns of X are
+	// computed from left to right, otherwise from right to left.
+	rowBlocks := schurBlocks(m, a, lda, notrana)
+	colBlocks := schurBlocks(n, b, ldb, !notranb)
+
+	bi := blas64.Implementation()
+	scale = 1
+	ok = true
+	var rhs, x [4]float64
+	for _, cb := range colBlocks {
+		l, n2 := cb[0], cb[1]
+		for _, rb := range rowBlocks {
+			k, n1 := rb[0], rb[1]
+			// Compute the right-hand side for the current block by
+			// subtracting the contributions of the already computed
+			// blocks of X.
+			for i := k; i < k+n1; i++ {
+				for j := l; j < l+n2; j++ {
+					sum := c[i*ldc+j]
+					if notrana {
+						if k+n1 < m {
+							sum -= bi.Ddot(m-k-n1, a[i*lda+k+n1:], 1, c[(k+n1)*ldc+j:], ldc)
+						}
+					} else if k > 0 {
+						sum -= bi.Ddot(k, a[i:], lda, c[j:], ldc)
+					}
+					if notranb {
+						if l > 0 {
+							sum -= sgn * bi.Ddot(l, c[i*ldc:], 1, b[j:], ldb)
+						}
+					} else if l+n2 < n {
+						sum -= sgn * bi.Ddot(n-l-n2, c[i*ldc+l+n2:], 1, b[j*ldb+l+n2:], 1)
+					}
+					rhs[(i-k)*2+j-l] = sum
+				}
+			}
+			scaloc, _, okloc := impl.Dlasy2(!notrana, !notranb, isgn, n1, n2, a[k*lda+k:], lda, b[l*ldb+l:], ldb, rhs[:], 2, x[:], 2)
+			if !okloc {
+				ok = false
+			}
+			if scaloc != 1 {
+				for i := 0; i < m; i++ {
+					bi.Dscal(n, scaloc, c[i*ldc:], 1)
+				}
+				scale *= scaloc
+			}
+			for i := 0; i < n1; i++ {
+				copy(c[(k+i)*ldc+l:(k+i)*ldc+l+n2], x[i*2:i*2+n2])
+			}
+		}
+	}
+	return scale, ok
+}
+
+// schurBlocks returns the starting indices and sizes of the diagonal blocks of
+// the n×n upper quasi-triangular matrix T. The blocks are returned in
+// descending order if reverse is true.
+func schurBlocks(n int, t []float64, ldt int, reverse bool) [][2]int {
+	var blocks [][2]int
+	for k := 0; k < n; {
+		if k < n-1 && t[(k+1)*ldt+k] != 0 {
+			blocks = append(blocks, [2]int{k, 2})
+			k += 2
+			continue
+		}
+		blocks = append(blocks, [2]int{k, 1})
+		k++
+	}
+	if reverse {
+		for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+			blocks[i], blocks[j] = blocks[j], blocks[i]
+		}
+	}
+	return blocks
+}
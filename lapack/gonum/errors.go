@@ -14,7 +14,10 @@ const (
 	badEVComp           = "lapack: bad EVComp"
 	badEVHowMany        = "lapack: bad EVHowMany"
 	badEVJob            = "lapack: bad EVJob"
+	badEVRange          = "lapack: bad EVRange"
 	badEVSide           = "lapack: bad EVSide"
+	badEquilibration    = "lapack: bad Equilibration"
+	badFact             = "lapack: bad Fact"
 	badGSVDJob          = "lapack: bad GSVDJob"
 	badGenOrtho         = "lapack: bad GenOrtho"
 	badLeftEVJob        = "lapack: bad LeftEVJob"
@@ -24,6 +27,7 @@ const (
 	badOrthoComp        = "lapack: bad OrthoComp"
 	badPivot            = "lapack: bad Pivot"
 	badRightEVJob       = "lapack: bad RightEVJob"
+	badSVDComp          = "lapack: bad SVDComp"
 	badSVDJob           = "lapack: bad SVDJob"
 	badSchurComp        = "lapack: bad SchurComp"
 	badSchurJob         = "lapack: bad SchurJob"
@@ -38,12 +42,17 @@ const (
 	// Panic strings for bad numerical and string values.
 	badIfst     = "lapack: ifst out of range"
 	badIhi      = "lapack: ihi out of range"
+	badIl       = "lapack: il out of range"
 	badIhiz     = "lapack: ihiz out of range"
 	badIlo      = "lapack: ilo out of range"
 	badIloz     = "lapack: iloz out of range"
 	badIlst     = "lapack: ilst out of range"
+	badInterval = "lapack: vl >= vu"
 	badIsave    = "lapack: bad isave value"
+	badIsgn     = "lapack: bad isgn value"
 	badIspec    = "lapack: bad ispec value"
+	badItype    = "lapack: bad itype value"
+	badIu       = "lapack: iu out of range"
 	badJ1       = "lapack: j1 out of range"
 	badJpvt     = "lapack: bad element of jpvt"
 	badK1       = "lapack: k1 out of range"
@@ -51,6 +60,7 @@ const (
 	badKacc22   = "lapack: invalid value of kacc22"
 	badKbot     = "lapack: kbot out of range"
 	badKtop     = "lapack: ktop out of range"
+	badLIWork   = "lapack: insufficient declared integer workspace length"
 	badLWork    = "lapack: insufficient declared workspace length"
 	badMm       = "lapack: mm out of range"
 	badN1       = "lapack: bad value of n1"
@@ -60,6 +70,9 @@ const (
 	badNh       = "lapack: bad value of nh"
 	badNw       = "lapack: bad value of nw"
 	badPp       = "lapack: bad value of pp"
+	badScaleC   = "lapack: non-positive scale factor in c"
+	badScaleR   = "lapack: non-positive scale factor in r"
+	badScaleS   = "lapack: non-positive scale factor in s"
 	badShifts   = "lapack: bad shifts"
 	i0LT0       = "lapack: i0 < 0"
 	kGTM        = "lapack: k > m"
@@ -102,65 +115,80 @@ const (
 	// Panic strings for bad slice lengths.
 	badLenAlpha    = "lapack: bad length of alpha"
 	badLenBeta     = "lapack: bad length of beta"
+	badLenIblock   = "lapack: bad length of iblock"
 	badLenIpiv     = "lapack: bad length of ipiv"
 	badLenJpiv     = "lapack: bad length of jpiv"
 	badLenJpvt     = "lapack: bad length of jpvt"
 	badLenK        = "lapack: bad length of k"
 	badLenPiv      = "lapack: bad length of piv"
+	badLenIsplit   = "lapack: bad length of isplit"
+	badLenIsuppz   = "lapack: bad length of isuppz"
 	badLenSelected = "lapack: bad length of selected"
 	badLenSi       = "lapack: bad length of si"
 	badLenSr       = "lapack: bad length of sr"
+	badLenSva      = "lapack: bad length of sva"
 	badLenTau      = "lapack: bad length of tau"
 	badLenWi       = "lapack: bad length of wi"
 	badLenWr       = "lapack: bad length of wr"
 
 	// Panic strings for insufficient slice lengths.
-	shortA     = "lapack: insufficient length of a"
-	shortAB    = "lapack: insufficient length of ab"
-	shortAuxv  = "lapack: insufficient length of auxv"
-	shortB     = "lapack: insufficient length of b"
-	shortC     = "lapack: insufficient length of c"
-	shortCNorm = "lapack: insufficient length of cnorm"
-	shortD     = "lapack: insufficient length of d"
-	shortDL    = "lapack: insufficient length of dl"
-	shortDU    = "lapack: insufficient length of du"
-	shortE     = "lapack: insufficient length of e"
-	shortF     = "lapack: insufficient length of f"
-	shortH     = "lapack: insufficient length of h"
-	shortIWork = "lapack: insufficient length of iwork"
-	shortIsgn  = "lapack: insufficient length of isgn"
-	shortQ     = "lapack: insufficient length of q"
-	shortRHS   = "lapack: insufficient length of rhs"
-	shortS     = "lapack: insufficient length of s"
-	shortScale = "lapack: insufficient length of scale"
-	shortT     = "lapack: insufficient length of t"
-	shortTau   = "lapack: insufficient length of tau"
-	shortTauP  = "lapack: insufficient length of tauP"
-	shortTauQ  = "lapack: insufficient length of tauQ"
-	shortU     = "lapack: insufficient length of u"
-	shortV     = "lapack: insufficient length of v"
-	shortVL    = "lapack: insufficient length of vl"
-	shortVR    = "lapack: insufficient length of vr"
-	shortVT    = "lapack: insufficient length of vt"
-	shortVn1   = "lapack: insufficient length of vn1"
-	shortVn2   = "lapack: insufficient length of vn2"
-	shortW     = "lapack: insufficient length of w"
-	shortWH    = "lapack: insufficient length of wh"
-	shortWV    = "lapack: insufficient length of wv"
-	shortWi    = "lapack: insufficient length of wi"
-	shortWork  = "lapack: insufficient length of work"
-	shortWr    = "lapack: insufficient length of wr"
-	shortX     = "lapack: insufficient length of x"
-	shortY     = "lapack: insufficient length of y"
-	shortZ     = "lapack: insufficient length of z"
+	shortA      = "lapack: insufficient length of a"
+	shortAB     = "lapack: insufficient length of ab"
+	shortAF     = "lapack: insufficient length of af"
+	shortAlphai = "lapack: insufficient length of alphai"
+	shortAlphar = "lapack: insufficient length of alphar"
+	shortAuxv   = "lapack: insufficient length of auxv"
+	shortB      = "lapack: insufficient length of b"
+	shortBerr   = "lapack: insufficient length of berr"
+	shortBeta   = "lapack: insufficient length of beta"
+	shortC      = "lapack: insufficient length of c"
+	shortCNorm  = "lapack: insufficient length of cnorm"
+	shortD      = "lapack: insufficient length of d"
+	shortDL     = "lapack: insufficient length of dl"
+	shortDU     = "lapack: insufficient length of du"
+	shortE      = "lapack: insufficient length of e"
+	shortF      = "lapack: insufficient length of f"
+	shortFerr   = "lapack: insufficient length of ferr"
+	shortH      = "lapack: insufficient length of h"
+	shortIWork  = "lapack: insufficient length of iwork"
+	shortIsgn   = "lapack: insufficient length of isgn"
+	shortP      = "lapack: insufficient length of p"
+	shortQ      = "lapack: insufficient length of q"
+	shortR      = "lapack: insufficient length of r"
+	shortRHS    = "lapack: insufficient length of rhs"
+	shortS      = "lapack: insufficient length of s"
+	shortScale  = "lapack: insufficient length of scale"
+	shortT      = "lapack: insufficient length of t"
+	shortTau    = "lapack: insufficient length of tau"
+	shortTauP   = "lapack: insufficient length of tauP"
+	shortTauQ   = "lapack: insufficient length of tauQ"
+	shortU      = "lapack: insufficient length of u"
+	shortV      = "lapack: insufficient length of v"
+	shortVL     = "lapack: insufficient length of vl"
+	shortVR     = "lapack: insufficient length of vr"
+	shortVT     = "lapack: insufficient length of vt"
+	shortVn1    = "lapack: insufficient length of vn1"
+	shortVn2    = "lapack: insufficient length of vn2"
+	shortW      = "lapack: insufficient length of w"
+	shortWH     = "lapack: insufficient length of wh"
+	shortWV     = "lapack: insufficient length of wv"
+	shortWi     = "lapack: insufficient length of wi"
+	shortWork   = "lapack: insufficient length of work"
+	shortWr     = "lapack: insufficient length of wr"
+	shortX      = "lapack: insufficient length of x"
+	shortY      = "lapack: insufficient length of y"
+	shortZ      = "lapack: insufficient length of z"
 
 	// Panic strings for bad leading dimensions of matrices.
 	badLdA    = "lapack: bad leading dimension of A"
+	badLdAF   = "lapack: bad leading dimension of AF"
 	badLdB    = "lapack: bad leading dimension of B"
 	badLdC    = "lapack: bad leading dimension of C"
 	badLdF    = "lapack: bad leading dimension of F"
 	badLdH    = "lapack: bad leading dimension of H"
+	badLdP    = "lapack: bad leading dimension of P"
 	badLdQ    = "lapack: bad leading dimension of Q"
+	badLdS    = "lapack: bad leading dimension of S"
 	badLdT    = "lapack: bad leading dimension of T"
 	badLdU    = "lapack: bad leading dimension of U"
 	badLdV    = "lapack: bad leading dimension of V"
@@ -12,6 +12,11 @@ import (
 
 var impl = Implementation{}
 
+func TestDbdsdc(t *testing.T) {
+	t.Parallel()
+	testlapack.DbdsdcTest(t, impl)
+}
+
 func TestDbdsqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DbdsqrTest(t, impl)
@@ -42,6 +47,21 @@ func TestDgebrd(t *testing.T) {
 	testlapack.DgebrdTest(t, impl)
 }
 
+func TestDgbcon(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbconTest(t, impl)
+}
+
+func TestDgbtrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrfTest(t, impl)
+}
+
+func TestDgbtrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrsTest(t, impl)
+}
+
 func TestDgecon(t *testing.T) {
 	t.Parallel()
 	testlapack.DgeconTest(t, impl)
@@ -67,6 +87,11 @@ func TestDgelqf(t *testing.T) {
 	testlapack.DgelqfTest(t, impl)
 }
 
+func TestDgeequ(t *testing.T) {
+	t.Parallel()
+	testlapack.DgeequTest(t, impl)
+}
+
 func TestDgelq2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dgelq2Test(t, impl)
@@ -82,6 +107,11 @@ func TestDgels(t *testing.T) {
 	testlapack.DgelsTest(t, impl)
 }
 
+func TestDgerfs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgerfsTest(t, impl)
+}
+
 func TestDgerq2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dgerq2Test(t, impl)
@@ -117,12 +147,23 @@ func TestDgesv(t *testing.T) {
 	testlapack.DgesvTest(t, impl)
 }
 
+func TestDgesdd(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	testlapack.DgesddTest(t, impl, tol)
+}
+
 func TestDgesvd(t *testing.T) {
 	t.Parallel()
 	const tol = 1e-13
 	testlapack.DgesvdTest(t, impl, tol)
 }
 
+func TestDgesvx(t *testing.T) {
+	t.Parallel()
+	testlapack.DgesvxTest(t, impl)
+}
+
 func TestDgetc2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dgetc2Test(t, impl)
@@ -148,6 +189,11 @@ func TestDgetrs(t *testing.T) {
 	testlapack.DgetrsTest(t, impl)
 }
 
+func TestDggev(t *testing.T) {
+	t.Parallel()
+	testlapack.DggevTest(t, impl)
+}
+
 func TestDgghrd(t *testing.T) {
 	t.Parallel()
 	testlapack.DgghrdTest(t, impl)
@@ -173,6 +219,11 @@ func TestDlabrd(t *testing.T) {
 	testlapack.DlabrdTest(t, impl)
 }
 
+func TestDhgeqz(t *testing.T) {
+	t.Parallel()
+	testlapack.DhgeqzTest(t, impl)
+}
+
 func TestDlacn2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dlacn2Test(t, impl)
@@ -553,6 +604,21 @@ func TestDpotf2(t *testing.T) {
 	testlapack.Dpotf2Test(t, impl)
 }
 
+func TestDporfs(t *testing.T) {
+	t.Parallel()
+	testlapack.DporfsTest(t, impl)
+}
+
+func TestDpoequ(t *testing.T) {
+	t.Parallel()
+	testlapack.DpoequTest(t, impl)
+}
+
+func TestDposvx(t *testing.T) {
+	t.Parallel()
+	testlapack.DposvxTest(t, impl)
+}
+
 func TestDpotrf(t *testing.T) {
 	t.Parallel()
 	testlapack.DpotrfTest(t, impl)
@@ -603,6 +669,26 @@ func TestDrscl(t *testing.T) {
 	testlapack.DrsclTest(t, impl)
 }
 
+func TestDstebz(t *testing.T) {
+	t.Parallel()
+	testlapack.DstebzTest(t, impl)
+}
+
+func TestDstedc(t *testing.T) {
+	t.Parallel()
+	testlapack.DstedcTest(t, impl)
+}
+
+func TestDstein(t *testing.T) {
+	t.Parallel()
+	testlapack.DsteinTest(t, impl)
+}
+
+func TestDstemr(t *testing.T) {
+	t.Parallel()
+	testlapack.DstemrTest(t, impl)
+}
+
 func TestDsteqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DsteqrTest(t, impl)
@@ -618,6 +704,16 @@ func TestDsyev(t *testing.T) {
 	testlapack.DsyevTest(t, impl)
 }
 
+func TestDsyevd(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevdTest(t, impl)
+}
+
+func TestDsyevr(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevrTest(t, impl)
+}
+
 func TestDsytd2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dsytd2Test(t, impl)
@@ -628,6 +724,11 @@ func TestDsytrd(t *testing.T) {
 	testlapack.DsytrdTest(t, impl)
 }
 
+func TestDtgevc(t *testing.T) {
+	t.Parallel()
+	testlapack.DtgevcTest(t, impl)
+}
+
 func TestDtgsja(t *testing.T) {
 	t.Parallel()
 	testlapack.DtgsjaTest(t, impl)
@@ -653,6 +754,11 @@ func TestDtrexc(t *testing.T) {
 	testlapack.DtrexcTest(t, impl)
 }
 
+func TestDtrsyl(t *testing.T) {
+	t.Parallel()
+	testlapack.DtrsylTest(t, impl)
+}
+
 func TestDtrti2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dtrti2Test(t, impl)
@@ -677,3 +783,78 @@ func TestIladlr(t *testing.T) {
 	t.Parallel()
 	testlapack.IladlrTest(t, impl)
 }
+
+func TestZgeqrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgeqrfTest(t, impl)
+}
+
+func TestZgesvj(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgesvjTest(t, impl)
+}
+
+func TestZgetf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Zgetf2Test(t, impl)
+}
+
+func TestZgetrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgetrfTest(t, impl)
+}
+
+func TestZgetrs(t *testing.T) {
+	t.Parallel()
+	testlapack.ZgetrsTest(t, impl)
+}
+
+func TestZpotf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Zpotf2Test(t, impl)
+}
+
+func TestZpotrf(t *testing.T) {
+	t.Parallel()
+	testlapack.ZpotrfTest(t, impl)
+}
+
+func TestZpotrs(t *testing.T) {
+	t.Parallel()
+	testlapack.ZpotrsTest(t, impl)
+}
+
+func TestZunmqr(t *testing.T) {
+	t.Parallel()
+	testlapack.ZunmqrTest(t, impl)
+}
+
+func TestDsycon(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyconTest(t, impl)
+}
+
+func TestDsytf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dsytf2Test(t, impl)
+}
+
+func TestDsygst(t *testing.T) {
+	t.Parallel()
+	testlapack.DsygstTest(t, impl)
+}
+
+func TestDsygv(t *testing.T) {
+	t.Parallel()
+	testlapack.DsygvTest(t, impl)
+}
+
+func TestDsytrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrfTest(t, impl)
+}
+
+func TestDsytrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrsTest(t, impl)
+}
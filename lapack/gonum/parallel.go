@@ -0,0 +1,394 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// defaultTileSize is the default size of the tiles used by Parallel.
+const defaultTileSize = 128
+
+// Parallel is a LAPACK implementation in which the LU, Cholesky and QR
+// factorizations Dgetrf, Dpotrf and Dgeqrf of large matrices are computed by
+// task-parallel tiled algorithms. The matrix is partitioned into tiles and the
+// factorization is expressed as a graph of tasks operating on the tiles. The
+// tasks are executed by a pool of goroutines as soon as the tasks they depend
+// on have finished, which keeps the available cores busy also outside of the
+// level 3 BLAS calls. All other routines are provided by the embedded
+// Implementation.
+//
+// The results satisfy the same accuracy guarantees as those of the serial
+// routines but may differ from them by rounding errors.
+//
+// Parallel can be used with lapack64, for example
+//
+//	lapack64.Use(gonum.Parallel{})
+//
+// makes the factorizations in the mat package use the parallel algorithms.
+type Parallel struct {
+	Implementation
+
+	// Workers is the maximum number of goroutines used in a factorization.
+	// If Workers is zero, runtime.GOMAXPROCS(0) is used. If Workers is one,
+	// the serial routines of Implementation are used.
+	Workers int
+
+	// TileSize is the number of rows and columns of a tile. If TileSize is
+	// zero, a default of 128 is used. Matrices with fewer than two tiles in
+	// the factorized dimension are factorized by the serial routines.
+	TileSize int
+}
+
+var _ lapack.Float64 = Parallel{}
+
+// params returns the tile size and the number of workers.
+func (p Parallel) params() (nb, workers int) {
+	nb = p.TileSize
+	if nb <= 0 {
+		nb = defaultTileSize
+	}
+	workers = p.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return nb, workers
+}
+
+// Dgetrf computes the LU decomposition of an m×n matrix A using partial
+// pivoting with row interchanges. See Implementation.Dgetrf for the
+// description of the parameters.
+//
+// The columns of A are partitioned into blocks of TileSize columns. The
+// factorization of each panel and the update of each column block by a panel
+// are executed as separate tasks, so the factorization of the next panel
+// overlaps with the update of the trailing matrix.
+func (p Parallel) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	nb, workers := p.params()
+	if workers == 1 || mn <= 2*nb {
+		return p.Implementation.Dgetrf(m, n, a, lda, ipiv)
+	}
+
+	impl := p.Implementation
+	bi := blas64.Implementation()
+
+	// Blocks are numbered by the index of their first column divided by nb.
+	nblk := (n + nb - 1) / nb
+	npanel := (mn + nb - 1) / nb
+	priority := func(blk, step int) int { return blk*npanel + step }
+
+	var g taskGraph
+	ok = true
+	for k := 0; k < npanel; k++ {
+		j := k * nb
+		jb := min(nb, mn-j)
+		// The last panel may be narrower than its block if m < n.
+		// Factorize the whole block in that case.
+		bw := min(nb, n-j)
+		g.add(priority(k, k), nil, []int{k}, func() {
+			if !impl.Dgetf2(m-j, bw, a[j*lda+j:], lda, ipiv[j:j+jb]) {
+				ok = false
+			}
+			for i := j; i < j+jb; i++ {
+				ipiv[i] += j
+			}
+		})
+		for c := k + 1; c < nblk; c++ {
+			c0 := c * nb
+			cb := min(nb, n-c0)
+			g.add(priority(c, k), []int{k}, []int{c}, func() {
+				impl.Dlaswp(cb, a[c0:], lda, j, j+jb-1, ipiv[:j+jb], 1)
+				bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit,
+					jb, cb, 1,
+					a[j*lda+j:], lda,
+					a[j*lda+c0:], lda)
+				if j+jb < m {
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, m-j-jb, cb, jb, -1,
+						a[(j+jb)*lda+j:], lda,
+						a[j*lda+c0:], lda,
+						1, a[(j+jb)*lda+c0:], lda)
+				}
+			})
+		}
+	}
+	// Apply the row interchanges of the later panels to the columns of
+	// the earlier panels.
+	for c := 0; c < npanel-1; c++ {
+		c0 := c * nb
+		var later []int
+		for k := c + 1; k < npanel; k++ {
+			later = append(later, k)
+		}
+		g.add(nblk*npanel, later, []int{c}, func() {
+			impl.Dlaswp(nb, a[c0:], lda, c0+nb, mn-1, ipiv, 1)
+		})
+	}
+	g.execute(workers)
+	return ok
+}
+
+// Dpotrf computes the Cholesky decomposition of the symmetric positive definite
+// matrix a. See Implementation.Dpotrf for the description of the parameters.
+//
+// The matrix is partitioned into square tiles of TileSize rows and columns.
+// The Cholesky factorization of the diagonal tiles, the triangular solves with
+// the off-diagonal tiles and the updates of the trailing tiles are executed as
+// separate tasks.
+func (p Parallel) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool) {
+	switch {
+	case ul != blas.Upper && ul != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(shortA)
+	}
+
+	nb, workers := p.params()
+	if workers == 1 || n <= 2*nb {
+		return p.Implementation.Dpotrf(ul, n, a, lda)
+	}
+
+	impl := p.Implementation
+	bi := blas64.Implementation()
+
+	nt := (n + nb - 1) / nb
+	tile := func(i, j int) int { return i*nt + j }
+	size := func(i int) int { return min(nb, n-i*nb) }
+	at := func(i, j int) []float64 { return a[i*nb*lda+j*nb:] }
+
+	// The factorization stops at the first diagonal tile that is not
+	// positive definite.
+	var failed atomic.Bool
+	var g taskGraph
+	for k := 0; k < nt; k++ {
+		kb := size(k)
+		akk := at(k, k)
+		g.add(tile(k, k)*nt+k, nil, []int{tile(k, k)}, func() {
+			if failed.Load() {
+				return
+			}
+			if !impl.Dpotrf(ul, kb, akk, lda) {
+				failed.Store(true)
+			}
+		})
+		if ul == blas.Upper {
+			// Compute the row k of U and update the trailing tiles with
+			//  A[i,j] -= U[k,i]ᵀ * U[k,j].
+			for j := k + 1; j < nt; j++ {
+				jb := size(j)
+				akj := at(k, j)
+				g.add(tile(k, j)*nt+k, []int{tile(k, k)}, []int{tile(k, j)}, func() {
+					if failed.Load() {
+						return
+					}
+					bi.Dtrsm(blas.Left, blas.Upper, blas.Trans, blas.NonUnit, kb, jb,
+						1, akk, lda, akj, lda)
+				})
+			}
+			for j := k + 1; j < nt; j++ {
+				jb := size(j)
+				akj := at(k, j)
+				ajj := at(j, j)
+				g.add(tile(j, j)*nt+k, []int{tile(k, j)}, []int{tile(j, j)}, func() {
+					if failed.Load() {
+						return
+					}
+					bi.Dsyrk(blas.Upper, blas.Trans, jb, kb,
+						-1, akj, lda, 1, ajj, lda)
+				})
+				for i := k + 1; i < j; i++ {
+					ib := size(i)
+					aki := at(k, i)
+					aij := at(i, j)
+					g.add(tile(i, j)*nt+k, []int{tile(k, i), tile(k, j)}, []int{tile(i, j)}, func() {
+						if failed.Load() {
+							return
+						}
+						bi.Dgemm(blas.Trans, blas.NoTrans, ib, jb, kb,
+							-1, aki, lda, akj, lda, 1, aij, lda)
+					})
+				}
+			}
+			continue
+		}
+		// Compute the column k of L and update the trailing tiles with
+		//  A[i,j] -= L[i,k] * L[j,k]ᵀ.
+		for i := k + 1; i < nt; i++ {
+			ib := size(i)
+			aik := at(i, k)
+			g.add(tile(k, i)*nt+k, []int{tile(k, k)}, []int{tile(i, k)}, func() {
+				if failed.Load() {
+					return
+				}
+				bi.Dtrsm(blas.Right, blas.Lower, blas.Trans, blas.NonUnit, ib, kb,
+					1, akk, lda, aik, lda)
+			})
+		}
+		for i := k + 1; i < nt; i++ {
+			ib := size(i)
+			aik := at(i, k)
+			aii := at(i, i)
+			g.add(tile(i, i)*nt+k, []int{tile(i, k)}, []int{tile(i, i)}, func() {
+				if failed.Load() {
+					return
+				}
+				bi.Dsyrk(blas.Lower, blas.NoTrans, ib, kb,
+					-1, aik, lda, 1, aii, lda)
+			})
+			for j := k + 1; j < i; j++ {
+				jb := size(j)
+				ajk := at(j, k)
+				aij := at(i, j)
+				g.add(tile(j, i)*nt+k, []int{tile(i, k), tile(j, k)}, []int{tile(i, j)}, func() {
+					if failed.Load() {
+						return
+					}
+					bi.Dgemm(blas.NoTrans, blas.Trans, ib, jb, kb,
+						-1, aik, lda, ajk, lda, 1, aij, lda)
+				})
+			}
+		}
+	}
+	g.execute(workers)
+	return !failed.Load()
+}
+
+// Dgeqrf computes the QR factorization of the m×n matrix A. See
+// Implementation.Dgeqrf for the description of the parameters.
+//
+// The columns of A are partitioned into blocks of TileSize columns. The
+// factorization of each panel and the application of its block reflector to
+// each column block are executed as separate tasks, so the factorization of
+// the next panel overlaps with the update of the trailing matrix. The tiled
+// algorithm allocates its own workspace, so work is only used by the workspace
+// query and by the serial routine for small matrices. As for the serial
+// routine, work[0] holds the size of the workspace used by the blocked
+// algorithm on return.
+func (p Parallel) Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	mn := min(m, n)
+	nb, workers := p.params()
+	if lwork == -1 || workers == 1 || mn <= 2*nb {
+		p.Implementation.Dgeqrf(m, n, a, lda, tau, work, lwork)
+		return
+	}
+
+	if len(a) < (m-1)*lda+n {
+		panic(shortA)
+	}
+	if len(tau) != mn {
+		panic(badLenTau)
+	}
+
+	impl := p.Implementation
+
+	nblk := (n + nb - 1) / nb
+	npanel := (mn + nb - 1) / nb
+	priority := func(blk, step int) int { return blk*npanel + step }
+
+	// Each panel keeps the triangular factor of its block reflector
+	// until all column blocks have been updated.
+	t := make([]float64, npanel*nb*nb)
+	pool := sync.Pool{
+		New: func() any {
+			w := make([]float64, nb*nb)
+			return &w
+		},
+	}
+
+	var g taskGraph
+	for k := 0; k < npanel; k++ {
+		j := k * nb
+		jb := min(nb, mn-j)
+		// The last panel may be narrower than its block if m < n.
+		// Factorize the whole block in that case.
+		bw := min(nb, n-j)
+		tk := t[k*nb*nb : (k+1)*nb*nb]
+		last := k == nblk-1
+		g.add(priority(k, k), nil, []int{k}, func() {
+			w := pool.Get().(*[]float64)
+			impl.Dgeqr2(m-j, bw, a[j*lda+j:], lda, tau[j:j+jb], *w)
+			pool.Put(w)
+			if !last {
+				impl.Dlarft(lapack.Forward, lapack.ColumnWise, m-j, jb,
+					a[j*lda+j:], lda, tau[j:], tk, nb)
+			}
+		})
+		for c := k + 1; c < nblk; c++ {
+			c0 := c * nb
+			cb := min(nb, n-c0)
+			g.add(priority(c, k), []int{k}, []int{c}, func() {
+				w := pool.Get().(*[]float64)
+				impl.Dlarfb(blas.Left, blas.Trans, lapack.Forward, lapack.ColumnWise,
+					m-j, cb, jb,
+					a[j*lda+j:], lda,
+					tk, nb,
+					a[j*lda+c0:], lda,
+					*w, nb)
+				pool.Put(w)
+			})
+		}
+	}
+	g.execute(workers)
+
+	// Report the workspace the serial routine would need for m×n.
+	iws := n
+	if nbs := impl.Ilaenv(1, "DGEQRF", " ", m, n, -1, -1); 1 < nbs && nbs < mn {
+		if nx := max(0, impl.Ilaenv(3, "DGEQRF", " ", m, n, -1, -1)); mn > nx {
+			iws = n * nbs
+		}
+	}
+	work[0] = float64(iws)
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack/testlapack"
+)
+
+// parallelImpls are the configurations of Parallel used in tests. The small
+// tile sizes make the tiled algorithms run also on the small test matrices.
+var parallelImpls = []Parallel{
+	{TileSize: 4, Workers: 3},
+	{TileSize: 16, Workers: 8},
+	{TileSize: 50, Workers: 1},
+}
+
+func TestParallelDgetrf(t *testing.T) {
+	t.Parallel()
+	for _, p := range parallelImpls {
+		testlapack.DgetrfTest(t, p)
+	}
+}
+
+func TestParallelDpotrf(t *testing.T) {
+	t.Parallel()
+	for _, p := range parallelImpls {
+		testlapack.DpotrfTest(t, p)
+	}
+}
+
+func TestParallelDgeqrf(t *testing.T) {
+	t.Parallel()
+	for _, p := range parallelImpls {
+		testlapack.DgeqrfTest(t, p)
+	}
+}
+
+func TestParallelDgeqrfWorkspace(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, p := range parallelImpls {
+		for _, dims := range [][2]int{{5, 5}, {40, 30}, {30, 40}, {64, 64}} {
+			m, n := dims[0], dims[1]
+			lda := n
+			a := make([]float64, m*lda)
+			for i := range a {
+				a[i] = rnd.NormFloat64()
+			}
+			want := make([]float64, len(a))
+			copy(want, a)
+			tau := make([]float64, min(m, n))
+			wantTau := make([]float64, len(tau))
+
+			// Query the optimal workspace size and use it.
+			work := []float64{0}
+			p.Dgeqrf(m, n, a, lda, tau, work, -1)
+			lwork := int(work[0])
+			wantWork := []float64{0}
+			Implementation{}.Dgeqrf(m, n, want, lda, wantTau, wantWork, -1)
+			if lwork != int(wantWork[0]) {
+				t.Errorf("%+v m=%d n=%d: unexpected workspace query: got %d, want %d", p, m, n, lwork, int(wantWork[0]))
+			}
+			work = make([]float64, lwork)
+			p.Dgeqrf(m, n, a, lda, tau, work, lwork)
+			wantWork = make([]float64, lwork)
+			Implementation{}.Dgeqrf(m, n, want, lda, wantTau, wantWork, lwork)
+			if work[0] != wantWork[0] {
+				t.Errorf("%+v m=%d n=%d: unexpected work[0] on return: got %v, want %v", p, m, n, work[0], wantWork[0])
+			}
+			if !floats.EqualApprox(a, want, 1e-12) || !floats.EqualApprox(tau, wantTau, 1e-12) {
+				t.Errorf("%+v m=%d n=%d: factorization differs from serial result", p, m, n)
+			}
+		}
+	}
+}
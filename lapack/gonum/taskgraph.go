@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// taskGraph is a directed acyclic graph of tasks that operate on numbered
+// blocks of data. Tasks are added in the order of a sequential execution and
+// the dependencies between them are derived from the blocks that they read and
+// write. Executing the graph in parallel therefore gives the same result as
+// running the tasks sequentially in the order they were added.
+type taskGraph struct {
+	tasks  []*graphTask
+	blocks map[int]*blockAccess
+}
+
+// graphTask is a node of a taskGraph.
+type graphTask struct {
+	run func()
+
+	// priority determines the order in which ready tasks
+	// are executed. Tasks with lower priority run first.
+	priority int
+	// seq is the insertion index used to break ties.
+	seq int
+
+	// ndeps is the number of unfinished tasks that
+	// this task depends on.
+	ndeps int
+	succ  []*graphTask
+}
+
+// blockAccess records the tasks that access a block since its last write.
+type blockAccess struct {
+	writer  *graphTask
+	readers []*graphTask
+}
+
+// add adds a task to the graph that reads the blocks in reads, writes the
+// blocks in writes and performs the work in run.
+func (g *taskGraph) add(priority int, reads, writes []int, run func()) {
+	if g.blocks == nil {
+		g.blocks = make(map[int]*blockAccess)
+	}
+	t := &graphTask{run: run, priority: priority, seq: len(g.tasks)}
+	g.tasks = append(g.tasks, t)
+
+	access := func(b int) *blockAccess {
+		acc, ok := g.blocks[b]
+		if !ok {
+			acc = &blockAccess{}
+			g.blocks[b] = acc
+		}
+		return acc
+	}
+	for _, b := range reads {
+		acc := access(b)
+		t.dependOn(acc.writer)
+		acc.readers = append(acc.readers, t)
+	}
+	for _, b := range writes {
+		acc := access(b)
+		t.dependOn(acc.writer)
+		for _, r := range acc.readers {
+			t.dependOn(r)
+		}
+		acc.writer = t
+		acc.readers = acc.readers[:0]
+	}
+}
+
+// dependOn records that t must not start before p has finished.
+func (t *graphTask) dependOn(p *graphTask) {
+	if p == nil || p == t {
+		return
+	}
+	// Duplicate edges are harmless because each of them is both
+	// counted and released once.
+	p.succ = append(p.succ, t)
+	t.ndeps++
+}
+
+// execute runs all tasks of the graph using the given number of goroutines
+// and returns when all of them have finished.
+func (g *taskGraph) execute(workers int) {
+	var (
+		mu        sync.Mutex
+		cond      = sync.NewCond(&mu)
+		ready     taskHeap
+		remaining = len(g.tasks)
+	)
+	for _, t := range g.tasks {
+		if t.ndeps == 0 {
+			ready = append(ready, t)
+		}
+	}
+	heap.Init(&ready)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.Lock()
+			for {
+				for len(ready) == 0 && remaining > 0 {
+					cond.Wait()
+				}
+				if remaining == 0 {
+					mu.Unlock()
+					return
+				}
+				t := heap.Pop(&ready).(*graphTask)
+				mu.Unlock()
+
+				t.run()
+
+				mu.Lock()
+				remaining--
+				for _, s := range t.succ {
+					s.ndeps--
+					if s.ndeps == 0 {
+						heap.Push(&ready, s)
+						cond.Signal()
+					}
+				}
+				if remaining == 0 {
+					cond.Broadcast()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// taskHeap is a priority queue of ready tasks.
+type taskHeap []*graphTask
+
+func (h taskHeap) Len() int { return len(h) }
+func (h taskHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority < h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+func (h taskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *taskHeap) Push(x any)   { *h = append(*h, x.(*graphTask)) }
+func (h *taskHeap) Pop() any {
+	old := *h
+	n := len(old)
+	t := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return t
+}
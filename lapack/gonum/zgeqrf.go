@@ -0,0 +1,54 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// Zgeqrf computes the QR factorization of the m×n complex matrix A. See the
+// documentation for Zgeqr2 for a description of the parameters at entry and
+// exit.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// The length of work must be at least max(1, lwork) and lwork must be -1
+// or at least n, otherwise this function will panic. If lwork == -1, instead
+// of performing Zgeqrf, the optimal work length will be stored into work[0].
+//
+// Zgeqrf currently uses the unblocked algorithm of Zgeqr2.
+//
+// tau must have length min(m,n), and this function will panic otherwise.
+func (impl Implementation) Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	k := min(m, n)
+	if k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		work[0] = complex(float64(n), 0)
+		return
+	}
+
+	if len(a) < (m-1)*lda+n {
+		panic(shortA)
+	}
+	if len(tau) != k {
+		panic(badLenTau)
+	}
+
+	impl.Zgeqr2(m, n, a, lda, tau, work)
+	work[0] = complex(float64(n), 0)
+}
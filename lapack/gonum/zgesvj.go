@@ -0,0 +1,173 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zgesvj computes the singular value decomposition of an m×n complex matrix A
+// with m >= n using the one-sided Jacobi method
+//
+//	A = U * Σ * Vᴴ
+//
+// where U is an m×n matrix with orthonormal columns, Σ is an n×n diagonal
+// matrix with non-negative real diagonal elements and V is an n×n unitary
+// matrix.
+//
+// On entry, a contains the matrix A. On return, a contains the left singular
+// vectors U, sva contains the singular values in decreasing order and v
+// contains the right singular vectors V. Columns of U corresponding to zero
+// singular values are chosen to complete an orthonormal set. sva must have
+// length n and v must be n×n, otherwise Zgesvj will panic.
+//
+// The Jacobi method computes small singular values to high relative accuracy.
+// Unlike the reference implementation, Zgesvj always computes both the left
+// and right singular vectors and does not perform any preconditioning.
+//
+// Zgesvj returns whether the iteration converged within 30 sweeps.
+func (Implementation) Zgesvj(m, n int, a []complex128, lda int, sva []float64, v []complex128, ldv int) (ok bool) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case n > m:
+		panic(nGTM)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldv < max(1, n):
+		panic(badLdV)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(sva) != n:
+		panic(badLenSva)
+	case len(v) < (n-1)*ldv+n:
+		panic(shortV)
+	}
+
+	bi := cblas128.Implementation()
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			v[i*ldv+j] = 0
+		}
+		v[i*ldv+i] = 1
+	}
+
+	const maxSweep = 30
+	tol := math.Sqrt(float64(m)) * dlamchE
+	for sweep := 0; sweep < maxSweep && !ok; sweep++ {
+		ok = true
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				alpha := bi.Dznrm2(m, a[p:], lda)
+				beta := bi.Dznrm2(m, a[q:], lda)
+				if alpha == 0 || beta == 0 {
+					continue
+				}
+				gamma := bi.Zdotc(m, a[p:], lda, a[q:], lda)
+				g := cmplx.Abs(gamma)
+				if g <= tol*alpha*beta {
+					continue
+				}
+				ok = false
+
+				// Diagonalize the 2×2 Hermitian matrix
+				//  [ alpha²       gamma ]
+				//  [ conj(gamma)  beta² ]
+				// by a rotation combined with a phase change of column q.
+				e := gamma / complex(g, 0)
+				zeta := (beta - alpha) * (beta + alpha) / (2 * g)
+				t := math.Copysign(1/(math.Abs(zeta)+math.Hypot(1, zeta)), zeta)
+				c := 1 / math.Hypot(1, t)
+				s := c * t
+				zjrot(m, a[p:], a[q:], lda, c, s, e)
+				zjrot(n, v[p:], v[q:], ldv, c, s, e)
+			}
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		sva[j] = bi.Dznrm2(m, a[j:], lda)
+	}
+	// Sort the singular values into decreasing order.
+	for i := 0; i < n-1; i++ {
+		k := i
+		for j := i + 1; j < n; j++ {
+			if sva[j] > sva[k] {
+				k = j
+			}
+		}
+		if k != i {
+			sva[i], sva[k] = sva[k], sva[i]
+			bi.Zswap(m, a[i:], lda, a[k:], lda)
+			bi.Zswap(n, v[i:], ldv, v[k:], ldv)
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		if sva[j] != 0 {
+			bi.Zdscal(m, 1/sva[j], a[j:], lda)
+			continue
+		}
+		// Complete the orthonormal set of left singular vectors with
+		// the first unit vector that is not close to the span of the
+		// previous ones. Since the orthogonal complement of the span
+		// contains a unit vector, some unit vector e_k has a component
+		// of length at least 1/sqrt(m) in the complement.
+		thresh := 0.5 / math.Sqrt(float64(m))
+		for k := 0; k < m; k++ {
+			for i := 0; i < m; i++ {
+				a[i*lda+j] = 0
+			}
+			a[k*lda+j] = 1
+			// Orthogonalize twice to ensure orthogonality to working
+			// precision.
+			for range 2 {
+				for i := 0; i < j; i++ {
+					d := bi.Zdotc(m, a[i:], lda, a[j:], lda)
+					bi.Zaxpy(m, -d, a[i:], lda, a[j:], lda)
+				}
+			}
+			nrm := bi.Dznrm2(m, a[j:], lda)
+			if nrm > thresh {
+				bi.Zdscal(m, 1/nrm, a[j:], lda)
+				break
+			}
+		}
+	}
+	return ok
+}
+
+// zjrot applies the Jacobi rotation
+//
+//	[x y] = [x y] * [ c       s      ]
+//	                [ -s*ē    c*ē    ]
+//
+// to the n-element vectors x and y with increment inc, where e is a complex
+// number of unit modulus.
+func zjrot(n int, x, y []complex128, inc int, c, s float64, e complex128) {
+	ec := cmplx.Conj(e)
+	cc := complex(c, 0)
+	sc := complex(s, 0)
+	for i := 0; i < n; i++ {
+		xi := x[i*inc]
+		yi := ec * y[i*inc]
+		x[i*inc] = cc*xi - sc*yi
+		y[i*inc] = sc*xi + cc*yi
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlarf applies a complex elementary reflector H to an m×n matrix C:
+//
+//	C = H * C  if side == blas.Left
+//	C = C * H  if side == blas.Right
+//
+// H is represented in the form
+//
+//	H = I - tau * v * vᴴ
+//
+// where tau is a complex scalar and v is a complex vector. To apply Hᴴ, supply
+// the conjugate of tau.
+//
+// work must have length at least n if side == blas.Left and
+// at least m if side == blas.Right.
+//
+// Zlarf is an internal routine. It is exported for testing purposes.
+func (Implementation) Zlarf(side blas.Side, m, n int, v []complex128, incv int, tau complex128, c []complex128, ldc int, work []complex128) {
+	switch {
+	case side != blas.Left && side != blas.Right:
+		panic(badSide)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case incv == 0:
+		panic(zeroIncV)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	if m == 0 || n == 0 || tau == 0 {
+		return
+	}
+
+	applyleft := side == blas.Left
+	lenV := n
+	if applyleft {
+		lenV = m
+	}
+
+	switch {
+	case len(v) < 1+(lenV-1)*abs(incv):
+		panic(shortV)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case (applyleft && len(work) < n) || (!applyleft && len(work) < m):
+		panic(shortWork)
+	}
+
+	bi := cblas128.Implementation()
+	if applyleft {
+		// Form H * C
+		// w = Cᴴ * v
+		bi.Zgemv(blas.ConjTrans, m, n, 1, c, ldc, v, incv, 0, work, 1)
+		// C = C - tau * v * wᴴ
+		bi.Zgerc(m, n, -tau, v, incv, work, 1, c, ldc)
+		return
+	}
+	// Form C * H
+	// w = C * v
+	bi.Zgemv(blas.NoTrans, m, n, 1, c, ldc, v, incv, 0, work, 1)
+	// C = C - tau * w * vᴴ
+	bi.Zgerc(m, n, -tau, work, 1, v, incv, c, ldc)
+}
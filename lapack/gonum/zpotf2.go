@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zpotf2 computes the Cholesky decomposition of the Hermitian positive
+// definite matrix a. If ul == blas.Upper, then a is stored as an upper-triangular
+// matrix, and a = Uᴴ U is stored in place into a. If ul == blas.Lower, then
+// a = L Lᴴ is computed and stored in-place into a. If a is not positive
+// definite, false is returned. This is the unblocked version of the algorithm.
+//
+// Zpotf2 is an internal routine. It is exported for testing purposes.
+func (Implementation) Zpotf2(ul blas.Uplo, n int, a []complex128, lda int) (ok bool) {
+	switch {
+	case ul != blas.Upper && ul != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(shortA)
+	}
+
+	bi := cblas128.Implementation()
+
+	if ul == blas.Upper {
+		for j := 0; j < n; j++ {
+			ajj := real(a[j*lda+j])
+			if j != 0 {
+				ajj -= real(bi.Zdotc(j, a[j:], lda, a[j:], lda))
+			}
+			if ajj <= 0 || math.IsNaN(ajj) {
+				a[j*lda+j] = complex(ajj, 0)
+				return false
+			}
+			ajj = math.Sqrt(ajj)
+			a[j*lda+j] = complex(ajj, 0)
+			if j < n-1 {
+				// Compute row j of U as
+				//  (A[j,j+1:] - U[:j,j]ᴴ * U[:j,j+1:]) / U[j,j].
+				zlacgv(j, a[j:], lda)
+				bi.Zgemv(blas.Trans, j, n-j-1,
+					-1, a[j+1:], lda, a[j:], lda,
+					1, a[j*lda+j+1:], 1)
+				zlacgv(j, a[j:], lda)
+				bi.Zdscal(n-j-1, 1/ajj, a[j*lda+j+1:], 1)
+			}
+		}
+		return true
+	}
+	for j := 0; j < n; j++ {
+		ajj := real(a[j*lda+j])
+		if j != 0 {
+			ajj -= real(bi.Zdotc(j, a[j*lda:], 1, a[j*lda:], 1))
+		}
+		if ajj <= 0 || math.IsNaN(ajj) {
+			a[j*lda+j] = complex(ajj, 0)
+			return false
+		}
+		ajj = math.Sqrt(ajj)
+		a[j*lda+j] = complex(ajj, 0)
+		if j < n-1 {
+			// Compute column j of L as
+			//  (A[j+1:,j] - L[j+1:,:j] * L[j,:j]ᴴ) / L[j,j].
+			zlacgv(j, a[j*lda:], 1)
+			bi.Zgemv(blas.NoTrans, n-j-1, j,
+				-1, a[(j+1)*lda:], lda, a[j*lda:], 1,
+				1, a[(j+1)*lda+j:], lda)
+			zlacgv(j, a[j*lda:], 1)
+			bi.Zdscal(n-j-1, 1/ajj, a[(j+1)*lda+j:], lda)
+		}
+	}
+	return true
+}
+
+// zlacgv conjugates the n-element complex vector x with increment incX.
+func zlacgv(n int, x []complex128, incX int) {
+	for i := 0; i < n; i++ {
+		x[i*incX] = complex(real(x[i*incX]), -imag(x[i*incX]))
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// Zunmqr multiplies an m×n complex matrix C by a unitary matrix Q as
+//
+//	C = Q * C   if side == blas.Left  and trans == blas.NoTrans,
+//	C = Qᴴ * C  if side == blas.Left  and trans == blas.ConjTrans,
+//	C = C * Q   if side == blas.Right and trans == blas.NoTrans,
+//	C = C * Qᴴ  if side == blas.Right and trans == blas.ConjTrans,
+//
+// where Q is defined as the product of k elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}.
+//
+// If side == blas.Left, A is an m×k matrix and 0 <= k <= m.
+// If side == blas.Right, A is an n×k matrix and 0 <= k <= n.
+// The ith column of A contains the vector which defines the elementary
+// reflector H_i and tau[i] contains its scalar factor. tau must have length k
+// and Zunmqr will panic otherwise. Zgeqrf returns A and tau in the required
+// form.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Zunmqr
+// will panic.
+//
+// If lwork is -1, instead of performing Zunmqr, the optimal workspace size will
+// be stored into work[0].
+//
+// Zunmqr currently uses an unblocked algorithm.
+func (impl Implementation) Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) {
+	left := side == blas.Left
+	nw := m
+	if left {
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case lda < max(1, k):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		work[0] = complex(float64(nw), 0)
+		return
+	}
+
+	switch {
+	case left && len(a) < (m-1)*lda+k:
+		panic(shortA)
+	case !left && len(a) < (n-1)*lda+k:
+		panic(shortA)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(tau) != k:
+		panic(badLenTau)
+	}
+
+	notran := trans == blas.NoTrans
+	// Q * C and C * Qᴴ apply the reflectors in reverse order.
+	forward := left != notran
+	for l := 0; l < k; l++ {
+		i := l
+		if !forward {
+			i = k - 1 - l
+		}
+		taui := tau[i]
+		if !notran {
+			taui = cmplx.Conj(taui)
+		}
+		aii := a[i*lda+i]
+		a[i*lda+i] = 1
+		if left {
+			impl.Zlarf(side, m-i, n, a[i*lda+i:], lda, taui, c[i*ldc:], ldc, work)
+		} else {
+			impl.Zlarf(side, m, n-i, a[i*lda+i:], lda, taui, c[i:], ldc, work)
+		}
+		a[i*lda+i] = aii
+	}
+	work[0] = complex(float64(nw), 0)
+}
@@ -7,20 +7,37 @@ package lapack
 import "gonum.org/v1/gonum/blas"
 
 // Complex128 defines the public complex128 LAPACK API supported by gonum/lapack.
-type Complex128 interface{}
+type Complex128 interface {
+	Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zgesvj(m, n int, a []complex128, lda int, sva []float64, v []complex128, ldv int) (ok bool)
+	Zgetrf(m, n int, a []complex128, lda int, ipiv []int) (ok bool)
+	Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int)
+	Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+	Zpotrs(ul blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int)
+	Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int)
+}
 
 // Float64 defines the public float64 LAPACK API supported by gonum/lapack.
 type Float64 interface {
+	Dgbcon(norm MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
 	Dgecon(norm MatrixNorm, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64
+	Dgeequ(m, n int, a []float64, lda int, r, c []float64) (rowcnd, colcnd, amax float64, ok bool)
 	Dgeev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []float64, lda int, wr, wi []float64, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (first int)
 	Dgels(trans blas.Transpose, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) bool
 	Dgelqf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
 	Dgeqp3(m, n int, a []float64, lda int, jpvt []int, tau, work []float64, lwork int)
 	Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
+	Dgerfs(trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int)
+	Dgesdd(jobz SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool)
 	Dgesvd(jobU, jobVT SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool)
+	Dgesvx(fact Fact, trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, equed Equilibration, r, c, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (eq Equilibration, rcond, rpvgrw float64, ok bool)
 	Dgetrf(m, n int, a []float64, lda int, ipiv []int) (ok bool)
 	Dgetri(n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool)
 	Dgetrs(trans blas.Transpose, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+	Dggev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool)
 	Dggsvd3(jobU, jobV, jobQ GSVDJob, m, n, p int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []int) (k, l int, ok bool)
 	Dlantr(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float64, lda int, work []float64) float64
 	Dlange(norm MatrixNorm, m, n int, a []float64, lda int, work []float64) float64
@@ -35,11 +52,20 @@ type Float64 interface {
 	Dpbtrf(uplo blas.Uplo, n, kd int, ab []float64, ldab int) (ok bool)
 	Dpbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int)
 	Dpocon(uplo blas.Uplo, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64
+	Dpoequ(n int, a []float64, lda int, s []float64) (scond, amax float64, ok bool)
+	Dporfs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int)
+	Dposvx(fact Fact, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, equed Equilibration, s, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (eq Equilibration, rcond float64, ok bool)
 	Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool)
 	Dpotri(ul blas.Uplo, n int, a []float64, lda int) (ok bool)
 	Dpotrs(ul blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int)
 	Dpstrf(uplo blas.Uplo, n int, a []float64, lda int, piv []int, tol float64, work []float64) (rank int, ok bool)
+	Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64
 	Dsyev(jobz EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) (ok bool)
+	Dsyevd(jobz EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+	Dsyevr(jobz EVJob, rng EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
+	Dsygv(itype int, jobz EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool)
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool)
+	Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
 	Dtbtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, kd, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool)
 	Dtrcon(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int, work []float64, iwork []int) float64
 	Dtrtri(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) (ok bool)
@@ -124,6 +150,14 @@ const (
 	SVDNone      SVDJob = 'N' // Do not compute singular vectors.
 )
 
+// SVDComp specifies how singular vectors are computed in Dbdsdc.
+type SVDComp byte
+
+const (
+	SVDCompExplicit SVDComp = 'I' // Compute the singular vectors of the bidiagonal matrix.
+	SVDCompNone     SVDComp = 'N' // Do not compute singular vectors.
+)
+
 // GSVDJob specifies the singular vector computation type for Generalized SVD.
 type GSVDJob byte
 
@@ -152,6 +186,15 @@ const (
 	EVNone    EVJob = 'N' // Do not compute eigenvectors.
 )
 
+// EVRange specifies which eigenvalues are computed in Dsyevr.
+type EVRange byte
+
+const (
+	EVRangeAll   EVRange = 'A' // Compute all eigenvalues.
+	EVRangeValue EVRange = 'V' // Compute the eigenvalues in the half-open interval (vl, vu].
+	EVRangeIndex EVRange = 'I' // Compute the il-th through iu-th eigenvalues.
+)
+
 // LeftEVJob specifies whether left eigenvectors are computed in Dgeev.
 type LeftEVJob byte
 
@@ -238,3 +281,25 @@ const (
 	OrthoExplicit OrthoComp = 'I' // The orthogonal matrix is formed explicitly and returned in the argument.
 	OrthoPostmul  OrthoComp = 'V' // The orthogonal matrix is post-multiplied into the matrix stored in the argument on entry.
 )
+
+// Fact specifies whether and how the matrix is factorized in the expert
+// drivers Dgesvx and Dposvx.
+type Fact byte
+
+const (
+	FactProvided    Fact = 'F' // The factorization of A is supplied on entry.
+	FactCompute     Fact = 'N' // Factorize A.
+	FactEquilibrate Fact = 'E' // Equilibrate A if necessary and then factorize it.
+)
+
+// Equilibration specifies the form of equilibration that was applied to a
+// matrix.
+type Equilibration byte
+
+const (
+	EquilibrateNone Equilibration = 'N' // No equilibration.
+	EquilibrateRow  Equilibration = 'R' // Row equilibration, A has been replaced by diag(r)*A.
+	EquilibrateCol  Equilibration = 'C' // Column equilibration, A has been replaced by A*diag(c).
+	EquilibrateBoth Equilibration = 'B' // Row and column equilibration, A has been replaced by diag(r)*A*diag(c).
+	EquilibrateSym  Equilibration = 'Y' // Symmetric equilibration, A has been replaced by diag(s)*A*diag(s).
+)
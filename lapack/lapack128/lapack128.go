@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack128
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+)
+
+var lapack128 lapack.Complex128 = gonum.Implementation{}
+
+// Use sets the LAPACK complex128 implementation to be used by subsequent BLAS calls.
+// The default implementation is native.Implementation.
+func Use(l lapack.Complex128) {
+	lapack128 = l
+}
+
+// Geqrf computes the QR factorization of the m×n complex matrix A. A is
+// modified to contain the information to construct Q and R. The upper triangle
+// of a contains the matrix R. The lower triangular elements (not including the
+// diagonal) contain the elementary reflectors. tau is modified to contain the
+// reflector scales. tau must have length min(m,n), and this function will panic
+// otherwise.
+//
+// The ith elementary reflector can be explicitly constructed by first extracting
+// the
+//
+//	v[j] = 0           j < i
+//	v[j] = 1           j == i
+//	v[j] = a[j*lda+i]  j > i
+//
+// and computing H_i = I - tau[i] * v * vᴴ.
+//
+// The unitary matrix Q can be constructed from a product of these elementary
+// reflectors, Q = H_0 * H_1 * ... * H_{k-1}, where k = min(m,n).
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= n and this function will panic otherwise. If
+// lwork == -1, instead of performing Geqrf, the optimal work length will be
+// stored into work[0].
+func Geqrf(a cblas128.General, tau, work []complex128, lwork int) {
+	lapack128.Zgeqrf(a.Rows, a.Cols, a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
+// Gesvj computes the singular value decomposition of an m×n complex matrix A
+// with m >= n using the one-sided Jacobi method
+//
+//	A = U * Σ * Vᴴ
+//
+// On entry, a contains the matrix A. On return, a contains the m×n matrix U of
+// left singular vectors, s contains the n singular values in decreasing order
+// and v contains the n×n unitary matrix V of right singular vectors.
+//
+// Gesvj returns whether the iteration converged.
+func Gesvj(a cblas128.General, s []float64, v cblas128.General) (ok bool) {
+	return lapack128.Zgesvj(a.Rows, a.Cols, a.Data, max(1, a.Stride), s, v.Data, max(1, v.Stride))
+}
+
+// Getrf computes the LU decomposition of an m×n complex matrix A using partial
+// pivoting with row interchanges.
+//
+// The LU decomposition is a factorization of A into
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is a lower triangular with unit diagonal
+// elements (lower trapezoidal if m > n), and U is upper triangular (upper
+// trapezoidal if m < n).
+//
+// On entry, a contains the matrix A. On return, L and U are stored in place
+// into a, and P is represented by ipiv.
+//
+// ipiv contains a sequence of row swaps. It indicates that row i of the matrix
+// was interchanged with ipiv[i]. ipiv must have length min(m,n), and Getrf will
+// panic otherwise. ipiv is zero-indexed.
+//
+// Getrf returns whether the matrix A is nonsingular. The LU decomposition will
+// be computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equation.
+func Getrf(a cblas128.General, ipiv []int) bool {
+	return lapack128.Zgetrf(a.Rows, a.Cols, a.Data, max(1, a.Stride), ipiv)
+}
+
+// Getrs solves a system of equations using an LU factorization.
+// The system of equations solved is
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans
+//	Aᴴ * X = B  if trans == blas.ConjTrans
+//
+// A is a general n×n matrix with stride lda. B is a general matrix of size n×nrhs.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Getrf. ipiv is zero-indexed.
+func Getrs(trans blas.Transpose, a cblas128.General, b cblas128.General, ipiv []int) {
+	lapack128.Zgetrs(trans, a.Cols, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
+// Potrf computes the Cholesky factorization of a.
+// The factorization has the form
+//
+//	A = Uᴴ * U  if a.Uplo == blas.Upper, or
+//	A = L * Lᴴ  if a.Uplo == blas.Lower,
+//
+// where U is an upper triangular matrix and L is lower triangular.
+// The triangular matrix is returned in t, and the underlying data between
+// a and t is shared. The returned bool indicates whether a is positive
+// definite and the factorization could be finished.
+func Potrf(a cblas128.Hermitian) (t cblas128.Triangular, ok bool) {
+	ok = lapack128.Zpotrf(a.Uplo, a.N, a.Data, max(1, a.Stride))
+	t.Uplo = a.Uplo
+	t.N = a.N
+	t.Data = a.Data
+	t.Stride = a.Stride
+	t.Diag = blas.NonUnit
+	return
+}
+
+// Potrs solves a system of n linear equations A*X = B where A is an n×n
+// Hermitian positive definite matrix and B is an n×nrhs matrix, using the
+// Cholesky factorization A = Uᴴ*U or A = L*Lᴴ. t contains the corresponding
+// triangular factor as returned by Potrf. On entry, B contains the right-hand
+// side matrix B, on return it contains the solution matrix X.
+func Potrs(t cblas128.Triangular, b cblas128.General) {
+	lapack128.Zpotrs(t.Uplo, t.N, b.Cols, t.Data, max(1, t.Stride), b.Data, max(1, b.Stride))
+}
+
+// Ungqr generates an m×n complex matrix Q with orthonormal columns defined by
+// the product of elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}
+//
+// as computed by Geqrf.
+//
+// k is determined by the length of tau.
+//
+// The length of work must be at least n and it also must be that 0 <= k <= n
+// and 0 <= n <= m.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= n. If lwork == -1, instead of computing Ungqr the optimal
+// work length is stored into work[0].
+//
+// Ungqr will panic if the conditions on input values are not met.
+func Ungqr(a cblas128.General, tau []complex128, work []complex128, lwork int) {
+	lapack128.Zungqr(a.Rows, a.Cols, len(tau), a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
+// Unmqr multiplies an m×n complex matrix C by a unitary matrix Q as
+//
+//	C = Q * C   if side == blas.Left  and trans == blas.NoTrans,
+//	C = Qᴴ * C  if side == blas.Left  and trans == blas.ConjTrans,
+//	C = C * Q   if side == blas.Right and trans == blas.NoTrans,
+//	C = C * Qᴴ  if side == blas.Right and trans == blas.ConjTrans,
+//
+// where Q is defined as the product of k elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}.
+//
+// k is determined by the length of tau. a and tau contain the elementary
+// reflectors as returned by Geqrf.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Unmqr will
+// panic. If lwork is -1, instead of performing Unmqr, the optimal workspace
+// size will be stored into work[0].
+func Unmqr(side blas.Side, trans blas.Transpose, a cblas128.General, tau []complex128, c cblas128.General, work []complex128, lwork int) {
+	lapack128.Zunmqr(side, trans, c.Rows, c.Cols, len(tau), a.Data, max(1, a.Stride), tau, c.Data, max(1, c.Stride), work, lwork)
+}
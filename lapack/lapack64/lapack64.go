@@ -76,6 +76,27 @@ func Potrs(t blas64.Triangular, b blas64.General) {
 	lapack64.Dpotrs(t.Uplo, t.N, b.Cols, t.Data, max(1, t.Stride), b.Data, max(1, b.Stride))
 }
 
+// Posvx solves the system of linear equations A * X = B, where A is an n×n
+// symmetric positive definite matrix, using the Cholesky factorization of A,
+// equilibration and iterative refinement. It returns the equilibration that
+// was applied, an estimate of the reciprocal condition number of A, and
+// forward error bounds and backward errors for each column of the solution in
+// ferr and berr. See the documentation of lapack.Float64.Dposvx for the
+// details.
+//
+// If fact is lapack.FactProvided, af must contain the triangular factor of
+// the Cholesky factorization of A as computed by Potrf. Otherwise af is used
+// for output. af.Uplo must match a.Uplo.
+//
+// The length of work must be at least 3*n and the length of iwork must be at
+// least n.
+func Posvx(fact lapack.Fact, a blas64.Symmetric, af blas64.Triangular, equed lapack.Equilibration, s []float64, b, x blas64.General, ferr, berr, work []float64, iwork []int) (eq lapack.Equilibration, rcond float64, ok bool) {
+	if af.Uplo != a.Uplo {
+		panic("lapack64: mismatched uplo")
+	}
+	return lapack64.Dposvx(fact, a.Uplo, a.N, b.Cols, a.Data, max(1, a.Stride), af.Data, max(1, af.Stride), equed, s, b.Data, max(1, b.Stride), x.Data, max(1, x.Stride), ferr, berr, work, iwork)
+}
+
 // Pbcon returns an estimate of the reciprocal of the condition number (in the
 // 1-norm) of an n×n symmetric positive definite band matrix using the Cholesky
 // factorization
@@ -166,6 +187,65 @@ func Pstrf(a blas64.Symmetric, piv []int, tol float64, work []float64) (t blas64
 	return t, rank, ok
 }
 
+// Gbcon estimates the reciprocal of the condition number of the n×n band
+// matrix A given the LU factorization of the matrix computed by Gbtrf. The
+// condition number computed may be based on the 1-norm or the ∞-norm.
+//
+// f contains the result of the LU factorization of A as returned by Gbtrf and
+// ipiv contains the row interchanges.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 3*n and Gbcon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Gbcon will panic otherwise.
+func Gbcon(norm lapack.MatrixNorm, f blas64.Band, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	return lapack64.Dgbcon(norm, f.Cols, f.KL, f.KU-f.KL, f.Data, max(1, f.Stride), ipiv, anorm, work, iwork)
+}
+
+// Gbtrf computes the LU factorization of an m×n band matrix A using partial
+// pivoting with row interchanges.
+//
+// The LU factorization is a factorization of A into
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is a unit lower triangular matrix with at
+// most a.KL non-zero elements below the diagonal in each column, and U is an
+// upper triangular band matrix with a.KL+a.KU super-diagonals.
+//
+// a.Stride must be at least 2*a.KL+a.KU+1 to make room for the additional
+// super-diagonals of U created by the row interchanges, and Gbtrf will panic
+// otherwise. The last a.KL elements of each row need not be set on entry.
+//
+// The factors are returned in f which shares the underlying data with a and
+// has a.KL+a.KU super-diagonals. ipiv contains a sequence of row interchanges
+// and must have length min(m,n).
+//
+// Gbtrf returns whether the matrix A is nonsingular. The LU factorization will
+// be computed regardless of the singularity of A, but the result should not be
+// used to solve a system of equation.
+func Gbtrf(a blas64.Band, ipiv []int) (f blas64.Band, ok bool) {
+	ok = lapack64.Dgbtrf(a.Rows, a.Cols, a.KL, a.KU, a.Data, max(1, a.Stride), ipiv)
+	f = a
+	f.KU = a.KL + a.KU
+	return f, ok
+}
+
+// Gbtrs solves a system of equations
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n band matrix, using the LU factorization f and the row
+// interchanges ipiv computed by Gbtrf.
+//
+// On entry, b contains the right hand side matrix B. On return, it is
+// overwritten with the solution matrix X.
+func Gbtrs(trans blas.Transpose, f blas64.Band, ipiv []int, b blas64.General) {
+	lapack64.Dgbtrs(trans, f.Cols, f.KL, f.KU-f.KL, b.Cols, f.Data, max(1, f.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
 // Gecon estimates the reciprocal of the condition number of the n×n matrix A
 // given the LU decomposition of the matrix. The condition number computed may
 // be based on the 1-norm or the ∞-norm.
@@ -304,6 +384,47 @@ func Gelqf(a blas64.General, tau, work []float64, lwork int) {
 	lapack64.Dgelqf(a.Rows, a.Cols, a.Data, max(1, a.Stride), tau, work, lwork)
 }
 
+// Gesdd computes the singular value decomposition of the input matrix A
+// using the divide and conquer method.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᵀ
+//
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobz specifies how the singular vectors are computed. The behavior is as
+// follows
+//
+//	jobz == lapack.SVDAll       All m columns of U and all n rows of Vᵀ are
+//	                            returned in u and vt.
+//	jobz == lapack.SVDStore     The first min(m,n) columns of U and rows of
+//	                            Vᵀ are returned in u and vt.
+//	jobz == lapack.SVDOverwrite If m >= n, the first n columns of U are written
+//	                            into a and all rows of Vᵀ are returned in vt.
+//	                            Otherwise, all columns of U are returned in u
+//	                            and the first m rows of Vᵀ are written into a.
+//	jobz == lapack.SVDNone      The singular vectors are not computed.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Gesdd
+// the data is overwritten.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. If lwork == -1, instead of performing Gesdd, the optimal work
+// length will be stored into work[0]. Gesdd will panic if the working memory
+// has insufficient storage. iwork must have length at least 8*min(m,n).
+//
+// Gesdd returns whether the decomposition successfully completed.
+func Gesdd(jobz lapack.SVDJob, a blas64.General, s []float64, u, vt blas64.General, work []float64, lwork int, iwork []int) (ok bool) {
+	return lapack64.Dgesdd(jobz, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), vt.Data, max(1, vt.Stride), work, lwork, iwork)
+}
+
 // Gesvd computes the singular value decomposition of the input matrix A.
 //
 // The singular value decomposition is
@@ -354,6 +475,28 @@ func Gesvd(jobU, jobVT lapack.SVDJob, a, u, vt blas64.General, s, work []float64
 	return lapack64.Dgesvd(jobU, jobVT, a.Rows, a.Cols, a.Data, max(1, a.Stride), s, u.Data, max(1, u.Stride), vt.Data, max(1, vt.Stride), work, lwork)
 }
 
+// Gesvx solves the system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans,
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n matrix, using the LU factorization of A, equilibration
+// and iterative refinement. It returns the equilibration that was applied, an
+// estimate of the reciprocal condition number of A, the reciprocal pivot
+// growth factor, and forward error bounds and backward errors for each column
+// of the solution in ferr and berr. See the documentation of
+// lapack.Float64.Dgesvx for the details.
+//
+// If fact is lapack.FactProvided, af and ipiv must contain the LU
+// factorization of A as computed by Getrf. Otherwise they are used for
+// output.
+//
+// The length of work must be at least 4*n and the length of iwork must be at
+// least n.
+func Gesvx(fact lapack.Fact, trans blas.Transpose, a, af blas64.General, ipiv []int, equed lapack.Equilibration, r, c []float64, b, x blas64.General, ferr, berr, work []float64, iwork []int) (eq lapack.Equilibration, rcond, rpvgrw float64, ok bool) {
+	return lapack64.Dgesvx(fact, trans, a.Cols, b.Cols, a.Data, max(1, a.Stride), af.Data, max(1, af.Stride), ipiv, equed, r, c, b.Data, max(1, b.Stride), x.Data, max(1, x.Stride), ferr, berr, work, iwork)
+}
+
 // Getrf computes the LU decomposition of an m×n matrix A using partial
 // pivoting with row interchanges.
 //
@@ -412,6 +555,47 @@ func Getrs(trans blas.Transpose, a blas64.General, b blas64.General, ipiv []int)
 	lapack64.Dgetrs(trans, a.Cols, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
 }
 
+// Ggev computes the generalized eigenvalues and, optionally, the left and/or
+// right generalized eigenvectors for a pair of n×n real nonsymmetric matrices
+// (A,B). A generalized eigenvalue is a ratio λ = alpha/beta such that A - λ*B
+// is singular.
+//
+// On return, (alphar[j] + alphai[j]*i)/beta[j] will be the generalized
+// eigenvalues. Complex conjugate pairs of eigenvalues appear consecutively
+// with the eigenvalue having the positive imaginary part first. beta[j] may be
+// zero, so the ratio should not be computed naively. alphar, alphai and beta
+// must have length n, and Ggev will panic otherwise.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone. Right eigenvectors will be
+// computed only if jobvr == lapack.RightEVCompute, otherwise jobvr must be
+// lapack.RightEVNone. The eigenvectors are stored in the columns of vl and vr
+// in the same way as by Geev. On return, a and b are overwritten.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n).
+// For good performance, lwork must generally be larger. On return, the optimal
+// value of lwork will be stored in work[0]. If lwork == -1, instead of
+// performing Ggev, the function only calculates the optimal value of lwork
+// and stores it into work[0].
+//
+// Ggev returns whether the computation was successful.
+func Ggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a, b blas64.General, alphar, alphai, beta []float64, vl, vr blas64.General, work []float64, lwork int) (ok bool) {
+	n := a.Rows
+	if a.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if b.Rows != n || b.Cols != n {
+		panic("lapack64: bad size of B")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack64: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack64: bad size of VR")
+	}
+	return lapack64.Dggev(jobvl, jobvr, n, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), alphar, alphai, beta, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork)
+}
+
 // Ggsvd3 computes the generalized singular value decomposition (GSVD)
 // of an m×n matrix A and p×n matrix B:
 //
@@ -780,6 +964,19 @@ func Pocon(a blas64.Symmetric, anorm float64, work []float64, iwork []int) float
 	return lapack64.Dpocon(a.Uplo, a.N, a.Data, max(1, a.Stride), anorm, work, iwork)
 }
 
+// Sycon estimates the reciprocal of the condition number in the 1-norm of a
+// symmetric matrix A given the Bunch-Kaufman factorization of A computed by
+// Sytrf.
+//
+// anorm is the 1-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Sycon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Sycon will panic otherwise.
+func Sycon(a blas64.Symmetric, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	return lapack64.Dsycon(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, anorm, work, iwork)
+}
+
 // Syev computes all eigenvalues and, optionally, the eigenvectors of a real
 // symmetric matrix A.
 //
@@ -799,6 +996,112 @@ func Syev(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int) (
 	return lapack64.Dsyev(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork)
 }
 
+// Syevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A using the divide and conquer method when the eigenvectors
+// are computed. It is considerably faster than Syev for large matrices.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Syevd will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work and iwork are temporary storage, and lwork and liwork specify their
+// usable lengths. If lwork == -1 or liwork == -1, instead of computing Syevd
+// the optimal work length is stored into work[0] and the minimum integer work
+// length into iwork[0].
+func Syevd(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	return lapack64.Dsyevd(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork, iwork, liwork)
+}
+
+// Syevr computes selected eigenvalues and, optionally, the eigenvectors of a
+// real symmetric matrix A. The eigenvalues are selected by rng: all of them,
+// those in the half-open interval (vl, vu], or the il-th through iu-th
+// eigenvalues counted from zero in ascending order.
+//
+// On return, m is the number of eigenvalues found and the first m elements of
+// w contain them in ascending order. If jobz == lapack.EVCompute, the first m
+// columns of z contain the orthonormal eigenvectors. On exit, the triangular
+// region of a specified by uplo is overwritten.
+//
+// abstol is the absolute tolerance to which each eigenvalue is required. If
+// abstol <= 0, a default tolerance is used.
+//
+// work and iwork are temporary storage, and lwork and liwork specify their
+// usable lengths. If lwork == -1 or liwork == -1, instead of computing Syevr
+// the optimal work length is stored into work[0] and the minimum integer work
+// length into iwork[0].
+func Syevr(jobz lapack.EVJob, rng lapack.EVRange, a blas64.Symmetric, vl, vu float64, il, iu int, abstol float64, w []float64, z blas64.General, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	return lapack64.Dsyevr(jobz, rng, a.Uplo, a.N, a.Data, max(1, a.Stride), vl, vu, il, iu, abstol, w, z.Data, max(1, z.Stride), work, lwork, iwork, liwork)
+}
+
+// Sygv computes all eigenvalues and, optionally, the eigenvectors of a real
+// generalized symmetric-definite eigenproblem of the form
+//
+//	A*x = λ*B*x  if itype == 1,
+//	A*B*x = λ*x  if itype == 2,
+//	B*A*x = λ*x  if itype == 3,
+//
+// where A and B are symmetric and B is also positive definite. a and b must
+// have the same Uplo and size.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Sygv will panic otherwise.
+//
+// If jobz == lapack.EVCompute, a contains the eigenvectors Z on exit,
+// normalized so that Zᵀ*B*Z = I if itype is 1 or 2, and Zᵀ*inv(B)*Z = I if
+// itype is 3. On exit, b contains the Cholesky factor of B.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1,3*n-1), and Sygv will panic otherwise. If
+// lwork == -1, instead of computing Sygv the optimal work length is stored
+// into work[0].
+//
+// Sygv returns false if B is not positive definite or the eigenvalue
+// computation did not converge.
+func Sygv(itype int, jobz lapack.EVJob, a, b blas64.Symmetric, w, work []float64, lwork int) (ok bool) {
+	if a.N != b.N {
+		panic("lapack64: bad size of B")
+	}
+	if a.Uplo != b.Uplo {
+		panic("lapack64: mismatched uplo")
+	}
+	return lapack64.Dsygv(itype, jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), w, work, lwork)
+}
+
+// Sytrf computes the factorization of a symmetric matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The form of the factorization is
+//
+//	A = U * D * Uᵀ  if a.Uplo == blas.Upper, or
+//	A = L * D * Lᵀ  if a.Uplo == blas.Lower,
+//
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks. On return, a contains D and the multipliers used to obtain the
+// factor U or L, and ipiv contains details of the interchanges and the block
+// structure of D. ipiv must have length n and is zero-indexed; see the
+// documentation of gonum.Implementation.Dsytrf for its format.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= 1 and Sytrf will panic otherwise. If lwork == -1,
+// instead of performing Sytrf, the optimal work length will be stored into
+// work[0].
+//
+// Sytrf returns whether D is nonsingular.
+func Sytrf(a blas64.Symmetric, ipiv []int, work []float64, lwork int) (ok bool) {
+	return lapack64.Dsytrf(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, work, lwork)
+}
+
+// Sytrs solves a system of linear equations A * X = B with a symmetric matrix
+// A using the Bunch-Kaufman factorization computed by Sytrf. On entry, b
+// contains the right-hand side matrix B, on return it contains the solution
+// matrix X.
+func Sytrs(a blas64.Symmetric, ipiv []int, b blas64.General) {
+	lapack64.Dsytrs(a.Uplo, a.N, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
 // Tbtrs solves a triangular system of the form
 //
 //	A * X = B   if trans == blas.NoTrans
@@ -906,3 +1209,144 @@ func Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a blas64.General, wr,
 	}
 	return lapack64.Dgeev(jobvl, jobvr, n, a.Data, max(1, a.Stride), wr, wi, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork)
 }
+
+// Gehrd reduces the n×n general matrix A to upper Hessenberg form H by an
+// orthogonal similarity transformation Qᵀ * A * Q = H. Only the block
+// A[ilo:ihi+1,ilo:ihi+1] is reduced; ilo and ihi are typically set by a
+// previous call to Gebal, otherwise they should be set to 0 and n-1,
+// respectively.
+//
+// On return, the upper triangle and the first subdiagonal of A will be
+// overwritten with H, and the elements below the first subdiagonal, with tau,
+// represent Q as a product of elementary reflectors. tau must have length n-1
+// if n > 0.
+//
+// work must have length at least lwork and lwork must be at least max(1,n). On
+// return, the optimal value of lwork will be stored in work[0]. If lwork == -1,
+// instead of performing Gehrd, only the optimal value of lwork will be stored
+// in work[0].
+//
+// Dgehrd is not part of the lapack.Float64 interface and so calls to Gehrd are
+// always executed by the Gonum implementation.
+func Gehrd(a blas64.General, ilo, ihi int, tau, work []float64, lwork int) {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	gonum.Implementation{}.Dgehrd(a.Rows, ilo, ihi, a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
+// Orghr generates the n×n orthogonal matrix Q which is defined as the product
+// of the elementary reflectors returned by Gehrd. On entry, A and tau must
+// contain the reflectors as returned by Gehrd with the same values of ilo and
+// ihi. On return, A is overwritten by Q.
+//
+// work must have length at least lwork and lwork must be at least max(1,ihi-ilo).
+// On return, the optimal value of lwork will be stored in work[0]. If
+// lwork == -1, instead of performing Orghr, only the optimal value of lwork
+// will be stored in work[0].
+//
+// Dorghr is not part of the lapack.Float64 interface and so calls to Orghr are
+// always executed by the Gonum implementation.
+func Orghr(a blas64.General, ilo, ihi int, tau, work []float64, lwork int) {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	gonum.Implementation{}.Dorghr(a.Rows, ilo, ihi, a.Data, max(1, a.Stride), tau, work, lwork)
+}
+
+// Hseqr computes the eigenvalues of the n×n upper Hessenberg matrix H and,
+// optionally, the matrices T and Z from the Schur decomposition
+//
+//	H = Z T Zᵀ,
+//
+// where T is an upper quasi-triangular matrix (the Schur form), and Z is the
+// orthogonal matrix of Schur vectors.
+//
+// If job == lapack.EigenvaluesAndSchur, on return H will contain T. If
+// compz == lapack.SchurHess, on return Z will contain the Schur vectors of H,
+// and if compz == lapack.SchurOrig, Z must contain on entry the orthogonal
+// matrix Q that reduced A to H and on return it will contain Q*Z. If
+// compz == lapack.SchurNone, z is not referenced.
+//
+// wr and wi must have length n and will contain on return the real and
+// imaginary parts of the eigenvalues in the order in which they appear on the
+// diagonal of T. Complex conjugate pairs appear consecutively with the
+// eigenvalue having the positive imaginary part first.
+//
+// work must have length at least lwork and lwork must be at least max(1,n). On
+// return, the optimal value of lwork will be stored in work[0]. If lwork == -1,
+// instead of performing Hseqr, only the optimal value of lwork will be stored
+// in work[0].
+//
+// unconverged is zero if all eigenvalues have been computed. See the
+// documentation of Dhseqr for the contents of H, Z, wr and wi otherwise.
+//
+// Dhseqr is not part of the lapack.Float64 interface and so calls to Hseqr are
+// always executed by the Gonum implementation.
+func Hseqr(job lapack.SchurJob, compz lapack.SchurComp, h blas64.General, ilo, ihi int, wr, wi []float64, z blas64.General, work []float64, lwork int) (unconverged int) {
+	if h.Rows != h.Cols {
+		panic("lapack64: matrix not square")
+	}
+	if compz != lapack.SchurNone && (z.Rows != h.Rows || z.Cols != h.Cols) {
+		panic("lapack64: bad size of Z")
+	}
+	return gonum.Implementation{}.Dhseqr(job, compz, h.Rows, ilo, ihi, h.Data, max(1, h.Stride), wr, wi, z.Data, max(1, z.Stride), work, lwork)
+}
+
+// Trexc reorders the real Schur factorization of an n×n real matrix
+//
+//	A = Q*T*Qᵀ
+//
+// so that the diagonal block of T with row index ifst is moved to row ilst.
+// T must be in Schur canonical form as returned by Hseqr and on return it will
+// again be in Schur canonical form.
+//
+// If compq is lapack.UpdateSchur, on return Q will be updated by
+// post-multiplying it with the orthogonal transformation used for the
+// reordering. If compq is lapack.UpdateSchurNone, q is not referenced.
+//
+// If ifst points to the second row of a 2×2 block, ifstOut will point to the
+// first row, otherwise it will be equal to ifst. ilstOut will point to the
+// first row of the block in its final position.
+//
+// If ok is false, two adjacent blocks were too close to swap because the
+// problem is very ill-conditioned, and T may have been partially reordered.
+//
+// work must have length at least n.
+//
+// Dtrexc is not part of the lapack.Float64 interface and so calls to Trexc are
+// always executed by the Gonum implementation.
+func Trexc(compq lapack.UpdateSchurComp, t, q blas64.General, ifst, ilst int, work []float64) (ifstOut, ilstOut int, ok bool) {
+	if t.Rows != t.Cols {
+		panic("lapack64: matrix not square")
+	}
+	if compq == lapack.UpdateSchur && (q.Rows != t.Rows || q.Cols != t.Cols) {
+		panic("lapack64: bad size of Q")
+	}
+	return gonum.Implementation{}.Dtrexc(compq, t.Rows, t.Data, max(1, t.Stride), q.Data, max(1, q.Stride), ifst, ilst, work)
+}
+
+// Trsyl solves the real Sylvester matrix equation
+//
+//	op(A)*X + isgn*X*op(B) = scale*C
+//
+// where A and B are upper quasi-triangular matrices in Schur canonical form as
+// returned by Hseqr, and op(A) is A if trana == blas.NoTrans and Aᵀ otherwise,
+// and similarly for op(B). On return, C is overwritten by the solution X.
+// scale is an output scale factor, 0 < scale <= 1, chosen to avoid overflow
+// in X.
+//
+// If ok is false, A and -isgn*B have common or very close eigenvalues and
+// perturbed values were used to solve the equation.
+//
+// Dtrsyl is not part of the lapack.Float64 interface and so calls to Trsyl are
+// always executed by the Gonum implementation.
+func Trsyl(trana, tranb blas.Transpose, isgn int, a, b, c blas64.General) (scale float64, ok bool) {
+	if a.Rows != a.Cols || b.Rows != b.Cols {
+		panic("lapack64: matrix not square")
+	}
+	if c.Rows != a.Rows || c.Cols != b.Rows {
+		panic("lapack64: bad size of C")
+	}
+	return gonum.Implementation{}.Dtrsyl(trana, tranb, isgn, c.Rows, c.Cols, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), c.Data, max(1, c.Stride))
+}
@@ -0,0 +1,115 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dbdsdcer interface {
+	Dbdsdc(uplo blas.Uplo, compq lapack.SVDComp, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool)
+}
+
+func DbdsdcTest(t *testing.T, impl Dbdsdcer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 25, 26, 40, 51, 100, 160} {
+			for _, ld := range []int{n, n + 5} {
+				ld = max(1, ld)
+				for _, kind := range tridiagKinds {
+					testDbdsdc(t, impl, rnd, uplo, kind, n, ld)
+				}
+			}
+		}
+	}
+}
+
+func testDbdsdc(t *testing.T, impl Dbdsdcer, rnd *rand.Rand, uplo blas.Uplo, kind string, n, ld int) {
+	const tol = 100
+
+	prefix := fmt.Sprintf("uplo=%c,kind=%v,n=%v,ld=%v", uplo, kind, n, ld)
+
+	d, e := randomTridiagKind(kind, n, rnd)
+	dCopy := make([]float64, len(d))
+	copy(dCopy, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+
+	// Compute the singular values only.
+	want := make([]float64, n)
+	copy(want, d)
+	work := make([]float64, max(1, 4*n))
+	iwork := make([]int, 8*n)
+	ok := impl.Dbdsdc(uplo, lapack.SVDCompNone, n, want, append([]float64(nil), e...), nil, 1, nil, 1, work, iwork)
+	if !ok {
+		t.Errorf("%v: Dbdsdc failed computing singular values only", prefix)
+		return
+	}
+
+	u := nanGeneral(n, n, ld)
+	vt := nanGeneral(n, n, ld)
+	work = make([]float64, max(1, 3*n*n+12*n))
+	ok = impl.Dbdsdc(uplo, lapack.SVDCompExplicit, n, d, e, u.Data, u.Stride, vt.Data, vt.Stride, work, iwork)
+	if !ok {
+		t.Errorf("%v: Dbdsdc failed", prefix)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !generalOutsideAllNaN(u) {
+		t.Errorf("%v: out-of-range write to U", prefix)
+	}
+	if !generalOutsideAllNaN(vt) {
+		t.Errorf("%v: out-of-range write to VT", prefix)
+	}
+
+	bnorm := dlanst(lapack.MaxAbs, n, dCopy, eCopy)
+	if !floats.EqualApprox(d, want, 1e-12*math.Max(1, bnorm)) {
+		t.Errorf("%v: singular values do not match those computed without vectors", prefix)
+	}
+	for i := 0; i < n; i++ {
+		if d[i] < 0 {
+			t.Errorf("%v: singular value %v is negative", prefix, i)
+		}
+		if i > 0 && d[i] > d[i-1] {
+			t.Errorf("%v: singular values not in decreasing order", prefix)
+			break
+		}
+	}
+
+	if resid := residualOrthogonal(u, false); resid > tol*float64(n)*dlamchE {
+		t.Errorf("%v: U is not orthogonal; resid=%v", prefix, resid)
+	}
+	if resid := residualOrthogonal(vt, true); resid > tol*float64(n)*dlamchE {
+		t.Errorf("%v: VT is not orthogonal; resid=%v", prefix, resid)
+	}
+
+	// Check that B = U * S * Vᵀ.
+	b := constructBidiagonal(uplo, n, dCopy, eCopy)
+	us := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			us.Data[i*us.Stride+j] = u.Data[i*u.Stride+j] * d[j]
+		}
+	}
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, us, vt, 1, b)
+	resid := dlange(lapack.MaxColumnSum, n, n, b.Data, b.Stride)
+	if bnorm != 0 {
+		resid /= float64(n) * bnorm
+	}
+	if resid > tol*float64(n)*dlamchE {
+		t.Errorf("%v: unexpected residual |B - U*S*Vᵀ|/(n*|B|)=%v", prefix, resid)
+	}
+}
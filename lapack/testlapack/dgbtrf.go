@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbtrfer interface {
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+}
+
+// DgbtrfTest tests Dgbtrf by checking that the computed factors reconstruct
+// the original random band matrix.
+func DgbtrfTest(t *testing.T, impl Dgbtrfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 31} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31} {
+			for _, kl := range []int{0, 1, 2, (m + 1) / 4, m + 1} {
+				for _, ku := range []int{0, 1, 2, (n + 1) / 4, n + 1} {
+					for _, extra := range []int{0, 3} {
+						ldab := 2*kl + ku + 1 + extra
+						dgbtrfTest(t, impl, rnd, m, n, kl, ku, ldab, false)
+						dgbtrfTest(t, impl, rnd, m, n, kl, ku, ldab, true)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrfTest(t *testing.T, impl Dgbtrfer, rnd *rand.Rand, m, n, kl, ku, ldab int, singular bool) {
+	const tol = 1e-13
+
+	mn := min(m, n)
+	name := fmt.Sprintf("m=%v,n=%v,kl=%v,ku=%v,ldab=%v,singular=%v", m, n, kl, ku, ldab, singular)
+
+	ab := randGenBand(m, n, kl, ku, ldab, rnd)
+	if singular {
+		if mn == 0 {
+			return
+		}
+		// Zero a column of A to make it singular.
+		j := rnd.IntN(mn)
+		for i := max(0, j-ku); i <= min(m-1, j+kl); i++ {
+			ab[i*ldab+kl+j-i] = 0
+		}
+	}
+	a := genBandToGeneral(m, n, kl, ku, ab, ldab)
+
+	abFac := make([]float64, len(ab))
+	copy(abFac, ab)
+	ipiv := make([]int, mn)
+	ok := impl.Dgbtrf(m, n, kl, ku, abFac, ldab, ipiv)
+	if singular && ok {
+		t.Errorf("%v: unexpected success for singular matrix", name)
+	}
+	if !singular && !ok {
+		// A random band matrix is nonsingular with probability one.
+		t.Errorf("%v: unexpected failure", name)
+	}
+	if mn == 0 {
+		return
+	}
+
+	// Check that the elements outside the band of the factors are not
+	// modified.
+	kv := kl + ku
+	for i := 0; i < min(m, n+kl); i++ {
+		for k := 0; k < ldab && i*ldab+k < len(abFac); k++ {
+			j := i - kl + k
+			inBand := 0 <= j && j < n && j-i <= kv
+			if !inBand && !math.IsNaN(abFac[i*ldab+k]) {
+				t.Errorf("%v: unexpected modification outside the band at row %v, offset %v", name, i, k)
+			}
+		}
+	}
+
+	for j, p := range ipiv {
+		if p < j || p > min(j+kl, m-1) {
+			t.Errorf("%v: ipiv[%v]=%v out of range", name, j, p)
+		}
+	}
+
+	// Reconstruct A by applying the multipliers and row interchanges in
+	// reverse order to U.
+	w := zeros(m, n, max(1, n))
+	for i := 0; i < mn; i++ {
+		for j := i; j <= min(n-1, i+kv); j++ {
+			w.Data[i*w.Stride+j] = abFac[i*ldab+kl+j-i]
+		}
+	}
+	for j := mn - 1; j >= 0; j-- {
+		for i := j + 1; i <= min(m-1, j+kl); i++ {
+			l := abFac[i*ldab+kl+j-i]
+			for k := 0; k < n; k++ {
+				w.Data[i*w.Stride+k] += l * w.Data[j*w.Stride+k]
+			}
+		}
+		if p := ipiv[j]; p != j {
+			for k := 0; k < n; k++ {
+				w.Data[j*w.Stride+k], w.Data[p*w.Stride+k] = w.Data[p*w.Stride+k], w.Data[j*w.Stride+k]
+			}
+		}
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			w.Data[i*w.Stride+j] -= a.Data[i*a.Stride+j]
+		}
+	}
+	resid := dlange(lapack.MaxColumnSum, m, n, w.Data, w.Stride)
+	anorm := dlange(lapack.MaxColumnSum, m, n, a.Data, a.Stride)
+	if anorm > 0 {
+		resid /= anorm
+	}
+	if resid > tol*float64(max(1, n)) {
+		t.Errorf("%v: P*L*U does not reconstruct A, resid=%v", name, resid)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbtrser interface {
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
+
+	Dgbtrfer
+}
+
+// DgbtrsTest tests Dgbtrs by checking the residual of the computed solution of
+// a linear system with a random band matrix.
+func DgbtrsTest(t *testing.T, impl Dgbtrser) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 65, 100} {
+		for _, kl := range []int{0, 1, (n + 1) / 4, n + 1} {
+			for _, ku := range []int{0, 2, (3*n - 1) / 4} {
+				for _, nrhs := range []int{0, 1, 2, 5} {
+					for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+						for _, extra := range []int{0, 3} {
+							ldab := 2*kl + ku + 1 + extra
+							for _, ldb := range []int{max(1, nrhs), nrhs + 4} {
+								dgbtrsTest(t, impl, rnd, trans, n, kl, ku, nrhs, ldab, ldb)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrsTest(t *testing.T, impl Dgbtrser, rnd *rand.Rand, trans blas.Transpose, n, kl, ku, nrhs, ldab, ldb int) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("trans=%v,n=%v,kl=%v,ku=%v,nrhs=%v,ldab=%v,ldb=%v", transToString(trans), n, kl, ku, nrhs, ldab, ldb)
+
+	ab := randGenBand(n, n, kl, ku, ldab, rnd)
+	abFac := make([]float64, len(ab))
+	copy(abFac, ab)
+	ipiv := make([]int, n)
+	ok := impl.Dgbtrf(n, n, kl, ku, abFac, ldab, ipiv)
+	if !ok {
+		t.Fatalf("%v: bad test matrix, Dgbtrf failed", name)
+	}
+	abFacCopy := make([]float64, len(abFac))
+	copy(abFacCopy, abFac)
+
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	x := cloneGeneral(b)
+	impl.Dgbtrs(trans, n, kl, ku, nrhs, abFac, ldab, ipiv, x.Data, x.Stride)
+
+	if !floats.Same(abFac, abFacCopy) {
+		t.Errorf("%v: unexpected modification of ab", name)
+	}
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	// Compute the residual op(A)*X - B and scale it by
+	//  1 / (n * norm(A) * norm(X)).
+	bi := blas64.Implementation()
+	for j := 0; j < nrhs; j++ {
+		bi.Dgbmv(trans, n, n, kl, ku, 1, ab, ldab, x.Data[j:], x.Stride, -1, b.Data[j:], b.Stride)
+	}
+	a := genBandToGeneral(n, n, kl, ku, ab, ldab)
+	anorm := dlange(lapack.MaxAbs, n, n, a.Data, a.Stride)
+	xnorm := dlange(lapack.MaxAbs, n, nrhs, x.Data, x.Stride)
+	resid := dlange(lapack.MaxAbs, n, nrhs, b.Data, b.Stride) / float64(n) / anorm / xnorm
+	if resid > tol || math.IsNaN(resid) {
+		t.Errorf("%v: unexpected residual, resid=%v", name, resid)
+	}
+}
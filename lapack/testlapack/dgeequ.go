@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgeequer interface {
+	Dgeequ(m, n int, a []float64, lda int, r, c []float64) (rowcnd, colcnd, amax float64, ok bool)
+	Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) lapack.Equilibration
+}
+
+func DgeequTest(t *testing.T, impl Dgeequer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 5, 10, 33} {
+		for _, n := range []int{0, 1, 2, 5, 10, 33} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for _, scaling := range []int{0, 1, 2, 3} {
+					dgeequTest(t, impl, rnd, m, n, lda, scaling)
+				}
+			}
+		}
+	}
+}
+
+// dgeequTest tests Dgeequ and Dlaqge on a random m×n matrix. If scaling is 1,
+// the rows of the matrix are badly scaled, if it is 2, the columns are badly
+// scaled and if it is 3, both rows and columns are badly scaled.
+func dgeequTest(t *testing.T, impl Dgeequer, rnd *rand.Rand, m, n, lda, scaling int) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("m=%v,n=%v,lda=%v,scaling=%v", m, n, lda, scaling)
+
+	a := randomGeneral(m, n, lda, rnd)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a.Data[i*lda+j] += 0.1 * math.Copysign(1, a.Data[i*lda+j])
+		}
+	}
+	if scaling&1 != 0 {
+		for i := 0; i < m; i++ {
+			s := math.Pow(10, float64(rnd.IntN(21)-10))
+			for j := 0; j < n; j++ {
+				a.Data[i*lda+j] *= s
+			}
+		}
+	}
+	if scaling&2 != 0 {
+		for j := 0; j < n; j++ {
+			s := math.Pow(10, float64(rnd.IntN(21)-10))
+			for i := 0; i < m; i++ {
+				a.Data[i*lda+j] *= s
+			}
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	r := nanSlice(m)
+	c := nanSlice(n)
+	rowcnd, colcnd, amax, ok := impl.Dgeequ(m, n, a.Data, lda, r, c)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if !equalGeneral(a, aCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if m == 0 || n == 0 {
+		if rowcnd != 1 || colcnd != 1 || amax != 0 {
+			t.Errorf("%v: unexpected result for empty matrix: rowcnd=%v,colcnd=%v,amax=%v", name, rowcnd, colcnd, amax)
+		}
+		return
+	}
+
+	if want := dlange(lapack.MaxAbs, m, n, a.Data, lda); amax != want {
+		t.Errorf("%v: unexpected amax; got %v, want %v", name, amax, want)
+	}
+	if want := floats.Min(r) / floats.Max(r); !scalar.EqualWithinRel(rowcnd, want, tol) {
+		t.Errorf("%v: unexpected rowcnd; got %v, want %v", name, rowcnd, want)
+	}
+	if want := floats.Min(c) / floats.Max(c); !scalar.EqualWithinRel(colcnd, want, tol) {
+		t.Errorf("%v: unexpected colcnd; got %v, want %v", name, colcnd, want)
+	}
+
+	// Check that the largest element in each row of diag(r)*A and in
+	// each column of diag(r)*A*diag(c) has absolute value 1.
+	for i := 0; i < m; i++ {
+		var rmax float64
+		for j := 0; j < n; j++ {
+			rmax = math.Max(rmax, math.Abs(r[i]*a.Data[i*lda+j]))
+		}
+		if math.Abs(rmax-1) > tol {
+			t.Errorf("%v: row %v of diag(r)*A not equilibrated; max=%v", name, i, rmax)
+		}
+	}
+	for j := 0; j < n; j++ {
+		var cmax float64
+		for i := 0; i < m; i++ {
+			cmax = math.Max(cmax, math.Abs(r[i]*a.Data[i*lda+j]*c[j]))
+		}
+		if math.Abs(cmax-1) > tol {
+			t.Errorf("%v: column %v of diag(r)*A*diag(c) not equilibrated; max=%v", name, j, cmax)
+		}
+	}
+
+	// Check that Dlaqge applies the expected scaling.
+	const thresh = 0.1
+	rowScale := rowcnd < thresh
+	colScale := colcnd < thresh
+	var want lapack.Equilibration
+	switch {
+	case rowScale && colScale:
+		want = lapack.EquilibrateBoth
+	case rowScale:
+		want = lapack.EquilibrateRow
+	case colScale:
+		want = lapack.EquilibrateCol
+	default:
+		want = lapack.EquilibrateNone
+	}
+	equed := impl.Dlaqge(m, n, a.Data, lda, r, c, rowcnd, colcnd, amax)
+	if equed != want {
+		t.Errorf("%v: unexpected equilibration; got %c, want %c", name, equed, want)
+	}
+	if scaling == 3 && m > 1 && n > 1 && equed == lapack.EquilibrateNone {
+		t.Errorf("%v: badly scaled matrix not equilibrated", name)
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			aij := aCopy.Data[i*lda+j]
+			switch equed {
+			case lapack.EquilibrateRow:
+				aij *= r[i]
+			case lapack.EquilibrateCol:
+				aij *= c[j]
+			case lapack.EquilibrateBoth:
+				aij *= r[i] * c[j]
+			}
+			if a.Data[i*lda+j] != aij {
+				t.Errorf("%v: unexpected element of equilibrated matrix at (%v,%v)", name, i, j)
+				return
+			}
+		}
+	}
+	if !generalOutsideAllNaN(a) {
+		t.Errorf("%v: out-of-range write to A", name)
+	}
+
+	// Check that a zero row and a zero column are detected.
+	copyGeneral(a, aCopy)
+	for j := 0; j < n; j++ {
+		a.Data[(m-1)*lda+j] = 0
+	}
+	if _, _, _, ok := impl.Dgeequ(m, n, a.Data, lda, r, c); ok {
+		t.Errorf("%v: zero row not detected", name)
+	}
+	copyGeneral(a, aCopy)
+	for i := 0; i < m; i++ {
+		a.Data[i*lda+n-1] = 0
+	}
+	if _, _, _, ok := impl.Dgeequ(m, n, a.Data, lda, r, c); ok {
+		t.Errorf("%v: zero column not detected", name)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+func DgeqrfBenchmark(b *testing.B, impl Dgeqrfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{100, 500, 1000, 2000} {
+		a := make([]float64, n*n)
+		for i := range a {
+			a[i] = rnd.NormFloat64()
+		}
+		aCopy := make([]float64, len(a))
+		tau := make([]float64, n)
+		work := make([]float64, 1)
+		impl.Dgeqrf(n, n, aCopy, n, tau, work, -1)
+		work = make([]float64, int(work[0]))
+		b.Run(fmt.Sprintf("N=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(aCopy, a)
+				b.StartTimer()
+				impl.Dgeqrf(n, n, aCopy, n, tau, work, len(work))
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dgerfser interface {
+	Dgetrser
+	Dgerfs(trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int)
+}
+
+func DgerfsTest(t *testing.T, impl Dgerfser) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 25, 50} {
+			for _, nrhs := range []int{0, 1, 3} {
+				for _, ld := range []int{0, 5} {
+					for _, cond := range []float64{10, 1e6} {
+						for _, perturb := range []bool{false, true} {
+							dgerfsTest(t, impl, rnd, trans, n, nrhs, ld, cond, perturb)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgerfsTest(t *testing.T, impl Dgerfser, rnd *rand.Rand, trans blas.Transpose, n, nrhs, ld int, cond float64, perturb bool) {
+	name := fmt.Sprintf("trans=%c,n=%v,nrhs=%v,ld=%v,cond=%v,perturb=%v", trans, n, nrhs, ld, cond, perturb)
+
+	lda := max(1, n+ld)
+	ldb := max(1, nrhs+ld)
+
+	// Generate a random matrix A with the given condition number.
+	a := randomConditionedGeneral(n, lda, cond, rnd)
+	xTrue, b := randomLinearSystem(trans, n, nrhs, ldb, a, rnd)
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+
+	// Compute the LU factorization of A and the solution X.
+	af := cloneGeneral(a)
+	ipiv := make([]int, n)
+	if !impl.Dgetrf(n, n, af.Data, af.Stride, ipiv) {
+		t.Fatalf("%v: unexpected failure in Dgetrf", name)
+	}
+	afCopy := cloneGeneral(af)
+	x := cloneGeneral(b)
+	impl.Dgetrs(trans, n, nrhs, af.Data, af.Stride, ipiv, x.Data, x.Stride)
+	if perturb {
+		// Perturb the solution so that the refinement has to improve it.
+		for i := 0; i < n; i++ {
+			for j := 0; j < nrhs; j++ {
+				x.Data[i*x.Stride+j] *= 1 + 1e-6*rnd.NormFloat64()
+			}
+		}
+	}
+
+	ferr := nanSlice(nrhs)
+	berr := nanSlice(nrhs)
+	work := nanSlice(3 * n)
+	iwork := make([]int, n)
+	impl.Dgerfs(trans, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, ipiv, b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+
+	if !equalGeneral(a, aCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !equalGeneral(af, afCopy) {
+		t.Errorf("%v: unexpected modification of AF", name)
+	}
+	if !equalGeneral(b, bCopy) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	checkErrorBounds(t, name, trans, n, nrhs, a, b, x, xTrue, ferr, berr, cond)
+}
+
+// randomConditionedGeneral returns a random n×n matrix with singular values
+// spread between 1 and 1/cond.
+func randomConditionedGeneral(n, lda int, cond float64, rnd *rand.Rand) blas64.General {
+	a := nanGeneral(n, n, lda)
+	if n == 0 {
+		return a
+	}
+	d := make([]float64, n)
+	Dlatm1(d, 3, cond, false, 1, rnd)
+	Dlagge(n, n, n-1, n-1, d, a.Data, a.Stride, rnd, make([]float64, 2*n))
+	return a
+}
+
+// randomLinearSystem returns a random n×nrhs solution matrix X and the
+// corresponding right-hand side B = op(A) * X.
+func randomLinearSystem(trans blas.Transpose, n, nrhs, ldb int, a blas64.General, rnd *rand.Rand) (x, b blas64.General) {
+	x = randomGeneral(n, nrhs, ldb, rnd)
+	b = nanGeneral(n, nrhs, ldb)
+	if n == 0 || nrhs == 0 {
+		return x, b
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			b.Data[i*b.Stride+j] = 0
+		}
+	}
+	blas64.Gemm(trans, blas.NoTrans, 1, a, x, 0, b)
+	return x, b
+}
+
+// checkErrorBounds checks the solution x of op(A) * X = B and the
+// corresponding forward error bounds and backward errors computed by an
+// expert solver. xTrue is the solution from which B was generated and cond
+// bounds the condition number of A.
+func checkErrorBounds(t *testing.T, name string, trans blas.Transpose, n, nrhs int, a, b, x, xTrue blas64.General, ferr, berr []float64, cond float64) {
+	t.Helper()
+
+	if !generalOutsideAllNaN(x) {
+		t.Errorf("%v: out-of-range write to X", name)
+	}
+	if n == 0 {
+		for j := 0; j < nrhs; j++ {
+			if ferr[j] != 0 || berr[j] != 0 {
+				t.Errorf("%v: unexpected error bounds for empty system", name)
+				return
+			}
+		}
+		return
+	}
+
+	berrTol := 10 * float64(n+1) * dlamchE
+	ferrTol := 100 * float64(n) * cond * dlamchE
+	for j := 0; j < nrhs; j++ {
+		// Compute the componentwise backward error of the j-th
+		// solution vector directly.
+		var resid float64
+		for i := 0; i < n; i++ {
+			r := b.Data[i*b.Stride+j]
+			s := math.Abs(r)
+			for k := 0; k < n; k++ {
+				aik := a.Data[i*a.Stride+k]
+				if trans != blas.NoTrans {
+					aik = a.Data[k*a.Stride+i]
+				}
+				xk := x.Data[k*x.Stride+j]
+				r -= aik * xk
+				s += math.Abs(aik) * math.Abs(xk)
+			}
+			if s != 0 {
+				resid = math.Max(resid, math.Abs(r)/s)
+			}
+		}
+		if resid > berrTol {
+			t.Errorf("%v: backward error of solution %v too large; got %v, want <= %v", name, j, resid, berrTol)
+		}
+		if berr[j] > berrTol || berr[j] < 0 {
+			t.Errorf("%v: unexpected berr[%v]=%v", name, j, berr[j])
+		}
+
+		// Check that ferr bounds the error of the solution.
+		diff := make([]float64, n)
+		xj := make([]float64, n)
+		for i := 0; i < n; i++ {
+			xj[i] = x.Data[i*x.Stride+j]
+			diff[i] = xj[i] - xTrue.Data[i*xTrue.Stride+j]
+		}
+		xnorm := floats.Norm(xj, math.Inf(1))
+		err := floats.Norm(diff, math.Inf(1)) / xnorm
+		if err > ferr[j] {
+			t.Errorf("%v: ferr[%v] does not bound the forward error; got %v, error %v", name, j, ferr[j], err)
+		}
+		if ferr[j] > ferrTol {
+			t.Errorf("%v: ferr[%v] too large; got %v, want <= %v", name, j, ferr[j], ferrTol)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgesdder interface {
+	Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool)
+}
+
+func DgesddTest(t *testing.T, impl Dgesdder, tol float64) {
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 30, 60, 150} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 30, 60, 150} {
+			for _, mtype := range []int{1, 2, 3, 4, 5} {
+				dgesddTest(t, impl, m, n, mtype, tol)
+			}
+		}
+	}
+}
+
+// dgesddTest tests a Dgesdd implementation on an m×n matrix A generated
+// according to mtype as in dgesvdTest.
+//
+// It first computes the full SVD  A = U*Sigma*Vᵀ  and checks that
+//   - U has orthonormal columns, and Vᵀ has orthonormal rows,
+//   - U*Sigma*Vᵀ multiply back to A,
+//   - the singular values are non-negative and sorted in decreasing order.
+//
+// Then the SVD is computed for the remaining values of jobz and the results
+// are checked whether they match the full SVD result.
+func dgesddTest(t *testing.T, impl Dgesdder, m, n, mtype int, tol float64) {
+	const tolOrtho = 1e-15
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	lda := n + 3
+	ldu := m + 5
+	ldvt := n + 7
+
+	minmn := min(m, n)
+	maxmn := max(m, n)
+
+	a := make([]float64, m*lda)
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	var aNorm float64
+	switch mtype {
+	default:
+		panic("unknown test matrix type")
+	case 1:
+		// Zero matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+		}
+	case 2:
+		// Identity matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+			if i < n {
+				a[i*lda+i] = 1
+			}
+		}
+		aNorm = 1
+	case 3, 4, 5:
+		// Random matrix with singular values spread linearly between
+		// aNorm/cond and aNorm.
+		s := make([]float64, minmn)
+		Dlatm1(s, 4, float64(max(1, minmn)), false, 1, rnd)
+		aNorm = 1
+		if mtype == 4 {
+			aNorm = smlnum
+		}
+		if mtype == 5 {
+			aNorm = bignum
+		}
+		floats.Scale(aNorm, s)
+		Dlagge(m, n, max(0, m-1), max(0, n-1), s, a, lda, rnd, make([]float64, m+n))
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	iwork := make([]int, 8*minmn)
+	for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+		prefix := fmt.Sprintf("m=%v,n=%v,work=%v,mtype=%v", m, n, wl, mtype)
+
+		// Use the workspace sufficient for jobz == lapack.SVDOverwrite in
+		// all calls.
+		minwork := 1
+		if minmn > 0 {
+			minwork = 4*minmn + minmn*minmn + maxmn*minmn + max(maxmn, 3*minmn*minmn+12*minmn)
+		}
+		var lwork int
+		switch wl {
+		case minimumWork:
+			lwork = minwork
+		case mediumWork, optimumWork:
+			work := make([]float64, 1)
+			impl.Dgesdd(lapack.SVDOverwrite, m, n, a, lda, nil, nil, max(1, m), nil, max(1, n), work, -1, nil)
+			lwork = int(work[0])
+			if wl == mediumWork {
+				lwork = (lwork + minwork) / 2
+			}
+		}
+		work := make([]float64, max(1, lwork))
+
+		// Compute the full SVD which will be used later for checking the
+		// partial results.
+		copy(a, aCopy)
+		uAll := make([]float64, m*ldu)
+		vtAll := make([]float64, n*ldvt)
+		sAll := make([]float64, minmn)
+		for i := range sAll {
+			sAll[i] = math.NaN()
+		}
+		ok := impl.Dgesdd(lapack.SVDAll, m, n, a, lda, sAll, uAll, ldu, vtAll, ldvt, work, len(work), iwork)
+		if !ok {
+			t.Fatalf("Case %v: unexpected failure in full SVD", prefix)
+		}
+		if resid := svdFullResidual(m, n, aNorm, aCopy, lda, uAll, ldu, sAll, vtAll, ldvt); resid > tol {
+			t.Errorf("Case %v: original matrix not recovered for full SVD, |A - U*D*VT|=%v", prefix, resid)
+		}
+		if minmn > 0 {
+			q := blas64.General{Rows: m, Cols: m, Data: uAll, Stride: ldu}
+			if resid := residualOrthogonal(q, false); resid > tolOrtho*float64(m) {
+				t.Errorf("Case %v: UAll is not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(m))
+			}
+			q = blas64.General{Rows: n, Cols: n, Data: vtAll, Stride: ldvt}
+			if resid := residualOrthogonal(q, true); resid > tolOrtho*float64(n) {
+				t.Errorf("Case %v: VTAll is not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(n))
+			}
+		}
+		if !sort.IsSorted(sort.Reverse(sort.Float64Slice(sAll))) {
+			t.Errorf("Case %v: singular values from full SVD are not decreasing", prefix)
+		}
+		if minmn > 0 && floats.Min(sAll) < 0 {
+			t.Errorf("Case %v: some singular values from full SVD are negative", prefix)
+		}
+
+		for _, jobz := range []lapack.SVDJob{lapack.SVDStore, lapack.SVDOverwrite, lapack.SVDNone} {
+			prefix := prefix + ",job=" + svdJobString(jobz)
+
+			copy(a, aCopy)
+			u := make([]float64, m*ldu)
+			for i := range u {
+				u[i] = rnd.NormFloat64()
+			}
+			vt := make([]float64, n*ldvt)
+			for i := range vt {
+				vt[i] = rnd.NormFloat64()
+			}
+			s := make([]float64, minmn)
+			for i := range s {
+				s[i] = math.NaN()
+			}
+			ok := impl.Dgesdd(jobz, m, n, a, lda, s, u, ldu, vt, ldvt, work, len(work), iwork)
+			if !ok {
+				t.Fatalf("Case %v: unexpected failure in partial Dgesdd", prefix)
+			}
+			if minmn == 0 {
+				continue
+			}
+
+			if !floats.EqualApprox(s, sAll, tol/10) {
+				t.Errorf("Case %v: singular values differ from full SVD\n%v\n%v", prefix, s, sAll)
+			}
+
+			// Determine where the computed singular vectors are stored
+			// and how many of them are available.
+			var ucols, vtrows int
+			switch jobz {
+			case lapack.SVDStore:
+				ucols, vtrows = minmn, minmn
+			case lapack.SVDOverwrite:
+				if m >= n {
+					// Copy the columns of U from A.
+					for i := 0; i < m; i++ {
+						copy(u[i*ldu:i*ldu+n], a[i*lda:i*lda+n])
+					}
+					ucols, vtrows = n, n
+				} else {
+					// Copy the rows of Vᵀ from A.
+					for i := 0; i < m; i++ {
+						copy(vt[i*ldvt:i*ldvt+n], a[i*lda:i*lda+n])
+					}
+					ucols, vtrows = m, m
+				}
+			}
+			if ucols > 0 {
+				q := blas64.General{Rows: m, Cols: ucols, Data: u, Stride: ldu}
+				if resid := residualOrthogonal(q, false); resid > tolOrtho*float64(m) {
+					t.Errorf("Case %v: columns of U are not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(m))
+				}
+				if res := svdPartialUResidual(m, ucols, u, uAll, ldu); res > tol {
+					t.Errorf("Case %v: columns of U do not match UAll", prefix)
+				}
+			}
+			if vtrows > 0 {
+				q := blas64.General{Rows: vtrows, Cols: n, Data: vt, Stride: ldvt}
+				if resid := residualOrthogonal(q, true); resid > tolOrtho*float64(n) {
+					t.Errorf("Case %v: rows of VT are not orthogonal; resid=%v, want<=%v", prefix, resid, tolOrtho*float64(n))
+				}
+				if res := svdPartialVTResidual(vtrows, n, vt, vtAll, ldvt); res > tol {
+					t.Errorf("Case %v: rows of VT do not match VTAll", prefix)
+				}
+			}
+		}
+	}
+}
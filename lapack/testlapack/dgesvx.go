@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgesvxer interface {
+	Dgetrier
+	Dgesvx(fact lapack.Fact, trans blas.Transpose, n, nrhs int, a []float64, lda int, af []float64, ldaf int, ipiv []int, equed lapack.Equilibration, r, c, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (eq lapack.Equilibration, rcond, rpvgrw float64, ok bool)
+}
+
+func DgesvxTest(t *testing.T, impl Dgesvxer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, fact := range []lapack.Fact{lapack.FactCompute, lapack.FactEquilibrate} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, n := range []int{0, 1, 2, 3, 5, 10, 25, 50} {
+				for _, nrhs := range []int{0, 1, 3} {
+					for _, ld := range []int{0, 5} {
+						for _, scaled := range []bool{false, true} {
+							dgesvxTest(t, impl, rnd, fact, trans, n, nrhs, ld, scaled)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgesvxTest(t *testing.T, impl Dgesvxer, rnd *rand.Rand, fact lapack.Fact, trans blas.Transpose, n, nrhs, ld int, scaled bool) {
+	const ratioThresh = 10
+
+	name := fmt.Sprintf("fact=%c,trans=%c,n=%v,nrhs=%v,ld=%v,scaled=%v", fact, trans, n, nrhs, ld, scaled)
+
+	lda := max(1, n+ld)
+	ldb := max(1, nrhs+ld)
+
+	// Generate a well-conditioned matrix and optionally scale its rows
+	// and columns badly.
+	a := randomConditionedGeneral(n, lda, 10, rnd)
+	cond := 1e3
+	if scaled {
+		scaleGeneralBadly(a, rnd)
+		cond = math.Inf(1)
+	}
+	xTrue, b := randomLinearSystem(trans, n, nrhs, ldb, a, rnd)
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+
+	af := nanGeneral(n, n, lda)
+	ipiv := make([]int, n)
+	r := nanSlice(n)
+	c := nanSlice(n)
+	x := nanGeneral(n, nrhs, ldb)
+	ferr := nanSlice(nrhs)
+	berr := nanSlice(nrhs)
+	work := nanSlice(4 * n)
+	iwork := make([]int, n)
+	equed, rcond, rpvgrw, ok := impl.Dgesvx(fact, trans, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, ipiv, lapack.EquilibrateNone,
+		r, c, b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if fact == lapack.FactCompute && equed != lapack.EquilibrateNone {
+		t.Errorf("%v: unexpected equilibration %c", name, equed)
+	}
+	if fact == lapack.FactEquilibrate && scaled && n > 1 && equed == lapack.EquilibrateNone {
+		t.Errorf("%v: badly scaled matrix not equilibrated", name)
+	}
+
+	// Check that A and B have been scaled as reported by equed.
+	rowequ := equed == lapack.EquilibrateRow || equed == lapack.EquilibrateBoth
+	colequ := equed == lapack.EquilibrateCol || equed == lapack.EquilibrateBoth
+	want := cloneGeneral(aCopy)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			switch equed {
+			case lapack.EquilibrateRow:
+				want.Data[i*want.Stride+j] *= r[i]
+			case lapack.EquilibrateCol:
+				want.Data[i*want.Stride+j] *= c[j]
+			case lapack.EquilibrateBoth:
+				want.Data[i*want.Stride+j] *= r[i] * c[j]
+			}
+		}
+	}
+	if !sameGeneral(a, want) {
+		t.Errorf("%v: unexpected equilibrated matrix A", name)
+	}
+	want = cloneGeneral(bCopy)
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			if trans == blas.NoTrans && rowequ {
+				want.Data[i*want.Stride+j] *= r[i]
+			} else if trans != blas.NoTrans && colequ {
+				want.Data[i*want.Stride+j] *= c[i]
+			}
+		}
+	}
+	if !sameGeneral(b, want) {
+		t.Errorf("%v: unexpected scaled matrix B", name)
+	}
+
+	if n > 0 {
+		// Compare the condition estimate with the reciprocal condition
+		// number of the equilibrated matrix computed from its inverse.
+		norm := lapack.MaxColumnSum
+		if trans != blas.NoTrans {
+			norm = lapack.MaxRowSum
+		}
+		ainv := cloneGeneral(af)
+		impl.Dgetri(n, ainv.Data, ainv.Stride, ipiv, make([]float64, n), n)
+		rcondWant := 1 / dlange(norm, n, n, a.Data, a.Stride) / dlange(norm, n, n, ainv.Data, ainv.Stride)
+		if rcond < rcondWant/(1+1e-10) || rcond > ratioThresh*rcondWant {
+			t.Errorf("%v: unexpected rcond; got %v, want %v", name, rcond, rcondWant)
+		}
+		if rpvgrw <= 0 || rpvgrw > 1 {
+			t.Errorf("%v: unexpected reciprocal pivot growth %v", name, rpvgrw)
+		}
+	}
+	checkErrorBounds(t, name, trans, n, nrhs, aCopy, bCopy, x, xTrue, ferr, berr, cond)
+
+	// Solve the system again using the factorization and the scaling
+	// computed above.
+	b2 := cloneGeneral(bCopy)
+	x2 := nanGeneral(n, nrhs, ldb)
+	equed2, rcond2, _, ok := impl.Dgesvx(lapack.FactProvided, trans, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, ipiv, equed,
+		r, c, b2.Data, b2.Stride, x2.Data, x2.Stride, ferr, berr, work, iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure with provided factorization", name)
+		return
+	}
+	if equed2 != equed || rcond2 != rcond {
+		t.Errorf("%v: unexpected result with provided factorization", name)
+	}
+	if !sameGeneral(b2, b) || !sameGeneral(x2, x) {
+		t.Errorf("%v: unexpected solution with provided factorization", name)
+	}
+
+	// Check that an exactly singular matrix is detected.
+	if n > 0 {
+		copyGeneral(a, aCopy)
+		for i := 0; i < n; i++ {
+			a.Data[i*a.Stride+n/2] = 0
+		}
+		_, rcond, _, ok := impl.Dgesvx(fact, trans, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, ipiv, lapack.EquilibrateNone,
+			r, c, b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+		if ok || rcond != 0 {
+			t.Errorf("%v: singular matrix not detected", name)
+		}
+	}
+}
+
+// scaleGeneralBadly scales the rows and columns of a by random powers of ten.
+func scaleGeneralBadly(a blas64.General, rnd *rand.Rand) {
+	for i := 0; i < a.Rows; i++ {
+		s := math.Pow(10, float64(rnd.IntN(17)-8))
+		for j := 0; j < a.Cols; j++ {
+			a.Data[i*a.Stride+j] *= s
+		}
+	}
+	for j := 0; j < a.Cols; j++ {
+		s := math.Pow(10, float64(rnd.IntN(17)-8))
+		for i := 0; i < a.Rows; i++ {
+			a.Data[i*a.Stride+j] *= s
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+func DgetrfBenchmark(b *testing.B, impl Dgetrfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{100, 500, 1000, 2000} {
+		a := make([]float64, n*n)
+		for i := range a {
+			a[i] = rnd.NormFloat64()
+		}
+		aCopy := make([]float64, len(a))
+		ipiv := make([]int, n)
+		b.Run(fmt.Sprintf("N=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(aCopy, a)
+				b.StartTimer()
+				impl.Dgetrf(n, n, aCopy, n, ipiv)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggever interface {
+	Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool)
+}
+
+func DggevTest(t *testing.T, impl Dggever) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 25, 50} {
+		for _, extra := range []int{0, 3} {
+			for _, wl := range []worklen{minimumWork, optimumWork} {
+				for _, kind := range []string{"random", "identity", "singular"} {
+					a := randomGeneral(n, n, n+extra, rnd)
+					var b blas64.General
+					switch kind {
+					case "random":
+						b = randomGeneral(n, n, n+extra, rnd)
+					case "identity":
+						b = eye(n, n+extra)
+					case "singular":
+						// B with a zero row and a zero column has
+						// at least one infinite eigenvalue.
+						b = randomGeneral(n, n, n+extra, rnd)
+						if n > 0 {
+							for j := 0; j < n; j++ {
+								b.Data[(n/2)*b.Stride+j] = 0
+							}
+							for i := 0; i < n; i++ {
+								b.Data[i*b.Stride+n/3] = 0
+							}
+						}
+					}
+					testDggev(t, impl, kind, a, b, extra, wl)
+				}
+			}
+		}
+	}
+}
+
+func testDggev(t *testing.T, impl Dggever, kind string, a, b blas64.General, extra int, wl worklen) {
+	const tol = 1e-12
+
+	n := a.Rows
+	name := fmt.Sprintf("kind=%v,n=%v,extra=%v,work=%v", kind, n, extra, wl)
+
+	// Compute only the eigenvalues first.
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	alpharWant := make([]float64, n)
+	alphaiWant := make([]float64, n)
+	betaWant := make([]float64, n)
+	work := make([]float64, max(1, 8*n))
+	ok := impl.Dggev(lapack.LeftEVNone, lapack.RightEVNone, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride,
+		alpharWant, alphaiWant, betaWant, nil, 1, nil, 1, work, len(work))
+	if !ok {
+		t.Errorf("%v: unexpected failure computing eigenvalues only", name)
+		return
+	}
+
+	vl := nanGeneral(n, n, n+extra)
+	vr := nanGeneral(n, n, n+extra)
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+
+	aCopy = cloneGeneral(a)
+	bCopy = cloneGeneral(b)
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, 8*n)
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dggev(lapack.LeftEVCompute, lapack.RightEVCompute, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride,
+			alphar, alphai, beta, vl.Data, vl.Stride, vr.Data, vr.Stride, work, -1)
+		lwork = int(work[0])
+	}
+	work = make([]float64, lwork)
+	ok = impl.Dggev(lapack.LeftEVCompute, lapack.RightEVCompute, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride,
+		alphar, alphai, beta, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, len(work))
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !generalOutsideAllNaN(vl) {
+		t.Errorf("%v: out-of-range write to VL", name)
+	}
+	if !generalOutsideAllNaN(vr) {
+		t.Errorf("%v: out-of-range write to VR", name)
+	}
+
+	for j := 0; j < n; j++ {
+		if beta[j] < 0 {
+			t.Errorf("%v: beta[%v] is negative", name, j)
+		}
+		if alphai[j] > 0 && (j == n-1 || alphai[j+1] >= 0 ||
+			chordalDistance(alphar[j], alphai[j], beta[j], alphar[j+1], -alphai[j+1], beta[j+1]) > tol) {
+			t.Errorf("%v: eigenvalue %v is not part of a complex conjugate pair", name, j)
+		}
+	}
+	if kind == "singular" {
+		var infinite bool
+		for j := range beta {
+			if beta[j] <= tol*math.Hypot(alphar[j], alphai[j]) {
+				infinite = true
+				break
+			}
+		}
+		if !infinite {
+			t.Errorf("%v: no infinite eigenvalue found for singular B", name)
+		}
+	}
+
+	// Check that the eigenvalues computed with and without eigenvectors
+	// agree. Compare the chordal distance between them to avoid problems
+	// with infinite eigenvalues.
+	for j := 0; j < n; j++ {
+		var found bool
+		for k := 0; k < n; k++ {
+			if chordalDistance(alphar[j], alphai[j], beta[j], alpharWant[k], alphaiWant[k], betaWant[k]) < 1e-8 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%v: eigenvalue %v not found when computing eigenvalues only", name, j)
+		}
+	}
+
+	if resid := residualGeneralizedRightEV(a, b, vr, alphar, alphai, beta); resid > tol {
+		t.Errorf("%v: unexpected residual of right eigenvectors; got %v, want <= %v", name, resid, tol)
+	}
+	if resid := residualGeneralizedLeftEV(a, b, vl, alphar, alphai, beta); resid > tol {
+		t.Errorf("%v: unexpected residual of left eigenvectors; got %v, want <= %v", name, resid, tol)
+	}
+	if resid := residualEVNormalization(vr, alphai); resid > tol {
+		t.Errorf("%v: right eigenvectors not normalized; resid=%v", name, resid)
+	}
+	if resid := residualEVNormalization(vl, alphai); resid > tol {
+		t.Errorf("%v: left eigenvectors not normalized; resid=%v", name, resid)
+	}
+}
+
+// chordalDistance returns the chordal distance between the generalized
+// eigenvalues (ar1+i*ai1)/b1 and (ar2+i*ai2)/b2.
+func chordalDistance(ar1, ai1, b1, ar2, ai2, b2 float64) float64 {
+	a1 := complex(ar1, ai1)
+	a2 := complex(ar2, ai2)
+	n1 := math.Hypot(cmplx.Abs(a1), b1)
+	n2 := math.Hypot(cmplx.Abs(a2), b2)
+	if n1 == 0 || n2 == 0 {
+		return 0
+	}
+	return cmplx.Abs(a1*complex(b2, 0)-a2*complex(b1, 0)) / (n1 * n2)
+}
+
+// residualGeneralizedRightEV returns the maximum over all eigenvalues of the
+// residual
+//
+//	|β_j*A*v_j - α_j*B*v_j|_1 / ((|β_j|*|A|_1 + |α_j|*|B|_1) * |v_j|_1)
+//
+// where the columns of E contain the right eigenvectors v_j of the matrix pair
+// (A,B) stored as returned by Dggev.
+func residualGeneralizedRightEV(a, b, e blas64.General, alphar, alphai, beta []float64) float64 {
+	return residualGeneralizedEV(false, a, b, e, alphar, alphai, beta)
+}
+
+// residualGeneralizedLeftEV returns the maximum over all eigenvalues of the
+// residual
+//
+//	|β_j*u_jᴴ*A - α_j*u_jᴴ*B|_1 / ((|β_j|*|A|_1 + |α_j|*|B|_1) * |u_j|_1)
+//
+// where the columns of E contain the left eigenvectors u_j of the matrix pair
+// (A,B) stored as returned by Dggev.
+func residualGeneralizedLeftEV(a, b, e blas64.General, alphar, alphai, beta []float64) float64 {
+	return residualGeneralizedEV(true, a, b, e, alphar, alphai, beta)
+}
+
+// residualGeneralizedEV returns the maximum eigenvector residual of the matrix
+// pair (A,B) where the n×m matrix E holds the right or left eigenvectors
+// corresponding to the m eigenvalues (alphar[j]+i*alphai[j])/beta[j].
+func residualGeneralizedEV(left bool, a, b, e blas64.General, alphar, alphai, beta []float64) float64 {
+	n := a.Rows
+	if n == 0 {
+		return 0
+	}
+	at := func(m blas64.General, i, j int) float64 {
+		if left {
+			return m.Data[j*m.Stride+i]
+		}
+		return m.Data[i*m.Stride+j]
+	}
+	anorm := math.Max(dlange(lapack.MaxColumnSum, n, n, a.Data, a.Stride), safmin)
+	bnorm := math.Max(dlange(lapack.MaxColumnSum, n, n, b.Data, b.Stride), safmin)
+	if left {
+		anorm = math.Max(dlange(lapack.MaxRowSum, n, n, a.Data, a.Stride), safmin)
+		bnorm = math.Max(dlange(lapack.MaxRowSum, n, n, b.Data, b.Stride), safmin)
+	}
+
+	v := make([]complex128, n)
+	var resid float64
+	for j := 0; j < e.Cols; j++ {
+		alpha := complex(alphar[j], alphai[j])
+		switch {
+		case alphai[j] == 0:
+			for i := range v {
+				v[i] = complex(e.Data[i*e.Stride+j], 0)
+			}
+		case alphai[j] > 0:
+			for i := range v {
+				v[i] = complex(e.Data[i*e.Stride+j], e.Data[i*e.Stride+j+1])
+			}
+		default:
+			// The second eigenvalue of a complex conjugate pair has the
+			// same residual as the first one.
+			continue
+		}
+		if left {
+			// u_jᴴ*A = λ_j*u_jᴴ*B is equivalent to Aᵀ*u_j = conj(λ_j)*Bᵀ*u_j
+			// for real A and B.
+			alpha = cmplx.Conj(alpha)
+		}
+		var vnorm float64
+		for _, vi := range v {
+			vnorm += cmplx.Abs(vi)
+		}
+		var rnorm float64
+		for i := 0; i < n; i++ {
+			var av, bv complex128
+			for k := 0; k < n; k++ {
+				av += complex(at(a, i, k), 0) * v[k]
+				bv += complex(at(b, i, k), 0) * v[k]
+			}
+			rnorm += cmplx.Abs(complex(beta[j], 0)*av - alpha*bv)
+		}
+		denom := (math.Abs(beta[j])*anorm + cmplx.Abs(alpha)*bnorm) * math.Max(vnorm, safmin)
+		if denom == 0 {
+			continue
+		}
+		resid = math.Max(resid, rnorm/denom)
+	}
+	return resid
+}
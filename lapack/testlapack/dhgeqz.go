@@ -0,0 +1,177 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dhgeqzer interface {
+	Dgghrder
+	Dhgeqz(job lapack.SchurJob, compq, compz lapack.OrthoComp, n, ilo, ihi int, h []float64, ldh int, t []float64, ldt int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int, work []float64, lwork int) (unconverged int)
+}
+
+func DhgeqzTest(t *testing.T, impl Dhgeqzer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 50} {
+		for _, extra := range []int{0, 3} {
+			for _, singular := range []bool{false, true} {
+				testDhgeqz(t, impl, rnd, n, extra, singular)
+			}
+		}
+	}
+}
+
+func testDhgeqz(t *testing.T, impl Dhgeqzer, rnd *rand.Rand, n, extra int, singular bool) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("n=%v,extra=%v,singular=%v", n, extra, singular)
+	ld := n + extra
+
+	// Reduce a random pair (A,B) with B upper triangular to generalized
+	// Hessenberg form (H,T).
+	a := randomGeneral(n, n, ld, rnd)
+	b := randomGeneral(n, n, ld, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			b.Data[i*b.Stride+j] = 0
+		}
+	}
+	if singular && n > 0 {
+		// Zero diagonal elements of B lead to infinite eigenvalues.
+		b.Data[0] = 0
+		b.Data[(n/2)*b.Stride+n/2] = 0
+	}
+	h := cloneGeneral(a)
+	tt := cloneGeneral(b)
+	q := nanGeneral(n, n, ld)
+	z := nanGeneral(n, n, ld)
+	impl.Dgghrd(lapack.OrthoExplicit, lapack.OrthoExplicit, n, 0, n-1, h.Data, max(1, h.Stride), tt.Data, max(1, tt.Stride), q.Data, max(1, q.Stride), z.Data, max(1, z.Stride))
+
+	// Compute the eigenvalues only.
+	alpharWant := make([]float64, n)
+	alphaiWant := make([]float64, n)
+	betaWant := make([]float64, n)
+	hCopy := cloneGeneral(h)
+	tCopy := cloneGeneral(tt)
+	work := make([]float64, max(1, n))
+	unconverged := impl.Dhgeqz(lapack.EigenvaluesOnly, lapack.OrthoNone, lapack.OrthoNone, n, 0, n-1,
+		hCopy.Data, max(1, hCopy.Stride), tCopy.Data, max(1, tCopy.Stride), alpharWant, alphaiWant, betaWant,
+		nil, 1, nil, 1, work, len(work))
+	if unconverged != 0 {
+		t.Errorf("%v: EigenvaluesOnly did not converge", name)
+		return
+	}
+
+	// Compute the generalized Schur form.
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+	s := cloneGeneral(h)
+	p := cloneGeneral(tt)
+	qGot := cloneGeneral(q)
+	zGot := cloneGeneral(z)
+	unconverged = impl.Dhgeqz(lapack.EigenvaluesAndSchur, lapack.OrthoPostmul, lapack.OrthoPostmul, n, 0, n-1,
+		s.Data, max(1, s.Stride), p.Data, max(1, p.Stride), alphar, alphai, beta,
+		qGot.Data, max(1, qGot.Stride), zGot.Data, max(1, zGot.Stride), work, len(work))
+	if unconverged != 0 {
+		t.Errorf("%v: EigenvaluesAndSchur did not converge", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !generalOutsideAllNaN(s) {
+		t.Errorf("%v: out-of-range write to S", name)
+	}
+	if !isUpperTriangular(p) {
+		t.Errorf("%v: P is not upper triangular", name)
+	}
+	// Check the structure of S and of the corresponding blocks of P.
+	for j := 0; j < n; {
+		for i := j + 2; i < n; i++ {
+			if s.Data[i*s.Stride+j] != 0 {
+				t.Errorf("%v: S is not quasi-triangular", name)
+			}
+		}
+		if j == n-1 || s.Data[(j+1)*s.Stride+j] == 0 {
+			if alphai[j] != 0 {
+				t.Errorf("%v: unexpected complex eigenvalue %v for a 1×1 block", name, j)
+			}
+			if alphar[j] != s.Data[j*s.Stride+j] || beta[j] != p.Data[j*p.Stride+j] {
+				t.Errorf("%v: eigenvalue %v does not match diagonal of S and P", name, j)
+			}
+			j++
+			continue
+		}
+		if alphai[j] <= 0 || alphai[j+1] >= 0 {
+			t.Errorf("%v: unexpected real eigenvalues %v and %v for a 2×2 block", name, j, j+1)
+		}
+		if p.Data[j*p.Stride+j+1] != 0 || p.Data[j*p.Stride+j] <= 0 || p.Data[(j+1)*p.Stride+j+1] <= 0 {
+			t.Errorf("%v: 2×2 block of P at %v is not positive diagonal", name, j)
+		}
+		j += 2
+	}
+	for j := range beta {
+		if beta[j] < 0 {
+			t.Errorf("%v: beta[%v] is negative", name, j)
+		}
+	}
+	if singular {
+		var infinite bool
+		for j := range beta {
+			if beta[j] == 0 {
+				infinite = true
+				break
+			}
+		}
+		if !infinite {
+			t.Errorf("%v: no infinite eigenvalue found", name)
+		}
+	}
+
+	// Check that the eigenvalues computed with and without the Schur form
+	// agree.
+	for j := 0; j < n; j++ {
+		var found bool
+		for k := 0; k < n; k++ {
+			if chordalDistance(alphar[j], alphai[j], beta[j], alpharWant[k], alphaiWant[k], betaWant[k]) < 1e-8 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%v: eigenvalue %v not found when computing eigenvalues only", name, j)
+		}
+	}
+
+	if resid := residualOrthogonal(qGot, false); resid > tol*float64(n) {
+		t.Errorf("%v: Q is not orthogonal; resid=%v", name, resid)
+	}
+	if resid := residualOrthogonal(zGot, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z is not orthogonal; resid=%v", name, resid)
+	}
+
+	// Check that A = Q*S*Zᵀ and B = Q*P*Zᵀ.
+	aux := zeros(n, n, n)
+	got := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, qGot, s, 0, aux)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, aux, zGot, 0, got)
+	if !equalApproxGeneral(got, a, tol*float64(n)) {
+		t.Errorf("%v: A != Q*S*Zᵀ", name)
+	}
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, qGot, p, 0, aux)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, aux, zGot, 0, got)
+	if !equalApproxGeneral(got, b, tol*float64(n)) {
+		t.Errorf("%v: B != Q*P*Zᵀ", name)
+	}
+}
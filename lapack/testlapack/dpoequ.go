@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dpoequer interface {
+	Dpoequ(n int, a []float64, lda int, s []float64) (scond, amax float64, ok bool)
+	Dlaqsy(uplo blas.Uplo, n int, a []float64, lda int, s []float64, scond, amax float64) lapack.Equilibration
+}
+
+func DpoequTest(t *testing.T, impl Dpoequer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 10, 33} {
+			for _, lda := range []int{max(1, n), n + 3} {
+				for _, scaled := range []bool{false, true} {
+					dpoequTest(t, impl, rnd, uplo, n, lda, scaled)
+				}
+			}
+		}
+	}
+}
+
+func dpoequTest(t *testing.T, impl Dpoequer, rnd *rand.Rand, uplo blas.Uplo, n, lda int, scaled bool) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("uplo=%c,n=%v,lda=%v,scaled=%v", uplo, n, lda, scaled)
+
+	a := randomPositiveDefinite(n, lda, rnd)
+	if scaled {
+		// Apply a symmetric diagonal scaling with a wide range.
+		d := make([]float64, n)
+		for i := range d {
+			d[i] = math.Pow(10, float64(rnd.IntN(13)-6))
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.Data[i*lda+j] *= d[i] * d[j]
+			}
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	s := nanSlice(n)
+	scond, amax, ok := impl.Dpoequ(n, a.Data, lda, s)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if !equalGeneral(a, aCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if n == 0 {
+		if scond != 1 || amax != 0 {
+			t.Errorf("%v: unexpected result for empty matrix: scond=%v,amax=%v", name, scond, amax)
+		}
+		return
+	}
+
+	var wantAmax float64
+	for i := 0; i < n; i++ {
+		wantAmax = math.Max(wantAmax, a.Data[i*lda+i])
+		if want := 1 / math.Sqrt(a.Data[i*lda+i]); !scalar.EqualWithinRel(s[i], want, tol) {
+			t.Errorf("%v: unexpected s[%v]; got %v, want %v", name, i, s[i], want)
+		}
+	}
+	if amax != wantAmax {
+		t.Errorf("%v: unexpected amax; got %v, want %v", name, amax, wantAmax)
+	}
+	if want := floats.Min(s) / floats.Max(s); !scalar.EqualWithinRel(scond, want, tol) {
+		t.Errorf("%v: unexpected scond; got %v, want %v", name, scond, want)
+	}
+
+	equed := impl.Dlaqsy(uplo, n, a.Data, lda, s, scond, amax)
+	want := lapack.EquilibrateNone
+	if scond < 0.1 {
+		want = lapack.EquilibrateSym
+	}
+	if equed != want {
+		t.Errorf("%v: unexpected equilibration; got %c, want %c", name, equed, want)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aij := aCopy.Data[i*lda+j]
+			inTriangle := (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i)
+			if inTriangle && equed == lapack.EquilibrateSym {
+				aij *= s[i] * s[j]
+			}
+			if a.Data[i*lda+j] != aij {
+				t.Errorf("%v: unexpected element of equilibrated matrix at (%v,%v)", name, i, j)
+				return
+			}
+			if inTriangle && i == j && equed == lapack.EquilibrateSym && math.Abs(aij-1) > tol {
+				t.Errorf("%v: diagonal element %v of equilibrated matrix is not one: %v", name, i, aij)
+			}
+		}
+	}
+
+	// Check that a non-positive diagonal element is detected.
+	copyGeneral(a, aCopy)
+	a.Data[(n-1)*lda+n-1] = 0
+	if _, _, ok := impl.Dpoequ(n, a.Data, lda, s); ok {
+		t.Errorf("%v: non-positive diagonal element not detected", name)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dporfser interface {
+	Dpotrfer
+	Dpotrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int)
+	Dporfs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int)
+}
+
+func DporfsTest(t *testing.T, impl Dporfser) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 25, 50} {
+			for _, nrhs := range []int{0, 1, 3} {
+				for _, ld := range []int{0, 5} {
+					for _, cond := range []float64{10, 1e6} {
+						for _, perturb := range []bool{false, true} {
+							dporfsTest(t, impl, rnd, uplo, n, nrhs, ld, cond, perturb)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dporfsTest(t *testing.T, impl Dporfser, rnd *rand.Rand, uplo blas.Uplo, n, nrhs, ld int, cond float64, perturb bool) {
+	name := fmt.Sprintf("uplo=%c,n=%v,nrhs=%v,ld=%v,cond=%v,perturb=%v", uplo, n, nrhs, ld, cond, perturb)
+
+	lda := max(1, n+ld)
+	ldb := max(1, nrhs+ld)
+
+	a := randomConditionedPosDef(n, lda, cond, rnd)
+	xTrue, b := randomLinearSystem(blas.NoTrans, n, nrhs, ldb, a, rnd)
+	// Only the triangle specified by uplo is referenced.
+	aTri := cloneGeneral(a)
+	nanOutsideTriangle(uplo, aTri)
+	aTriCopy := cloneGeneral(aTri)
+	bCopy := cloneGeneral(b)
+
+	// Compute the Cholesky factorization of A and the solution X.
+	af := cloneGeneral(aTri)
+	if !impl.Dpotrf(uplo, n, af.Data, af.Stride) {
+		t.Fatalf("%v: unexpected failure in Dpotrf", name)
+	}
+	afCopy := cloneGeneral(af)
+	x := cloneGeneral(b)
+	impl.Dpotrs(uplo, n, nrhs, af.Data, af.Stride, x.Data, x.Stride)
+	if perturb {
+		for i := 0; i < n; i++ {
+			for j := 0; j < nrhs; j++ {
+				x.Data[i*x.Stride+j] *= 1 + 1e-6*rnd.NormFloat64()
+			}
+		}
+	}
+
+	ferr := nanSlice(nrhs)
+	berr := nanSlice(nrhs)
+	work := nanSlice(3 * n)
+	iwork := make([]int, n)
+	impl.Dporfs(uplo, n, nrhs, aTri.Data, aTri.Stride, af.Data, af.Stride, b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+
+	if !sameGeneral(aTri, aTriCopy) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !sameGeneral(af, afCopy) {
+		t.Errorf("%v: unexpected modification of AF", name)
+	}
+	if !equalGeneral(b, bCopy) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	checkErrorBounds(t, name, blas.NoTrans, n, nrhs, a, b, x, xTrue, ferr, berr, cond)
+}
+
+// randomConditionedPosDef returns a random n×n symmetric positive definite
+// matrix with eigenvalues spread between 1 and 1/cond.
+func randomConditionedPosDef(n, lda int, cond float64, rnd *rand.Rand) blas64.General {
+	a := nanGeneral(n, n, lda)
+	if n == 0 {
+		return a
+	}
+	d := make([]float64, n)
+	Dlatm1(d, 3, cond, false, 1, rnd)
+	Dlagsy(n, 0, d, a.Data, a.Stride, rnd, make([]float64, 2*n))
+	return a
+}
+
+// nanOutsideTriangle sets the elements of the n×n matrix a outside the
+// triangle specified by uplo to NaN.
+func nanOutsideTriangle(uplo blas.Uplo, a blas64.General) {
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+				a.Data[i*a.Stride+j] = math.NaN()
+			}
+		}
+	}
+}
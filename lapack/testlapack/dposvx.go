@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dposvxer interface {
+	Dpotri(uplo blas.Uplo, n int, a []float64, lda int) bool
+	Dposvx(fact lapack.Fact, uplo blas.Uplo, n, nrhs int, a []float64, lda int, af []float64, ldaf int, equed lapack.Equilibration, s, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) (eq lapack.Equilibration, rcond float64, ok bool)
+}
+
+func DposvxTest(t *testing.T, impl Dposvxer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, fact := range []lapack.Fact{lapack.FactCompute, lapack.FactEquilibrate} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, n := range []int{0, 1, 2, 3, 5, 10, 25, 50} {
+				for _, nrhs := range []int{0, 1, 3} {
+					for _, ld := range []int{0, 5} {
+						for _, scaled := range []bool{false, true} {
+							dposvxTest(t, impl, rnd, fact, uplo, n, nrhs, ld, scaled)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dposvxTest(t *testing.T, impl Dposvxer, rnd *rand.Rand, fact lapack.Fact, uplo blas.Uplo, n, nrhs, ld int, scaled bool) {
+	const ratioThresh = 10
+
+	name := fmt.Sprintf("fact=%c,uplo=%c,n=%v,nrhs=%v,ld=%v,scaled=%v", fact, uplo, n, nrhs, ld, scaled)
+
+	lda := max(1, n+ld)
+	ldb := max(1, nrhs+ld)
+
+	// Generate a well-conditioned symmetric positive definite matrix and
+	// optionally apply a bad symmetric scaling.
+	aFull := randomConditionedPosDef(n, lda, 10, rnd)
+	cond := 1e3
+	if scaled {
+		for i := 0; i < n; i++ {
+			s := math.Pow(10, float64(rnd.IntN(13)-6))
+			for j := 0; j < n; j++ {
+				aFull.Data[i*lda+j] *= s
+				aFull.Data[j*lda+i] *= s
+			}
+		}
+		cond = math.Inf(1)
+	}
+	xTrue, b := randomLinearSystem(blas.NoTrans, n, nrhs, ldb, aFull, rnd)
+	a := cloneGeneral(aFull)
+	nanOutsideTriangle(uplo, a)
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+
+	af := nanGeneral(n, n, lda)
+	s := nanSlice(n)
+	x := nanGeneral(n, nrhs, ldb)
+	ferr := nanSlice(nrhs)
+	berr := nanSlice(nrhs)
+	work := nanSlice(3 * n)
+	iwork := make([]int, n)
+	equed, rcond, ok := impl.Dposvx(fact, uplo, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, lapack.EquilibrateNone,
+		s, b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if fact == lapack.FactCompute && equed != lapack.EquilibrateNone {
+		t.Errorf("%v: unexpected equilibration %c", name, equed)
+	}
+	if fact == lapack.FactEquilibrate && n > 0 {
+		scond := floats.Min(s) / floats.Max(s)
+		if (scond < 0.1) != (equed == lapack.EquilibrateSym) {
+			t.Errorf("%v: unexpected equilibration %c for scond=%v", name, equed, scond)
+		}
+	}
+
+	// Check that A and B have been scaled as reported by equed.
+	want := cloneGeneral(aCopy)
+	wantB := cloneGeneral(bCopy)
+	if equed == lapack.EquilibrateSym {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				want.Data[i*want.Stride+j] *= s[i] * s[j]
+			}
+			for j := 0; j < nrhs; j++ {
+				wantB.Data[i*wantB.Stride+j] *= s[i]
+			}
+		}
+	}
+	if !sameGeneral(a, want) {
+		t.Errorf("%v: unexpected equilibrated matrix A", name)
+	}
+	if !sameGeneral(b, wantB) {
+		t.Errorf("%v: unexpected scaled matrix B", name)
+	}
+
+	if n > 0 {
+		// Compare the condition estimate with the reciprocal condition
+		// number of the equilibrated matrix computed from its inverse.
+		ainv := cloneGeneral(af)
+		impl.Dpotri(uplo, n, ainv.Data, ainv.Stride)
+		rcondWant := 1 / dlansy(lapack.MaxColumnSum, uplo, n, a.Data, a.Stride) / dlansy(lapack.MaxColumnSum, uplo, n, ainv.Data, ainv.Stride)
+		if rcond < rcondWant/(1+1e-10) || rcond > ratioThresh*rcondWant {
+			t.Errorf("%v: unexpected rcond; got %v, want %v", name, rcond, rcondWant)
+		}
+	}
+	checkErrorBounds(t, name, blas.NoTrans, n, nrhs, aFull, bCopy, x, xTrue, ferr, berr, cond)
+
+	// Solve the system again using the factorization and the scaling
+	// computed above.
+	b2 := cloneGeneral(bCopy)
+	x2 := nanGeneral(n, nrhs, ldb)
+	equed2, rcond2, ok := impl.Dposvx(lapack.FactProvided, uplo, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, equed,
+		s, b2.Data, b2.Stride, x2.Data, x2.Stride, ferr, berr, work, iwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure with provided factorization", name)
+		return
+	}
+	if equed2 != equed || rcond2 != rcond {
+		t.Errorf("%v: unexpected result with provided factorization", name)
+	}
+	if !sameGeneral(b2, b) || !sameGeneral(x2, x) {
+		t.Errorf("%v: unexpected solution with provided factorization", name)
+	}
+
+	// Check that a matrix that is not positive definite is detected.
+	if n > 0 {
+		copyGeneral(a, aCopy)
+		a.Data[(n-1)*a.Stride+n-1] = -1
+		_, rcond, ok := impl.Dposvx(fact, uplo, n, nrhs, a.Data, a.Stride, af.Data, af.Stride, lapack.EquilibrateNone,
+			s, b.Data, b.Stride, x.Data, x.Stride, ferr, berr, work, iwork)
+		if ok || rcond != 0 {
+			t.Errorf("%v: matrix that is not positive definite not detected", name)
+		}
+	}
+}
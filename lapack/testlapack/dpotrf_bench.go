@@ -0,0 +1,37 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func DpotrfBenchmark(b *testing.B, impl Dpotrfer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{100, 500, 1000, 2000} {
+		// Construct a well-conditioned positive definite matrix.
+		d := make([]float64, n)
+		Dlatm1(d, 4, 100, false, 1, rnd)
+		a := make([]float64, n*n)
+		Dlagsy(n, 0, d, a, n, rnd, make([]float64, 2*n))
+		aCopy := make([]float64, len(a))
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			b.Run(fmt.Sprintf("%vN=%d", uploToString(uplo), n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					copy(aCopy, a)
+					b.StartTimer()
+					if !impl.Dpotrf(uplo, n, aCopy, n) {
+						b.Fatal("unexpected failure")
+					}
+				}
+			})
+		}
+	}
+}
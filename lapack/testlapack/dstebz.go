@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstebzer interface {
+	Dstebz(rng lapack.EVRange, n int, vl, vu float64, il, iu int, abstol float64, d, e, w []float64, iblock, isplit []int, work []float64) (m, nsplit int)
+	Dsterfer
+}
+
+func DstebzTest(t *testing.T, impl Dstebzer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 10, 33, 100} {
+		for _, kind := range tridiagKinds {
+			d, e := randomTridiagKind(kind, n, rnd)
+
+			// Compute the reference eigenvalues.
+			all := make([]float64, n)
+			copy(all, d)
+			impl.Dsterf(n, all, append([]float64(nil), e...))
+			tol := 1e-12 * math.Max(1, dlanst(lapack.MaxAbs, n, d, e))
+
+			type rangeTest struct {
+				rng    lapack.EVRange
+				vl, vu float64
+				il, iu int
+				want   []float64
+			}
+			tests := []rangeTest{{rng: lapack.EVRangeAll, want: all}}
+			if n > 0 {
+				il := rnd.IntN(n)
+				iu := il + rnd.IntN(n-il)
+				tests = append(tests,
+					rangeTest{rng: lapack.EVRangeIndex, il: il, iu: iu, want: all[il : iu+1]},
+					rangeTest{rng: lapack.EVRangeIndex, il: 0, iu: n - 1, want: all},
+				)
+				// Choose the interval end points between well separated
+				// eigenvalues.
+				lo, hi := -1, -1
+				for i := 0; i < n-1; i++ {
+					if all[i+1]-all[i] > 1e-6 {
+						if lo < 0 {
+							lo = i
+						}
+						hi = i
+					}
+				}
+				if lo >= 0 {
+					vl := (all[lo] + all[lo+1]) / 2
+					vu := (all[hi] + all[hi+1]) / 2
+					if vl < vu {
+						tests = append(tests, rangeTest{rng: lapack.EVRangeValue, vl: vl, vu: vu, want: all[lo+1 : hi+1]})
+					}
+				}
+				tests = append(tests, rangeTest{rng: lapack.EVRangeValue, vl: all[n-1] + 1, vu: all[n-1] + 2})
+			}
+
+			for _, test := range tests {
+				prefix := fmt.Sprintf("n=%v,kind=%v,rng=%c,vl=%v,vu=%v,il=%v,iu=%v", n, kind, test.rng, test.vl, test.vu, test.il, test.iu)
+				w := nanSlice(n)
+				iblock := make([]int, n)
+				isplit := make([]int, n)
+				work := nanSlice(max(1, n-1))
+				m, nsplit := impl.Dstebz(test.rng, n, test.vl, test.vu, test.il, test.iu, 0, d, e, w, iblock, isplit, work)
+				if m != len(test.want) {
+					t.Errorf("%v: unexpected number of eigenvalues: got %v, want %v", prefix, m, len(test.want))
+					continue
+				}
+				for i := 0; i < m; i++ {
+					if math.Abs(w[i]-test.want[i]) > tol {
+						t.Errorf("%v: unexpected eigenvalue %v: got %v, want %v", prefix, i, w[i], test.want[i])
+						break
+					}
+				}
+				if n == 0 {
+					continue
+				}
+				if isplit[nsplit-1] != n-1 {
+					t.Errorf("%v: last block does not end at n-1", prefix)
+				}
+				for i := 0; i < m; i++ {
+					if iblock[i] < 0 || iblock[i] >= nsplit {
+						t.Errorf("%v: block index out of range", prefix)
+						break
+					}
+				}
+			}
+		}
+	}
+}
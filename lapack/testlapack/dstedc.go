@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstedcer interface {
+	Dstedc(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+	Dsterfer
+}
+
+// tridiagKinds are the kinds of symmetric tridiagonal test matrices generated
+// by randomTridiagKind.
+var tridiagKinds = []string{"random", "split", "clustered", "wilkinson", "glued"}
+
+func DstedcTest(t *testing.T, impl Dstedcer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, compz := range []lapack.EVComp{lapack.EVCompNone, lapack.EVTridiag, lapack.EVOrig} {
+		for _, n := range []int{0, 1, 2, 5, 25, 26, 40, 51, 100, 160} {
+			for _, ldz := range []int{n, n + 5} {
+				ldz = max(1, ldz)
+				for _, kind := range tridiagKinds {
+					testDstedc(t, impl, rnd, compz, kind, n, ldz)
+				}
+			}
+		}
+	}
+}
+
+func testDstedc(t *testing.T, impl Dstedcer, rnd *rand.Rand, compz lapack.EVComp, kind string, n, ldz int) {
+	const tol = 100
+
+	prefix := fmt.Sprintf("compz=%c,kind=%v,n=%v,ldz=%v", compz, kind, n, ldz)
+
+	d, e := randomTridiagKind(kind, n, rnd)
+	dCopy := make([]float64, len(d))
+	copy(dCopy, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+
+	// Compute the reference eigenvalues.
+	want := make([]float64, n)
+	copy(want, d)
+	impl.Dsterf(n, want, append([]float64(nil), e...))
+
+	var q, z blas64.General
+	if compz == lapack.EVOrig {
+		// Use a random orthogonal matrix as the matrix of the reduction
+		// to tridiagonal form.
+		q = randomOrthogonal(n, rnd)
+		z = nanGeneral(n, n, ldz)
+		copyGeneral(z, q)
+	} else {
+		z = nanGeneral(n, n, ldz)
+	}
+
+	work := []float64{0}
+	iwork := []int{0}
+	impl.Dstedc(compz, n, d, e, z.Data, z.Stride, work, -1, iwork, -1)
+	lwork := int(work[0])
+	liwork := iwork[0]
+	work = make([]float64, max(1, lwork))
+	iwork = make([]int, max(1, liwork))
+	ok := impl.Dstedc(compz, n, d, e, z.Data, z.Stride, work, lwork, iwork, liwork)
+	if !ok {
+		t.Errorf("%v: Dstedc failed", prefix)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !floats.EqualApprox(d, want, 1e-12*math.Max(1, dlanst(lapack.MaxAbs, n, dCopy, eCopy))) {
+		t.Errorf("%v: eigenvalues do not match Dsterf", prefix)
+	}
+	for i := 1; i < n; i++ {
+		if d[i] < d[i-1] {
+			t.Errorf("%v: eigenvalues not in ascending order", prefix)
+			break
+		}
+	}
+
+	if compz == lapack.EVCompNone {
+		return
+	}
+
+	if resid := residualOrthogonal(z, false); resid > tol*float64(n)*dlamchE {
+		t.Errorf("%v: Z is not orthogonal; resid=%v", prefix, resid)
+	}
+
+	// Transform the eigenvectors back to those of the tridiagonal matrix.
+	if compz == lapack.EVOrig {
+		tz := zeros(n, n, n)
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, q, z, 0, tz)
+		z = tz
+	}
+	if resid := residualTridiagEigen(n, dCopy, eCopy, d, z.Data, z.Stride, n); resid > tol*float64(n)*dlamchE {
+		t.Errorf("%v: unexpected residual |T*Z - Z*Λ|/(n*|T|)=%v", prefix, resid)
+	}
+}
+
+// randomTridiagKind returns the diagonal and off-diagonal of a random
+// symmetric tridiagonal matrix of the given kind.
+func randomTridiagKind(kind string, n int, rnd *rand.Rand) (d, e []float64) {
+	d = make([]float64, n)
+	e = make([]float64, max(0, n-1))
+	switch kind {
+	default:
+		panic("bad kind")
+	case "random":
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+		for i := range e {
+			e[i] = rnd.NormFloat64()
+		}
+	case "split":
+		// Random matrix with some zero off-diagonal elements.
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+		for i := range e {
+			if rnd.IntN(7) != 0 {
+				e[i] = rnd.NormFloat64()
+			}
+		}
+	case "clustered":
+		// A perturbation of a multiple of the identity which leads to
+		// clusters of close eigenvalues and much deflation.
+		for i := range d {
+			d[i] = 1 + 1e-10*rnd.NormFloat64()
+		}
+		for i := range e {
+			e[i] = 1e-8 * rnd.NormFloat64()
+		}
+	case "wilkinson":
+		// The Wilkinson matrix W+ has pairs of very close eigenvalues.
+		for i := range d {
+			d[i] = math.Abs(float64(i - (n-1)/2))
+		}
+		for i := range e {
+			e[i] = 1
+		}
+	case "glued":
+		// Copies of a small random matrix glued by tiny off-diagonal
+		// elements.
+		const size = 7
+		var db [size]float64
+		var eb [size]float64
+		for i := range db {
+			db[i] = rnd.NormFloat64()
+			eb[i] = rnd.NormFloat64()
+		}
+		for i := range d {
+			d[i] = db[i%size]
+		}
+		for i := range e {
+			if i%size == size-1 {
+				e[i] = 1e-12
+			} else {
+				e[i] = eb[i%size]
+			}
+		}
+	}
+	return d, e
+}
+
+// residualTridiagEigen returns |T*Z - Z*Λ|_1 / (n * |T|_1) where T is the n×n
+// symmetric tridiagonal matrix with diagonal d and off-diagonal e, Λ is the
+// diagonal matrix of the m eigenvalues in w and Z is the n×m matrix of the
+// corresponding eigenvectors.
+func residualTridiagEigen(n int, d, e, w []float64, z []float64, ldz int, m int) float64 {
+	if n == 0 || m == 0 {
+		return 0
+	}
+	r := zeros(n, m, m)
+	dstmm(n, m, d, e, z, ldz, r.Data, r.Stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			r.Data[i*r.Stride+j] -= z[i*ldz+j] * w[j]
+		}
+	}
+	tnorm := dlanst(lapack.MaxColumnSum, n, d, e)
+	rnorm := dlange(lapack.MaxColumnSum, n, m, r.Data, r.Stride)
+	if tnorm == 0 {
+		return rnorm
+	}
+	return rnorm / (float64(n) * tnorm)
+}
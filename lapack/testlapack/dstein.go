@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsteiner interface {
+	Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int, z []float64, ldz int, work []float64, iwork []int) (ok bool)
+	Dstebzer
+}
+
+func DsteinTest(t *testing.T, impl Dsteiner) {
+	const tol = 100
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 10, 33, 100} {
+		for _, kind := range tridiagKinds {
+			for _, extra := range []int{0, 3} {
+				d, e := randomTridiagKind(kind, n, rnd)
+
+				// Compute a random subset of the eigenvalues.
+				il, iu := 0, n-1
+				if n > 1 {
+					il = rnd.IntN(n)
+					iu = il + rnd.IntN(n-il)
+				}
+				w := make([]float64, n)
+				iblock := make([]int, n)
+				isplit := make([]int, n)
+				work := make([]float64, max(1, 5*n))
+				m, _ := impl.Dstebz(lapack.EVRangeIndex, n, 0, 0, il, iu, 0, d, e, w, iblock, isplit, work)
+
+				prefix := fmt.Sprintf("n=%v,kind=%v,m=%v,extra=%v", n, kind, m, extra)
+
+				ldz := max(1, m+extra)
+				z := nanGeneral(n, m, ldz)
+				iwork := make([]int, n)
+				ok := impl.Dstein(n, d, e, m, w, iblock, isplit, z.Data, ldz, work, iwork)
+				if !ok {
+					t.Errorf("%v: Dstein did not converge", prefix)
+				}
+				if n == 0 || m == 0 {
+					continue
+				}
+
+				if resid := residualOrthogonal(z, false); resid > tol*float64(n)*dlamchE {
+					t.Errorf("%v: Z is not orthogonal; resid=%v", prefix, resid)
+				}
+				if resid := residualTridiagEigen(n, d, e, w, z.Data, ldz, m); resid > tol*float64(n)*dlamchE {
+					t.Errorf("%v: unexpected residual |T*Z - Z*Λ|/(n*|T|)=%v", prefix, resid)
+				}
+			}
+		}
+	}
+}
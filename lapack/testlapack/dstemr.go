@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstemrer interface {
+	Dstemr(jobz lapack.EVJob, rng lapack.EVRange, n int, d, e []float64, vl, vu float64, il, iu int, w, z []float64, ldz int, isuppz []int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
+	Dsterfer
+}
+
+func DstemrTest(t *testing.T, impl Dstemrer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+		for _, n := range []int{0, 1, 2, 5, 10, 33, 100, 200} {
+			for _, kind := range tridiagKinds {
+				for _, extra := range []int{0, 3} {
+					testDstemr(t, impl, rnd, jobz, kind, n, extra)
+				}
+			}
+		}
+	}
+}
+
+func testDstemr(t *testing.T, impl Dstemrer, rnd *rand.Rand, jobz lapack.EVJob, kind string, n, extra int) {
+	const tol = 100
+
+	d, e := randomTridiagKind(kind, n, rnd)
+
+	// Compute the reference eigenvalues.
+	all := make([]float64, n)
+	copy(all, d)
+	impl.Dsterf(n, all, append([]float64(nil), e...))
+	eigTol := 1e-12 * math.Max(1, dlanst(lapack.MaxAbs, n, d, e))
+
+	type rangeTest struct {
+		rng    lapack.EVRange
+		vl, vu float64
+		il, iu int
+		want   []float64
+	}
+	tests := []rangeTest{{rng: lapack.EVRangeAll, want: all}}
+	if n > 0 {
+		il := rnd.IntN(n)
+		iu := il + rnd.IntN(n-il)
+		tests = append(tests, rangeTest{rng: lapack.EVRangeIndex, il: il, iu: iu, want: all[il : iu+1]})
+		// Choose the interval end points between well separated
+		// eigenvalues.
+		lo, hi := -1, -1
+		for i := 0; i < n-1; i++ {
+			if all[i+1]-all[i] > 1e-6 {
+				if lo < 0 {
+					lo = i
+				}
+				hi = i
+			}
+		}
+		if lo >= 0 {
+			vl := (all[lo] + all[lo+1]) / 2
+			vu := (all[hi] + all[hi+1]) / 2
+			if vl < vu {
+				tests = append(tests, rangeTest{rng: lapack.EVRangeValue, vl: vl, vu: vu, want: all[lo+1 : hi+1]})
+			}
+		}
+	}
+
+	for _, test := range tests {
+		prefix := fmt.Sprintf("jobz=%c,kind=%v,n=%v,extra=%v,rng=%c,vl=%v,vu=%v,il=%v,iu=%v", jobz, kind, n, extra, test.rng, test.vl, test.vu, test.il, test.iu)
+
+		mmax := n
+		if test.rng == lapack.EVRangeIndex {
+			mmax = test.iu - test.il + 1
+		}
+		ldz := max(1, mmax+extra)
+		z := nanGeneral(n, mmax, ldz)
+		isuppz := make([]int, 2*mmax)
+		w := nanSlice(n)
+
+		work := []float64{0}
+		iwork := []int{0}
+		impl.Dstemr(jobz, test.rng, n, nil, nil, test.vl, test.vu, test.il, test.iu, nil, nil, ldz, nil, work, -1, iwork, -1)
+		work = nanSlice(int(work[0]))
+		iwork = make([]int, iwork[0])
+
+		dCopy := append([]float64(nil), d...)
+		eCopy := append([]float64(nil), e...)
+		m, ok := impl.Dstemr(jobz, test.rng, n, dCopy, eCopy, test.vl, test.vu, test.il, test.iu, w, z.Data, ldz, isuppz, work, len(work), iwork, len(iwork))
+		if !ok {
+			t.Errorf("%v: Dstemr failed", prefix)
+			continue
+		}
+		if m != len(test.want) {
+			t.Errorf("%v: unexpected number of eigenvalues: got %v, want %v", prefix, m, len(test.want))
+			continue
+		}
+		for i := 0; i < m; i++ {
+			if math.Abs(w[i]-test.want[i]) > eigTol {
+				t.Errorf("%v: unexpected eigenvalue %v: got %v, want %v", prefix, i, w[i], test.want[i])
+				break
+			}
+		}
+		if jobz == lapack.EVNone || m == 0 {
+			continue
+		}
+
+		zm := z
+		zm.Cols = m
+		if resid := residualOrthogonal(zm, false); resid > tol*float64(n)*dlamchE {
+			t.Errorf("%v: Z is not orthogonal; resid=%v", prefix, resid)
+		}
+		if resid := residualTridiagEigen(n, d, e, w, z.Data, ldz, m); resid > tol*float64(n)*dlamchE {
+			t.Errorf("%v: unexpected residual |T*Z - Z*Λ|/(n*|T|)=%v", prefix, resid)
+		}
+	support:
+		for j := 0; j < m; j++ {
+			for i := 0; i < n; i++ {
+				if (i < isuppz[2*j] || isuppz[2*j+1] < i) && z.Data[i*ldz+j] != 0 {
+					t.Errorf("%v: nonzero element of eigenvector %v outside of its support", prefix, j)
+					break support
+				}
+			}
+		}
+	}
+}
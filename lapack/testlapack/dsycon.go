@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyconer interface {
+	Dsytrser
+	Dgeconer
+	Dlansy(norm lapack.MatrixNorm, uplo blas.Uplo, n int, a []float64, lda int, work []float64) float64
+	Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+}
+
+func DsyconTest(t *testing.T, impl Dsyconer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31} {
+			for _, lda := range []int{n, n + 5} {
+				lda = max(1, lda)
+				for _, kind := range []string{"indefinite", "zero diagonal", "singular"} {
+					if kind == "zero diagonal" && n == 1 {
+						continue
+					}
+					prefix := fmt.Sprintf("uplo=%v,n=%v,lda=%v,kind=%v", uploToString(uplo), n, lda, kind)
+					a := randomSymmetricKind(kind, n, lda, rnd)
+					aCopy := cloneGeneral(a)
+
+					work := make([]float64, max(1, 4*n))
+					iwork := make([]int, n)
+					anorm := impl.Dlansy(lapack.MaxColumnSum, uplo, n, a.Data, a.Stride, work)
+					ipiv := make([]int, n)
+					impl.Dsytrf(uplo, n, a.Data, a.Stride, ipiv, work, len(work))
+					got := impl.Dsycon(uplo, n, a.Data, a.Stride, ipiv, anorm, work, iwork)
+
+					if n == 0 {
+						if got != 1 {
+							t.Errorf("%v: unexpected rcond for empty matrix: got %v, want 1", prefix, got)
+						}
+						continue
+					}
+					if kind == "singular" {
+						if got != 0 {
+							t.Errorf("%v: unexpected rcond for singular matrix: got %v, want 0", prefix, got)
+						}
+						continue
+					}
+
+					// Compare with the estimate from the LU factorization
+					// of the full matrix.
+					ipivLU := make([]int, n)
+					impl.Dgetrf(n, n, aCopy.Data, aCopy.Stride, ipivLU)
+					want := impl.Dgecon(lapack.MaxColumnSum, n, aCopy.Data, aCopy.Stride, anorm, work, iwork)
+					// Both are estimates, so only require the same
+					// order of magnitude.
+					if got > 10*want || got < want/10 {
+						t.Errorf("%v: Dsycon and Dgecon mismatch: Dsycon %v, Dgecon %v", prefix, got, want)
+					}
+				}
+			}
+		}
+	}
+}
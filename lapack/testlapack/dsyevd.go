@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevder interface {
+	Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+	Dsyever
+}
+
+func DsyevdTest(t *testing.T, impl Dsyevder) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Lower, blas.Upper} {
+		for _, n := range []int{0, 1, 2, 5, 10, 30, 64, 100} {
+			for _, lda := range []int{n, n + 5} {
+				lda = max(1, lda)
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					testDsyevd(t, impl, rnd, uplo, n, lda, wl)
+				}
+			}
+		}
+	}
+}
+
+func testDsyevd(t *testing.T, impl Dsyevder, rnd *rand.Rand, uplo blas.Uplo, n, lda int, wl worklen) {
+	const tol = 100
+
+	prefix := fmt.Sprintf("uplo=%v,n=%v,lda=%v,work=%v", uploToString(uplo), n, lda, wl)
+
+	a := randomSymmetricKind("indefinite", n, lda, rnd)
+	aCopy := cloneGeneral(a)
+
+	// Compute the reference eigenvalues with Dsyev.
+	want := make([]float64, n)
+	ref := cloneGeneral(a)
+	work := []float64{0}
+	impl.Dsyev(lapack.EVNone, uplo, n, ref.Data, ref.Stride, want, work, -1)
+	work = make([]float64, max(1, int(work[0])))
+	impl.Dsyev(lapack.EVNone, uplo, n, ref.Data, ref.Stride, want, work, len(work))
+
+	for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+		copyGeneral(a, aCopy)
+		w := nanSlice(n)
+
+		work := []float64{0}
+		iwork := []int{0}
+		impl.Dsyevd(jobz, uplo, n, a.Data, a.Stride, w, work, -1, iwork, -1)
+		lwork := int(work[0])
+		liwork := iwork[0]
+		if wl == minimumWork {
+			switch {
+			case n <= 1:
+				lwork = 1
+			case jobz == lapack.EVNone:
+				lwork = 2*n + 1
+			default:
+				lwork = 3*n*n + 8*n
+			}
+		}
+		work = make([]float64, lwork)
+		iwork = make([]int, max(1, liwork))
+		ok := impl.Dsyevd(jobz, uplo, n, a.Data, a.Stride, w, work, lwork, iwork, liwork)
+		if !ok {
+			t.Errorf("%v,jobz=%c: Dsyevd failed", prefix, jobz)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+
+		if !floats.EqualApprox(w, want, 1e-12*math.Max(1, dlange(lapack.MaxAbs, n, n, aCopy.Data, aCopy.Stride))) {
+			t.Errorf("%v,jobz=%c: eigenvalues do not match Dsyev", prefix, jobz)
+		}
+		if jobz == lapack.EVNone {
+			continue
+		}
+
+		z := a
+		if resid := residualOrthogonal(z, false); resid > tol*float64(n)*dlamchE {
+			t.Errorf("%v: Z is not orthogonal; resid=%v", prefix, resid)
+		}
+		if resid := residualSymmetricEigen(aCopy, w, z, n); resid > tol*float64(n)*dlamchE {
+			t.Errorf("%v: unexpected residual |A*Z - Z*Λ|/(n*|A|)=%v", prefix, resid)
+		}
+	}
+}
+
+// residualSymmetricEigen returns |A*Z - Z*Λ|_1 / (n * |A|_1) where A is an
+// n×n symmetric matrix stored in both triangles of a, Λ is the diagonal matrix
+// of the m eigenvalues in w and Z is the n×m matrix of the corresponding
+// eigenvectors.
+func residualSymmetricEigen(a blas64.General, w []float64, z blas64.General, m int) float64 {
+	n := a.Rows
+	if n == 0 || m == 0 {
+		return 0
+	}
+	r := zeros(n, m, m)
+	zm := z
+	zm.Cols = m
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, zm, 0, r)
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			r.Data[i*r.Stride+j] -= z.Data[i*z.Stride+j] * w[j]
+		}
+	}
+	anorm := dlange(lapack.MaxColumnSum, n, n, a.Data, a.Stride)
+	rnorm := dlange(lapack.MaxColumnSum, n, m, r.Data, r.Stride)
+	if anorm == 0 {
+		return rnorm
+	}
+	return rnorm / (float64(n) * anorm)
+}
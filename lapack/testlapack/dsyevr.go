@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevrer interface {
+	Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
+	Dsyever
+}
+
+func DsyevrTest(t *testing.T, impl Dsyevrer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Lower, blas.Upper} {
+		for _, n := range []int{0, 1, 2, 5, 10, 30, 64, 100} {
+			for _, ld := range []int{0, 5} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					testDsyevr(t, impl, rnd, uplo, n, ld, wl)
+				}
+			}
+		}
+	}
+}
+
+func testDsyevr(t *testing.T, impl Dsyevrer, rnd *rand.Rand, uplo blas.Uplo, n, ld int, wl worklen) {
+	const tol = 100
+
+	lda := max(1, n+ld)
+	a := randomSymmetricKind("indefinite", n, lda, rnd)
+	aCopy := cloneGeneral(a)
+
+	// Compute the reference eigenvalues with Dsyev.
+	all := make([]float64, n)
+	ref := cloneGeneral(a)
+	work := []float64{0}
+	impl.Dsyev(lapack.EVNone, uplo, n, ref.Data, ref.Stride, all, work, -1)
+	work = make([]float64, max(1, int(work[0])))
+	impl.Dsyev(lapack.EVNone, uplo, n, ref.Data, ref.Stride, all, work, len(work))
+	eigTol := 1e-12 * math.Max(1, dlange(lapack.MaxAbs, n, n, aCopy.Data, aCopy.Stride))
+
+	type rangeTest struct {
+		rng    lapack.EVRange
+		vl, vu float64
+		il, iu int
+		want   []float64
+	}
+	tests := []rangeTest{{rng: lapack.EVRangeAll, want: all}}
+	if n > 0 {
+		il := rnd.IntN(n)
+		iu := il + rnd.IntN(n-il)
+		tests = append(tests,
+			rangeTest{rng: lapack.EVRangeIndex, il: il, iu: iu, want: all[il : iu+1]},
+			rangeTest{rng: lapack.EVRangeIndex, il: 0, iu: n - 1, want: all},
+			rangeTest{rng: lapack.EVRangeValue, vl: all[n-1] + 1, vu: all[n-1] + 2},
+		)
+		if n > 1 {
+			lo := rnd.IntN(n - 1)
+			hi := lo + rnd.IntN(n-1-lo)
+			vl := (all[lo] + all[lo+1]) / 2
+			vu := (all[hi] + all[hi+1]) / 2
+			if vl < vu {
+				tests = append(tests, rangeTest{rng: lapack.EVRangeValue, vl: vl, vu: vu, want: all[lo+1 : hi+1]})
+			}
+		}
+	}
+
+	for _, test := range tests {
+		for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+			prefix := fmt.Sprintf("uplo=%v,n=%v,lda=%v,work=%v,jobz=%c,rng=%c,vl=%v,vu=%v,il=%v,iu=%v",
+				uploToString(uplo), n, lda, wl, jobz, test.rng, test.vl, test.vu, test.il, test.iu)
+
+			mmax := n
+			if test.rng == lapack.EVRangeIndex {
+				mmax = test.iu - test.il + 1
+			}
+			ldz := max(1, mmax+ld)
+			z := nanGeneral(n, mmax, ldz)
+
+			copyGeneral(a, aCopy)
+			w := nanSlice(n)
+			work := []float64{0}
+			iwork := []int{0}
+			impl.Dsyevr(jobz, test.rng, uplo, n, a.Data, a.Stride, test.vl, test.vu, test.il, test.iu, 0, w, z.Data, ldz, work, -1, iwork, -1)
+			lwork := int(work[0])
+			liwork := iwork[0]
+			if wl == minimumWork {
+				lwork = max(1, 8*n)
+				if jobz == lapack.EVCompute {
+					lwork = max(1, 24*n)
+				}
+			}
+			work = make([]float64, lwork)
+			iwork = make([]int, max(1, liwork))
+			m, ok := impl.Dsyevr(jobz, test.rng, uplo, n, a.Data, a.Stride, test.vl, test.vu, test.il, test.iu, 0, w, z.Data, ldz, work, lwork, iwork, liwork)
+			if !ok {
+				t.Errorf("%v: Dsyevr failed", prefix)
+				continue
+			}
+			if m != len(test.want) {
+				t.Errorf("%v: unexpected number of eigenvalues: got %v, want %v", prefix, m, len(test.want))
+				continue
+			}
+			for i := 0; i < m; i++ {
+				if math.Abs(w[i]-test.want[i]) > eigTol {
+					t.Errorf("%v: unexpected eigenvalue %v: got %v, want %v", prefix, i, w[i], test.want[i])
+					break
+				}
+			}
+			if jobz == lapack.EVNone || m == 0 {
+				continue
+			}
+
+			zm := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z.Data}
+			if resid := residualOrthogonal(zm, false); resid > tol*float64(n)*dlamchE {
+				t.Errorf("%v: Z is not orthogonal; resid=%v", prefix, resid)
+			}
+			if resid := residualSymmetricEigen(aCopy, w, zm, m); resid > tol*float64(n)*dlamchE {
+				t.Errorf("%v: unexpected residual |A*Z - Z*Λ|/(n*|A|)=%v", prefix, resid)
+			}
+		}
+	}
+}
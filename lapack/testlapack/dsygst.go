@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsygster interface {
+	Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool)
+	Dsygst(itype int, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int)
+}
+
+func DsygstTest(t *testing.T, impl Dsygster) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, itype := range []int{1, 2, 3} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, n := range []int{0, 1, 2, 3, 5, 10, 65, 150} {
+				for _, ld := range []int{max(1, n), n + 4} {
+					testDsygst(t, impl, rnd, itype, uplo, n, ld)
+				}
+			}
+		}
+	}
+}
+
+func testDsygst(t *testing.T, impl Dsygster, rnd *rand.Rand, itype int, uplo blas.Uplo, n, ld int) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("itype=%v,uplo=%v,n=%v,ld=%v", itype, uploToString(uplo), n, ld)
+
+	a := randomSymmetricKind("", n, ld, rnd)
+	b := randomPositiveDefinite(n, ld, rnd)
+	if !impl.Dpotrf(uplo, n, b.Data, max(1, b.Stride)) {
+		t.Fatalf("%v: unexpected Cholesky failure", name)
+	}
+	c := cloneGeneral(a)
+	impl.Dsygst(itype, uplo, n, c.Data, max(1, c.Stride), b.Data, max(1, b.Stride))
+	if n == 0 {
+		return
+	}
+
+	// Extract the triangular factor F of B such that B = Fᵀ*F.
+	f := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if uplo == blas.Upper && j >= i {
+				f.Data[i*f.Stride+j] = b.Data[i*b.Stride+j]
+			}
+			if uplo == blas.Lower && j <= i {
+				// F = Lᵀ
+				f.Data[j*f.Stride+i] = b.Data[i*b.Stride+j]
+			}
+		}
+	}
+	// Make C a full symmetric matrix.
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if uplo == blas.Upper {
+				c.Data[j*c.Stride+i] = c.Data[i*c.Stride+j]
+			} else {
+				c.Data[i*c.Stride+j] = c.Data[j*c.Stride+i]
+			}
+		}
+	}
+
+	aux := zeros(n, n, n)
+	got := zeros(n, n, n)
+	var want blas64.General
+	if itype == 1 {
+		// A = Fᵀ*C*F.
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, f, c, 0, aux)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, aux, f, 0, got)
+		want = a
+	} else {
+		// C = F*A*Fᵀ.
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, f, a, 0, aux)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, aux, f, 0, got)
+		want = c
+	}
+	anorm := dlange(lapack.MaxAbs, n, n, want.Data, want.Stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aux.Data[i*aux.Stride+j] = got.Data[i*got.Stride+j] - want.Data[i*want.Stride+j]
+		}
+	}
+	resid := dlange(lapack.MaxAbs, n, n, aux.Data, aux.Stride) / (anorm * float64(n))
+	if resid > tol {
+		t.Errorf("%v: unexpected result; resid=%v, want<=%v", name, resid, tol)
+	}
+}
+
+// randomPositiveDefinite returns a random well-conditioned symmetric positive
+// definite n×n matrix.
+func randomPositiveDefinite(n, lda int, rnd *rand.Rand) blas64.General {
+	x := randomGeneral(n, n, max(1, n), rnd)
+	a := nanGeneral(n, n, lda)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] = 0
+		}
+		a.Data[i*a.Stride+i] = float64(n)
+	}
+	if n > 0 {
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, x, x, 1, a)
+	}
+	return a
+}
@@ -0,0 +1,155 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsygver interface {
+	Dsygv(itype int, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool)
+}
+
+func DsygvTest(t *testing.T, impl Dsygver) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, itype := range []int{1, 2, 3} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, n := range []int{0, 1, 2, 3, 5, 10, 70} {
+				for _, ld := range []int{max(1, n), n + 4} {
+					for _, wl := range []worklen{minimumWork, optimumWork} {
+						testDsygv(t, impl, rnd, itype, uplo, n, ld, wl)
+					}
+				}
+			}
+		}
+	}
+
+	// Dsygv must fail if B is not positive definite.
+	n := 5
+	a := randomSymmetricKind("", n, n, rnd)
+	b := randomSymmetricKind("", n, n, rnd)
+	for i := 0; i < n; i++ {
+		b.Data[i*b.Stride+i] = -1
+	}
+	w := make([]float64, n)
+	work := make([]float64, 3*n-1)
+	if impl.Dsygv(1, lapack.EVCompute, blas.Upper, n, a.Data, a.Stride, b.Data, b.Stride, w, work, len(work)) {
+		t.Errorf("unexpected success for B not positive definite")
+	}
+}
+
+func testDsygv(t *testing.T, impl Dsygver, rnd *rand.Rand, itype int, uplo blas.Uplo, n, ld int, wl worklen) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("itype=%v,uplo=%v,n=%v,ld=%v,work=%v", itype, uploToString(uplo), n, ld, wl)
+
+	a := randomSymmetricKind("", n, ld, rnd)
+	b := randomPositiveDefinite(n, ld, rnd)
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, 3*n-1)
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dsygv(itype, lapack.EVCompute, uplo, n, nil, max(1, ld), nil, max(1, ld), nil, work, -1)
+		lwork = int(work[0])
+	}
+	work := make([]float64, lwork)
+
+	// Compute the eigenvalues only.
+	wWant := make([]float64, n)
+	z := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	ok := impl.Dsygv(itype, lapack.EVNone, uplo, n, z.Data, max(1, z.Stride), bCopy.Data, max(1, bCopy.Stride), wWant, work, len(work))
+	if !ok {
+		t.Errorf("%v: unexpected failure computing eigenvalues only", name)
+		return
+	}
+
+	w := make([]float64, n)
+	z = cloneGeneral(a)
+	bCopy = cloneGeneral(b)
+	ok = impl.Dsygv(itype, lapack.EVCompute, uplo, n, z.Data, max(1, z.Stride), bCopy.Data, max(1, bCopy.Stride), w, work, len(work))
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.Float64sAreSorted(w) {
+		t.Errorf("%v: eigenvalues are not sorted", name)
+	}
+	for i := range w {
+		if !scalar.EqualWithinAbsOrRel(w[i], wWant[i], tol, tol) {
+			t.Errorf("%v: eigenvalue %v mismatch with and without eigenvectors; got %v, want %v", name, i, w[i], wWant[i])
+		}
+	}
+
+	// Compute the residual of the eigenproblem
+	//  A*Z - B*Z*Λ  if itype == 1,
+	//  A*B*Z - Z*Λ  if itype == 2,
+	//  B*A*Z - Z*Λ  if itype == 3.
+	zw := cloneGeneral(z)
+	for j := 0; j < n; j++ {
+		blas64.Implementation().Dscal(n, w[j], zw.Data[j:], zw.Stride)
+	}
+	aux := zeros(n, n, n)
+	r := zeros(n, n, n)
+	switch itype {
+	case 1:
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, z, 0, r)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, b, zw, 1, r)
+	case 2:
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, b, z, 0, aux)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, aux, 0, r)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, eye(n, n), zw, 1, r)
+	case 3:
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, z, 0, aux)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, b, aux, 0, r)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, eye(n, n), zw, 1, r)
+	}
+	anorm := dlange(lapack.MaxAbs, n, n, a.Data, a.Stride)
+	bnorm := dlange(lapack.MaxAbs, n, n, b.Data, b.Stride)
+	znorm := dlange(lapack.MaxAbs, n, n, z.Data, z.Stride)
+	resid := dlange(lapack.MaxAbs, n, n, r.Data, r.Stride) / (anorm * bnorm * znorm * float64(n))
+	if resid > tol {
+		t.Errorf("%v: unexpected eigenproblem residual; resid=%v, want<=%v", name, resid, tol)
+	}
+
+	// Check the normalization of the eigenvectors
+	//  Zᵀ*B*Z = I       if itype == 1 or 2,
+	//  Zᵀ*inv(B)*Z = I  if itype == 3.
+	var m blas64.General
+	if itype == 3 {
+		// Solve B*X = Z using the Cholesky factor of B returned by Dsygv.
+		m = cloneGeneral(z)
+		tri := blas64.Triangular{Uplo: uplo, Diag: blas.NonUnit, N: n, Stride: bCopy.Stride, Data: bCopy.Data}
+		if uplo == blas.Upper {
+			blas64.Trsm(blas.Left, blas.Trans, 1, tri, m)
+			blas64.Trsm(blas.Left, blas.NoTrans, 1, tri, m)
+		} else {
+			blas64.Trsm(blas.Left, blas.NoTrans, 1, tri, m)
+			blas64.Trsm(blas.Left, blas.Trans, 1, tri, m)
+		}
+	} else {
+		m = zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, b, z, 0, m)
+	}
+	blas64.Gemm(blas.Trans, blas.NoTrans, 1, z, m, 0, aux)
+	if resid := distFromIdentity(n, aux.Data, aux.Stride); resid > tol*float64(n) {
+		t.Errorf("%v: eigenvectors not normalized; resid=%v", name, resid)
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsytf2er interface {
+	Dsytf2(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) (ok bool)
+}
+
+type Dsytrfer interface {
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool)
+}
+
+func Dsytf2Test(t *testing.T, impl Dsytf2er) {
+	testDsytrf(t, "Dsytf2", func(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) bool {
+		return impl.Dsytf2(uplo, n, a, lda, ipiv)
+	})
+}
+
+func DsytrfTest(t *testing.T, impl Dsytrfer) {
+	testDsytrf(t, "Dsytrf", func(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) bool {
+		work := []float64{0}
+		impl.Dsytrf(uplo, n, a, lda, ipiv, work, -1)
+		lwork := int(work[0])
+		work = make([]float64, lwork)
+		return impl.Dsytrf(uplo, n, a, lda, ipiv, work, lwork)
+	})
+}
+
+func testDsytrf(t *testing.T, name string, factorize func(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) bool) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50} {
+			for _, lda := range []int{n, n + 5} {
+				lda = max(1, lda)
+				for _, kind := range []string{"indefinite", "zero diagonal", "singular"} {
+					if kind == "zero diagonal" && n == 1 {
+						continue
+					}
+					a := randomSymmetricKind(kind, n, lda, rnd)
+					want := cloneGeneral(a)
+
+					ipiv := make([]int, n)
+					ok := factorize(uplo, n, a.Data, a.Stride, ipiv)
+
+					prefix := fmt.Sprintf("%s: uplo=%v,n=%v,lda=%v,kind=%v", name, uploToString(uplo), n, lda, kind)
+					if kind == "singular" && n > 0 {
+						if ok {
+							t.Errorf("%v: unexpected success for singular matrix", prefix)
+						}
+						continue
+					}
+					if !ok {
+						t.Errorf("%v: unexpected failure", prefix)
+						continue
+					}
+					if !checkSytrfPivots(ipiv) {
+						t.Errorf("%v: inconsistent ipiv %v", prefix, ipiv)
+						continue
+					}
+					got := reconstructLDLT(uplo, n, a.Data, a.Stride, ipiv)
+					resid := residualSymmetric(uplo, want, got)
+					if resid > tol*float64(max(1, n)) {
+						t.Errorf("%v: unexpected residual |A - U*D*Uᵀ|/|A| = %v", prefix, resid)
+					}
+				}
+			}
+		}
+	}
+}
+
+// randomSymmetricKind returns a random n×n symmetric matrix of the given
+// kind stored in both triangles.
+func randomSymmetricKind(kind string, n, lda int, rnd *rand.Rand) blas64.General {
+	a := nanGeneral(n, n, lda)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := rnd.NormFloat64()
+			a.Data[i*lda+j] = v
+			a.Data[j*lda+i] = v
+		}
+	}
+	switch kind {
+	case "zero diagonal":
+		// Force the use of 2×2 pivot blocks.
+		for i := 0; i < n; i++ {
+			a.Data[i*lda+i] = 0
+		}
+	case "singular":
+		// Make a row and column zero.
+		if n > 0 {
+			k := n / 2
+			for i := 0; i < n; i++ {
+				a.Data[i*lda+k] = 0
+				a.Data[k*lda+i] = 0
+			}
+		}
+	}
+	return a
+}
+
+// checkSytrfPivots returns whether the 2×2 blocks encoded in ipiv are
+// consistent with the convention used by Dsytrf.
+func checkSytrfPivots(ipiv []int) bool {
+	n := len(ipiv)
+	for k := 0; k < n; {
+		if ipiv[k] >= 0 {
+			if ipiv[k] >= n {
+				return false
+			}
+			k++
+			continue
+		}
+		if k == n-1 || ipiv[k+1] != ipiv[k] || -ipiv[k]-1 >= n {
+			return false
+		}
+		k += 2
+	}
+	return true
+}
+
+// sytrfBlocks returns the starting indices and sizes of the diagonal blocks
+// of D in the factorization computed by Dsytrf.
+func sytrfBlocks(ipiv []int) (start, size []int) {
+	for k := 0; k < len(ipiv); {
+		start = append(start, k)
+		if ipiv[k] >= 0 {
+			size = append(size, 1)
+			k++
+		} else {
+			size = append(size, 2)
+			k += 2
+		}
+	}
+	return start, size
+}
+
+// reconstructLDLT returns the n×n symmetric matrix U*D*Uᵀ or L*D*Lᵀ from the
+// factorization computed by Dsytrf.
+func reconstructLDLT(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) blas64.General {
+	bi := blas64.Implementation()
+	start, size := sytrfBlocks(ipiv)
+
+	// Form the block diagonal matrix D.
+	m := zeros(n, n, max(1, n))
+	for b, k := range start {
+		m.Data[k*m.Stride+k] = a[k*lda+k]
+		if size[b] == 2 {
+			m.Data[(k+1)*m.Stride+k+1] = a[(k+1)*lda+k+1]
+			var d float64
+			if uplo == blas.Upper {
+				d = a[k*lda+k+1]
+			} else {
+				d = a[(k+1)*lda+k]
+			}
+			m.Data[k*m.Stride+k+1] = d
+			m.Data[(k+1)*m.Stride+k] = d
+		}
+	}
+
+	// Apply the transformations P_k * U_k from the innermost outwards. For
+	// the upper factorization the innermost transformation corresponds to
+	// the first block, for the lower to the last.
+	order := make([]int, len(start))
+	for i := range order {
+		if uplo == blas.Upper {
+			order[i] = i
+		} else {
+			order[i] = len(start) - 1 - i
+		}
+	}
+	tmp := zeros(n, n, max(1, n))
+	for _, b := range order {
+		k := start[b]
+		s := size[b]
+
+		// Construct the unit triangular transformation.
+		u := eye(n, max(1, n))
+		var kp, kk int
+		if uplo == blas.Upper {
+			for j := k; j < k+s; j++ {
+				for i := 0; i < k; i++ {
+					u.Data[i*u.Stride+j] = a[i*lda+j]
+				}
+			}
+			kk = k
+		} else {
+			for j := k; j < k+s; j++ {
+				for i := k + s; i < n; i++ {
+					u.Data[i*u.Stride+j] = a[i*lda+j]
+				}
+			}
+			kk = k + s - 1
+		}
+		if s == 1 {
+			kp = ipiv[k]
+		} else {
+			kp = -ipiv[k] - 1
+		}
+
+		// M = U * M * Uᵀ.
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n, 1, u.Data, u.Stride, m.Data, m.Stride, 0, tmp.Data, tmp.Stride)
+		bi.Dgemm(blas.NoTrans, blas.Trans, n, n, n, 1, tmp.Data, tmp.Stride, u.Data, u.Stride, 0, m.Data, m.Stride)
+
+		// M = P * M * Pᵀ.
+		if kp != kk {
+			bi.Dswap(n, m.Data[kk*m.Stride:], 1, m.Data[kp*m.Stride:], 1)
+			bi.Dswap(n, m.Data[kk:], m.Stride, m.Data[kp:], m.Stride)
+		}
+	}
+	return m
+}
+
+// residualSymmetric returns |A - B|/|A| in the 1-norm, where only the
+// triangle of A specified by uplo is used and B is a full symmetric matrix.
+func residualSymmetric(uplo blas.Uplo, a, b blas64.General) float64 {
+	n := a.Rows
+	if n == 0 {
+		return 0
+	}
+	diff := zeros(n, n, n)
+	full := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			var v float64
+			if uplo == blas.Upper {
+				v = a.Data[i*a.Stride+j]
+			} else {
+				v = a.Data[j*a.Stride+i]
+			}
+			full.Data[i*n+j] = v
+			full.Data[j*n+i] = v
+			diff.Data[i*n+j] = v - b.Data[i*b.Stride+j]
+			diff.Data[j*n+i] = v - b.Data[j*b.Stride+i]
+		}
+	}
+	anorm := dlange(lapack.MaxColumnSum, n, n, full.Data, n)
+	dnorm := dlange(lapack.MaxColumnSum, n, n, diff.Data, n)
+	if anorm == 0 {
+		return dnorm
+	}
+	return dnorm / anorm
+}
@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsytrser interface {
+	Dsytrfer
+	Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+}
+
+func DsytrsTest(t *testing.T, impl Dsytrser) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	bi := blas64.Implementation()
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, ld := range []int{0, 5} {
+					for _, kind := range []string{"indefinite", "zero diagonal"} {
+						if kind == "zero diagonal" && n == 1 {
+							continue
+						}
+						lda := max(1, n+ld)
+						ldb := max(1, nrhs+ld)
+						a := randomSymmetricKind(kind, n, lda, rnd)
+						aCopy := cloneGeneral(a)
+
+						ipiv := make([]int, n)
+						work := make([]float64, 1)
+						ok := impl.Dsytrf(uplo, n, a.Data, a.Stride, ipiv, work, len(work))
+						if !ok {
+							t.Errorf("bad test: matrix is singular")
+							continue
+						}
+
+						b := randomGeneral(n, nrhs, ldb, rnd)
+						x := cloneGeneral(b)
+						impl.Dsytrs(uplo, n, nrhs, a.Data, a.Stride, ipiv, x.Data, x.Stride)
+
+						prefix := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,ld=%v,kind=%v", uploToString(uplo), n, nrhs, ld, kind)
+						if n == 0 || nrhs == 0 {
+							continue
+						}
+
+						// Compute the residual |B - A*X| / (|A|*|X|).
+						resid := cloneGeneral(b)
+						bi.Dgemm(blas.NoTrans, blas.NoTrans, n, nrhs, n, -1, aCopy.Data, aCopy.Stride, x.Data, x.Stride, 1, resid.Data, resid.Stride)
+						rnorm := dlange(lapack.MaxColumnSum, n, nrhs, resid.Data, resid.Stride)
+						anorm := dlange(lapack.MaxColumnSum, n, n, aCopy.Data, aCopy.Stride)
+						xnorm := dlange(lapack.MaxColumnSum, n, nrhs, x.Data, x.Stride)
+						if rnorm > tol*anorm*xnorm*float64(n) {
+							t.Errorf("%v: unexpected residual %v", prefix, rnorm/(anorm*xnorm))
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtgevcer interface {
+	Dhgeqzer
+	Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) (m int, ok bool)
+}
+
+func DtgevcTest(t *testing.T, impl Dtgevcer) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 50} {
+		for _, extra := range []int{0, 3} {
+			for _, side := range []lapack.EVSide{lapack.EVRight, lapack.EVLeft, lapack.EVBoth} {
+				for _, howmny := range []lapack.EVHowMany{lapack.EVAll, lapack.EVSelected} {
+					testDtgevc(t, impl, rnd, side, howmny, n, extra)
+				}
+			}
+		}
+	}
+}
+
+func testDtgevc(t *testing.T, impl Dtgevcer, rnd *rand.Rand, side lapack.EVSide, howmny lapack.EVHowMany, n, extra int) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("side=%c,howmny=%c,n=%v,extra=%v", side, howmny, n, extra)
+
+	// Compute the generalized Schur form (S,P) of a random matrix pair.
+	s := randomGeneral(n, n, n+extra, rnd)
+	p := randomGeneral(n, n, n+extra, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			p.Data[i*p.Stride+j] = 0
+		}
+	}
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+	impl.Dgghrd(lapack.OrthoNone, lapack.OrthoNone, n, 0, n-1, s.Data, max(1, s.Stride), p.Data, max(1, p.Stride), nil, 1, nil, 1)
+	work := make([]float64, max(1, 6*n))
+	unconverged := impl.Dhgeqz(lapack.EigenvaluesAndSchur, lapack.OrthoNone, lapack.OrthoNone, n, 0, n-1,
+		s.Data, max(1, s.Stride), p.Data, max(1, p.Stride), alphar, alphai, beta, nil, 1, nil, 1, work, len(work))
+	if unconverged != 0 {
+		t.Errorf("%v: Dhgeqz did not converge", name)
+		return
+	}
+
+	// Select roughly half of the eigenvalues.
+	var selected []bool
+	var mWant int
+	if howmny == lapack.EVSelected {
+		selected = make([]bool, n)
+		for j := 0; j < n; j++ {
+			if alphai[j] < 0 {
+				continue
+			}
+			if rnd.Float64() < 0.5 {
+				selected[j] = true
+				if alphai[j] > 0 {
+					mWant += 2
+				} else {
+					mWant++
+				}
+			}
+		}
+	} else {
+		mWant = n
+	}
+
+	var vl, vr blas64.General
+	if side != lapack.EVRight {
+		vl = nanGeneral(n, n, n+extra)
+	} else {
+		vl.Stride = 1
+	}
+	if side != lapack.EVLeft {
+		vr = nanGeneral(n, n, n+extra)
+	} else {
+		vr.Stride = 1
+	}
+
+	m, ok := impl.Dtgevc(side, howmny, selected, n, s.Data, max(1, s.Stride), p.Data, max(1, p.Stride),
+		vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), n, work)
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if m != mWant {
+		t.Errorf("%v: unexpected number of eigenvectors; got %v, want %v", name, m, mWant)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Compact the eigenvalues to correspond to the computed eigenvectors.
+	wr := make([]float64, 0, m)
+	wi := make([]float64, 0, m)
+	bb := make([]float64, 0, m)
+	for j := 0; j < n; j++ {
+		if howmny == lapack.EVAll || selected[j] || (j > 0 && alphai[j] < 0 && selected[j-1]) {
+			wr = append(wr, alphar[j])
+			wi = append(wi, alphai[j])
+			bb = append(bb, beta[j])
+		}
+	}
+
+	if side != lapack.EVRight {
+		e := blas64.General{Rows: n, Cols: m, Stride: vl.Stride, Data: vl.Data}
+		if resid := residualGeneralizedEV(true, s, p, e, wr, wi, bb); resid > tol {
+			t.Errorf("%v: unexpected residual of left eigenvectors; got %v, want <= %v", name, resid, tol)
+		}
+	}
+	if side != lapack.EVLeft {
+		e := blas64.General{Rows: n, Cols: m, Stride: vr.Stride, Data: vr.Data}
+		if resid := residualGeneralizedEV(false, s, p, e, wr, wi, bb); resid > tol {
+			t.Errorf("%v: unexpected residual of right eigenvectors; got %v, want <= %v", name, resid, tol)
+		}
+	}
+}
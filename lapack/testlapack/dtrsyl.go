@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtrsyler interface {
+	Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool)
+}
+
+func DtrsylTest(t *testing.T, impl Dtrsyler) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trana := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, tranb := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, isgn := range []int{1, -1} {
+				for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 17} {
+					for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 17} {
+						for _, extra := range []int{0, 3} {
+							for cas := 0; cas < 5; cas++ {
+								dtrsylTest(t, impl, rnd, trana, tranb, isgn, m, n, extra)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dtrsylTest(t *testing.T, impl Dtrsyler, rnd *rand.Rand, trana, tranb blas.Transpose, isgn, m, n, extra int) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("trana=%v,tranb=%v,isgn=%v,m=%v,n=%v,extra=%v", transToString(trana), transToString(tranb), isgn, m, n, extra)
+
+	a, _, _ := randomSchurCanonical(m, m+extra, false, rnd)
+	b, _, _ := randomSchurCanonical(n, n+extra, false, rnd)
+	// Shift the eigenvalues of A so that A and -isgn*B do not have
+	// common eigenvalues.
+	for i := 0; i < m; i++ {
+		a.Data[i*a.Stride+i] += 5
+	}
+	for i := 0; i < n; i++ {
+		b.Data[i*b.Stride+i] += float64(isgn) * 5
+	}
+	c := randomGeneral(m, n, n+extra, rnd)
+	cCopy := cloneGeneral(c)
+
+	scale, ok := impl.Dtrsyl(trana, tranb, isgn, m, n, a.Data, a.Stride, b.Data, b.Stride, c.Data, c.Stride)
+	if !generalOutsideAllNaN(c) {
+		t.Errorf("%v: out-of-range write to C", name)
+	}
+	if !ok {
+		t.Errorf("%v: unexpected perturbation of eigenvalues", name)
+		return
+	}
+	if scale <= 0 || 1 < scale {
+		t.Errorf("%v: invalid scale %v", name, scale)
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// Compute the residual op(A)*X + isgn*X*op(B) - scale*C.
+	res := cloneGeneral(cCopy)
+	blas64.Gemm(trana, blas.NoTrans, 1, a, c, -scale, res)
+	blas64.Gemm(blas.NoTrans, tranb, float64(isgn), c, b, 1, res)
+	resid := dlange(lapack.MaxColumnSum, m, n, res.Data, res.Stride)
+	anorm := dlange(lapack.MaxColumnSum, m, m, a.Data, a.Stride)
+	bnorm := dlange(lapack.MaxColumnSum, n, n, b.Data, b.Stride)
+	xnorm := dlange(lapack.MaxColumnSum, m, n, c.Data, c.Stride)
+	resid /= math.Max(1, (anorm+bnorm)*xnorm)
+	if resid > tol {
+		t.Errorf("%v: unexpected residual %v", name, resid)
+	}
+}
@@ -945,6 +945,22 @@ func equalGeneral(a, b blas64.General) bool {
 	return true
 }
 
+// sameGeneral returns whether the general matrices a and b are equal, treating
+// NaN elements as equal to each other.
+func sameGeneral(a, b blas64.General) bool {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		panic("bad input")
+	}
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			if !sameFloat64(a.Data[i*a.Stride+j], b.Data[i*b.Stride+j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // equalApproxGeneral returns whether the general matrices a and b are
 // approximately equal within given tolerance.
 func equalApproxGeneral(a, b blas64.General, tol float64) bool {
@@ -1023,6 +1039,35 @@ func distSymBand(uplo blas.Uplo, n, kd int, a []float64, lda int, b []float64, l
 	return dist
 }
 
+// randGenBand returns an m×n random general band matrix with kl sub-diagonals
+// and ku super-diagonals stored in the format used by Dgbtrf. The elements of
+// ab that do not belong to the band of A are set to NaN.
+func randGenBand(m, n, kl, ku, ldab int, rnd *rand.Rand) []float64 {
+	var ab []float64
+	if min(m, n) > 0 {
+		ab = nanSlice((min(m, n+kl)-1)*ldab + 2*kl + ku + 1)
+	}
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+			ab[i*ldab+kl+j-i] = rnd.NormFloat64()
+		}
+	}
+	return ab
+}
+
+// genBandToGeneral returns the dense representation of the m×n general band
+// matrix with kl sub-diagonals and ku super-diagonals stored in ab. Elements of
+// ab outside the band are ignored.
+func genBandToGeneral(m, n, kl, ku int, ab []float64, ldab int) blas64.General {
+	a := zeros(m, n, max(1, n))
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+			a.Data[i*a.Stride+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	return a
+}
+
 // eye returns an identity matrix of given order and stride.
 func eye(n, stride int) blas64.General {
 	ans := nanGeneral(n, n, stride)
@@ -0,0 +1,129 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// nanZGeneral allocates a new r×c complex general matrix filled with NaN
+// values.
+func nanZGeneral(r, c, stride int) cblas128.General {
+	if r < 0 || c < 0 {
+		panic("bad matrix size")
+	}
+	if r == 0 || c == 0 {
+		return cblas128.General{Stride: max(1, stride)}
+	}
+	if stride < c {
+		panic("bad stride")
+	}
+	data := make([]complex128, (r-1)*stride+c)
+	for i := range data {
+		data[i] = cmplx.NaN()
+	}
+	return cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: stride,
+		Data:   data,
+	}
+}
+
+// randomZGeneral allocates a new r×c complex general matrix filled with random
+// numbers. Out-of-range elements are filled with NaN values.
+func randomZGeneral(r, c, stride int, rnd *rand.Rand) cblas128.General {
+	ans := nanZGeneral(r, c, stride)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			ans.Data[i*ans.Stride+j] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	return ans
+}
+
+// randomZHermitianPosDef returns a random n×n Hermitian positive definite
+// matrix stored in full.
+func randomZHermitianPosDef(n, stride int, rnd *rand.Rand) cblas128.General {
+	b := randomZGeneral(n, n, n, rnd)
+	a := nanZGeneral(n, n, stride)
+	cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, b, b, 0, a)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] = complex(real(a.Data[i*a.Stride+i])+float64(n), 0)
+	}
+	return a
+}
+
+// cloneZGeneral allocates and returns an exact copy of the given complex
+// general matrix.
+func cloneZGeneral(a cblas128.General) cblas128.General {
+	c := a
+	c.Data = make([]complex128, len(a.Data))
+	copy(c.Data, a.Data)
+	return c
+}
+
+// zeroZGeneral returns a new r×c complex general matrix with all elements zero.
+func zeroZGeneral(r, c int) cblas128.General {
+	return cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: max(1, c),
+		Data:   make([]complex128, r*c),
+	}
+}
+
+// zmul returns op(a) * op(b).
+func zmul(ta blas.Transpose, a cblas128.General, tb blas.Transpose, b cblas128.General) cblas128.General {
+	m, k := a.Rows, a.Cols
+	if ta != blas.NoTrans {
+		m, k = k, m
+	}
+	n := b.Cols
+	if tb != blas.NoTrans {
+		n = b.Rows
+	}
+	c := zeroZGeneral(m, n)
+	if m == 0 || n == 0 || k == 0 {
+		return c
+	}
+	cblas128.Gemm(ta, tb, 1, a, b, 0, c)
+	return c
+}
+
+// zmaxAbsDiff returns the maximum absolute difference between the elements of
+// the complex general matrices a and b of the same size.
+func zmaxAbsDiff(a, b cblas128.General) float64 {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		panic("bad matrix size")
+	}
+	var diff float64
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			diff = math.Max(diff, cmplx.Abs(a.Data[i*a.Stride+j]-b.Data[i*b.Stride+j]))
+		}
+	}
+	return diff
+}
+
+// zmaxAbs returns the maximum absolute value of the elements of a.
+func zmaxAbs(a cblas128.General) float64 {
+	return zmaxAbsDiff(a, zeroZGeneral(a.Rows, a.Cols))
+}
+
+// residualZOrthonormalColumns returns the maximum absolute difference between
+// Qᴴ*Q and the identity matrix.
+func residualZOrthonormalColumns(q cblas128.General) float64 {
+	qhq := zmul(blas.ConjTrans, q, blas.NoTrans, q)
+	for i := 0; i < q.Cols; i++ {
+		qhq.Data[i*qhq.Stride+i] -= 1
+	}
+	return zmaxAbs(qhq)
+}
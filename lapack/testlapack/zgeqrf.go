@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zgeqrfer interface {
+	Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int)
+}
+
+// ZgeqrfTest tests Zgeqrf and Zungqr by checking that Q*R = A and that Q has
+// orthonormal columns.
+func ZgeqrfTest(t *testing.T, impl Zgeqrfer) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 3, 10, 30} {
+		for _, n := range []int{0, 1, 2, 3, 10, 30} {
+			for _, extra := range []int{0, 4} {
+				name := fmt.Sprintf("m=%d,n=%d,extra=%d", m, n, extra)
+				a := randomZGeneral(m, n, n+extra, rnd)
+				aCopy := cloneZGeneral(a)
+				k := min(m, n)
+				tau := make([]complex128, k)
+
+				work := make([]complex128, 1)
+				impl.Zgeqrf(m, n, a.Data, a.Stride, tau, work, -1)
+				lwork := max(1, n, int(real(work[0])))
+				work = make([]complex128, lwork)
+				impl.Zgeqrf(m, n, a.Data, a.Stride, tau, work, lwork)
+				if k == 0 {
+					continue
+				}
+
+				// Extract R and check that its diagonal is real.
+				r := zeroZGeneral(k, n)
+				for i := 0; i < k; i++ {
+					for j := i; j < n; j++ {
+						r.Data[i*r.Stride+j] = a.Data[i*a.Stride+j]
+					}
+					if imag(r.Data[i*r.Stride+i]) != 0 {
+						t.Errorf("%s: diagonal of R not real", name)
+					}
+				}
+
+				// Construct the first k columns of Q.
+				q := nanZGeneral(m, k, k+extra)
+				for i := 0; i < m; i++ {
+					copy(q.Data[i*q.Stride:i*q.Stride+k], a.Data[i*a.Stride:i*a.Stride+k])
+				}
+				impl.Zungqr(m, k, k, q.Data, q.Stride, tau, make([]complex128, k), k)
+				if resid := residualZOrthonormalColumns(q); resid > tol*float64(m) {
+					t.Errorf("%s: Q does not have orthonormal columns, resid %v", name, resid)
+				}
+
+				qr := zmul(blas.NoTrans, q, blas.NoTrans, r)
+				if diff := zmaxAbsDiff(qr, aCopy); diff > tol*float64(max(m, n)) {
+					t.Errorf("%s: Q*R != A, max diff %v", name, diff)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zgesvjer interface {
+	Zgesvj(m, n int, a []complex128, lda int, sva []float64, v []complex128, ldv int) (ok bool)
+}
+
+func ZgesvjTest(t *testing.T, impl Zgesvjer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{0, 1, 2, 5, 10, 40} {
+		for _, n := range []int{0, 1, 2, 5, 10, 40} {
+			if n > m {
+				continue
+			}
+			for _, rankDeficient := range []bool{false, true} {
+				for _, extra := range []int{0, 3} {
+					name := fmt.Sprintf("m=%d,n=%d,rankDeficient=%t,extra=%d", m, n, rankDeficient, extra)
+					a := randomZGeneral(m, n, n+extra, rnd)
+					if rankDeficient && n > 0 {
+						// Zero the last column and, if possible, make the
+						// first column a multiple of the second.
+						for i := 0; i < m; i++ {
+							a.Data[i*a.Stride+n-1] = 0
+							if n > 2 {
+								a.Data[i*a.Stride] = 2i * a.Data[i*a.Stride+1]
+							}
+						}
+					}
+					aCopy := cloneZGeneral(a)
+					sva := make([]float64, n)
+					v := nanZGeneral(n, n, n+extra)
+					ok := impl.Zgesvj(m, n, a.Data, a.Stride, sva, v.Data, v.Stride)
+					if !ok {
+						t.Errorf("%s: iteration did not converge", name)
+					}
+					if n == 0 {
+						continue
+					}
+
+					for i := 1; i < n; i++ {
+						if sva[i] > sva[i-1] {
+							t.Errorf("%s: singular values not in decreasing order", name)
+							break
+						}
+					}
+					if sva[n-1] < 0 {
+						t.Errorf("%s: negative singular value", name)
+					}
+					if rankDeficient && sva[n-1] != 0 {
+						t.Errorf("%s: unexpected non-zero smallest singular value %v", name, sva[n-1])
+					}
+					if resid := residualZOrthonormalColumns(a); resid > tol*float64(m) {
+						t.Errorf("%s: U does not have orthonormal columns, resid %v", name, resid)
+					}
+					if resid := residualZOrthonormalColumns(v); resid > tol*float64(n) {
+						t.Errorf("%s: V is not unitary, resid %v", name, resid)
+					}
+
+					// Check that U * Σ * Vᴴ = A.
+					us := cloneZGeneral(a)
+					for i := 0; i < m; i++ {
+						for j := 0; j < n; j++ {
+							us.Data[i*us.Stride+j] *= complex(sva[j], 0)
+						}
+					}
+					got := zmul(blas.NoTrans, us, blas.ConjTrans, v)
+					if diff := zmaxAbsDiff(got, aCopy); diff > tol*float64(m) {
+						t.Errorf("%s: U*Σ*Vᴴ != A, max diff %v", name, diff)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zgetrfer interface {
+	Zgetrf(m, n int, a []complex128, lda int, ipiv []int) bool
+}
+
+func ZgetrfTest(t *testing.T, impl Zgetrfer) {
+	testZgetrf(t, impl.Zgetrf, []int{0, 1, 2, 5, 10, 65, 150})
+}
+
+type Zgetf2er interface {
+	Zgetf2(m, n int, a []complex128, lda int, ipiv []int) bool
+}
+
+func Zgetf2Test(t *testing.T, impl Zgetf2er) {
+	testZgetrf(t, impl.Zgetf2, []int{0, 1, 2, 5, 10, 30})
+
+	// A singular matrix must be reported.
+	a := []complex128{1, 2, 2, 4}
+	if impl.Zgetf2(2, 2, a, 2, make([]int, 2)) {
+		t.Error("unexpected ok for singular matrix")
+	}
+}
+
+func testZgetrf(t *testing.T, getrf func(m, n int, a []complex128, lda int, ipiv []int) bool, sizes []int) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range sizes {
+		for _, n := range sizes {
+			for _, extra := range []int{0, 5} {
+				name := fmt.Sprintf("m=%d,n=%d,extra=%d", m, n, extra)
+				a := randomZGeneral(m, n, n+extra, rnd)
+				aCopy := cloneZGeneral(a)
+				mn := min(m, n)
+				ipiv := make([]int, mn)
+				ok := getrf(m, n, a.Data, a.Stride, ipiv)
+				if !ok {
+					t.Errorf("%s: unexpected singular matrix", name)
+					continue
+				}
+				if mn == 0 {
+					continue
+				}
+
+				// Construct L and U and check that P * L * U = A.
+				l := zeroZGeneral(m, mn)
+				u := zeroZGeneral(mn, n)
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						v := a.Data[i*a.Stride+j]
+						switch {
+						case i == j:
+							l.Data[i*l.Stride+i] = 1
+							u.Data[i*u.Stride+j] = v
+						case i > j:
+							if j < mn {
+								l.Data[i*l.Stride+j] = v
+							}
+						default:
+							if i < mn {
+								u.Data[i*u.Stride+j] = v
+							}
+						}
+					}
+				}
+				lu := zmul(blas.NoTrans, l, blas.NoTrans, u)
+				for i := mn - 1; i >= 0; i-- {
+					if ipiv[i] != i {
+						cblas128.Swap(
+							cblas128.Vector{N: n, Data: lu.Data[i*lu.Stride:], Inc: 1},
+							cblas128.Vector{N: n, Data: lu.Data[ipiv[i]*lu.Stride:], Inc: 1},
+						)
+					}
+				}
+				if diff := zmaxAbsDiff(lu, aCopy); diff > tol*float64(max(m, n)) {
+					t.Errorf("%s: P*L*U != A, max diff %v", name, diff)
+				}
+			}
+		}
+	}
+}
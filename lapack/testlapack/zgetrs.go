@@ -0,0 +1,52 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zgetrser interface {
+	Zgetrfer
+	Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int)
+}
+
+func ZgetrsTest(t *testing.T, impl Zgetrser) {
+	const tol = 1e-11
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+		for _, n := range []int{0, 1, 2, 5, 10, 70} {
+			for _, nrhs := range []int{1, 4} {
+				for _, extra := range []int{0, 3} {
+					name := fmt.Sprintf("trans=%v,n=%d,nrhs=%d,extra=%d", transToString(trans), n, nrhs, extra)
+					a := randomZGeneral(n, n, n+extra, rnd)
+					for i := 0; i < n; i++ {
+						// Make A well-conditioned.
+						a.Data[i*a.Stride+i] += complex(float64(2*n), 0)
+					}
+					aCopy := cloneZGeneral(a)
+					b := randomZGeneral(n, nrhs, nrhs+extra, rnd)
+					x := cloneZGeneral(b)
+
+					ipiv := make([]int, n)
+					impl.Zgetrf(n, n, a.Data, a.Stride, ipiv)
+					impl.Zgetrs(trans, n, nrhs, a.Data, a.Stride, ipiv, x.Data, x.Stride)
+					if n == 0 {
+						continue
+					}
+
+					got := zmul(trans, aCopy, blas.NoTrans, x)
+					if diff := zmaxAbsDiff(got, b); diff > tol {
+						t.Errorf("%s: residual too large: %v", name, diff)
+					}
+				}
+			}
+		}
+	}
+}
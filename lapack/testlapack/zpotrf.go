@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zpotrfer interface {
+	Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+}
+
+func ZpotrfTest(t *testing.T, impl Zpotrfer) {
+	testZpotrf(t, impl.Zpotrf, []int{0, 1, 2, 3, 10, 63, 65, 130})
+}
+
+type Zpotf2er interface {
+	Zpotf2(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+}
+
+func Zpotf2Test(t *testing.T, impl Zpotf2er) {
+	testZpotrf(t, impl.Zpotf2, []int{0, 1, 2, 3, 10, 30})
+}
+
+func testZpotrf(t *testing.T, potrf func(ul blas.Uplo, n int, a []complex128, lda int) bool, sizes []int) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range sizes {
+			for _, extra := range []int{0, 4} {
+				name := fmt.Sprintf("uplo=%c,n=%d,extra=%d", uplo, n, extra)
+				a := randomZHermitianPosDef(n, n+extra, rnd)
+				aCopy := cloneZGeneral(a)
+				ok := potrf(uplo, n, a.Data, a.Stride)
+				if !ok {
+					t.Errorf("%s: unexpected failure for positive definite matrix", name)
+					continue
+				}
+				if n == 0 {
+					continue
+				}
+
+				// Extract the triangular factor and check the factorization.
+				f := zeroZGeneral(n, n)
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i) {
+							f.Data[i*f.Stride+j] = a.Data[i*a.Stride+j]
+						}
+					}
+					if imag(f.Data[i*f.Stride+i]) != 0 {
+						t.Errorf("%s: diagonal element %d not real", name, i)
+					}
+				}
+				got := zmul(blas.ConjTrans, f, blas.NoTrans, f)
+				if uplo == blas.Lower {
+					got = zmul(blas.NoTrans, f, blas.ConjTrans, f)
+				}
+				if diff := zmaxAbsDiff(got, aCopy); diff > tol*float64(n) {
+					t.Errorf("%s: factorization does not match A, max diff %v", name, diff)
+				}
+			}
+		}
+
+		// A Hermitian indefinite matrix must be reported.
+		a := []complex128{
+			1, 2i,
+			-2i, 1,
+		}
+		if potrf(uplo, 2, a, 2) {
+			t.Errorf("uplo=%c: unexpected ok for indefinite matrix", uplo)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zpotrser interface {
+	Zpotrfer
+	Zpotrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int)
+}
+
+func ZpotrsTest(t *testing.T, impl Zpotrser) {
+	const tol = 1e-11
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 5, 10, 70} {
+			for _, nrhs := range []int{1, 3} {
+				for _, extra := range []int{0, 2} {
+					name := fmt.Sprintf("uplo=%c,n=%d,nrhs=%d,extra=%d", uplo, n, nrhs, extra)
+					a := randomZHermitianPosDef(n, n+extra, rnd)
+					aCopy := cloneZGeneral(a)
+					b := randomZGeneral(n, nrhs, nrhs+extra, rnd)
+					x := cloneZGeneral(b)
+					if !impl.Zpotrf(uplo, n, a.Data, a.Stride) {
+						t.Errorf("%s: unexpected failure of Zpotrf", name)
+						continue
+					}
+					impl.Zpotrs(uplo, n, nrhs, a.Data, a.Stride, x.Data, x.Stride)
+					if n == 0 {
+						continue
+					}
+					got := zmul(blas.NoTrans, aCopy, blas.NoTrans, x)
+					if diff := zmaxAbsDiff(got, b); diff > tol {
+						t.Errorf("%s: residual too large: %v", name, diff)
+					}
+				}
+			}
+		}
+	}
+}
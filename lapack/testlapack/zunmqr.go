@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zunmqrer interface {
+	Zgeqrfer
+	Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int)
+}
+
+// ZunmqrTest compares the result of Zunmqr with an explicit multiplication by
+// the unitary matrix Q formed by Zungqr.
+func ZunmqrTest(t *testing.T, impl Zunmqrer) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+			for _, m := range []int{1, 2, 5, 12} {
+				for _, n := range []int{1, 3, 12} {
+					nq := m
+					if side == blas.Right {
+						nq = n
+					}
+					for _, k := range []int{0, 1, nq / 2, nq} {
+						name := fmt.Sprintf("side=%c,trans=%v,m=%d,n=%d,k=%d", side, transToString(trans), m, n, k)
+
+						// Compute the QR factorization of a random nq×k matrix.
+						a := randomZGeneral(nq, k, max(1, k), rnd)
+						tau := make([]complex128, k)
+						impl.Zgeqrf(nq, k, a.Data, a.Stride, tau, make([]complex128, max(1, k)), max(1, k))
+
+						// Form the full nq×nq matrix Q.
+						q := zeroZGeneral(nq, nq)
+						for i := 0; i < nq && k > 0; i++ {
+							copy(q.Data[i*q.Stride:i*q.Stride+k], a.Data[i*a.Stride:i*a.Stride+k])
+						}
+						impl.Zungqr(nq, nq, k, q.Data, q.Stride, tau, make([]complex128, nq), nq)
+
+						c := randomZGeneral(m, n, n, rnd)
+						var want cblas128.General
+						if side == blas.Left {
+							want = zmul(trans, q, blas.NoTrans, c)
+						} else {
+							want = zmul(blas.NoTrans, c, trans, q)
+						}
+
+						nw := n
+						if side == blas.Right {
+							nw = m
+						}
+						impl.Zunmqr(side, trans, m, n, k, a.Data, a.Stride, tau, c.Data, c.Stride, make([]complex128, nw), nw)
+						if diff := zmaxAbsDiff(c, want); diff > tol*float64(nq) {
+							t.Errorf("%s: unexpected result, max diff %v", name, diff)
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// BiCGStab implements the BiConjugate Gradient Stabilized method with right
+// preconditioning for solving systems of linear equations
+//
+//	A⋅x = b,
+//
+// where A is a nonsymmetric matrix. It requires two matrix-vector products and
+// two preconditioner solves per iteration and a small, constant amount of
+// storage. Its convergence is often smoother than that of the BiConjugate
+// Gradient method, but it may break down.
+//
+// References:
+//   - Barrett, R. et al. (1994). Section 2.3.8 BiConjugate Gradient Stabilized
+//     (Bi-CGSTAB). In Templates for the Solution of Linear Systems: Building
+//     Blocks for Iterative Methods (2nd ed.) (pp. 24-25). Philadelphia, PA:
+//     SIAM. Retrieved from http://www.netlib.org/templates/templates.pdf
+//   - van der Vorst, H. (1992). Bi-CGSTAB: A fast and smoothly converging
+//     variant of Bi-CG for the solution of nonsymmetric linear systems. SIAM
+//     J. Sci. Stat. Comput., 13(2), 631-644.
+type BiCGStab struct {
+	x    mat.VecDense
+	r    mat.VecDense
+	rt   mat.VecDense
+	p    mat.VecDense
+	v    mat.VecDense
+	t    mat.VecDense
+	phat mat.VecDense
+	shat mat.VecDense
+
+	rho, rhoPrev float64
+	alpha, omega float64
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (b *BiCGStab) Init(x, residual *mat.VecDense) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("bicgstab: vector length mismatch")
+	}
+
+	b.x.CloneFromVec(x)
+	b.r.CloneFromVec(residual)
+	b.rt.CloneFromVec(residual)
+	b.p.Reset()
+	b.p.ReuseAsVec(n)
+	b.v.Reset()
+	b.v.ReuseAsVec(n)
+	b.t.Reset()
+	b.t.ReuseAsVec(n)
+	b.phat.Reset()
+	b.phat.ReuseAsVec(n)
+	b.shat.Reset()
+	b.shat.ReuseAsVec(n)
+
+	b.rhoPrev = 1
+	b.alpha = 0
+	b.omega = 1
+
+	b.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// BiCGStab will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+func (b *BiCGStab) Iterate(ctx *Context) (Operation, error) {
+	switch b.resume {
+	case 1:
+		b.rho = mat.Dot(&b.rt, &b.r)
+		if b.rho == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: b.rho}
+		}
+		// p_i = r_{i-1} + β*(p_{i-1} - ω*v_{i-1})
+		beta := (b.rho / b.rhoPrev) * (b.alpha / b.omega)
+		b.p.AddScaledVec(&b.p, -b.omega, &b.v)
+		b.p.AddScaledVec(&b.r, beta, &b.p)
+		// Solve M⋅p̂_i = p_i.
+		ctx.Src.CopyVec(&b.p)
+		b.resume = 2
+		return PreconSolve, nil
+	case 2:
+		b.phat.CopyVec(ctx.Dst)
+		// Compute A⋅p̂_i.
+		ctx.Src.CopyVec(&b.phat)
+		b.resume = 3
+		return MulVec, nil
+	case 3:
+		b.v.CopyVec(ctx.Dst)
+		rtv := mat.Dot(&b.rt, &b.v)
+		if rtv == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: 0}
+		}
+		b.alpha = b.rho / rtv
+		// Form the intermediate residual s in r.
+		b.r.AddScaledVec(&b.r, -b.alpha, &b.v)
+		ctx.ResidualNorm = mat.Norm(&b.r, 2)
+		b.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		b.x.AddScaledVec(&b.x, b.alpha, &b.phat)
+		if ctx.Converged {
+			ctx.X.CopyVec(&b.x)
+			b.resume = 0
+			return MajorIteration, nil
+		}
+		// Solve M⋅ŝ = s.
+		ctx.Src.CopyVec(&b.r)
+		b.resume = 5
+		return PreconSolve, nil
+	case 5:
+		b.shat.CopyVec(ctx.Dst)
+		// Compute A⋅ŝ.
+		ctx.Src.CopyVec(&b.shat)
+		b.resume = 6
+		return MulVec, nil
+	case 6:
+		b.t.CopyVec(ctx.Dst)
+		tt := mat.Dot(&b.t, &b.t)
+		if tt == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: 0}
+		}
+		b.omega = mat.Dot(&b.t, &b.r) / tt
+		b.x.AddScaledVec(&b.x, b.omega, &b.shat)
+		b.r.AddScaledVec(&b.r, -b.omega, &b.t)
+		ctx.ResidualNorm = mat.Norm(&b.r, 2)
+		b.resume = 7
+		return CheckResidualNorm, nil
+	case 7:
+		ctx.X.CopyVec(&b.x)
+		if ctx.Converged {
+			b.resume = 0
+			return MajorIteration, nil
+		}
+		if b.omega == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: b.omega}
+		}
+		b.rhoPrev = b.rho
+		b.resume = 1
+		return MajorIteration, nil
+
+	default:
+		panic("bicgstab: Init not called")
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// CG implements the Conjugate Gradient iterative method with preconditioning
+// for solving systems of linear equations
+//
+//	A⋅x = b,
+//
+// where A is a symmetric positive definite matrix. It requires minimal
+// memory storage and is a good choice for symmetric positive definite
+// problems. The preconditioner must also be symmetric positive definite.
+//
+// References:
+//   - Barrett, R. et al. (1994). Section 2.3.1 Conjugate Gradient Method (CG).
+//     In Templates for the Solution of Linear Systems: Building Blocks
+//     for Iterative Methods (2nd ed.) (pp. 12-15). Philadelphia, PA: SIAM.
+//     Retrieved from http://www.netlib.org/templates/templates.pdf
+type CG struct {
+	x, r, p mat.VecDense
+
+	rho, rhoPrev float64
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (cg *CG) Init(x, residual *mat.VecDense) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("cg: vector length mismatch")
+	}
+
+	cg.x.CloneFromVec(x)
+	cg.r.CloneFromVec(residual)
+	cg.p.Reset()
+	cg.p.ReuseAsVec(n)
+
+	cg.rhoPrev = 1
+
+	cg.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// CG will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+func (cg *CG) Iterate(ctx *Context) (Operation, error) {
+	switch cg.resume {
+	case 1:
+		// Solve M⋅z = r_{i-1}.
+		ctx.Src.CopyVec(&cg.r)
+		cg.resume = 2
+		return PreconSolve, nil
+	case 2:
+		z := ctx.Dst
+		cg.rho = mat.Dot(&cg.r, z)  // ρ_i = r_{i-1} · z
+		beta := cg.rho / cg.rhoPrev // β = ρ_i / ρ_{i-1}
+		cg.p.AddScaledVec(z, beta, &cg.p)
+		// Compute A⋅p.
+		ctx.Src.CopyVec(&cg.p)
+		cg.resume = 3
+		return MulVec, nil
+	case 3:
+		ap := ctx.Dst
+		pAp := mat.Dot(&cg.p, ap)
+		if pAp <= 0 {
+			// A or M is not positive definite.
+			cg.resume = 0
+			return NoOperation, &BreakdownError{Value: pAp, Tolerance: 0}
+		}
+		alpha := cg.rho / pAp                  // α = ρ_i / p_i · A⋅p_i
+		cg.x.AddScaledVec(&cg.x, alpha, &cg.p) // x_i = x_{i-1} + α p_i
+		cg.r.AddScaledVec(&cg.r, -alpha, ap)   // r_i = r_{i-1} - α A⋅p_i
+		ctx.ResidualNorm = mat.Norm(&cg.r, 2)
+		cg.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		ctx.X.CopyVec(&cg.x)
+		cg.rhoPrev = cg.rho
+		cg.resume = 1
+		if ctx.Converged {
+			cg.resume = 0
+		}
+		return MajorIteration, nil
+
+	default:
+		panic("cg: Init not called")
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package linsolve provides iterative methods for solving linear systems.
+//
+// # Background
+//
+// A system of linear equations can be written as
+//
+//	A⋅x = b,
+//
+// where A is a given n×n non-singular matrix, b is a given n-vector (the
+// right-hand side), and x is an unknown n-vector.
+//
+// Direct methods such as the LU or QR decomposition compute (in the absence
+// of roundoff errors) the exact solution after a finite number of steps. For a
+// general matrix A they require O(n²) storage and O(n³) arithmetic operations,
+// which is prohibitive when A is large. Sparse direct methods, such as
+// mat.SparseCholesky and mat.SparseLU, reduce this cost by reordering A so
+// that its factors stay sparse, but the fill-in of the factors may still be
+// too large for matrices arising from three dimensional problems.
+//
+// Iterative methods, in contrast, generate a sequence of approximate solutions
+// which, hopefully, converges to the exact solution. They only access A
+// through matrix-vector products, so A can be stored in any convenient format,
+// for example as a sparse or banded matrix, or not stored at all.
+//
+// The convergence of an iterative method usually depends strongly on the
+// spectral properties of A and can be improved by preconditioning, that is, by
+// solving an equivalent system with a better conditioned matrix. A
+// preconditioner M is an approximation of A for which systems M⋅z = r are
+// cheap to solve.
+//
+// # Usage
+//
+// The function Iterative solves A⋅x = b for any A that implements the
+// MulVecToer interface, which includes mat.Dense, mat.SymDense,
+// mat.BandDense, mat.SymBandDense, mat.Tridiag, mat.CSR and mat.CSC. The
+// iterative method is selected by passing one of the Method implementations
+// in this package: CG and MINRES for symmetric matrices and GMRES and
+// BiCGStab for general matrices. Settings control the convergence criteria
+// and the preconditioner.
+package linsolve // import "gonum.org/v1/gonum/linsolve"
@@ -0,0 +1,237 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/mat"
+)
+
+// GMRES implements the Generalized Minimum Residual method with the modified
+// Gram-Schmidt orthogonalization and right preconditioning for solving systems
+// of linear equations
+//
+//	A⋅x = b,
+//
+// where A is a nonsymmetric matrix.
+//
+// GMRES minimizes the norm of the residual over a Krylov subspace whose
+// dimension grows by one in every inner step, so the storage and work grow
+// with the number of steps. To limit them, GMRES is restarted after Restart
+// inner steps, which constitute one iteration. Because right preconditioning
+// is used, the residual norm checked for convergence is the 2-norm of the
+// residual of the original system.
+//
+// References:
+//   - Barrett, R. et al. (1994). Section 2.3.4 Generalized Minimal Residual
+//     (GMRES). In Templates for the Solution of Linear Systems: Building
+//     Blocks for Iterative Methods (2nd ed.) (pp. 17-19). Philadelphia, PA:
+//     SIAM. Retrieved from http://www.netlib.org/templates/templates.pdf
+//   - Saad, Y., and Schultz, M. (1986). GMRES: A generalized minimal residual
+//     algorithm for solving nonsymmetric linear systems. SIAM J. Sci. Stat.
+//     Comput., 7(3), 856-869.
+type GMRES struct {
+	// Restart is the restart parameter which limits the computation and
+	// storage costs. It must hold that
+	//
+	//	1 <= Restart <= n
+	//
+	// where n is the dimension of the problem. If Restart is 0, a default
+	// value of min(20, n) will be used.
+	Restart int
+
+	m int
+
+	// v is an n×(m+1) matrix V whose columns form an orthonormal basis of
+	// the Krylov subspace.
+	v mat.Dense
+	// h is an (m+1)×m upper Hessenberg matrix H reduced to upper triangular
+	// form by Givens rotations.
+	h mat.Dense
+	// cs and sn hold the Givens rotations that reduce H to upper triangular
+	// form.
+	cs []float64
+	sn []float64
+	// s is the right-hand side of the least-squares problem in the
+	// reduced Krylov subspace.
+	s []float64
+	// y is the solution of the least-squares problem.
+	y mat.VecDense
+
+	x mat.VecDense
+
+	k int
+	// breakdown indicates that the last inner step found an invariant
+	// subspace, so the Krylov subspace cannot be extended in this cycle.
+	breakdown bool
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (g *GMRES) Init(x, residual *mat.VecDense) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("gmres: vector length mismatch")
+	}
+
+	g.m = g.Restart
+	if g.m == 0 {
+		g.m = min(20, n)
+	}
+	if g.m <= 0 || n < g.m {
+		panic("gmres: invalid value of Restart")
+	}
+
+	g.v.Reset()
+	g.v.ReuseAs(n, g.m+1)
+	g.h.Reset()
+	g.h.ReuseAs(g.m+1, g.m)
+	g.cs = make([]float64, g.m)
+	g.sn = make([]float64, g.m)
+	g.s = make([]float64, g.m+1)
+	g.y.Reset()
+	g.y.ReuseAsVec(g.m)
+
+	g.x.CloneFromVec(x)
+	g.startCycle(residual)
+
+	g.resume = 1
+}
+
+// startCycle prepares the state for a new restart cycle using the residual
+// of the current approximate solution.
+func (g *GMRES) startCycle(residual *mat.VecDense) {
+	beta := mat.Norm(residual, 2)
+	v0 := g.v.ColView(0).(*mat.VecDense)
+	v0.ScaleVec(1/beta, residual)
+	for i := range g.s {
+		g.s[i] = 0
+	}
+	g.s[0] = beta
+	g.k = 0
+	g.breakdown = false
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// GMRES will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	ComputeResidual
+//	CheckResidualNorm
+//	MajorIteration
+func (g *GMRES) Iterate(ctx *Context) (Operation, error) {
+	switch g.resume {
+	case 1:
+		// Compute M⁻¹ v_k.
+		ctx.Src.CopyVec(g.v.ColView(g.k))
+		g.resume = 2
+		return PreconSolve, nil
+	case 2:
+		// Compute A M⁻¹ v_k.
+		ctx.Src.CopyVec(ctx.Dst)
+		g.resume = 3
+		return MulVec, nil
+	case 3:
+		k := g.k
+		w := ctx.Dst
+		// Orthogonalize w against the previous basis vectors using the
+		// modified Gram-Schmidt process.
+		for i := 0; i <= k; i++ {
+			vi := g.v.ColView(i)
+			hik := mat.Dot(vi, w)
+			g.h.Set(i, k, hik)
+			w.AddScaledVec(w, -hik, vi)
+		}
+		hk1k := mat.Norm(w, 2)
+		g.h.Set(k+1, k, hk1k)
+		// H[k+1,k] is eliminated by the Givens rotation below, so record
+		// whether it vanished before it is overwritten.
+		g.breakdown = hk1k == 0
+		if hk1k != 0 {
+			g.v.ColView(k+1).(*mat.VecDense).ScaleVec(1/hk1k, w)
+		}
+
+		// Apply the previous Givens rotations to the new column of H.
+		for i := 0; i < k; i++ {
+			hik := g.h.At(i, k)
+			hi1k := g.h.At(i+1, k)
+			g.h.Set(i, k, g.cs[i]*hik+g.sn[i]*hi1k)
+			g.h.Set(i+1, k, -g.sn[i]*hik+g.cs[i]*hi1k)
+		}
+		// Compute and apply a new rotation that eliminates H[k+1,k].
+		c, s, r, _ := blas64.Implementation().Drotg(g.h.At(k, k), hk1k)
+		g.cs[k], g.sn[k] = c, s
+		g.h.Set(k, k, r)
+		g.h.Set(k+1, k, 0)
+		g.s[k+1] = -s * g.s[k]
+		g.s[k] *= c
+
+		g.k++
+		ctx.ResidualNorm = math.Abs(g.s[g.k])
+		g.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		if !ctx.Converged && g.k < g.m && !g.breakdown {
+			// Continue with the next inner step.
+			g.resume = 1
+			return g.Iterate(ctx)
+		}
+		// Solve the upper triangular least-squares problem H y = s and form
+		// the update V y.
+		k := g.k
+		y := g.y.SliceVec(0, k).(*mat.VecDense)
+		copy(y.RawVector().Data, g.s[:k])
+		h := g.h.Slice(0, k, 0, k).(*mat.Dense).RawMatrix()
+		blas64.Trsv(blas.NoTrans, blas64.Triangular{
+			Uplo:   blas.Upper,
+			Diag:   blas.NonUnit,
+			N:      k,
+			Data:   h.Data,
+			Stride: h.Stride,
+		}, y.RawVector())
+		ctx.Src.MulVec(g.v.Slice(0, g.v.RawMatrix().Rows, 0, k), y)
+		// Compute M⁻¹ V y.
+		g.resume = 5
+		return PreconSolve, nil
+	case 5:
+		g.x.AddVec(&g.x, ctx.Dst)
+		ctx.X.CopyVec(&g.x)
+		if ctx.Converged {
+			g.resume = 0
+			return MajorIteration, nil
+		}
+		g.resume = 6
+		return MajorIteration, nil
+	case 6:
+		// Restart with the true residual of the current approximation.
+		g.resume = 7
+		return ComputeResidual, nil
+	case 7:
+		beta := mat.Norm(ctx.Dst, 2)
+		if beta == 0 {
+			ctx.ResidualNorm = 0
+			g.resume = 8
+			return CheckResidualNorm, nil
+		}
+		g.startCycle(ctx.Dst)
+		g.resume = 1
+		return g.Iterate(ctx)
+	case 8:
+		// The residual vanished, so the current approximation is exact.
+		g.resume = 0
+		return MajorIteration, nil
+
+	default:
+		panic("gmres: Init not called")
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// dlamchE is the machine epsilon.
+const dlamchE = 1.0 / (1 << 53)
+
+// ErrIterationLimit is returned when a maximum number of iterations was done
+// without reaching convergence.
+var ErrIterationLimit = errors.New("linsolve: iteration limit reached")
+
+// BreakdownError signifies that a breakdown occurred and the method cannot
+// continue.
+type BreakdownError struct {
+	Value     float64
+	Tolerance float64
+}
+
+func (e *BreakdownError) Error() string {
+	return fmt.Sprintf("linsolve: breakdown, value=%v tolerance=%v", e.Value, e.Tolerance)
+}
+
+// MulVecToer represents a linear operator A. MulVecTo computes A⋅x if trans
+// is false or Aᵀ⋅x if trans is true, and stores the result into dst.
+type MulVecToer interface {
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+}
+
+// Preconditioner represents a preconditioner M, an approximation of the system
+// matrix A. PreconSolve solves M⋅dst = rhs if trans is false or Mᵀ⋅dst = rhs if
+// trans is true, and stores the result into dst.
+type Preconditioner interface {
+	PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error
+}
+
+// Method is an iterative method that produces a sequence of vectors converging
+// to the vector x satisfying a system of linear equations
+//
+//	A⋅x = b,
+//
+// where A is a non-singular n×n matrix and x and b are vectors of dimension n.
+//
+// Method uses a reverse-communication interface between the iterative
+// algorithm and the caller. Method acts as a client that commands the caller
+// to perform needed operations via an Operation returned from the Iterate
+// method. This provides independence of Method on representation of the
+// matrix A, and enables automation of common operations like checking for
+// convergence and maintaining statistics.
+type Method interface {
+	// Init initializes the method for solving an n×n linear system with an
+	// initial estimate x and the corresponding residual vector
+	// b - A⋅x. The method must not retain x or residual.
+	Init(x, residual *mat.VecDense)
+
+	// Iterate performs a step of the iterative method and returns an
+	// Operation, or an error if the method cannot proceed. The caller
+	// performs the operation and calls Iterate again, until the method
+	// returns a MajorIteration with ctx.Converged set, or an error.
+	Iterate(ctx *Context) (Operation, error)
+}
+
+// Context mediates the communication between the Method and the caller. The
+// caller must not modify Context apart from the commanded Operations.
+type Context struct {
+	// X will be set by Method to the current approximate solution when it
+	// commands ComputeResidual and MajorIteration.
+	X *mat.VecDense
+
+	// ResidualNorm is (an estimate of) a norm of the residual. Method will
+	// set it to the current value when it commands CheckResidualNorm.
+	ResidualNorm float64
+
+	// Converged will be set by the caller to the result of the convergence
+	// test when Method commands CheckResidualNorm.
+	Converged bool
+
+	// Src and Dst are the source and destination vectors for various
+	// Operations. Src will be set by Method and Dst must be set by the
+	// caller. Src and Dst do not share storage.
+	Src, Dst *mat.VecDense
+}
+
+// Operation specifies the type of operation.
+type Operation uint
+
+// Operations commanded by Method.Iterate.
+const (
+	NoOperation Operation = 0
+
+	// Compute A*x where x is stored in Context.Src. The result must be
+	// placed in Context.Dst.
+	MulVec Operation = 1 << (iota - 1)
+
+	// Perform a preconditioning solve M z = r where r is stored in
+	// Context.Src. The solution z must be placed in Context.Dst.
+	PreconSolve
+
+	// Trans indicates that MulVec or PreconSolve operation must be
+	// performed with the transpose, that is, compute Aᵀ*x or solve Mᵀ z = r.
+	// Method will command Trans only in bitwise OR combination with MulVec
+	// or PreconSolve.
+	Trans
+
+	// Compute b-A*x where x is stored in Context.X and store the result in
+	// Context.Dst.
+	ComputeResidual
+
+	// Check convergence using (an estimate of) a residual norm in
+	// Context.ResidualNorm. Context.X does not need to be valid. The
+	// caller must set Context.Converged to indicate whether convergence
+	// has been determined.
+	CheckResidualNorm
+
+	// MajorIteration indicates that Method has finished what it considers
+	// to be one iteration. Method must make sure that Context.X is updated.
+	// If Context.Converged is true, the caller must terminate the
+	// iterative process, otherwise it should call Method.Iterate again.
+	MajorIteration
+)
+
+// Settings holds settings for solving a linear system.
+type Settings struct {
+	// InitX holds the initial guess. If it is nil or empty, the zero vector
+	// will be used, otherwise its length must be equal to the dimension of
+	// the system.
+	InitX *mat.VecDense
+
+	// Dst, if not nil, will be used for storing the approximate solution,
+	// otherwise a new vector will be allocated. In both cases the vector
+	// will also be returned in Result. If Dst is not empty, its length must
+	// be equal to the dimension of the system.
+	Dst *mat.VecDense
+
+	// Tolerance specifies error tolerance for the final (approximate)
+	// solution produced by the iterative method. The iteration will be
+	// stopped when
+	//
+	//	|r_i| < Tolerance * |b|
+	//
+	// where r_i is the residual at i-th iteration. For preconditioned
+	// methods r_i may be measured in a norm induced by the preconditioner,
+	// see the documentation of each Method.
+	//
+	// If Tolerance is zero, a default value of 1e-8 will be used, otherwise
+	// it must be positive and less than 1.
+	Tolerance float64
+
+	// MaxIterations is the limit on the number of iterations. If it is
+	// zero, a default value of four times the dimension of the system will
+	// be used.
+	MaxIterations int
+
+	// Preconditioner is used for preconditioning solves. If it is nil,
+	// no preconditioning will be performed, that is, M is the identity.
+	Preconditioner Preconditioner
+}
+
+// defaultSettings fills zero fields of s with default values.
+func defaultSettings(s *Settings, dim int) {
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-8
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 4 * dim
+	}
+}
+
+// Result holds the result of an iterative solve.
+type Result struct {
+	// X is the approximate solution.
+	X *mat.VecDense
+
+	// ResidualNorm is the last value of the residual norm reported by
+	// the method.
+	ResidualNorm float64
+
+	Stats
+}
+
+// Stats holds statistics about an iterative solve.
+type Stats struct {
+	// Iterations is the number of iteration done by Method.
+	Iterations int
+
+	// MulVec is the number of MulVec operations commanded by Method.
+	MulVec int
+
+	// PreconSolve is the number of PreconSolve operations commanded by
+	// Method.
+	PreconSolve int
+
+	// Runtime is an approximation of the wall-clock time spent in the
+	// iterative solve.
+	Runtime time.Duration
+}
+
+// Iterative finds an approximate solution of the system of n linear equations
+//
+//	A⋅x = b,
+//
+// where A is a non-singular n×n matrix represented by the MulVecToer a, and b
+// is a given n-vector. On success, the approximate solution is returned in
+// Result.X.
+//
+// If method is nil, GMRES with default restart will be used. If settings is
+// nil, default settings will be used.
+//
+// Iterative will return an error if the method fails, and ErrIterationLimit
+// if the iteration limit is reached before convergence. In both cases a
+// non-nil Result holding the last approximate solution is returned.
+func Iterative(a MulVecToer, b *mat.VecDense, method Method, settings *Settings) (*Result, error) {
+	start := time.Now()
+
+	n := b.Len()
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	defaultSettings(&s, n)
+	if s.Tolerance <= 0 || 1 <= s.Tolerance {
+		panic("linsolve: invalid tolerance")
+	}
+	if s.MaxIterations < 0 {
+		panic("linsolve: negative iteration limit")
+	}
+	if method == nil {
+		method = &GMRES{}
+	}
+
+	var x *mat.VecDense
+	if s.Dst == nil {
+		x = mat.NewVecDense(n, nil)
+	} else {
+		x = s.Dst
+		if x.IsEmpty() {
+			x.ReuseAsVec(n)
+		} else if x.Len() != n {
+			panic("linsolve: mismatched length of destination")
+		}
+		x.Zero()
+	}
+	if s.InitX != nil && !s.InitX.IsEmpty() {
+		if s.InitX.Len() != n {
+			panic("linsolve: mismatched length of initial guess")
+		}
+		x.CopyVec(s.InitX)
+	}
+
+	stats := Stats{}
+	ctx := Context{
+		X:   mat.NewVecDense(n, nil),
+		Src: mat.NewVecDense(n, nil),
+		Dst: mat.NewVecDense(n, nil),
+	}
+
+	bNorm := mat.Norm(b, 2)
+	if bNorm == 0 {
+		// The solution of A⋅x = 0 is x = 0.
+		x.Zero()
+		stats.Runtime = time.Since(start)
+		return &Result{X: x, Stats: stats}, nil
+	}
+
+	ctx.X.CopyVec(x)
+	computeResidual(ctx.Dst, a, b, ctx.X, &stats)
+	ctx.ResidualNorm = mat.Norm(ctx.Dst, 2)
+	if ctx.ResidualNorm < s.Tolerance*bNorm {
+		stats.Runtime = time.Since(start)
+		return &Result{X: x, ResidualNorm: ctx.ResidualNorm, Stats: stats}, nil
+	}
+	method.Init(ctx.X, ctx.Dst)
+
+	var err error
+	for {
+		var op Operation
+		op, err = method.Iterate(&ctx)
+		if err != nil {
+			break
+		}
+		switch op {
+		case NoOperation:
+		case MulVec, MulVec | Trans:
+			stats.MulVec++
+			a.MulVecTo(ctx.Dst, op&Trans == Trans, ctx.Src)
+		case PreconSolve, PreconSolve | Trans:
+			if s.Preconditioner == nil {
+				ctx.Dst.CopyVec(ctx.Src)
+				break
+			}
+			stats.PreconSolve++
+			err = s.Preconditioner.PreconSolve(ctx.Dst, op&Trans == Trans, ctx.Src)
+		case ComputeResidual:
+			computeResidual(ctx.Dst, a, b, ctx.X, &stats)
+		case CheckResidualNorm:
+			ctx.Converged = ctx.ResidualNorm < s.Tolerance*bNorm
+		case MajorIteration:
+			stats.Iterations++
+			if !ctx.Converged && stats.Iterations >= s.MaxIterations {
+				err = ErrIterationLimit
+			}
+		default:
+			panic("linsolve: invalid operation")
+		}
+		if err != nil || (op == MajorIteration && ctx.Converged) {
+			break
+		}
+	}
+	x.CopyVec(ctx.X)
+	stats.Runtime = time.Since(start)
+	return &Result{X: x, ResidualNorm: ctx.ResidualNorm, Stats: stats}, err
+}
+
+// computeResidual stores b - A⋅x into dst.
+func computeResidual(dst *mat.VecDense, a MulVecToer, b, x *mat.VecDense, stats *Stats) {
+	stats.MulVec++
+	a.MulVecTo(dst, false, x)
+	dst.AddScaledVec(b, -1, dst)
+}
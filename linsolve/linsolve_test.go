@@ -0,0 +1,356 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// laplacian2D returns the matrix of the five-point finite difference
+// discretization of the operator -Δu + c⋅∇u + shift*u on an nx×ny grid.
+func laplacian2D(nx, ny int, c, shift float64) *mat.CSR {
+	n := nx * ny
+	a := mat.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < nx; i++ {
+		for j := 0; j < ny; j++ {
+			row := i*ny + j
+			a.Append(row, row, 4+shift)
+			if i > 0 {
+				a.Append(row, row-ny, -1-c)
+			}
+			if i < nx-1 {
+				a.Append(row, row+ny, -1+c)
+			}
+			if j > 0 {
+				a.Append(row, row-1, -1-c)
+			}
+			if j < ny-1 {
+				a.Append(row, row+1, -1+c)
+			}
+		}
+	}
+	var csr mat.CSR
+	csr.CloneFrom(a)
+	return &csr
+}
+
+// randomSPD returns a random dense symmetric positive definite matrix.
+func randomSPD(n int, rnd *rand.Rand) *mat.SymDense {
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var s mat.SymDense
+	s.SymOuterK(1, a)
+	for i := 0; i < n; i++ {
+		s.SetSym(i, i, s.At(i, i)+float64(n))
+	}
+	return &s
+}
+
+type testMatrix interface {
+	mat.Matrix
+	MulVecToer
+}
+
+type testCase struct {
+	name string
+	a    testMatrix
+}
+
+func spdCases(rnd *rand.Rand) []testCase {
+	return []testCase{
+		{name: "SPD 1×1", a: randomSPD(1, rnd)},
+		{name: "SPD 10×10", a: randomSPD(10, rnd)},
+		{name: "SPD 40×40", a: randomSPD(40, rnd)},
+		{name: "Laplacian 10×10", a: laplacian2D(10, 10, 0, 0)},
+		{name: "Laplacian 17×23", a: laplacian2D(17, 23, 0, 0)},
+		{name: "SymBand 30", a: mat.NewSymBandDense(30, 1, func() []float64 {
+			d := make([]float64, 60)
+			for i := 0; i < 30; i++ {
+				d[2*i] = 3
+				d[2*i+1] = -1
+			}
+			return d
+		}())},
+	}
+}
+
+func nonsymCases(rnd *rand.Rand) []testCase {
+	dense := mat.NewDense(30, 30, nil)
+	for i := 0; i < 30; i++ {
+		for j := 0; j < 30; j++ {
+			dense.Set(i, j, rnd.NormFloat64())
+		}
+		dense.Set(i, i, dense.At(i, i)+30)
+	}
+	return []testCase{
+		{name: "Dense 30×30", a: dense},
+		{name: "Convection-diffusion 10×10", a: laplacian2D(10, 10, 0.4, 0)},
+		{name: "Convection-diffusion 15×12", a: laplacian2D(15, 12, 0.9, 0)},
+	}
+}
+
+func indefiniteCases() []testCase {
+	return []testCase{
+		{name: "Shifted Laplacian 10×10", a: laplacian2D(10, 10, 0, -1.3)},
+		{name: "Shifted Laplacian 12×15", a: laplacian2D(12, 15, 0, -0.77)},
+	}
+}
+
+type preconCase struct {
+	name   string
+	precon func(a mat.Matrix) (Preconditioner, error)
+}
+
+var (
+	noPrecon = preconCase{name: "none", precon: func(mat.Matrix) (Preconditioner, error) { return nil, nil }}
+	jacobi   = preconCase{name: "Jacobi", precon: func(a mat.Matrix) (Preconditioner, error) { return NewJacobi(a) }}
+	ssor     = preconCase{name: "SSOR", precon: func(a mat.Matrix) (Preconditioner, error) { return NewSSOR(a, 1.2) }}
+	ilu0     = preconCase{name: "ILU0", precon: func(a mat.Matrix) (Preconditioner, error) { return NewILU0(a) }}
+	ic0      = preconCase{name: "IC0", precon: func(a mat.Matrix) (Preconditioner, error) { return NewIC0(a) }}
+)
+
+func TestIterative(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	spd := spdCases(rnd)
+	nonsym := nonsymCases(rnd)
+	indef := indefiniteCases()
+	allPrecon := []preconCase{noPrecon, jacobi, ssor, ilu0}
+	for _, test := range []struct {
+		name    string
+		method  func() Method
+		cases   []testCase
+		precons []preconCase
+	}{
+		{
+			name:    "CG",
+			method:  func() Method { return &CG{} },
+			cases:   spd,
+			precons: []preconCase{noPrecon, jacobi, ssor, ic0},
+		},
+		{
+			name:    "MINRES",
+			method:  func() Method { return &MINRES{} },
+			cases:   append(append([]testCase{}, spd...), indef...),
+			precons: []preconCase{noPrecon, jacobi},
+		},
+		{
+			name:    "MINRES",
+			method:  func() Method { return &MINRES{} },
+			cases:   spd,
+			precons: []preconCase{ssor, ic0},
+		},
+		{
+			name:    "GMRES",
+			method:  func() Method { return &GMRES{} },
+			cases:   append(append([]testCase{}, spd...), nonsym...),
+			precons: allPrecon,
+		},
+		{
+			name:    "GMRES(1)",
+			method:  func() Method { return &GMRES{Restart: 1} },
+			cases:   nonsym,
+			precons: []preconCase{ilu0},
+		},
+		{
+			name:    "GMRES(3)",
+			method:  func() Method { return &GMRES{Restart: 3} },
+			cases:   append(append([]testCase{}, spd[1:]...), nonsym...),
+			precons: allPrecon,
+		},
+		{
+			name:    "BiCGStab",
+			method:  func() Method { return &BiCGStab{} },
+			cases:   append(append([]testCase{}, spd...), nonsym...),
+			precons: allPrecon,
+		},
+	} {
+		for _, tc := range test.cases {
+			for _, pc := range test.precons {
+				name := fmt.Sprintf("%s,%s,precon=%s", test.name, tc.name, pc.name)
+				precon, err := pc.precon(tc.a)
+				if err != nil {
+					t.Fatalf("%s: unexpected error constructing preconditioner: %v", name, err)
+				}
+				testIterative(t, name, tc.a, test.method(), precon, rnd)
+			}
+		}
+	}
+}
+
+func testIterative(t *testing.T, name string, a testMatrix, method Method, precon Preconditioner, rnd *rand.Rand) {
+	const tol = 1e-10
+
+	n, _ := a.Dims()
+	want := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		want.SetVec(i, rnd.NormFloat64())
+	}
+	var b mat.VecDense
+	a.MulVecTo(&b, false, want)
+	initX := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		initX.SetVec(i, rnd.NormFloat64())
+	}
+
+	settings := &Settings{
+		InitX:          initX,
+		Tolerance:      tol,
+		MaxIterations:  10 * n,
+		Preconditioner: precon,
+	}
+	result, err := Iterative(a, &b, method, settings)
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", name, err)
+		return
+	}
+	if result.Iterations == 0 || result.MulVec == 0 {
+		t.Errorf("%s: unexpected statistics: %+v", name, result.Stats)
+	}
+	if precon != nil && result.PreconSolve == 0 {
+		t.Errorf("%s: preconditioner not used", name)
+	}
+
+	var r mat.VecDense
+	a.MulVecTo(&r, false, result.X)
+	r.SubVec(&b, &r)
+	rNorm := mat.Norm(&r, 2)
+	bNorm := mat.Norm(&b, 2)
+	// The residual norm checked by the method may be an estimate or be
+	// measured in a different norm, so allow for some slack.
+	if rNorm > 100*tol*bNorm {
+		t.Errorf("%s: residual too large: got %v, want <= %v", name, rNorm, 100*tol*bNorm)
+	}
+	if !mat.EqualApprox(result.X, want, 1e-6) {
+		t.Errorf("%s: unexpected solution", name)
+	}
+}
+
+func TestIterativeZeroRHS(t *testing.T) {
+	t.Parallel()
+	a := laplacian2D(4, 4, 0, 0)
+	b := mat.NewVecDense(16, nil)
+	dst := mat.NewVecDense(16, nil)
+	for i := 0; i < 16; i++ {
+		dst.SetVec(i, 1)
+	}
+	result, err := Iterative(a, b, &CG{}, &Settings{Dst: dst})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.X != dst {
+		t.Errorf("result not stored in Settings.Dst")
+	}
+	if mat.Norm(dst, 2) != 0 {
+		t.Errorf("unexpected non-zero solution for zero right-hand side")
+	}
+}
+
+func TestIterativeIterationLimit(t *testing.T) {
+	t.Parallel()
+	a := laplacian2D(20, 20, 0, 0)
+	b := mat.NewVecDense(400, nil)
+	for i := 0; i < 400; i++ {
+		b.SetVec(i, 1)
+	}
+	result, err := Iterative(a, b, &CG{}, &Settings{MaxIterations: 3})
+	if err != ErrIterationLimit {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrIterationLimit)
+	}
+	if result == nil || result.Iterations != 3 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestGMRESFullRestart(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 30} {
+		// In exact arithmetic GMRES(n) finds the solution of an n×n system
+		// in at most n inner steps, that is in a single iteration, whatever
+		// the spectrum of A. Use random matrices that are far from being
+		// diagonally dominant so that a few inner steps are not enough.
+		a := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		want := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			want.SetVec(i, rnd.NormFloat64())
+		}
+		var b mat.VecDense
+		b.MulVec(a, want)
+
+		for _, pc := range []preconCase{noPrecon, jacobi} {
+			name := fmt.Sprintf("n=%d,precon=%s", n, pc.name)
+			precon, err := pc.precon(a)
+			if err != nil {
+				t.Fatalf("%s: unexpected error constructing preconditioner: %v", name, err)
+			}
+			result, err := Iterative(a, &b, &GMRES{Restart: n}, &Settings{
+				Tolerance:      tol,
+				MaxIterations:  1,
+				Preconditioner: precon,
+			})
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if result.Iterations != 1 {
+				t.Errorf("%s: unexpected number of iterations: got %d, want 1", name, result.Iterations)
+			}
+			// One product for the initial residual and at most one per
+			// inner step.
+			if result.MulVec > n+1 {
+				t.Errorf("%s: too many inner steps: got %d, want <= %d", name, result.MulVec-1, n)
+			}
+
+			var r mat.VecDense
+			r.MulVec(a, result.X)
+			r.SubVec(&b, &r)
+			if rNorm, bNorm := mat.Norm(&r, 2), mat.Norm(&b, 2); rNorm > 100*tol*bNorm {
+				t.Errorf("%s: residual too large: got %v, want <= %v", name, rNorm, 100*tol*bNorm)
+			}
+		}
+	}
+}
+
+func TestGMRESRestartCycle(t *testing.T) {
+	t.Parallel()
+	// A restart cycle of GMRES(m) must take m inner steps unless it has
+	// converged, so that the number of iterations is about the number of
+	// inner steps divided by m.
+	const (
+		n       = 40
+		restart = 10
+	)
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := laplacian2D(8, 5, 0.9, 0)
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		b.SetVec(i, rnd.NormFloat64())
+	}
+	result, err := Iterative(a, b, &GMRES{Restart: restart}, &Settings{Tolerance: 1e-10, MaxIterations: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// One product for the initial residual, one per inner step and one for
+	// the true residual at each restart.
+	steps := result.MulVec - 1 - (result.Iterations - 1)
+	if steps <= (result.Iterations-1)*restart {
+		t.Errorf("restart cycles too short: %d inner steps in %d iterations", steps, result.Iterations)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrIndefinitePreconditioner is returned by MINRES when the preconditioner
+// is found not to be positive definite.
+var ErrIndefinitePreconditioner = errors.New("minres: preconditioner is not positive definite")
+
+// MINRES implements the Minimum Residual method with preconditioning for
+// solving systems of linear equations
+//
+//	A⋅x = b,
+//
+// where A is a symmetric, possibly indefinite, matrix. The preconditioner
+// must be symmetric positive definite.
+//
+// MINRES minimizes the residual norm over a Krylov subspace using short
+// recurrences, so it requires only a small, constant amount of storage.
+// When a preconditioner M is used, the residual norm checked for convergence
+// is the M⁻¹-norm of the residual, sqrt(rᵀ⋅M⁻¹⋅r).
+//
+// References:
+//   - Paige, C., and Saunders, M. (1975). Solution of sparse indefinite
+//     systems of linear equations. SIAM J. Numer. Anal., 12(4), 617-629.
+//   - Choi, S.-C. T. (2006). Iterative methods for singular linear equations
+//     and least-squares problems (Doctoral dissertation, Stanford University).
+type MINRES struct {
+	x mat.VecDense
+
+	// r1, r2 and y hold the last two Lanczos vectors before normalization
+	// and the preconditioned one.
+	r1, r2, y *mat.VecDense
+	v         mat.VecDense
+	// w, w1 and w2 hold the last three search directions.
+	w, w1, w2 *mat.VecDense
+
+	alpha, beta, oldb float64
+	dbar, epsln       float64
+	phibar, cs, sn    float64
+	first             bool
+	vecs              [6]mat.VecDense
+	resume            int
+}
+
+// Init initializes the data for a linear solve. See the Method interface for
+// more details.
+func (m *MINRES) Init(x, residual *mat.VecDense) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("minres: vector length mismatch")
+	}
+
+	m.x.CloneFromVec(x)
+	m.v.Reset()
+	m.v.ReuseAsVec(n)
+	for i := range m.vecs {
+		m.vecs[i].Reset()
+		m.vecs[i].ReuseAsVec(n)
+	}
+	m.r1, m.r2, m.y = &m.vecs[0], &m.vecs[1], &m.vecs[2]
+	m.w, m.w1, m.w2 = &m.vecs[3], &m.vecs[4], &m.vecs[5]
+	m.r1.CopyVec(residual)
+	m.r2.CopyVec(residual)
+
+	m.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method interface
+// for more details.
+//
+// MINRES will command the following operations:
+//
+//	MulVec
+//	PreconSolve
+//	CheckResidualNorm
+//	MajorIteration
+func (m *MINRES) Iterate(ctx *Context) (Operation, error) {
+	switch m.resume {
+	case 1:
+		// Solve M⋅y = r_0.
+		ctx.Src.CopyVec(m.r1)
+		m.resume = 2
+		return PreconSolve, nil
+	case 2:
+		m.y.CopyVec(ctx.Dst)
+		beta1 := mat.Dot(m.r1, m.y)
+		if beta1 <= 0 {
+			m.resume = 0
+			return NoOperation, ErrIndefinitePreconditioner
+		}
+		beta1 = math.Sqrt(beta1)
+		m.beta = beta1
+		m.oldb = 0
+		m.dbar = 0
+		m.epsln = 0
+		m.phibar = beta1
+		m.cs = -1
+		m.sn = 0
+		m.first = true
+		m.resume = 3
+		return m.Iterate(ctx)
+	case 3:
+		// Compute the next Lanczos vector and A⋅v.
+		m.v.ScaleVec(1/m.beta, m.y)
+		ctx.Src.CopyVec(&m.v)
+		m.resume = 4
+		return MulVec, nil
+	case 4:
+		m.y.CopyVec(ctx.Dst)
+		if !m.first {
+			m.y.AddScaledVec(m.y, -m.beta/m.oldb, m.r1)
+		}
+		m.alpha = mat.Dot(&m.v, m.y)
+		m.y.AddScaledVec(m.y, -m.alpha/m.beta, m.r2)
+		m.r1, m.r2, m.y = m.r2, m.y, m.r1
+		// Solve M⋅y = r2.
+		ctx.Src.CopyVec(m.r2)
+		m.resume = 5
+		return PreconSolve, nil
+	case 5:
+		m.y.CopyVec(ctx.Dst)
+		m.oldb = m.beta
+		beta := mat.Dot(m.r2, m.y)
+		if beta < 0 {
+			m.resume = 0
+			return NoOperation, ErrIndefinitePreconditioner
+		}
+		m.beta = math.Sqrt(beta)
+		m.first = false
+
+		// Apply the previous rotation and compute the next one to
+		// eliminate β from the tridiagonal Lanczos matrix.
+		oldeps := m.epsln
+		delta := m.cs*m.dbar + m.sn*m.alpha
+		gbar := m.sn*m.dbar - m.cs*m.alpha
+		m.epsln = m.sn * m.beta
+		m.dbar = -m.cs * m.beta
+		gamma := math.Max(math.Hypot(gbar, m.beta), dlamchE)
+		m.cs = gbar / gamma
+		m.sn = m.beta / gamma
+		phi := m.cs * m.phibar
+		m.phibar *= m.sn
+
+		// Update the search direction and the solution.
+		m.w1, m.w2, m.w = m.w2, m.w, m.w1
+		m.w.AddScaledVec(&m.v, -oldeps, m.w1)
+		m.w.AddScaledVec(m.w, -delta, m.w2)
+		m.w.ScaleVec(1/gamma, m.w)
+		m.x.AddScaledVec(&m.x, phi, m.w)
+
+		ctx.ResidualNorm = math.Abs(m.phibar)
+		m.resume = 6
+		return CheckResidualNorm, nil
+	case 6:
+		ctx.X.CopyVec(&m.x)
+		m.resume = 3
+		if ctx.Converged {
+			m.resume = 0
+		} else if m.beta == 0 {
+			// The Krylov subspace is invariant, so no further progress
+			// is possible.
+			m.resume = 0
+			return NoOperation, &BreakdownError{Value: m.beta}
+		}
+		return MajorIteration, nil
+
+	default:
+		panic("minres: Init not called")
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrZeroPivot is returned when a preconditioner cannot be constructed
+	// because of a zero diagonal element or pivot.
+	ErrZeroPivot = errors.New("linsolve: zero pivot")
+
+	// ErrNotPositiveDefinite is returned when an incomplete Cholesky
+	// factorization encounters a non-positive pivot.
+	ErrNotPositiveDefinite = errors.New("linsolve: matrix not positive definite")
+)
+
+var (
+	_ Preconditioner = (*Jacobi)(nil)
+	_ Preconditioner = (*SSOR)(nil)
+	_ Preconditioner = (*ILU0)(nil)
+	_ Preconditioner = (*IC0)(nil)
+)
+
+// rowMatrix is a square matrix stored in compressed sparse row format with
+// sorted column indices. It is used for the construction and application of
+// preconditioners.
+type rowMatrix struct {
+	n      int
+	indptr []int
+	ind    []int
+	data   []float64
+	// diag holds the position of the diagonal element of each row in ind
+	// and data, or -1 if the diagonal element is not stored.
+	diag []int
+}
+
+// newRowMatrix returns the non-zero elements of the square matrix a in row
+// format. If lower is true, only the lower triangle of a is stored. If a is a
+// mat.RowNonZeroDoer, its DoRowNonZero method is used to find the non-zero
+// elements, otherwise every element of a is visited.
+func newRowMatrix(a mat.Matrix, lower bool) *rowMatrix {
+	n, c := a.Dims()
+	if n != c {
+		panic(mat.ErrSquare)
+	}
+	m := &rowMatrix{
+		n:      n,
+		indptr: make([]int, n+1),
+		diag:   make([]int, n),
+	}
+	var row []rowElem
+	for i := 0; i < n; i++ {
+		row = row[:0]
+		add := func(_, j int, v float64) {
+			if lower && j > i {
+				return
+			}
+			row = append(row, rowElem{j, v})
+		}
+		if rnz, ok := a.(mat.RowNonZeroDoer); ok {
+			rnz.DoRowNonZero(i, add)
+		} else {
+			for j := 0; j < n; j++ {
+				if v := a.At(i, j); v != 0 {
+					add(i, j, v)
+				}
+			}
+		}
+		sort.Sort(byColumn(row))
+		m.diag[i] = -1
+		for _, e := range row {
+			if e.j == i {
+				m.diag[i] = len(m.ind)
+			}
+			m.ind = append(m.ind, e.j)
+			m.data = append(m.data, e.v)
+		}
+		m.indptr[i+1] = len(m.ind)
+	}
+	return m
+}
+
+type rowElem struct {
+	j int
+	v float64
+}
+
+type byColumn []rowElem
+
+func (r byColumn) Len() int           { return len(r) }
+func (r byColumn) Less(i, j int) bool { return r[i].j < r[j].j }
+func (r byColumn) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+
+// diagonal returns the diagonal elements of m in a new slice.
+func (m *rowMatrix) diagonal() []float64 {
+	d := make([]float64, m.n)
+	for i, k := range m.diag {
+		if k >= 0 {
+			d[i] = m.data[k]
+		}
+	}
+	return d
+}
+
+// lowerSolve solves in place the lower triangular system
+//
+//	(D + L)⋅x = b   if trans == false,
+//	(D + Uᵀ)⋅x = b  if trans == true,
+//
+// where L and U are the strictly lower and upper triangles of m and D is the
+// diagonal matrix with the elements of d. If d is nil, D is the identity. On
+// entry x holds b.
+func (m *rowMatrix) lowerSolve(x, d []float64, trans bool) {
+	if !trans {
+		for i := 0; i < m.n; i++ {
+			s := x[i]
+			for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+				j := m.ind[k]
+				if j >= i {
+					break
+				}
+				s -= m.data[k] * x[j]
+			}
+			if d != nil {
+				s /= d[i]
+			}
+			x[i] = s
+		}
+		return
+	}
+	for i := 0; i < m.n; i++ {
+		if d != nil {
+			x[i] /= d[i]
+		}
+		xi := x[i]
+		for k := m.indptr[i+1] - 1; k >= m.indptr[i]; k-- {
+			j := m.ind[k]
+			if j <= i {
+				break
+			}
+			x[j] -= m.data[k] * xi
+		}
+	}
+}
+
+// upperSolve solves in place the upper triangular system
+//
+//	(D + U)⋅x = b   if trans == false,
+//	(D + Lᵀ)⋅x = b  if trans == true,
+//
+// where L and U are the strictly lower and upper triangles of m and D is the
+// diagonal matrix with the elements of d. If d is nil, D is the identity. On
+// entry x holds b.
+func (m *rowMatrix) upperSolve(x, d []float64, trans bool) {
+	if !trans {
+		for i := m.n - 1; i >= 0; i-- {
+			s := x[i]
+			for k := m.indptr[i+1] - 1; k >= m.indptr[i]; k-- {
+				j := m.ind[k]
+				if j <= i {
+					break
+				}
+				s -= m.data[k] * x[j]
+			}
+			if d != nil {
+				s /= d[i]
+			}
+			x[i] = s
+		}
+		return
+	}
+	for i := m.n - 1; i >= 0; i-- {
+		if d != nil {
+			x[i] /= d[i]
+		}
+		xi := x[i]
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			j := m.ind[k]
+			if j >= i {
+				break
+			}
+			x[j] -= m.data[k] * xi
+		}
+	}
+}
+
+// copyToSlice copies the elements of rhs into dst, allocating it if needed,
+// and returns the contiguous backing data of dst.
+func copyToSlice(dst *mat.VecDense, rhs mat.Vector) []float64 {
+	n := rhs.Len()
+	if dst.IsEmpty() {
+		dst.ReuseAsVec(n)
+	} else if dst.Len() != n {
+		panic(mat.ErrShape)
+	}
+	dst.CopyVec(rhs)
+	raw := dst.RawVector()
+	if raw.Inc != 1 {
+		panic("linsolve: non-unit increment destination")
+	}
+	return raw.Data[:n]
+}
+
+// Jacobi is the Jacobi, or diagonal, preconditioner M = diag(A).
+type Jacobi struct {
+	d []float64
+}
+
+// NewJacobi returns a Jacobi preconditioner for the square matrix a. It
+// returns ErrZeroPivot if a has a zero diagonal element.
+func NewJacobi(a mat.Matrix) (*Jacobi, error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(mat.ErrSquare)
+	}
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = a.At(i, i)
+		if d[i] == 0 {
+			return nil, ErrZeroPivot
+		}
+	}
+	return &Jacobi{d: d}, nil
+}
+
+// PreconSolve solves M⋅dst = rhs, storing the result into dst. Since M is
+// diagonal, trans is ignored.
+func (p *Jacobi) PreconSolve(dst *mat.VecDense, _ bool, rhs mat.Vector) error {
+	if rhs.Len() != len(p.d) {
+		panic(mat.ErrShape)
+	}
+	x := copyToSlice(dst, rhs)
+	for i, di := range p.d {
+		x[i] /= di
+	}
+	return nil
+}
+
+// SSOR is the symmetric successive over-relaxation preconditioner
+//
+//	M = ω/(2-ω) ⋅ (D/ω + L) ⋅ (D/ω)⁻¹ ⋅ (D/ω + U),
+//
+// where D is the diagonal and L and U are the strictly lower and upper
+// triangles of A. If A is symmetric positive definite, so is M.
+type SSOR struct {
+	a     *rowMatrix
+	d     []float64
+	omega float64
+}
+
+// NewSSOR returns an SSOR preconditioner for the square matrix a with the
+// relaxation parameter omega which must satisfy 0 < omega < 2. If a is a
+// mat.RowNonZeroDoer, only its non-zero elements are visited. NewSSOR returns
+// ErrZeroPivot if a has a zero diagonal element.
+func NewSSOR(a mat.Matrix, omega float64) (*SSOR, error) {
+	if omega <= 0 || 2 <= omega {
+		panic("linsolve: invalid relaxation parameter")
+	}
+	m := newRowMatrix(a, false)
+	d := m.diagonal()
+	for i := range d {
+		if d[i] == 0 {
+			return nil, ErrZeroPivot
+		}
+		d[i] /= omega
+	}
+	return &SSOR{a: m, d: d, omega: omega}, nil
+}
+
+// PreconSolve solves M⋅dst = rhs or Mᵀ⋅dst = rhs, storing the result into
+// dst.
+func (p *SSOR) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	if rhs.Len() != p.a.n {
+		panic(mat.ErrShape)
+	}
+	x := copyToSlice(dst, rhs)
+	p.a.lowerSolve(x, p.d, trans)
+	scale := (2 - p.omega) / p.omega
+	for i, di := range p.d {
+		x[i] *= scale * di
+	}
+	p.a.upperSolve(x, p.d, trans)
+	return nil
+}
+
+// ILU0 is the incomplete LU factorization preconditioner with zero fill-in,
+// M = L⋅U, where L is unit lower triangular, U is upper triangular and L+U
+// has the same sparsity pattern as A.
+type ILU0 struct {
+	lu *rowMatrix
+	d  []float64
+}
+
+// NewILU0 computes the incomplete LU factorization with zero fill-in of the
+// square matrix a. If a is a mat.RowNonZeroDoer, only its non-zero elements
+// are visited. NewILU0 returns ErrZeroPivot if a zero pivot is encountered.
+func NewILU0(a mat.Matrix) (*ILU0, error) {
+	m := newRowMatrix(a, false)
+	n := m.n
+	// pos maps a column index to its position in the current row.
+	pos := make([]int, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	for i := 0; i < n; i++ {
+		if m.diag[i] < 0 {
+			return nil, ErrZeroPivot
+		}
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			pos[m.ind[k]] = k
+		}
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			j := m.ind[k]
+			if j >= i {
+				break
+			}
+			// l_ij = a_ij / u_jj
+			m.data[k] /= m.data[m.diag[j]]
+			lij := m.data[k]
+			// Update the remainder of row i using row j of U, dropping
+			// any fill-in outside of the pattern of A.
+			for kk := m.diag[j] + 1; kk < m.indptr[j+1]; kk++ {
+				if p := pos[m.ind[kk]]; p >= 0 {
+					m.data[p] -= lij * m.data[kk]
+				}
+			}
+		}
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			pos[m.ind[k]] = -1
+		}
+		if m.data[m.diag[i]] == 0 {
+			return nil, ErrZeroPivot
+		}
+	}
+	return &ILU0{lu: m, d: m.diagonal()}, nil
+}
+
+// PreconSolve solves M⋅dst = rhs or Mᵀ⋅dst = rhs, storing the result into
+// dst.
+func (p *ILU0) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	if rhs.Len() != p.lu.n {
+		panic(mat.ErrShape)
+	}
+	x := copyToSlice(dst, rhs)
+	if !trans {
+		p.lu.lowerSolve(x, nil, false)
+		p.lu.upperSolve(x, p.d, false)
+	} else {
+		p.lu.lowerSolve(x, p.d, true)
+		p.lu.upperSolve(x, nil, true)
+	}
+	return nil
+}
+
+// IC0 is the incomplete Cholesky factorization preconditioner with zero
+// fill-in, M = L⋅Lᵀ, where L is lower triangular and has the same sparsity
+// pattern as the lower triangle of the symmetric matrix A.
+type IC0 struct {
+	l *rowMatrix
+	d []float64
+}
+
+// NewIC0 computes the incomplete Cholesky factorization with zero fill-in of
+// the symmetric matrix a. Only the lower triangle of a is accessed. If a is a
+// mat.RowNonZeroDoer, only its non-zero elements are visited. NewIC0 returns
+// ErrNotPositiveDefinite if a non-positive pivot is encountered, which may
+// happen even for positive definite matrices.
+func NewIC0(a mat.Matrix) (*IC0, error) {
+	m := newRowMatrix(a, true)
+	for i := 0; i < m.n; i++ {
+		if m.diag[i] < 0 {
+			return nil, ErrNotPositiveDefinite
+		}
+		for k := m.indptr[i]; k <= m.diag[i]; k++ {
+			j := m.ind[k]
+			// Compute the sparse dot product of the rows i and j of L
+			// restricted to columns less than j.
+			s := m.data[k]
+			ki, kj := m.indptr[i], m.indptr[j]
+			for ki < k && kj < m.diag[j] {
+				switch {
+				case m.ind[ki] < m.ind[kj]:
+					ki++
+				case m.ind[ki] > m.ind[kj]:
+					kj++
+				default:
+					s -= m.data[ki] * m.data[kj]
+					ki++
+					kj++
+				}
+			}
+			if j < i {
+				m.data[k] = s / m.data[m.diag[j]]
+				continue
+			}
+			if s <= 0 {
+				return nil, ErrNotPositiveDefinite
+			}
+			m.data[k] = math.Sqrt(s)
+		}
+	}
+	return &IC0{l: m, d: m.diagonal()}, nil
+}
+
+// PreconSolve solves M⋅dst = rhs, storing the result into dst. Since M is
+// symmetric, trans is ignored.
+func (p *IC0) PreconSolve(dst *mat.VecDense, _ bool, rhs mat.Vector) error {
+	if rhs.Len() != p.l.n {
+		panic(mat.ErrShape)
+	}
+	x := copyToSlice(dst, rhs)
+	p.l.lowerSolve(x, p.d, false)
+	p.l.upperSolve(x, p.d, true)
+	return nil
+}
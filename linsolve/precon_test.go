@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// checkPreconSolve checks that p solves M⋅x = b and Mᵀ⋅x = b for the dense
+// matrix M.
+func checkPreconSolve(t *testing.T, name string, p Preconditioner, m *mat.Dense, rnd *rand.Rand) {
+	t.Helper()
+	n, _ := m.Dims()
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		b.SetVec(i, rnd.NormFloat64())
+	}
+	for _, trans := range []bool{false, true} {
+		var want mat.VecDense
+		var err error
+		if trans {
+			err = want.SolveVec(m.T(), b)
+		} else {
+			err = want.SolveVec(m, b)
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error in dense solve: %v", name, err)
+		}
+		var got mat.VecDense
+		err = p.PreconSolve(&got, trans, b)
+		if err != nil {
+			t.Errorf("%s,trans=%t: unexpected error: %v", name, trans, err)
+			continue
+		}
+		if !mat.EqualApprox(&got, &want, 1e-12) {
+			t.Errorf("%s,trans=%t: unexpected result:\ngot  %v\nwant %v", name, trans, mat.Formatted(got.T()), mat.Formatted(want.T()))
+		}
+	}
+}
+
+func TestPreconditioners(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 20} {
+		// A nonsymmetric tridiagonal matrix and a symmetric positive
+		// definite tridiagonal matrix. The incomplete factorizations
+		// of tridiagonal matrices do not drop any fill-in and so are
+		// exact.
+		dl := make([]float64, n-1)
+		d := make([]float64, n)
+		du := make([]float64, n-1)
+		for i := range d {
+			d[i] = 4 + rnd.Float64()
+		}
+		for i := range dl {
+			dl[i] = rnd.NormFloat64()
+			du[i] = rnd.NormFloat64()
+		}
+		nonsym := mat.NewTridiag(n, dl, d, du)
+		sym := mat.NewTridiag(n, dl, d, dl)
+
+		var dense mat.Dense
+		dense.CloneFrom(nonsym)
+		ilu, err := NewILU0(nonsym)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error in NewILU0: %v", n, err)
+		}
+		checkPreconSolve(t, fmt.Sprintf("ILU0,n=%d", n), ilu, &dense, rnd)
+
+		var symDense mat.Dense
+		symDense.CloneFrom(sym)
+		ic, err := NewIC0(sym)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error in NewIC0: %v", n, err)
+		}
+		checkPreconSolve(t, fmt.Sprintf("IC0,n=%d", n), ic, &symDense, rnd)
+
+		jac, err := NewJacobi(nonsym)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error in NewJacobi: %v", n, err)
+		}
+		diag := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			diag.Set(i, i, d[i])
+		}
+		checkPreconSolve(t, fmt.Sprintf("Jacobi,n=%d", n), jac, diag, rnd)
+
+		// Construct the SSOR matrix explicitly from a dense random matrix.
+		const omega = 1.3
+		a := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+			a.Set(i, i, float64(n)+rnd.Float64())
+		}
+		lower := mat.NewDense(n, n, nil)
+		upper := mat.NewDense(n, n, nil)
+		dInv := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				switch {
+				case j < i:
+					lower.Set(i, j, a.At(i, j))
+				case j > i:
+					upper.Set(i, j, a.At(i, j))
+				default:
+					lower.Set(i, i, a.At(i, i)/omega)
+					upper.Set(i, i, a.At(i, i)/omega)
+					dInv.Set(i, i, omega/a.At(i, i))
+				}
+			}
+		}
+		var m mat.Dense
+		m.Product(lower, dInv, upper)
+		m.Scale(omega/(2-omega), &m)
+		ssor, err := NewSSOR(a, omega)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error in NewSSOR: %v", n, err)
+		}
+		checkPreconSolve(t, fmt.Sprintf("SSOR,n=%d", n), ssor, &m, rnd)
+	}
+}
+
+func TestIC0NotPositiveDefinite(t *testing.T) {
+	t.Parallel()
+	a := mat.NewSymDense(2, []float64{
+		1, 2,
+		2, 1,
+	})
+	_, err := NewIC0(a)
+	if err != ErrNotPositiveDefinite {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrNotPositiveDefinite)
+	}
+}
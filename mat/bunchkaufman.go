@@ -0,0 +1,285 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badBunchKaufman = "mat: invalid Bunch-Kaufman factorization"
+
+// BunchKaufman is a symmetric, possibly indefinite, matrix represented by its
+// Bunch-Kaufman factorization
+//
+//	A = U * D * Uᵀ
+//
+// where U is a product of permutation and unit upper triangular matrices and
+// D is symmetric and block diagonal with 1×1 and 2×2 diagonal blocks.
+//
+// The factorization is useful for solving symmetric indefinite systems, such
+// as saddle-point problems, for which the Cholesky factorization does not
+// exist. It preserves symmetry and requires half the work of an LU
+// factorization.
+type BunchKaufman struct {
+	// fact holds D and the multipliers of U in its upper triangle.
+	fact *SymDense
+	ipiv []int
+	ok   bool // Whether D is nonsingular.
+	cond float64
+}
+
+// Factorize computes the Bunch-Kaufman factorization of the symmetric matrix
+// A and stores the result in the receiver. The factorization will complete
+// regardless of the singularity of a.
+func (bk *BunchKaufman) Factorize(a Symmetric) {
+	n := a.SymmetricDim()
+	if bk.fact == nil {
+		bk.fact = NewSymDense(n, nil)
+	} else {
+		bk.fact.Reset()
+		bk.fact.reuseAsNonZeroed(n)
+	}
+	bk.fact.CopySym(a)
+	bk.ipiv = useInt(bk.ipiv, n)
+
+	work := getFloat64s(2*n, false)
+	defer putFloat64s(work)
+	anorm := lapack64.Lansy(CondNorm, bk.fact.mat, work)
+	lwork := []float64{0}
+	lapack64.Sytrf(bk.fact.mat, bk.ipiv, lwork, -1)
+	fwork := getFloat64s(int(lwork[0]), false)
+	bk.ok = lapack64.Sytrf(bk.fact.mat, bk.ipiv, fwork, len(fwork))
+	putFloat64s(fwork)
+
+	if !bk.ok {
+		bk.cond = math.Inf(1)
+		return
+	}
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	v := lapack64.Sycon(bk.fact.mat, bk.ipiv, anorm, work, iwork)
+	bk.cond = 1 / v
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (bk *BunchKaufman) isValid() bool {
+	return bk.fact != nil && !bk.fact.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (bk *BunchKaufman) Reset() {
+	if bk.fact != nil {
+		bk.fact.Reset()
+	}
+	bk.ipiv = bk.ipiv[:0]
+	bk.ok = false
+	bk.cond = math.Inf(1)
+}
+
+// IsEmpty returns whether the receiver is empty. Empty factorizations can be
+// the receiver for size-restricted operations. The receiver can be emptied
+// using Reset.
+func (bk *BunchKaufman) IsEmpty() bool {
+	return !bk.isValid()
+}
+
+// SymmetricDim returns the dimension of the factorized matrix.
+func (bk *BunchKaufman) SymmetricDim() int {
+	if bk.fact == nil {
+		return 0
+	}
+	return bk.fact.SymmetricDim()
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Cond() float64 {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	return bk.cond
+}
+
+// blocks calls fn with the elements of each diagonal block of D. For 1×1
+// blocks, d12 and d22 are zero and two is false.
+func (bk *BunchKaufman) blocks(fn func(d11, d12, d22 float64, two bool)) {
+	n := bk.fact.mat.N
+	data := bk.fact.mat.Data
+	stride := bk.fact.mat.Stride
+	// A 2×2 block occupies rows and columns k and k+1 where
+	// ipiv[k] = ipiv[k+1] < 0.
+	for k := 0; k < n; {
+		if bk.ipiv[k] >= 0 {
+			fn(data[k*stride+k], 0, 0, false)
+			k++
+			continue
+		}
+		fn(data[k*stride+k], data[k*stride+k+1], data[(k+1)*stride+k+1], true)
+		k += 2
+	}
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Det() float64 {
+	det, sign := bk.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) LogDet() (det float64, sign float64) {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	// The determinants of the permutations cancel and U has unit diagonal,
+	// so det(A) = det(D).
+	sign = 1
+	bk.blocks(func(d11, d12, d22 float64, two bool) {
+		v := d11
+		if two {
+			// Compute the determinant of the 2×2 block as in Dsytrs
+			// to avoid overflow.
+			v = d12 * d12 * (d11/d12*(d22/d12) - 1)
+		}
+		if v < 0 {
+			sign = -sign
+		}
+		det += math.Log(math.Abs(v))
+	})
+	return det, sign
+}
+
+// Inertia returns the inertia of the factorized matrix, that is the number of
+// positive, negative and zero eigenvalues. By Sylvester's law of inertia
+// these are the same for A and D.
+// Inertia will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) Inertia() (pos, neg, zero int) {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	count := func(v float64) {
+		switch {
+		case v > 0:
+			pos++
+		case v < 0:
+			neg++
+		default:
+			zero++
+		}
+	}
+	bk.blocks(func(d11, d12, d22 float64, two bool) {
+		if !two {
+			count(d11)
+			return
+		}
+		// The eigenvalues of a symmetric 2×2 block are real.
+		rt1, rt2 := eigSym2(d11, d12, d22)
+		count(rt1)
+		count(rt2)
+	})
+	return pos, neg, zero
+}
+
+// eigSym2 returns the eigenvalues of the symmetric 2×2 matrix
+//
+//	[a b]
+//	[b c]
+func eigSym2(a, b, c float64) (rt1, rt2 float64) {
+	mean := (a + c) / 2
+	rad := math.Hypot((a-c)/2, b)
+	// Compute the eigenvalue of larger magnitude directly and the other
+	// from the determinant to avoid cancellation.
+	if mean >= 0 {
+		rt1 = mean + rad
+	} else {
+		rt1 = mean - rad
+	}
+	if rt1 != 0 {
+		rt2 = (a*c - b*b) / rt1
+	}
+	return rt1, rt2
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the Bunch-Kaufman factorization. The result is stored in-place into dst.
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) SolveTo(dst *Dense, b Matrix) error {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	n := bk.fact.mat.N
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	if !bk.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		dst.checkOverlap(rm.RawMatrix())
+	}
+
+	dst.Copy(b)
+	lapack64.Sytrs(bk.fact.mat, bk.ipiv, dst.mat)
+	if bk.cond > ConditionTolerance {
+		return Condition(bk.cond)
+	}
+	return nil
+}
+
+// SolveVecTo finds the vector x that solves A * x = b where A is represented
+// by the Bunch-Kaufman factorization. The result is stored in-place into dst.
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (bk *BunchKaufman) SolveVecTo(dst *VecDense, b Vector) error {
+	if !bk.isValid() {
+		panic(badBunchKaufman)
+	}
+	n := bk.fact.mat.N
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	switch rv := b.(type) {
+	default:
+		dst.reuseAsNonZeroed(n)
+		return bk.SolveTo(dst.asDense(), b)
+	case RawVectorer:
+		if dst != b {
+			dst.checkOverlap(rv.RawVector())
+		}
+
+		if !bk.ok {
+			return Condition(math.Inf(1))
+		}
+
+		dst.reuseAsNonZeroed(n)
+		if dst != b {
+			dst.CopyVec(b)
+		}
+		lapack64.Sytrs(bk.fact.mat, bk.ipiv, dst.asGeneral())
+		if bk.cond > ConditionTolerance {
+			return Condition(bk.cond)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// randomIndefinite returns a random n×n symmetric indefinite matrix. If
+// zeroDiag is true the diagonal is zero, which forces the use of 2×2 pivots.
+func randomIndefinite(n int, zeroDiag bool, rnd *rand.Rand) *SymDense {
+	a := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, rnd.NormFloat64())
+		}
+		if zeroDiag {
+			a.SetSym(i, i, 0)
+		}
+	}
+	return a
+}
+
+func TestBunchKaufman(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 31} {
+		for _, zeroDiag := range []bool{false, true} {
+			if zeroDiag && n == 1 {
+				continue
+			}
+			name := fmt.Sprintf("n=%d zeroDiag=%t", n, zeroDiag)
+			a := randomIndefinite(n, zeroDiag, rnd)
+
+			var bk BunchKaufman
+			bk.Factorize(a)
+			if bk.SymmetricDim() != n {
+				t.Errorf("%s: unexpected dimension %d", name, bk.SymmetricDim())
+			}
+
+			// The solution satisfies A * X = B.
+			b := NewDense(n, 3, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < 3; j++ {
+					b.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			var x Dense
+			err := bk.SolveTo(&x, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var ax Dense
+			ax.Mul(a, &x)
+			if !EqualApprox(&ax, b, tol) {
+				t.Errorf("%s: A*X != B", name)
+			}
+
+			// SolveVecTo agrees with SolveTo.
+			var xv VecDense
+			err = bk.SolveVecTo(&xv, b.ColView(1))
+			if err != nil {
+				t.Errorf("%s: unexpected error from SolveVecTo: %v", name, err)
+			}
+			if !EqualApprox(&xv, x.ColView(1), tol) {
+				t.Errorf("%s: SolveVecTo does not match SolveTo", name)
+			}
+
+			// Compare the determinant with the LU factorization.
+			var lu LU
+			lu.Factorize(a)
+			wantDet, wantSign := lu.LogDet()
+			det, sign := bk.LogDet()
+			if sign != wantSign || math.Abs(det-wantDet) > tol*math.Max(1, math.Abs(wantDet)) {
+				t.Errorf("%s: unexpected log determinant: got (%v,%v), want (%v,%v)", name, det, sign, wantDet, wantSign)
+			}
+
+			// Compare the inertia with the eigenvalues.
+			var es EigenSym
+			if !es.Factorize(a, false) {
+				t.Fatalf("%s: bad test: eigendecomposition failed", name)
+			}
+			var wantPos, wantNeg int
+			for _, v := range es.Values(nil) {
+				if v > 0 {
+					wantPos++
+				} else {
+					wantNeg++
+				}
+			}
+			pos, neg, zero := bk.Inertia()
+			if pos != wantPos || neg != wantNeg || zero != 0 {
+				t.Errorf("%s: unexpected inertia: got (%d,%d,%d), want (%d,%d,0)", name, pos, neg, zero, wantPos, wantNeg)
+			}
+
+			// The condition number estimate is of the same order as the
+			// one from the LU factorization.
+			if c, want := bk.Cond(), lu.Cond(); c > 10*want || c < want/10 {
+				t.Errorf("%s: unexpected condition number: got %v, want approximately %v", name, c, want)
+			}
+		}
+	}
+
+	// A saddle-point matrix
+	//  [ H  Jᵀ ]
+	//  [ J  0  ]
+	// with positive definite H and full rank J has n positive and m negative
+	// eigenvalues.
+	const n, m = 6, 3
+	h := randomPosDef(n, rnd)
+	kkt := NewSymDense(n+m, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			kkt.SetSym(i, j, h.At(i, j))
+		}
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			kkt.SetSym(j, n+i, rnd.NormFloat64())
+		}
+	}
+	var bk BunchKaufman
+	bk.Factorize(kkt)
+	if pos, neg, zero := bk.Inertia(); pos != n || neg != m || zero != 0 {
+		t.Errorf("unexpected inertia of saddle-point matrix: got (%d,%d,%d), want (%d,%d,0)", pos, neg, zero, n, m)
+	}
+
+	// A singular matrix.
+	bk.Factorize(NewSymDense(3, []float64{
+		1, 2, 0,
+		2, 1, 0,
+		0, 0, 0,
+	}))
+	var x Dense
+	if err := bk.SolveTo(&x, NewDense(3, 1, nil)); err == nil {
+		t.Errorf("expected error for singular matrix")
+	}
+	if pos, neg, zero := bk.Inertia(); pos != 1 || neg != 1 || zero != 1 {
+		t.Errorf("unexpected inertia of singular matrix: got (%d,%d,%d), want (1,1,1)", pos, neg, zero)
+	}
+	if det := bk.Det(); det != 0 {
+		t.Errorf("unexpected determinant of singular matrix: got %v, want 0", det)
+	}
+}
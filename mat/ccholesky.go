@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+const badCCholesky = "mat: invalid complex Cholesky factorization"
+
+// CCholesky is a Hermitian positive definite matrix represented by its
+// Cholesky decomposition
+//
+//	A = Uᴴ * U
+//
+// where U is upper triangular with real positive diagonal elements.
+//
+// CCholesky methods may only be called on a value that has been successfully
+// initialized by a call to Factorize that has returned true. Calls to methods
+// of an unsuccessful CCholesky factorization will panic.
+type CCholesky struct {
+	// chol holds U in its upper triangle. The strictly lower triangle
+	// is not referenced.
+	chol *CDense
+}
+
+// Dims returns the dimensions of the matrix A.
+func (c *CCholesky) Dims() (r, cols int) {
+	if c.chol == nil {
+		return 0, 0
+	}
+	return c.chol.Dims()
+}
+
+// Factorize calculates the Cholesky decomposition of the matrix A and returns
+// whether the matrix is positive definite. A must be square and is assumed to
+// be Hermitian; only its upper triangle is referenced. If Factorize returns
+// false, the factorization must not be used.
+func (c *CCholesky) Factorize(a CMatrix) (ok bool) {
+	n, nc := a.Dims()
+	if n != nc {
+		panic(ErrSquare)
+	}
+	if c.chol == nil {
+		c.chol = NewCDense(n, n, nil)
+	} else {
+		c.chol.Reset()
+		c.chol.reuseAsNonZeroed(n, n)
+	}
+	c.chol.Copy(a)
+	_, ok = lapack128.Potrf(c.asHermitian())
+	if !ok {
+		c.Reset()
+	}
+	return ok
+}
+
+// asHermitian returns the factorization storage as a cblas128.Hermitian
+// referencing the upper triangle.
+func (c *CCholesky) asHermitian() cblas128.Hermitian {
+	return cblas128.Hermitian{
+		N:      c.chol.mat.Rows,
+		Stride: c.chol.mat.Stride,
+		Data:   c.chol.mat.Data,
+		Uplo:   blas.Upper,
+	}
+}
+
+// asTriangular returns the factor U as a cblas128.Triangular.
+func (c *CCholesky) asTriangular() cblas128.Triangular {
+	return cblas128.Triangular{
+		N:      c.chol.mat.Rows,
+		Stride: c.chol.mat.Stride,
+		Data:   c.chol.mat.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (c *CCholesky) isValid() bool {
+	return c.chol != nil && !c.chol.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (c *CCholesky) Reset() {
+	if c.chol != nil {
+		c.chol.Reset()
+	}
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (c *CCholesky) IsEmpty() bool {
+	return c.chol == nil || c.chol.IsEmpty()
+}
+
+// Det returns the determinant of the matrix that has been factorized. The
+// determinant of a Hermitian positive definite matrix is real and positive.
+func (c *CCholesky) Det() float64 {
+	if !c.isValid() {
+		panic(badCCholesky)
+	}
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been factorized.
+func (c *CCholesky) LogDet() float64 {
+	if !c.isValid() {
+		panic(badCCholesky)
+	}
+	var det float64
+	n := c.chol.mat.Rows
+	for i := 0; i < n; i++ {
+		det += 2 * math.Log(real(c.chol.mat.Data[i*c.chol.mat.Stride+i]))
+	}
+	return det
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition. The result is stored in-place into dst.
+//
+// The factorization of a positive definite matrix is never singular, so
+// SolveTo does not return a Condition error unless the factor U has a zero
+// on its diagonal, which can only occur through underflow.
+func (c *CCholesky) SolveTo(dst *CDense, b CMatrix) error {
+	if !c.isValid() {
+		panic(badCCholesky)
+	}
+	n := c.chol.mat.Rows
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+
+	dst.reuseAsNonZeroed(bm, bn)
+	bU, _, _ := untransposeCmplx(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawCMatrixer); ok {
+		dst.checkOverlap(rm.RawCMatrix())
+	}
+
+	for i := 0; i < n; i++ {
+		if c.chol.mat.Data[i*c.chol.mat.Stride+i] == 0 {
+			return Condition(math.Inf(1))
+		}
+	}
+	dst.Copy(b)
+	lapack128.Potrs(c.asTriangular(), dst.mat)
+	return nil
+}
+
+// UTo stores into dst the n×n upper triangular matrix U from a Cholesky
+// decomposition
+//
+//	A = Uᴴ * U.
+//
+// If dst is empty, it is resized to be an n×n matrix. When dst is non-empty,
+// UTo panics if dst is not n×n.
+func (c *CCholesky) UTo(dst *CDense) {
+	if !c.isValid() {
+		panic(badCCholesky)
+	}
+	n := c.chol.mat.Rows
+	dst.reuseAsZeroed(n, n)
+	for i := 0; i < n; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride+i:i*dst.mat.Stride+n], c.chol.mat.Data[i*c.chol.mat.Stride+i:i*c.chol.mat.Stride+n])
+	}
+}
+
+// LTo stores into dst the n×n lower triangular matrix L from a Cholesky
+// decomposition
+//
+//	A = L * Lᴴ.
+//
+// If dst is empty, it is resized to be an n×n matrix. When dst is non-empty,
+// LTo panics if dst is not n×n.
+func (c *CCholesky) LTo(dst *CDense) {
+	if !c.isValid() {
+		panic(badCCholesky)
+	}
+	n := c.chol.mat.Rows
+	dst.reuseAsZeroed(n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			dst.mat.Data[j*dst.mat.Stride+i] = cmplx.Conj(c.chol.mat.Data[i*c.chol.mat.Stride+j])
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// Add adds a and b element-wise, placing the result in the receiver. Add
+// will panic if the two matrices do not have the same shape.
+func (m *CDense) Add(a, b CMatrix) {
+	m.addSub(a, b, 1)
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape.
+func (m *CDense) Sub(a, b CMatrix) {
+	m.addSub(a, b, -1)
+}
+
+// addSub places a + f*b into the receiver where f is ±1.
+func (m *CDense) addSub(a, b CMatrix, f complex128) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+
+	aU, aTrans, aConj := untransposeExtractCmplx(a)
+	bU, bTrans, bConj := untransposeExtractCmplx(b)
+	m.reuseAsNonZeroed(ar, ac)
+
+	if arm, ok := a.(*CDense); ok {
+		if brm, ok := b.(*CDense); ok {
+			amat, bmat := arm.mat, brm.mat
+			if m != aU {
+				m.checkOverlap(amat)
+			}
+			if m != bU {
+				m.checkOverlap(bmat)
+			}
+			for ja, jb, jm := 0, 0, 0; ja < ar*amat.Stride; ja, jb, jm = ja+amat.Stride, jb+bmat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = v + f*bmat.Data[i+jb]
+				}
+			}
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	var restore func()
+	if aTrans != aConj && m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if bTrans != bConj && m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, a.At(r, c)+f*b.At(r, c))
+		}
+	}
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+func (m *CDense) Scale(f complex128, a CMatrix) {
+	ar, ac := a.Dims()
+
+	m.reuseAsNonZeroed(ar, ac)
+
+	aU, aTrans, aConj := untransposeExtractCmplx(a)
+	if rm, ok := a.(*CDense); ok {
+		amat := rm.mat
+		if m != aU {
+			m.checkOverlap(amat)
+		}
+		for ja, jm := 0, 0; ja < ar*amat.Stride; ja, jm = ja+amat.Stride, jm+m.mat.Stride {
+			for i, v := range amat.Data[ja : ja+ac] {
+				m.mat.Data[i+jm] = f * v
+			}
+		}
+		return
+	}
+
+	m.checkOverlapMatrix(aU)
+	if aTrans != aConj && m == aU {
+		var restore func()
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	}
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, f*a.At(r, c))
+		}
+	}
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver.
+// If the number of columns in a does not equal the number of rows in b, Mul will panic.
+func (m *CDense) Mul(a, b CMatrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+
+	if ac != br {
+		panic(ErrShape)
+	}
+
+	aU, _, _ := untransposeExtractCmplx(a)
+	bU, _, _ := untransposeExtractCmplx(b)
+	m.reuseAsNonZeroed(ar, bc)
+	var restore func()
+	if m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+	if restore == nil {
+		m.checkOverlapMatrix(aU)
+		m.checkOverlapMatrix(bU)
+	}
+
+	amat, aT, aw := cblasOperand(a)
+	if aw != nil {
+		defer putCDenseWorkspace(aw)
+	}
+	bmat, bT, bw := cblasOperand(b)
+	if bw != nil {
+		defer putCDenseWorkspace(bw)
+	}
+	cblas128.Gemm(aT, bT, 1, amat, bmat, 0, m.mat)
+}
+
+// cblasOperand returns a cblas128.General and a transpose flag representing
+// a for use in a BLAS call. If a is not a possibly transposed *CDense or
+// RawCMatrixer, or if it is an implicitly conjugated matrix without
+// transposition, a is copied into a workspace w which must be returned to
+// the pool by the caller.
+func cblasOperand(a CMatrix) (mat cblas128.General, t blas.Transpose, w *CDense) {
+	u, trans, conj := untransposeExtractCmplx(a)
+	if rm, ok := u.(*CDense); ok {
+		switch {
+		case !trans && !conj:
+			return rm.mat, blas.NoTrans, nil
+		case trans && !conj:
+			return rm.mat, blas.Trans, nil
+		case !trans && conj:
+			return rm.mat, blas.ConjTrans, nil
+		}
+	}
+	r, c := a.Dims()
+	w = getCDenseWorkspace(r, c, false)
+	w.Copy(a)
+	return w.mat, blas.NoTrans, w
+}
+
+// Inverse computes the inverse of the matrix a, storing the result into the
+// receiver. If a is ill-conditioned, a Condition error will be returned.
+// Note that matrix inversion is numerically unstable, and should generally
+// be avoided where possible, for example by using the Solve routines.
+func (m *CDense) Inverse(a CMatrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	lu := getCDenseWorkspace(r, r, false)
+	defer putCDenseWorkspace(lu)
+	lu.Copy(a)
+	anorm := cdenseNorm1(lu.mat)
+	ipiv := getInts(r, false)
+	defer putInts(ipiv)
+	ok := lapack128.Getrf(lu.mat, ipiv)
+
+	m.reuseAsZeroed(r, r)
+	for i := 0; i < r; i++ {
+		m.mat.Data[i*m.mat.Stride+i] = 1
+	}
+	if !ok {
+		// A is exactly singular.
+		return Condition(math.Inf(1))
+	}
+	lapack128.Getrs(blas.NoTrans, lu.mat, m.mat, ipiv)
+	// The inverse is available, so compute the 1-norm condition number
+	// exactly.
+	cond := anorm * cdenseNorm1(m.mat)
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// Solve solves the linear least squares problem
+//
+//	minimize over x |b - A*x|_2
+//
+// where A is an m×n complex matrix, b is a given m×k matrix and x is the
+// n×k solution. Solve assumes that A has full rank, that is
+//
+//	rank(A) = min(m,n)
+//
+// If m >= n, Solve finds the unique least squares solution of an overdetermined
+// system.
+//
+// If m < n, there is an infinite number of solutions that satisfy b-A*x=0. In
+// this case Solve finds the unique solution of an underdetermined system that
+// minimizes |x|_2.
+//
+// The solution matrix x will be stored in-place into the receiver.
+//
+// If A does not have full rank, a Condition error is returned. See the
+// documentation for Condition for more information.
+func (m *CDense) Solve(a, b CMatrix) error {
+	ar, ac := a.Dims()
+	br, _ := b.Dims()
+	if ar != br {
+		panic(ErrShape)
+	}
+	switch {
+	case ar == ac:
+		var lu CLU
+		lu.Factorize(a)
+		return lu.SolveTo(m, false, b)
+	case ar > ac:
+		var qr CQR
+		qr.Factorize(a)
+		return qr.SolveTo(m, false, b)
+	default:
+		var qr CQR
+		qr.Factorize(a.H())
+		return qr.SolveTo(m, true, b)
+	}
+}
+
+// cdenseNorm1 returns the maximum absolute column sum of a.
+func cdenseNorm1(a cblas128.General) float64 {
+	work := getFloat64s(a.Cols, true)
+	defer putFloat64s(work)
+	for i := 0; i < a.Rows; i++ {
+		for j, v := range a.Data[i*a.Stride : i*a.Stride+a.Cols] {
+			work[j] += cmplx.Abs(v)
+		}
+	}
+	var norm float64
+	for _, v := range work {
+		norm = math.Max(norm, v)
+	}
+	return norm
+}
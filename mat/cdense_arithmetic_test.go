@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+// randCDense returns an r×c complex matrix with random entries.
+func randCDense(r, c int, rnd *rand.Rand) *CDense {
+	m := NewCDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.Set(i, j, complex(rnd.NormFloat64(), rnd.NormFloat64()))
+		}
+	}
+	return m
+}
+
+// cidentity returns the n×n complex identity matrix.
+func cidentity(n int) *CDense {
+	m := NewCDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+// naiveCMul returns the product of a and b computed element-wise.
+func naiveCMul(a, b CMatrix) *CDense {
+	ar, ac := a.Dims()
+	_, bc := b.Dims()
+	m := NewCDense(ar, bc, nil)
+	for i := 0; i < ar; i++ {
+		for j := 0; j < bc; j++ {
+			var v complex128
+			for k := 0; k < ac; k++ {
+				v += a.At(i, k) * b.At(k, j)
+			}
+			m.Set(i, j, v)
+		}
+	}
+	return m
+}
+
+func TestCDenseAddSubScale(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ r, c int }{{1, 1}, {3, 3}, {4, 2}, {2, 5}} {
+		a := randCDense(test.r, test.c, rnd)
+		b := randCDense(test.r, test.c, rnd)
+		bt := randCDense(test.c, test.r, rnd)
+		const f = 2 - 3i
+		for _, bm := range []CMatrix{b, bt.H(), bt.T()} {
+			var add, sub, scale CDense
+			add.Add(a, bm)
+			sub.Sub(a, bm)
+			scale.Scale(f, bm)
+			for i := 0; i < test.r; i++ {
+				for j := 0; j < test.c; j++ {
+					if add.At(i, j) != a.At(i, j)+bm.At(i, j) {
+						t.Errorf("unexpected Add result for %d×%d %T at (%d,%d)", test.r, test.c, bm, i, j)
+					}
+					if sub.At(i, j) != a.At(i, j)-bm.At(i, j) {
+						t.Errorf("unexpected Sub result for %d×%d %T at (%d,%d)", test.r, test.c, bm, i, j)
+					}
+					if scale.At(i, j) != f*bm.At(i, j) {
+						t.Errorf("unexpected Scale result for %d×%d %T at (%d,%d)", test.r, test.c, bm, i, j)
+					}
+				}
+			}
+		}
+
+		// Aliased receivers.
+		want := NewCDense(test.r, test.c, nil)
+		want.Add(a, b)
+		got := NewCDense(test.r, test.c, nil)
+		got.Copy(a)
+		got.Add(got, b)
+		if !CEqual(got, want) {
+			t.Errorf("unexpected aliased Add result for %d×%d", test.r, test.c)
+		}
+	}
+
+	if panicked, _ := panics(func() {
+		var m CDense
+		m.Add(NewCDense(2, 3, nil), NewCDense(3, 2, nil))
+	}); !panicked {
+		t.Errorf("expected panic for shape mismatch")
+	}
+}
+
+func TestCDenseMul(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ m, k, n int }{{1, 1, 1}, {3, 3, 3}, {4, 2, 3}, {2, 5, 1}} {
+		a := randCDense(test.m, test.k, rnd)
+		at := randCDense(test.k, test.m, rnd)
+		b := randCDense(test.k, test.n, rnd)
+		bt := randCDense(test.n, test.k, rnd)
+		var ac CDense
+		ac.Conj(a)
+		for i, am := range []CMatrix{a, at.H(), at.T(), ac.H().T()} {
+			for j, bm := range []CMatrix{b, bt.H(), bt.T()} {
+				var got CDense
+				got.Mul(am, bm)
+				want := naiveCMul(am, bm)
+				if !CEqualApprox(&got, want, 1e-12) {
+					t.Errorf("unexpected Mul result for m=%d k=%d n=%d case (%d,%d)", test.m, test.k, test.n, i, j)
+				}
+			}
+		}
+	}
+
+	// Aliased receiver.
+	a := randCDense(3, 3, rnd)
+	want := naiveCMul(a, a)
+	a.Mul(a, a)
+	if !CEqualApprox(a, want, 1e-12) {
+		t.Errorf("unexpected aliased Mul result")
+	}
+}
+
+func TestCDenseInverse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		a := randCDense(n, n, rnd)
+		var inv CDense
+		err := inv.Inverse(a)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		var got CDense
+		got.Mul(a, &inv)
+		if !CEqualApprox(&got, cidentity(n), 1e-10) {
+			t.Errorf("n=%d: A*A⁻¹ != I", n)
+		}
+	}
+
+	var inv CDense
+	err := inv.Inverse(NewCDense(2, 2, []complex128{1, 1i, 2, 2i}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got %v", err)
+	}
+}
+
+func TestCDenseSolve(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ m, n, k int }{
+		{3, 3, 1}, {5, 5, 3}, {8, 3, 2}, {3, 8, 2}, {20, 20, 4},
+	} {
+		name := fmt.Sprintf("m=%d n=%d k=%d", test.m, test.n, test.k)
+		a := randCDense(test.m, test.n, rnd)
+		b := randCDense(test.m, test.k, rnd)
+		var x CDense
+		err := x.Solve(a, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var r CDense
+		r.Mul(a, &x)
+		r.Sub(&r, b)
+		if test.m <= test.n {
+			// The system is consistent.
+			if !CEqualApprox(&r, NewCDense(test.m, test.k, nil), 1e-10) {
+				t.Errorf("%s: A*X != B", name)
+			}
+		} else {
+			// The residual is orthogonal to the range of A.
+			var ar CDense
+			ar.Mul(a.H(), &r)
+			if !CEqualApprox(&ar, NewCDense(test.n, test.k, nil), 1e-10) {
+				t.Errorf("%s: Aᴴ*(A*X-B) != 0", name)
+			}
+		}
+		if test.m < test.n {
+			// The minimum norm solution lies in the range of Aᴴ, so it
+			// is unchanged by projecting onto that range.
+			var y, p CDense
+			if err := y.Solve(naiveCMul(a, a.H()), b); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			p.Mul(a.H(), &y)
+			if !CEqualApprox(&p, &x, 1e-10) {
+				t.Errorf("%s: solution is not minimum norm", name)
+			}
+		}
+	}
+}
+
+// cmaxAbs returns the largest absolute value of an element of a.
+func cmaxAbs(a CMatrix) float64 {
+	r, c := a.Dims()
+	var v float64
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v = max(v, cmplx.Abs(a.At(i, j)))
+		}
+	}
+	return v
+}
@@ -0,0 +1,288 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestCLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 70} {
+		a := randCDense(n, n, rnd)
+		var lu CLU
+		lu.Factorize(a)
+
+		var l, u CDense
+		lu.LTo(&l)
+		lu.UTo(&u)
+		piv := lu.RowPivots(nil)
+		var got CDense
+		got.Mul(&l, &u)
+		pa := NewCDense(n, n, nil)
+		for i, p := range piv {
+			for j := 0; j < n; j++ {
+				pa.Set(i, j, got.At(p, j))
+			}
+		}
+		if !CEqualApprox(pa, a, 1e-10) {
+			t.Errorf("n=%d: P*L*U != A", n)
+		}
+
+		// det(A) * det(A⁻¹) = 1.
+		det := lu.Det()
+		var inv CDense
+		if err := inv.Inverse(a); err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		var invLU CLU
+		invLU.Factorize(&inv)
+		if prod := det * invLU.Det(); cmplx.Abs(prod-1) > 1e-8 {
+			t.Errorf("n=%d: det(A)*det(A⁻¹) = %v, want 1", n, prod)
+		}
+
+		b := randCDense(n, 3, rnd)
+		for _, trans := range []bool{false, true} {
+			var x CDense
+			if err := lu.SolveTo(&x, trans, b); err != nil {
+				t.Errorf("n=%d trans=%t: unexpected error: %v", n, trans, err)
+				continue
+			}
+			var ax CDense
+			if trans {
+				ax.Mul(a.H(), &x)
+			} else {
+				ax.Mul(a, &x)
+			}
+			if !CEqualApprox(&ax, b, 1e-8*cmaxAbs(b)) {
+				t.Errorf("n=%d trans=%t: A*X != B", n, trans)
+			}
+		}
+	}
+
+	// A 2×2 diagonal matrix has a known determinant.
+	var lu CLU
+	lu.Factorize(NewCDense(2, 2, []complex128{2i, 0, 0, 3}))
+	if det := lu.Det(); cmplx.Abs(det-6i) > 1e-14 {
+		t.Errorf("unexpected determinant: got %v, want 6i", det)
+	}
+
+	lu.Factorize(NewCDense(2, 2, []complex128{1, 2, 2, 4}))
+	var x CDense
+	if err := lu.SolveTo(&x, false, NewCDense(2, 1, nil)); err == nil {
+		t.Errorf("expected error for singular matrix")
+	}
+}
+
+func TestCQR(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ m, n int }{{1, 1}, {3, 3}, {5, 3}, {10, 1}, {40, 25}} {
+		name := fmt.Sprintf("m=%d n=%d", test.m, test.n)
+		a := randCDense(test.m, test.n, rnd)
+		var qr CQR
+		qr.Factorize(a)
+
+		var q, r CDense
+		qr.QTo(&q)
+		qr.RTo(&r)
+		var qhq CDense
+		qhq.Mul(q.H(), &q)
+		if !CEqualApprox(&qhq, cidentity(test.m), 1e-12) {
+			t.Errorf("%s: Q is not unitary", name)
+		}
+		for i := 0; i < test.m; i++ {
+			for j := 0; j < min(i, test.n); j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("%s: R is not upper triangular", name)
+				}
+			}
+		}
+		var got CDense
+		got.Mul(&q, &r)
+		if !CEqualApprox(&got, a, 1e-12) {
+			t.Errorf("%s: Q*R != A", name)
+		}
+
+		b := randCDense(test.m, 2, rnd)
+		var x CDense
+		if err := qr.SolveTo(&x, false, b); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var res, ares CDense
+		res.Mul(a, &x)
+		res.Sub(&res, b)
+		ares.Mul(a.H(), &res)
+		if !CEqualApprox(&ares, NewCDense(test.n, 2, nil), 1e-10) {
+			t.Errorf("%s: least squares residual is not orthogonal to A", name)
+		}
+
+		c := randCDense(test.n, 2, rnd)
+		var y CDense
+		if err := qr.SolveTo(&y, true, c); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var ahx CDense
+		ahx.Mul(a.H(), &y)
+		if !CEqualApprox(&ahx, c, 1e-10) {
+			t.Errorf("%s: Aᴴ*X != C", name)
+		}
+	}
+
+	if panicked, _ := panics(func() {
+		var qr CQR
+		qr.Factorize(NewCDense(2, 3, nil))
+	}); !panicked {
+		t.Errorf("expected panic for wide matrix")
+	}
+}
+
+// randomHermitianPosDef returns a random n×n Hermitian positive definite
+// matrix.
+func randomHermitianPosDef(n int, rnd *rand.Rand) *CDense {
+	x := randCDense(n, n, rnd)
+	var a CDense
+	a.Mul(x.H(), x)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, complex(real(a.At(i, i))+float64(n), 0))
+	}
+	return &a
+}
+
+func TestCCholesky(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 70} {
+		a := randomHermitianPosDef(n, rnd)
+		var chol CCholesky
+		if ok := chol.Factorize(a); !ok {
+			t.Errorf("n=%d: unexpected failure to factorize", n)
+			continue
+		}
+		var u, l, got CDense
+		chol.UTo(&u)
+		chol.LTo(&l)
+		got.Mul(u.H(), &u)
+		if !CEqualApprox(&got, a, 1e-10*cmaxAbs(a)) {
+			t.Errorf("n=%d: Uᴴ*U != A", n)
+		}
+		got.Mul(&l, l.H())
+		if !CEqualApprox(&got, a, 1e-10*cmaxAbs(a)) {
+			t.Errorf("n=%d: L*Lᴴ != A", n)
+		}
+
+		var lu CLU
+		lu.Factorize(a)
+		want, _ := lu.LogDet()
+		if d := chol.LogDet(); math.Abs(d-want) > 1e-10*math.Abs(want)+1e-12 {
+			t.Errorf("n=%d: unexpected log determinant: got %v, want %v", n, d, want)
+		}
+
+		b := randCDense(n, 3, rnd)
+		var x, ax CDense
+		if err := chol.SolveTo(&x, b); err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		ax.Mul(a, &x)
+		if !CEqualApprox(&ax, b, 1e-10) {
+			t.Errorf("n=%d: A*X != B", n)
+		}
+	}
+
+	var chol CCholesky
+	if chol.Factorize(NewCDense(2, 2, []complex128{1, 2i, -2i, 1})) {
+		t.Errorf("unexpected success for indefinite matrix")
+	}
+	if !chol.IsEmpty() {
+		t.Errorf("failed factorization is not empty")
+	}
+}
+
+func TestCSVD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ m, n int }{{1, 1}, {3, 3}, {5, 3}, {3, 5}, {10, 1}, {1, 10}, {30, 20}} {
+		a := randCDense(test.m, test.n, rnd)
+		k := min(test.m, test.n)
+		for _, kind := range []SVDKind{SVDNone, SVDThin, SVDFull, SVDThinU | SVDFullV, SVDFullU | SVDThinV} {
+			name := fmt.Sprintf("m=%d n=%d kind=%d", test.m, test.n, kind)
+			var svd CSVD
+			if ok := svd.Factorize(a, kind); !ok {
+				t.Errorf("%s: unexpected failure to factorize", name)
+				continue
+			}
+			s := svd.Values(nil)
+			for i := 1; i < len(s); i++ {
+				if s[i] > s[i-1] {
+					t.Errorf("%s: singular values not in decreasing order", name)
+				}
+			}
+			if kind == SVDNone {
+				if panicked, _ := panics(func() { svd.UTo(&CDense{}) }); !panicked {
+					t.Errorf("%s: expected panic for U not computed", name)
+				}
+				continue
+			}
+
+			var u, v CDense
+			svd.UTo(&u)
+			svd.VTo(&v)
+			ur, uc := u.Dims()
+			vr, vc := v.Dims()
+			if ur != test.m || (kind&SVDFullU != 0 && uc != test.m) || (kind&SVDThinU != 0 && uc != k) {
+				t.Errorf("%s: unexpected U shape %d×%d", name, ur, uc)
+			}
+			if vr != test.n || (kind&SVDFullV != 0 && vc != test.n) || (kind&SVDThinV != 0 && vc != k) {
+				t.Errorf("%s: unexpected V shape %d×%d", name, vr, vc)
+			}
+			var uhu, vhv CDense
+			uhu.Mul(u.H(), &u)
+			if !CEqualApprox(&uhu, cidentity(uc), 1e-12) {
+				t.Errorf("%s: U does not have orthonormal columns", name)
+			}
+			vhv.Mul(v.H(), &v)
+			if !CEqualApprox(&vhv, cidentity(vc), 1e-12) {
+				t.Errorf("%s: V does not have orthonormal columns", name)
+			}
+
+			sigma := NewCDense(uc, vc, nil)
+			for i, sv := range s {
+				sigma.Set(i, i, complex(sv, 0))
+			}
+			var us, got CDense
+			us.Mul(&u, sigma)
+			got.Mul(&us, v.H())
+			if !CEqualApprox(&got, a, 1e-12*s[0]*float64(max(test.m, test.n))) {
+				t.Errorf("%s: U*Σ*Vᴴ != A", name)
+			}
+		}
+	}
+
+	// The singular values of a diagonal matrix are the moduli of its
+	// diagonal elements.
+	var svd CSVD
+	svd.Factorize(NewCDense(3, 3, []complex128{3i, 0, 0, 0, -1, 0, 0, 0, 2 + 2i}), SVDNone)
+	want := []float64{3, 2 * math.Sqrt2, 1}
+	for i, v := range svd.Values(nil) {
+		if math.Abs(v-want[i]) > 1e-14 {
+			t.Errorf("unexpected singular value %d: got %v, want %v", i, v, want[i])
+		}
+	}
+	if r := svd.Rank(1e-12); r != 3 {
+		t.Errorf("unexpected rank: got %d, want 3", r)
+	}
+	if c := svd.Cond(); math.Abs(c-3) > 1e-14 {
+		t.Errorf("unexpected condition number: got %v, want 3", c)
+	}
+}
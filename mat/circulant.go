@@ -0,0 +1,198 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+)
+
+var (
+	circulant *Circulant
+	_         Matrix = circulant
+)
+
+// Circulant represents an n×n circulant matrix, a Toeplitz matrix in which each
+// column is the previous column rotated down by one element. A circulant matrix
+// is defined by its first column, so that element (i, j) is c[(i-j) mod n].
+//
+// Circulant matrices are diagonalized by the discrete Fourier transform, so
+// matrix-vector products and linear solves are computed in O(n log n) time.
+type Circulant struct {
+	c []float64
+}
+
+// NewCirculant creates a new circulant matrix with first column c. The slice
+// is used as the backing data, so changes to its elements will be reflected in
+// the returned matrix. NewCirculant will panic if c has zero length.
+func NewCirculant(c []float64) *Circulant {
+	if len(c) == 0 {
+		panic(ErrZeroLength)
+	}
+	return &Circulant{c: c}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (c *Circulant) Dims() (r, cols int) {
+	return len(c.c), len(c.c)
+}
+
+// At returns the element at row i, column j.
+func (c *Circulant) At(i, j int) float64 {
+	n := len(c.c)
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+	k := i - j
+	if k < 0 {
+		k += n
+	}
+	return c.c[k]
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (c *Circulant) T() Matrix {
+	return Transpose{c}
+}
+
+// Column returns the first column of the matrix. The returned slice is the
+// backing data of the receiver.
+func (c *Circulant) Column() []float64 {
+	return c.c
+}
+
+// Eigenvalues returns the eigenvalues of the matrix. The k-th eigenvalue,
+// corresponding to the eigenvector with elements exp(2πi⋅j⋅k/n), is stored in
+// dst[k]. If dst is nil a new slice is allocated, otherwise it must have
+// length n.
+func (c *Circulant) Eigenvalues(dst []complex128) []complex128 {
+	n := len(c.c)
+	if dst == nil {
+		dst = make([]complex128, n)
+	} else if len(dst) != n {
+		panic(ErrSliceLengthMismatch)
+	}
+	half := fourier.NewFFT(n).Coefficients(nil, c.c)
+	copy(dst, half)
+	for k := len(half); k < n; k++ {
+		dst[k] = cmplx.Conj(half[n-k])
+	}
+	return dst
+}
+
+// Cond returns the condition number of the matrix in the 2-norm, the ratio of
+// the largest and smallest eigenvalue magnitudes.
+func (c *Circulant) Cond() float64 {
+	half := fourier.NewFFT(len(c.c)).Coefficients(nil, c.c)
+	return spectrumCond(half)
+}
+
+// spectrumCond returns the ratio of the largest and smallest magnitudes in
+// lambda.
+func spectrumCond(lambda []complex128) float64 {
+	lo := math.Inf(1)
+	var hi float64
+	for _, v := range lambda {
+		a := cmplx.Abs(v)
+		lo = math.Min(lo, a)
+		hi = math.Max(hi, a)
+	}
+	if lo == 0 {
+		return math.Inf(1)
+	}
+	return hi / lo
+}
+
+// MulVecTo computes C⋅x or Cᵀ⋅x storing the result into dst.
+func (c *Circulant) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	n := len(c.c)
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	xd := vecData(x)
+	y := make([]float64, n)
+	if n < minFFTLen {
+		for i := range y {
+			var sum float64
+			for j, v := range xd {
+				k := i - j
+				if trans {
+					k = -k
+				}
+				if k < 0 {
+					k += n
+				}
+				sum += c.c[k] * v
+			}
+			y[i] = sum
+		}
+		setVecData(dst, y)
+		return
+	}
+
+	fft := fourier.NewFFT(n)
+	lambda := fft.Coefficients(nil, c.c)
+	coeff := fft.Coefficients(nil, xd)
+	for k, v := range lambda {
+		if trans {
+			v = cmplx.Conj(v)
+		}
+		coeff[k] *= v
+	}
+	y = fft.Sequence(y, coeff)
+	scale := 1 / float64(n)
+	for i := range y {
+		y[i] *= scale
+	}
+	setVecData(dst, y)
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	C * x = b   if trans == false
+//	Cᵀ * x = b  if trans == true
+//
+// by dividing the Fourier coefficients of b by the eigenvalues of C, storing
+// the result into dst.
+//
+// If C is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. If C is exactly singular
+// the contents of dst are undefined.
+func (c *Circulant) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	n := len(c.c)
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	fft := fourier.NewFFT(n)
+	lambda := fft.Coefficients(nil, c.c)
+	cond := spectrumCond(lambda)
+	if math.IsInf(cond, 1) {
+		dst.reuseAsNonZeroed(n)
+		return Condition(cond)
+	}
+	coeff := fft.Coefficients(nil, vecData(b))
+	for k, v := range lambda {
+		if trans {
+			v = cmplx.Conj(v)
+		}
+		coeff[k] /= v
+	}
+	x := fft.Sequence(nil, coeff)
+	scale := 1 / float64(n)
+	for i := range x {
+		x[i] *= scale
+	}
+	setVecData(dst, x)
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
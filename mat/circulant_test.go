@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestCirculant(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 16, 63, 64, 97, 150} {
+		c := make([]float64, n)
+		randomSlice(c, rnd)
+		c[0] += float64(n)
+		a := NewCirculant(c)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if got, want := a.At(i, j), c[(i-j+n)%n]; got != want {
+					t.Fatalf("unexpected element at (%d,%d): got %v want %v", i, j, got, want)
+				}
+			}
+		}
+		testStructured(t, fmt.Sprintf("n=%d", n), a, 1e-10, rnd)
+
+		// The eigenvectors of a circulant matrix are the Fourier modes.
+		lambda := a.Eigenvalues(nil)
+		for _, k := range []int{0, n / 2, n - 1} {
+			var lhs, rhs complex128
+			for j := 0; j < n; j++ {
+				w := cmplx.Exp(complex(0, 2*math.Pi*float64(j*k)/float64(n)))
+				lhs += complex(a.At(1%n, j), 0) * w
+				if j == 1%n {
+					rhs = lambda[k] * w
+				}
+			}
+			if cmplx.Abs(lhs-rhs) > 1e-10*float64(n) {
+				t.Errorf("n=%d: eigenvalue %d does not satisfy eigen equation: %v != %v", n, k, lhs, rhs)
+			}
+		}
+
+		var svd SVD
+		if !svd.Factorize(a, SVDNone) {
+			t.Fatalf("n=%d: svd failed", n)
+		}
+		if got, want := a.Cond(), svd.Cond(); math.Abs(got-want) > 1e-10*want {
+			t.Errorf("n=%d: unexpected condition number: got %v want %v", n, got, want)
+		}
+	}
+}
+
+func TestCirculantSingular(t *testing.T) {
+	t.Parallel()
+	// Every row sums to zero, so the all ones vector is in the null space.
+	a := NewCirculant([]float64{1, -2, 1})
+	var x VecDense
+	err := a.SolveVecTo(&x, false, NewVecDense(3, []float64{1, 2, 3}))
+	if c, ok := err.(Condition); !ok || !math.IsInf(float64(c), 1) {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+	if p, _ := panics(func() { NewCirculant(nil) }); !p {
+		t.Errorf("expected panic for empty matrix")
+	}
+}
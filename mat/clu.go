@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+const badCLU = "mat: invalid complex LU factorization"
+
+// CLU is a square n×n complex matrix represented by its LU factorization with
+// partial pivoting.
+//
+// The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements, and U is upper triangular.
+type CLU struct {
+	lu    *CDense
+	swaps []int
+	ok    bool // Whether A is nonsingular
+}
+
+// Dims returns the dimensions of the matrix A.
+func (lu *CLU) Dims() (r, c int) {
+	if lu.lu == nil {
+		return 0, 0
+	}
+	return lu.lu.Dims()
+}
+
+// Factorize computes the LU factorization of the square matrix A and stores
+// the result in the receiver. The LU decomposition will complete regardless of
+// the singularity of a.
+//
+// The L and U matrix factors can be extracted from the factorization using the
+// LTo and UTo methods.
+func (lu *CLU) Factorize(a CMatrix) {
+	m, n := a.Dims()
+	if m != n {
+		panic(ErrSquare)
+	}
+	if lu.lu == nil {
+		lu.lu = NewCDense(n, n, nil)
+	} else {
+		lu.lu.Reset()
+		lu.lu.reuseAsNonZeroed(n, n)
+	}
+	lu.lu.Copy(a)
+	lu.swaps = useInt(lu.swaps, n)
+	lu.ok = lapack128.Getrf(lu.lu.mat, lu.swaps)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *CLU) isValid() bool {
+	return lu.lu != nil && !lu.lu.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *CLU) Reset() {
+	if lu.lu != nil {
+		lu.lu.Reset()
+	}
+	lu.swaps = lu.swaps[:0]
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (lu *CLU) Det() complex128 {
+	if !lu.ok {
+		return 0
+	}
+	det, phase := lu.LogDet()
+	return complex(math.Exp(det), 0) * phase
+}
+
+// LogDet returns the log of the absolute value of the determinant and the
+// phase of the determinant, a complex number of unit modulus, for the matrix
+// that has been factorized. The determinant is
+//
+//	exp(det) * phase.
+//
+// Numerical stability in product and division expressions is generally
+// improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (lu *CLU) LogDet() (det float64, phase complex128) {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+
+	n, _ := lu.lu.Dims()
+	phase = 1
+	for i := 0; i < n; i++ {
+		v := lu.lu.at(i, i)
+		abs := cmplx.Abs(v)
+		if abs != 0 {
+			phase *= v / complex(abs, 0)
+		}
+		if lu.swaps[i] != i {
+			phase = -phase
+		}
+		det += math.Log(abs)
+	}
+	return det, phase
+}
+
+// LTo extracts the lower triangular matrix from an LU factorization.
+//
+// If dst is empty, LTo will resize dst to be n×n. When dst is non-empty, LTo
+// will panic if dst is not n×n. LTo will also panic if the receiver does not
+// contain a successful factorization.
+func (lu *CLU) LTo(dst *CDense) {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+
+	n, _ := lu.lu.Dims()
+	dst.reuseAsZeroed(n, n)
+	// Extract the lower triangular elements.
+	for i := 1; i < n; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride:i*dst.mat.Stride+i], lu.lu.mat.Data[i*lu.lu.mat.Stride:i*lu.lu.mat.Stride+i])
+	}
+	// Set ones on the diagonal.
+	for i := 0; i < n; i++ {
+		dst.mat.Data[i*dst.mat.Stride+i] = 1
+	}
+}
+
+// UTo extracts the upper triangular matrix from an LU factorization.
+//
+// If dst is empty, UTo will resize dst to be n×n. When dst is non-empty, UTo
+// will panic if dst is not n×n. UTo will also panic if the receiver does not
+// contain a successful factorization.
+func (lu *CLU) UTo(dst *CDense) {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+
+	n, _ := lu.lu.Dims()
+	dst.reuseAsZeroed(n, n)
+	// Extract the upper triangular elements.
+	for i := 0; i < n; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride+i:i*dst.mat.Stride+n], lu.lu.mat.Data[i*lu.lu.mat.Stride+i:i*lu.lu.mat.Stride+n])
+	}
+}
+
+// RowPivots returns the row permutation that represents the permutation matrix
+// P from the LU factorization
+//
+//	A = P * L * U.
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the size of the factorized matrix, RowPivots
+// will panic. RowPivots will panic if the receiver does not contain a
+// factorization.
+func (lu *CLU) RowPivots(dst []int) []int {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+	n, _ := lu.lu.Dims()
+	if dst == nil {
+		dst = make([]int, n)
+	}
+	if len(dst) != n {
+		panic(badSliceLength)
+	}
+	// Replay the sequence of row swaps in order to find the row permutation.
+	for i := range dst {
+		dst[i] = i
+	}
+	for i := n - 1; i >= 0; i-- {
+		v := lu.swaps[i]
+		dst[i], dst[v] = dst[v], dst[i]
+	}
+	return dst
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᴴ * X = B  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution matrix X
+// is stored into dst.
+//
+// If A is exactly singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveTo will panic if the
+// receiver does not contain a factorization.
+func (lu *CLU) SolveTo(dst *CDense, trans bool, b CMatrix) error {
+	if !lu.isValid() {
+		panic(badCLU)
+	}
+
+	_, n := lu.lu.Dims()
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _, _ := untransposeCmplx(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawCMatrixer); ok {
+		dst.checkOverlap(rm.RawCMatrix())
+	}
+
+	dst.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.ConjTrans
+	}
+	lapack128.Getrs(t, lu.lu.mat, dst.mat, lu.swaps)
+	return nil
+}
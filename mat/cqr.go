@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+const badCQR = "mat: invalid complex QR factorization"
+
+// CQR is a type for creating and using the QR factorization of a complex
+// matrix.
+//
+// The factorization has the form
+//
+//	A = Q * R
+//
+// where Q is an m×m unitary matrix and R is an m×n upper triangular matrix
+// with real diagonal elements.
+type CQR struct {
+	qr  *CDense
+	tau []complex128
+}
+
+// Dims returns the dimensions of the matrix A.
+func (qr *CQR) Dims() (r, c int) {
+	if qr.qr == nil {
+		return 0, 0
+	}
+	return qr.qr.Dims()
+}
+
+// Factorize computes the QR factorization of an m×n matrix a where m >= n. The
+// QR factorization always exists even if A is singular.
+//
+// The QR decomposition is a factorization of the matrix A such that A = Q * R.
+// The matrix Q is a unitary m×m matrix, and R is an m×n upper triangular
+// matrix. Q and R can be extracted using the QTo and RTo methods.
+func (qr *CQR) Factorize(a CMatrix) {
+	m, n := a.Dims()
+	if m < n {
+		panic(ErrShape)
+	}
+	if qr.qr == nil {
+		qr.qr = NewCDense(m, n, nil)
+	} else {
+		qr.qr.Reset()
+		qr.qr.reuseAsNonZeroed(m, n)
+	}
+	qr.qr.Copy(a)
+	qr.tau = make([]complex128, n)
+	work := []complex128{0}
+	lapack128.Geqrf(qr.qr.mat, qr.tau, work, -1)
+	work = make([]complex128, int(real(work[0])))
+	lapack128.Geqrf(qr.qr.mat, qr.tau, work, len(work))
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (qr *CQR) isValid() bool {
+	return qr.qr != nil && !qr.qr.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (qr *CQR) Reset() {
+	if qr.qr != nil {
+		qr.qr.Reset()
+	}
+	qr.tau = qr.tau[:0]
+}
+
+// RTo extracts the m×n upper trapezoidal matrix from a QR decomposition.
+//
+// If dst is empty, RTo will resize dst to be m×n. When dst is non-empty, RTo
+// will panic if dst is not m×n. RTo will also panic if the receiver does not
+// contain a successful factorization.
+func (qr *CQR) RTo(dst *CDense) {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, c := qr.qr.Dims()
+	dst.reuseAsZeroed(r, c)
+	for i := 0; i < c; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride+i:i*dst.mat.Stride+c], qr.qr.mat.Data[i*qr.qr.mat.Stride+i:i*qr.qr.mat.Stride+c])
+	}
+}
+
+// QTo extracts the m×m unitary matrix Q from a QR decomposition.
+//
+// If dst is empty, QTo will resize dst to be m×m. When dst is non-empty, QTo
+// will panic if dst is not m×m. QTo will also panic if the receiver does not
+// contain a successful factorization.
+func (qr *CQR) QTo(dst *CDense) {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, c := qr.qr.Dims()
+	dst.reuseAsZeroed(r, r)
+	// Copy the reflectors and form Q.
+	for i := 0; i < r; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride:i*dst.mat.Stride+c], qr.qr.mat.Data[i*qr.qr.mat.Stride:i*qr.qr.mat.Stride+c])
+	}
+	work := []complex128{0}
+	lapack128.Ungqr(dst.mat, qr.tau, work, -1)
+	work = make([]complex128, int(real(work[0])))
+	lapack128.Ungqr(dst.mat, qr.tau, work, len(work))
+}
+
+// SolveTo finds a minimum-norm solution to a system of linear equations defined
+// by the matrices A and b, where A is an m×n matrix represented in its QR
+// factorized form. If A is singular a Condition error is returned.
+// See the documentation for Condition for more information.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//	If trans == false, find X such that ||A*X - B||_2 is minimized.
+//	If trans == true, find the minimum norm solution of Aᴴ * X = B.
+//
+// The solution matrix, X, is stored in place into dst.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (qr *CQR) SolveTo(dst *CDense, trans bool, b CMatrix) error {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, c := qr.qr.Dims()
+	br, bc := b.Dims()
+
+	// The QR solve algorithm stores the result in-place into the right hand side.
+	// The storage for the answer must be large enough to hold both b and x.
+	// However, this method's receiver must be the size of x. Copy b, and then
+	// copy the result into m at the end.
+	if trans {
+		if c != br {
+			panic(ErrShape)
+		}
+		dst.reuseAsNonZeroed(r, bc)
+	} else {
+		if r != br {
+			panic(ErrShape)
+		}
+		dst.reuseAsNonZeroed(c, bc)
+	}
+	for i := 0; i < c; i++ {
+		if qr.qr.mat.Data[i*qr.qr.mat.Stride+i] == 0 {
+			return Condition(math.Inf(1))
+		}
+	}
+	// Do not need to worry about overlap between m and b because x has its own
+	// independent storage.
+	w := getCDenseWorkspace(max(r, c), bc, false)
+	defer putCDenseWorkspace(w)
+	w.Copy(b)
+	t := cblas128.Triangular{
+		N:      c,
+		Stride: qr.qr.mat.Stride,
+		Data:   qr.qr.mat.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+	if trans {
+		// Solve Rᴴ * Y = B and compute X = Q * [Y; 0].
+		wc := w.slice(0, c, 0, bc)
+		cblas128.Trsm(blas.Left, blas.ConjTrans, 1, t, wc.mat)
+		for i := c; i < r; i++ {
+			zeroC(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
+		}
+		work := []complex128{0}
+		lapack128.Unmqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, -1)
+		work = make([]complex128, int(real(work[0])))
+		lapack128.Unmqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, len(work))
+	} else {
+		// Compute Qᴴ * B and solve R * X = (Qᴴ * B)[:n].
+		work := []complex128{0}
+		lapack128.Unmqr(blas.Left, blas.ConjTrans, qr.qr.mat, qr.tau, w.mat, work, -1)
+		work = make([]complex128, int(real(work[0])))
+		lapack128.Unmqr(blas.Left, blas.ConjTrans, qr.qr.mat, qr.tau, w.mat, work, len(work))
+		wc := w.slice(0, c, 0, bc)
+		cblas128.Trsm(blas.Left, blas.NoTrans, 1, t, wc.mat)
+	}
+	// X was set above to be the correct size for the result.
+	dst.Copy(w)
+	return nil
+}
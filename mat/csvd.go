@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// CSVD is a type for creating and using the Singular Value Decomposition
+// of a complex matrix.
+type CSVD struct {
+	kind SVDKind
+
+	s []float64
+	u *CDense
+	v *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (svd *CSVD) succFact() bool {
+	return len(svd.s) != 0
+}
+
+// Factorize computes the singular value decomposition (SVD) of the input
+// complex matrix A. The singular values of A are computed in all cases, while
+// the singular vectors are optionally computed depending on the input kind.
+//
+// The full singular value decomposition (kind == SVDFull) is a factorization
+// of an m×n matrix A of the form
+//
+//	A = U * Σ * Vᴴ
+//
+// where Σ is an m×n diagonal matrix, U is an m×m unitary matrix, and V is an
+// n×n unitary matrix. The diagonal elements of Σ are the singular values of A.
+// The first min(m,n) columns of U and V are, respectively, the left and right
+// singular vectors of A.
+//
+// The thin SVD (kind == SVDThin) finds
+//
+//	A = U~ * Σ * V~ᴴ
+//
+// where U~ is of size m×min(m,n), Σ is a diagonal matrix of size min(m,n)×min(m,n)
+// and V~ is of size n×min(m,n).
+//
+// The decomposition is computed with the one-sided Jacobi method, which
+// computes small singular values to high relative accuracy.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, routines that require a successful factorization will panic.
+func (svd *CSVD) Factorize(a CMatrix, kind SVDKind) (ok bool) {
+	// Kill the previous factorization.
+	svd.s = svd.s[:0]
+	svd.kind = kind
+
+	m, n := a.Dims()
+	k := min(m, n)
+
+	// The Jacobi method requires a tall matrix, so factorize Aᴴ when A is
+	// wide and exchange the roles of U and V.
+	wide := m < n
+	var w *CDense
+	if wide {
+		w = NewCDense(n, m, nil)
+		w.Copy(a.H())
+	} else {
+		w = NewCDense(m, n, nil)
+		w.Copy(a)
+	}
+	s := use(svd.s, k)
+	vw := NewCDense(k, k, nil)
+	ok = lapack128.Gesvj(w.mat, s, vw.mat)
+	if !ok {
+		svd.kind = 0
+		return false
+	}
+	svd.s = s
+
+	// w holds the left singular vectors of the factorized matrix and vw
+	// holds its right singular vectors.
+	u, v := w, vw
+	if wide {
+		u, v = vw, w
+	}
+	svd.u = nil
+	svd.v = nil
+	switch {
+	case kind&SVDFullU != 0:
+		svd.u = completeUnitary(u)
+	case kind&SVDThinU != 0:
+		svd.u = u
+	}
+	switch {
+	case kind&SVDFullV != 0:
+		svd.v = completeUnitary(v)
+	case kind&SVDThinV != 0:
+		svd.v = v
+	}
+	return true
+}
+
+// completeUnitary returns a p×p unitary matrix whose first k columns are the
+// p×k matrix q with orthonormal columns.
+func completeUnitary(q *CDense) *CDense {
+	p, k := q.Dims()
+	if p == k {
+		return q
+	}
+	// The first k columns of the Q factor of q span the same space as q,
+	// so the remaining columns complete the basis.
+	qr := NewCDense(p, p, nil)
+	qr.Copy(q)
+	tau := make([]complex128, k)
+	work := []complex128{0}
+	lapack128.Geqrf(q.mat, tau, work, -1)
+	work = make([]complex128, max(1, p, int(real(work[0]))))
+	reflectors := qr.slice(0, p, 0, k)
+	lapack128.Geqrf(reflectors.mat, tau, work, len(work))
+	lapack128.Ungqr(qr.mat, tau, work, len(work))
+	qr.slice(0, p, 0, k).Copy(q)
+	return qr
+}
+
+// Kind returns the SVDKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (svd *CSVD) Kind() SVDKind {
+	if !svd.succFact() {
+		return -1
+	}
+	return svd.kind
+}
+
+// Rank returns the rank of A based on the count of singular values greater than
+// rcond scaled by the largest singular value.
+// Rank will panic if the receiver does not contain a successful factorization or
+// rcond is negative.
+func (svd *CSVD) Rank(rcond float64) int {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	s0 := svd.s[0]
+	for i, v := range svd.s {
+		if v <= rcond*s0 {
+			return i
+		}
+	}
+	return len(svd.s)
+}
+
+// Cond returns the 2-norm condition number for the factorized matrix. Cond will
+// panic if the receiver does not contain a successful factorization.
+func (svd *CSVD) Cond() float64 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	return svd.s[0] / svd.s[len(svd.s)-1]
+}
+
+// Values returns the singular values of the factorized matrix in descending order.
+//
+// If the input slice is non-nil, the values will be stored in-place into
+// the slice. In this case, the slice must have length min(m,n), and Values will
+// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
+// slice of the appropriate length will be allocated and returned.
+//
+// Values will panic if the receiver does not contain a successful factorization.
+func (svd *CSVD) Values(s []float64) []float64 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if s == nil {
+		s = make([]float64, len(svd.s))
+	}
+	if len(s) != len(svd.s) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(s, svd.s)
+	return s
+}
+
+// UTo extracts the matrix U from the singular value decomposition. The first
+// min(m,n) columns are the left singular vectors and correspond to the singular
+// values as returned from CSVD.Values.
+//
+// If dst is empty, UTo will resize dst to be m×m if the full U was computed
+// and size m×min(m,n) if the thin U was computed. When dst is non-empty, then
+// UTo will panic if dst is not the appropriate size. UTo will also panic if
+// the receiver does not contain a successful factorization, or if U was
+// not computed during factorization.
+func (svd *CSVD) UTo(dst *CDense) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if svd.u == nil {
+		panic("svd: u not computed during factorization")
+	}
+	r, c := svd.u.Dims()
+	dst.reuseAsNonZeroed(r, c)
+	dst.Copy(svd.u)
+}
+
+// VTo extracts the matrix V from the singular value decomposition. The first
+// min(m,n) columns are the right singular vectors and correspond to the singular
+// values as returned from CSVD.Values.
+//
+// If dst is empty, VTo will resize dst to be n×n if the full V was computed
+// and size n×min(m,n) if the thin V was computed. When dst is non-empty, then
+// VTo will panic if dst is not the appropriate size. VTo will also panic if
+// the receiver does not contain a successful factorization, or if V was
+// not computed during factorization.
+func (svd *CSVD) VTo(dst *CDense) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if svd.v == nil {
+		panic("svd: v not computed during factorization")
+	}
+	r, c := svd.v.Dims()
+	dst.reuseAsNonZeroed(r, c)
+	dst.Copy(svd.v)
+}
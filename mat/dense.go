@@ -439,6 +439,13 @@ func (m *Dense) CloneFrom(a Matrix) {
 		mat.Data = make([]float64, aU.mat.N)
 		blas64.Copy(blas64.Vector{N: aU.mat.N, Inc: amat.Inc, Data: amat.Data},
 			blas64.Vector{N: aU.mat.N, Inc: 1, Data: mat.Data})
+	case *CSR, *CSC, *COO:
+		mat.Data = make([]float64, r*c)
+		w := *m
+		w.mat = mat
+		w.Copy(a)
+		*m = w
+		return
 	default:
 		mat.Data = make([]float64, r*c)
 		w := *m
@@ -525,6 +532,19 @@ func (m *Dense) Copy(a Matrix) (r, c int) {
 		default:
 			// Nothing to do.
 		}
+	case *CSR, *CSC, *COO:
+		for i := 0; i < r; i++ {
+			zero(m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c])
+		}
+		// Sum the stored elements since a COO may hold duplicates.
+		aU.(NonZeroDoer).DoNonZero(func(i, j int, v float64) {
+			if trans {
+				i, j = j, i
+			}
+			if i < r && j < c {
+				m.mat.Data[i*m.mat.Stride+j] += v
+			}
+		})
 	default:
 		m.checkOverlapMatrix(aU)
 		for i := 0; i < r; i++ {
@@ -614,6 +634,15 @@ func (m *Dense) Norm(norm float64) float64 {
 	return lapack64.Lange(lnorm, m.mat, nil)
 }
 
+// MulVecTo computes M⋅x or Mᵀ⋅x storing the result into dst.
+func (m *Dense) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	if trans {
+		dst.MulVec(m.T(), x)
+		return
+	}
+	dst.MulVec(m, x)
+}
+
 // Permutation constructs an n×n permutation matrix P from the given
 // row permutation such that the nonzero entries are P[i,p[i]] = 1.
 func (m *Dense) Permutation(n int, p []int) {
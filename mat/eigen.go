@@ -5,6 +5,7 @@
 package mat
 
 import (
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
@@ -14,8 +15,8 @@ const (
 	noVectors = "mat: eigenvectors not computed"
 )
 
-// EigenSym is a type for computing all eigenvalues and, optionally,
-// eigenvectors of a symmetric matrix A.
+// EigenSym is a type for computing all or a subset of the eigenvalues and,
+// optionally, eigenvectors of a symmetric matrix A.
 //
 // It is a Symmetric matrix represented by its spectral factorization. Once
 // computed, this representation is useful for extracting eigenvalues and
@@ -23,6 +24,7 @@ const (
 type EigenSym struct {
 	vectorsComputed bool
 
+	n       int
 	values  []float64
 	vectors *Dense
 }
@@ -35,10 +37,12 @@ func (e *EigenSym) Dims() (r, c int) {
 
 // SymmetricDim implements the Symmetric interface.
 func (e *EigenSym) SymmetricDim() int {
-	return len(e.values)
+	return e.n
 }
 
-// At returns the element at row i, column j of the matrix A.
+// At returns the element at row i, column j of the matrix A. If only a subset
+// of the eigenvalues was computed, At returns the element of Q * Λ * Qᵀ where
+// Λ and Q hold only the computed eigenvalues and eigenvectors.
 //
 // At will panic if the eigenvectors have not been computed.
 func (e *EigenSym) At(i, j int) float64 {
@@ -54,7 +58,7 @@ func (e *EigenSym) At(i, j int) float64 {
 	}
 
 	var val float64
-	for k := 0; k < n; k++ {
+	for k := range e.values {
 		val += e.values[k] * e.vectors.at(i, k) * e.vectors.at(j, k)
 	}
 	return val
@@ -73,7 +77,8 @@ func (e *EigenSym) T() Matrix {
 //	A = Q * Λ * Qᵀ
 //
 // where Λ is a diagonal matrix whose entries are the eigenvalues, and Q is an
-// orthogonal matrix whose columns are the eigenvectors.
+// orthogonal matrix whose columns are the eigenvectors. The eigenvectors are
+// computed by the divide and conquer method.
 //
 // If vectors is false, the eigenvectors are not computed and later calls to
 // VectorsTo and At will panic.
@@ -82,8 +87,7 @@ func (e *EigenSym) T() Matrix {
 // methods that require a successful factorization will panic.
 func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
 	// kill previous decomposition
-	e.vectorsComputed = false
-	e.values = e.values[:]
+	e.reset()
 
 	n := a.SymmetricDim()
 	sd := NewSymDense(n, nil)
@@ -95,34 +99,131 @@ func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
 	}
 	w := make([]float64, n)
 	work := []float64{0}
-	lapack64.Syev(jobz, sd.mat, w, work, -1)
+	iwork := []int{0}
+	lapack64.Syevd(jobz, sd.mat, w, work, -1, iwork, -1)
 
 	work = getFloat64s(int(work[0]), false)
-	ok = lapack64.Syev(jobz, sd.mat, w, work, len(work))
+	iwork = getInts(iwork[0], false)
+	ok = lapack64.Syevd(jobz, sd.mat, w, work, len(work), iwork, len(iwork))
 	putFloat64s(work)
+	putInts(iwork)
 	if !ok {
-		e.vectorsComputed = false
-		e.values = nil
-		e.vectors = nil
 		return false
 	}
 	e.vectorsComputed = vectors
+	e.n = n
 	e.values = w
 	e.vectors = NewDense(n, n, sd.mat.Data)
 	return true
 }
 
+// FactorizeIndex computes the eigenvalues of the symmetric matrix A with
+// indices in the half-open range [lo, hi), counted from zero in ascending
+// order, and optionally the corresponding eigenvectors. FactorizeIndex will
+// panic unless 0 <= lo < hi <= n where A is n×n.
+//
+// Computing a small subset of the eigenvalues of a large matrix is much
+// faster than computing all of them. After a successful call, Values returns
+// the hi-lo computed eigenvalues and VectorsTo the corresponding n×(hi-lo)
+// matrix of eigenvectors.
+//
+// FactorizeIndex returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSym) FactorizeIndex(a Symmetric, lo, hi int, vectors bool) (ok bool) {
+	n := a.SymmetricDim()
+	if lo < 0 || hi <= lo || n < hi {
+		panic(ErrIndexOutOfRange)
+	}
+	return e.factorizeRange(a, lapack.EVRangeIndex, 0, 0, lo, hi-1, vectors)
+}
+
+// FactorizeInterval computes the eigenvalues of the symmetric matrix A that
+// lie in the half-open interval (lo, hi], and optionally the corresponding
+// eigenvectors. FactorizeInterval will panic if lo >= hi.
+//
+// After a successful call, Values returns the m eigenvalues in the interval
+// and VectorsTo the corresponding n×m matrix of eigenvectors. If no
+// eigenvalues lie in the interval, m is zero and VectorsTo will panic.
+//
+// FactorizeInterval returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSym) FactorizeInterval(a Symmetric, lo, hi float64, vectors bool) (ok bool) {
+	if !(lo < hi) {
+		panic("mat: invalid eigenvalue interval")
+	}
+	return e.factorizeRange(a, lapack.EVRangeValue, lo, hi, 0, 0, vectors)
+}
+
+// factorizeRange computes the eigenvalues of a selected by rng, vl, vu, il
+// and iu as described for lapack64.Syevr.
+func (e *EigenSym) factorizeRange(a Symmetric, rng lapack.EVRange, vl, vu float64, il, iu int, vectors bool) (ok bool) {
+	e.reset()
+
+	n := a.SymmetricDim()
+	sd := NewSymDense(n, nil)
+	sd.CopySym(a)
+
+	jobz := lapack.EVNone
+	if vectors {
+		jobz = lapack.EVCompute
+	}
+	mmax := n
+	if rng == lapack.EVRangeIndex {
+		mmax = iu - il + 1
+	}
+	w := make([]float64, n)
+	z := blas64.General{
+		Rows:   n,
+		Cols:   mmax,
+		Stride: mmax,
+	}
+	if vectors {
+		z.Data = make([]float64, n*mmax)
+	}
+	work := []float64{0}
+	iwork := []int{0}
+	lapack64.Syevr(jobz, rng, sd.mat, vl, vu, il, iu, 0, w, z, work, -1, iwork, -1)
+
+	work = getFloat64s(int(work[0]), false)
+	iwork = getInts(iwork[0], false)
+	m, ok := lapack64.Syevr(jobz, rng, sd.mat, vl, vu, il, iu, 0, w, z, work, len(work), iwork, len(iwork))
+	putFloat64s(work)
+	putInts(iwork)
+	if !ok {
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.n = n
+	e.values = w[:m]
+	if vectors && m > 0 {
+		e.vectors = NewDense(n, mmax, z.Data)
+		if m < mmax {
+			e.vectors = e.vectors.Slice(0, n, 0, m).(*Dense)
+		}
+	}
+	return true
+}
+
+// reset clears any previous factorization held by the receiver.
+func (e *EigenSym) reset() {
+	e.vectorsComputed = false
+	e.n = 0
+	e.values = nil
+	e.vectors = nil
+}
+
 // succFact returns whether the receiver contains a successful factorization.
 func (e *EigenSym) succFact() bool {
-	return len(e.values) != 0
+	return e.n != 0
 }
 
-// Values extracts the eigenvalues of the factorized n×n matrix A in ascending
-// order.
+// Values extracts the computed eigenvalues of the factorized n×n matrix A in
+// ascending order.
 //
 // If dst is not nil, the values are stored in-place into dst and returned,
 // otherwise a new slice is allocated first. If dst is not nil, it must have
-// length equal to n.
+// length equal to the number of computed eigenvalues, which is n unless the
+// receiver was computed by FactorizeIndex or FactorizeInterval.
 //
 // If the receiver does not contain a successful factorization, Values will
 // panic.
@@ -155,12 +256,14 @@ func (e *EigenSym) RawValues() []float64 {
 }
 
 // VectorsTo stores the orthonormal eigenvectors of the factorized n×n matrix A
-// into the columns of dst.
-//
-// If dst is empty, VectorsTo will resize dst to be n×n. When dst is non-empty,
-// VectorsTo will panic if dst is not n×n. VectorsTo will also panic if the
-// eigenvectors were not computed during the factorization, or if the receiver
-// does not contain a successful factorization.
+// into the columns of dst. If m eigenvalues were computed, the eigenvectors
+// form an n×m matrix.
+//
+// If dst is empty, VectorsTo will resize dst to be n×m. When dst is non-empty,
+// VectorsTo will panic if dst is not n×m. VectorsTo will also panic if the
+// eigenvectors were not computed during the factorization, if no eigenvalues
+// were computed, or if the receiver does not contain a successful
+// factorization.
 func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.succFact() {
 		panic(badFact)
@@ -168,6 +271,9 @@ func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.vectorsComputed {
 		panic(noVectors)
 	}
+	if len(e.values) == 0 {
+		panic(ErrZeroLength)
+	}
 	r, c := e.vectors.Dims()
 	if dst.IsEmpty() {
 		dst.ReuseAs(r, c)
@@ -185,7 +291,8 @@ func (e *EigenSym) VectorsTo(dst *Dense) {
 //
 //	A = Q * Λ * Qᵀ
 //
-// The columns of Q contain the eigenvectors of A.
+// The columns of Q contain the eigenvectors of A. If only a subset of the
+// eigenvalues was computed, Q holds the corresponding eigenvectors.
 //
 // If the returned matrix is modified, the factorization is invalid and should
 // not be used.
@@ -193,7 +300,7 @@ func (e *EigenSym) VectorsTo(dst *Dense) {
 // If the receiver does not contain a successful factorization or eigenvectors
 // not computed, RawU will return nil.
 func (e *EigenSym) RawQ() Matrix {
-	if !e.succFact() || !e.vectorsComputed {
+	if !e.succFact() || !e.vectorsComputed || len(e.values) == 0 {
 		return nil
 	}
 	return e.vectors
@@ -310,14 +417,14 @@ func (e *Eigen) Factorize(a Matrix, kind EigenKind) (ok bool) {
 	var cvl, cvr CDense
 	if left {
 		cvl = *NewCDense(r, r, nil)
-		e.complexEigenTo(&cvl, &vl)
+		complexEigenTo(&cvl, &vl, e.values)
 		e.lVectors = &cvl
 	} else {
 		e.lVectors = nil
 	}
 	if right {
 		cvr = *NewCDense(c, c, nil)
-		e.complexEigenTo(&cvr, &vr)
+		complexEigenTo(&cvr, &vr, e.values)
 		e.rVectors = &cvr
 	} else {
 		e.rVectors = nil
@@ -356,7 +463,8 @@ func (e *Eigen) Values(dst []complex128) []complex128 {
 }
 
 // complexEigenTo extracts the complex eigenvectors from the real matrix d
-// and stores them into the complex matrix dst.
+// and stores them into the complex matrix dst. A non-zero imaginary part of
+// values[j] marks the start of a complex conjugate pair.
 //
 // The columns of the returned n×n dense matrix contain the eigenvectors of the
 // decomposition in the same order as the eigenvalues.
@@ -371,7 +479,7 @@ func (e *Eigen) Values(dst []complex128) []complex128 {
 //	dst[:,j+1] = d[:,j] - i*d[:,j+1],
 //
 // where i is the imaginary unit.
-func (e *Eigen) complexEigenTo(dst *CDense, d *Dense) {
+func complexEigenTo(dst *CDense, d *Dense, values []complex128) {
 	r, c := d.Dims()
 	cr, cc := dst.Dims()
 	if r != cr {
@@ -381,7 +489,7 @@ func (e *Eigen) complexEigenTo(dst *CDense, d *Dense) {
 		panic("size mismatch")
 	}
 	for j := 0; j < c; j++ {
-		if imag(e.values[j]) == 0 {
+		if imag(values[j]) == 0 {
 			for i := 0; i < r; i++ {
 				dst.set(i, j, complex(d.at(i, j), 0))
 			}
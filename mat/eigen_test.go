@@ -232,3 +232,83 @@ func TestEigenSym(t *testing.T) {
 		}
 	}
 }
+
+func TestEigenSymSubset(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 70} {
+		for cas := 0; cas < 5; cas++ {
+			a := make([]float64, n*n)
+			for i := range a {
+				a[i] = rnd.NormFloat64()
+			}
+			s := NewSymDense(n, a)
+			var full EigenSym
+			if !full.Factorize(s, false) {
+				t.Errorf("n=%d,cas=%d: bad test", n, cas)
+				continue
+			}
+			all := full.Values(nil)
+
+			lo := rnd.IntN(n)
+			hi := lo + 1 + rnd.IntN(n-lo)
+			var es EigenSym
+			if !es.FactorizeIndex(s, lo, hi, true) {
+				t.Errorf("n=%d,cas=%d: FactorizeIndex failed", n, cas)
+				continue
+			}
+			checkEigenSymSubset(t, "FactorizeIndex", n, cas, s, &es, all[lo:hi], tol)
+
+			if n < 2 {
+				continue
+			}
+			// Choose the interval end points between eigenvalues.
+			vl := (all[lo] + all[max(0, lo-1)]) / 2
+			if lo == 0 {
+				vl = all[0] - 1
+			}
+			vu := all[hi-1] + 1
+			if hi < n {
+				vu = (all[hi-1] + all[hi]) / 2
+			}
+			if !(vl < vu) {
+				continue
+			}
+			if !es.FactorizeInterval(s, vl, vu, true) {
+				t.Errorf("n=%d,cas=%d: FactorizeInterval failed", n, cas)
+				continue
+			}
+			checkEigenSymSubset(t, "FactorizeInterval", n, cas, s, &es, all[lo:hi], tol)
+		}
+	}
+}
+
+func checkEigenSymSubset(t *testing.T, name string, n, cas int, s *SymDense, es *EigenSym, want []float64, tol float64) {
+	t.Helper()
+	if r := es.SymmetricDim(); r != n {
+		t.Errorf("%s: n=%d,cas=%d: unexpected dimension: got %d, want %d", name, n, cas, r, n)
+	}
+	got := es.Values(nil)
+	if !floats.EqualApprox(got, want, tol) {
+		t.Errorf("%s: n=%d,cas=%d: eigenvalue mismatch: got %v, want %v", name, n, cas, got, want)
+		return
+	}
+	var q Dense
+	es.VectorsTo(&q)
+	if r, c := q.Dims(); r != n || c != len(want) {
+		t.Errorf("%s: n=%d,cas=%d: unexpected eigenvector dimensions: got %d×%d, want %d×%d", name, n, cas, r, c, n, len(want))
+		return
+	}
+	var qtq Dense
+	qtq.Mul(q.T(), &q)
+	if !EqualApprox(&qtq, eye(len(want)), tol) {
+		t.Errorf("%s: n=%d,cas=%d: eigenvectors not orthonormal", name, n, cas)
+	}
+	var aq, ql Dense
+	aq.Mul(s, &q)
+	ql.Mul(&q, NewDiagDense(len(got), got))
+	if !EqualApprox(&aq, &ql, tol*float64(n)) {
+		t.Errorf("%s: n=%d,cas=%d: A*Q != Q*Λ", name, n, cas)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// ErrorBounds holds the scaling, condition estimate and error bounds computed
+// by Dense.SolveExpert and Dense.SolveSymExpert.
+type ErrorBounds struct {
+	// RowScale and ColScale hold the row and column scale factors r and c
+	// of the equilibration
+	//  diag(r) * A * diag(c)
+	// that was applied to A before it was factorized. RowScale is nil if
+	// the rows were not scaled, and ColScale is nil if the columns were
+	// not scaled. For symmetric matrices the scaling is symmetric and
+	// RowScale and ColScale share the same data.
+	RowScale, ColScale []float64
+
+	// Cond is an estimate of the condition number of the equilibrated
+	// matrix A in the 1-norm. Cond is infinite if A is singular.
+	Cond float64
+
+	// PivotGrowth is the reciprocal pivot growth factor
+	//  min_j max_i |A[i,j]| / max_i |U[i,j]|
+	// of the LU factorization of the equilibrated matrix. If it is much
+	// less than 1, the factorization may be unstable and the solution and
+	// error bounds may be unreliable. PivotGrowth is 1 for the Cholesky
+	// factorization.
+	PivotGrowth float64
+
+	// Forward holds for each column x_j of the solution an estimated
+	// bound on its relative forward error
+	//  |x_j - xtrue_j|_∞ / |x_j|_∞,
+	// where xtrue_j is the exact solution.
+	Forward []float64
+
+	// Backward holds for each column x_j of the solution the
+	// componentwise relative backward error, the smallest relative change
+	// in any element of A or b_j that makes x_j an exact solution.
+	Backward []float64
+}
+
+// SolveExpert solves the system of linear equations
+//
+//	A * X = B
+//
+// where A is a square n×n matrix and B is an n×k matrix, and stores the
+// solution into the receiver. If A is a Transpose, the system with the
+// underlying matrix transposed is solved without forming the transpose
+// explicitly.
+//
+// SolveExpert equilibrates A if it is badly scaled, solves the system using
+// the LU factorization of the equilibrated matrix and improves the solution by
+// iterative refinement. The returned ErrorBounds describes the scaling that
+// was applied, an estimate of the condition number of the equilibrated matrix,
+// and forward and backward error bounds for each column of the solution.
+//
+// If A is singular, the receiver is not modified and a Condition error with an
+// infinite value is returned. If A is near-singular, the solution is computed
+// and a Condition error is returned. See the documentation for Condition for
+// more information.
+func (m *Dense) SolveExpert(a, b Matrix) (ErrorBounds, error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	aU, trans := untranspose(a)
+
+	aw := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(aw)
+	aw.Copy(aU)
+	bw := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bw)
+	bw.Copy(b)
+	af := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(af)
+	x := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(x)
+	ipiv := getInts(n, false)
+	defer putInts(ipiv)
+	work := getFloat64s(4*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+
+	r := make([]float64, n)
+	s := make([]float64, n)
+	ferr := make([]float64, bc)
+	berr := make([]float64, bc)
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	equed, rcond, rpvgrw, ok := lapack64.Gesvx(lapack.FactEquilibrate, t, aw.mat, af.mat, ipiv, lapack.EquilibrateNone,
+		r, s, bw.mat, x.mat, ferr, berr, work, iwork)
+
+	bounds := ErrorBounds{PivotGrowth: rpvgrw}
+	if equed == lapack.EquilibrateRow || equed == lapack.EquilibrateBoth {
+		bounds.RowScale = r
+	}
+	if equed == lapack.EquilibrateCol || equed == lapack.EquilibrateBoth {
+		bounds.ColScale = s
+	}
+	if trans {
+		// The scaling of the underlying matrix is transposed with
+		// respect to the matrix a.
+		bounds.RowScale, bounds.ColScale = bounds.ColScale, bounds.RowScale
+	}
+	if !ok {
+		bounds.Cond = math.Inf(1)
+		return bounds, Condition(math.Inf(1))
+	}
+
+	m.reuseAsNonZeroed(n, bc)
+	m.Copy(x)
+	bounds.Cond = 1 / rcond
+	bounds.Forward = ferr
+	bounds.Backward = berr
+	if bounds.Cond > ConditionTolerance {
+		return bounds, Condition(bounds.Cond)
+	}
+	return bounds, nil
+}
+
+// SolveSymExpert solves the system of linear equations
+//
+//	A * X = B
+//
+// where A is an n×n symmetric positive definite matrix and B is an n×k
+// matrix, and stores the solution into the receiver.
+//
+// SolveSymExpert equilibrates A if it is badly scaled, solves the system using
+// the Cholesky factorization of the equilibrated matrix and improves the
+// solution by iterative refinement. The returned ErrorBounds describes the
+// scaling that was applied, an estimate of the condition number of the
+// equilibrated matrix, and forward and backward error bounds for each column of
+// the solution.
+//
+// If A is not positive definite, the receiver is not modified and ErrNotPSD is
+// returned. If A is near-singular, the solution is computed and a Condition
+// error is returned. See the documentation for Condition for more information.
+func (m *Dense) SolveSymExpert(a Symmetric, b Matrix) (ErrorBounds, error) {
+	n := a.SymmetricDim()
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	aw := getSymDenseWorkspace(n, false)
+	defer putSymDenseWorkspace(aw)
+	aw.CopySym(a)
+	bw := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bw)
+	bw.Copy(b)
+	af := getTriDenseWorkspace(n, Upper, false)
+	defer putTriWorkspace(af)
+	x := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(x)
+	work := getFloat64s(3*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+
+	s := make([]float64, n)
+	ferr := make([]float64, bc)
+	berr := make([]float64, bc)
+	equed, rcond, ok := lapack64.Posvx(lapack.FactEquilibrate, aw.mat, af.mat, lapack.EquilibrateNone,
+		s, bw.mat, x.mat, ferr, berr, work, iwork)
+
+	bounds := ErrorBounds{PivotGrowth: 1}
+	if equed == lapack.EquilibrateSym {
+		bounds.RowScale = s
+		bounds.ColScale = s
+	}
+	if !ok {
+		return bounds, ErrNotPSD
+	}
+
+	m.reuseAsNonZeroed(n, bc)
+	m.Copy(x)
+	bounds.Cond = 1 / rcond
+	bounds.Forward = ferr
+	bounds.Backward = berr
+	if bounds.Cond > ConditionTolerance {
+		return bounds, Condition(bounds.Cond)
+	}
+	return bounds, nil
+}
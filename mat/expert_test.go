@@ -0,0 +1,250 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// checkErrorBounds checks that the bounds returned for the solution x of a
+// system with the true solution want are consistent.
+func checkErrorBounds(t *testing.T, name string, bounds ErrorBounds, x, want *Dense, cond float64) {
+	t.Helper()
+	n, nrhs := want.Dims()
+	if len(bounds.Forward) != nrhs || len(bounds.Backward) != nrhs {
+		t.Errorf("%s: unexpected length of error bounds", name)
+		return
+	}
+	if bounds.Cond < 1 || bounds.Cond > 10*cond {
+		t.Errorf("%s: unexpected condition estimate: got %v, want about %v", name, bounds.Cond, cond)
+	}
+	for j := 0; j < nrhs; j++ {
+		if bounds.Backward[j] > 10*float64(n+1)*dlamchE {
+			t.Errorf("%s: backward error of column %d too large: %v", name, j, bounds.Backward[j])
+		}
+		var diff, xmax float64
+		for i := 0; i < n; i++ {
+			diff = math.Max(diff, math.Abs(x.At(i, j)-want.At(i, j)))
+			xmax = math.Max(xmax, math.Abs(x.At(i, j)))
+		}
+		if diff/xmax > bounds.Forward[j] {
+			t.Errorf("%s: forward error of column %d exceeds bound: %v > %v", name, j, diff/xmax, bounds.Forward[j])
+		}
+	}
+}
+
+func TestSolveExpert(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 20} {
+		for _, nrhs := range []int{1, 3} {
+			for _, cond := range []float64{1, 1e6} {
+				for _, scale := range []bool{false, true} {
+					for _, trans := range []bool{false, true} {
+						name := fmt.Sprintf("n=%d,nrhs=%d,cond=%g,scale=%t,trans=%t", n, nrhs, cond, scale, trans)
+						a := conditionedMatrix(n, cond, rnd)
+						if scale {
+							// Scale the rows and columns by widely
+							// varying powers of two so that the
+							// scaling does not change the condition
+							// of the equilibrated matrix.
+							for i := 0; i < n; i++ {
+								for j := 0; j < n; j++ {
+									a.Set(i, j, math.Ldexp(a.At(i, j), 20*(i%3)-10*(j%4)))
+								}
+							}
+						}
+						var op Matrix = a
+						if trans {
+							op = a.T()
+						}
+						want := NewDense(n, nrhs, nil)
+						for i := 0; i < n; i++ {
+							for j := 0; j < nrhs; j++ {
+								want.Set(i, j, rnd.NormFloat64())
+							}
+						}
+						var b Dense
+						b.Mul(op, want)
+
+						var x Dense
+						bounds, err := x.SolveExpert(op, &b)
+						if err != nil {
+							t.Errorf("%s: unexpected error: %v", name, err)
+							continue
+						}
+						if scale && n > 2 && (bounds.RowScale == nil || bounds.ColScale == nil) {
+							t.Errorf("%s: expected badly scaled matrix to be equilibrated", name)
+						}
+						if !scale && cond == 1 && (bounds.RowScale != nil || bounds.ColScale != nil) {
+							t.Errorf("%s: unexpected equilibration of well scaled matrix", name)
+						}
+						if bounds.PivotGrowth <= 0 || bounds.PivotGrowth > 1 {
+							t.Errorf("%s: unexpected pivot growth: %v", name, bounds.PivotGrowth)
+						}
+						if bounds.RowScale != nil && bounds.ColScale != nil {
+							// The equilibrated matrix must have
+							// entries of unit magnitude in each row.
+							for i := 0; i < n; i++ {
+								var rmax float64
+								for j := 0; j < n; j++ {
+									rmax = math.Max(rmax, math.Abs(bounds.RowScale[i]*op.At(i, j)*bounds.ColScale[j]))
+								}
+								if rmax < 0.1 || rmax > 1+1e-14 {
+									t.Errorf("%s: row %d of equilibrated matrix has maximum %v", name, i, rmax)
+								}
+							}
+						}
+						checkErrorBounds(t, name, bounds, &x, want, math.Max(cond, float64(n)))
+
+						if scale {
+							// Solve does not equilibrate, so its
+							// solution of a badly scaled system
+							// is not a useful reference.
+							continue
+						}
+						var sol Dense
+						err = sol.Solve(op, &b)
+						if err != nil {
+							t.Fatalf("%s: unexpected error from Solve: %v", name, err)
+						}
+						if !EqualApprox(&x, &sol, 1e-10*cond) {
+							t.Errorf("%s: solution does not match Solve", name)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// A singular matrix is reported as infinitely ill-conditioned
+	// and leaves the receiver unchanged.
+	a := conditionedMatrix(5, 10, rnd)
+	for i := 0; i < 5; i++ {
+		a.Set(i, 2, 0)
+	}
+	x := NewDense(5, 1, []float64{1, 2, 3, 4, 5})
+	bounds, err := x.SolveExpert(a, NewDense(5, 1, nil))
+	if c, ok := err.(Condition); !ok || !math.IsInf(float64(c), 1) {
+		t.Errorf("singular: expected infinite Condition error, got %v", err)
+	}
+	if !math.IsInf(bounds.Cond, 1) {
+		t.Errorf("singular: expected infinite condition estimate, got %v", bounds.Cond)
+	}
+	if !Equal(x, NewDense(5, 1, []float64{1, 2, 3, 4, 5})) {
+		t.Errorf("singular: receiver modified")
+	}
+
+	// A nearly singular matrix is solved but reported.
+	a = conditionedMatrix(5, 1e18, rnd)
+	_, err = x.SolveExpert(a, NewDense(5, 1, []float64{1, 1, 1, 1, 1}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("near singular: expected Condition error, got %v", err)
+	}
+}
+
+func TestSolveSymExpert(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 20} {
+		for _, nrhs := range []int{1, 3} {
+			for _, cond := range []float64{1, 1e6} {
+				for _, scale := range []bool{false, true} {
+					name := fmt.Sprintf("n=%d,nrhs=%d,cond=%g,scale=%t", n, nrhs, cond, scale)
+					q := randomOrthonormal(n, n, rnd)
+					a := NewSymDense(n, nil)
+					for i := 0; i < n; i++ {
+						d := 1.0
+						if n > 1 {
+							d = math.Pow(cond, -float64(i)/float64(n-1))
+						}
+						a.SymRankOne(a, d, q.ColView(i))
+					}
+					if scale {
+						for i := 0; i < n; i++ {
+							for j := i; j < n; j++ {
+								a.SetSym(i, j, math.Ldexp(a.At(i, j), 15*(i%3)+15*(j%3)))
+							}
+						}
+					}
+					want := NewDense(n, nrhs, nil)
+					for i := 0; i < n; i++ {
+						for j := 0; j < nrhs; j++ {
+							want.Set(i, j, rnd.NormFloat64())
+						}
+					}
+					var b Dense
+					b.Mul(a, want)
+
+					var x Dense
+					bounds, err := x.SolveSymExpert(a, &b)
+					if err != nil {
+						t.Errorf("%s: unexpected error: %v", name, err)
+						continue
+					}
+					if scale && n > 2 && bounds.RowScale == nil {
+						t.Errorf("%s: expected badly scaled matrix to be equilibrated", name)
+					}
+					if bounds.PivotGrowth != 1 {
+						t.Errorf("%s: unexpected pivot growth: %v", name, bounds.PivotGrowth)
+					}
+					if bounds.RowScale != nil {
+						for i := 0; i < n; i++ {
+							d := bounds.RowScale[i] * a.At(i, i) * bounds.RowScale[i]
+							if math.Abs(d-1) > 1e-14 {
+								t.Errorf("%s: diagonal %d of equilibrated matrix is %v", name, i, d)
+							}
+						}
+					}
+					checkErrorBounds(t, name, bounds, &x, want, math.Max(cond, float64(n)))
+
+					if scale {
+						continue
+					}
+					var sol Dense
+					err = sol.Solve(a, &b)
+					if err != nil {
+						t.Fatalf("%s: unexpected error from Solve: %v", name, err)
+					}
+					if !EqualApprox(&x, &sol, 1e-10*cond) {
+						t.Errorf("%s: solution does not match Solve", name)
+					}
+				}
+			}
+		}
+	}
+
+	// An indefinite matrix is reported and leaves the receiver unchanged.
+	a := NewSymDense(3, []float64{
+		1, 2, 0,
+		2, 1, 0,
+		0, 0, 1,
+	})
+	x := NewDense(3, 1, []float64{1, 2, 3})
+	_, err := x.SolveSymExpert(a, NewDense(3, 1, nil))
+	if err != ErrNotPSD {
+		t.Errorf("indefinite: expected ErrNotPSD, got %v", err)
+	}
+	if !Equal(x, NewDense(3, 1, []float64{1, 2, 3})) {
+		t.Errorf("indefinite: receiver modified")
+	}
+}
+
+func TestSolveExpertPanics(t *testing.T) {
+	t.Parallel()
+	var x Dense
+	if panicked, _ := panics(func() { x.SolveExpert(NewDense(3, 2, nil), NewDense(3, 1, nil)) }); !panicked {
+		t.Errorf("non-square: expected panic")
+	}
+	if panicked, _ := panics(func() { x.SolveExpert(eye(3), NewDense(2, 1, nil)) }); !panicked {
+		t.Errorf("shape: expected panic")
+	}
+	if panicked, _ := panics(func() { x.SolveSymExpert(NewSymDense(3, nil), NewDense(2, 1, nil)) }); !panicked {
+		t.Errorf("symmetric shape: expected panic")
+	}
+}
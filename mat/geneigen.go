@@ -0,0 +1,380 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// GeneralizedEigen is a type for creating and using the generalized eigenvalue
+// decomposition of a pair of dense square matrices (A, B).
+type GeneralizedEigen struct {
+	n int // The size of the factorized matrices.
+
+	kind EigenKind
+
+	alpha    []complex128
+	beta     []float64
+	rVectors *CDense
+	lVectors *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *GeneralizedEigen) succFact() bool {
+	return e.n != 0
+}
+
+// Factorize computes the generalized eigenvalues of the pair of n×n matrices
+// (A, B), and optionally the eigenvectors, using the QZ algorithm.
+//
+// A right generalized eigenvalue/eigenvector combination is defined by
+//
+//	A * x_r = λ * B * x_r
+//
+// and a left generalized eigenvalue/eigenvector combination is defined by
+//
+//	x_lᴴ * A = λ * x_lᴴ * B
+//
+// Each eigenvalue λ is represented as a ratio α/β, where α is complex and β is
+// real and non-negative. If B is singular, some β may be zero, corresponding to
+// infinite eigenvalues.
+//
+// kind specifies which of the eigenvectors, if any, to compute. See the
+// EigenKind documentation for more information.
+// Factorize panics if the input matrices are not square or have different
+// sizes.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (e *GeneralizedEigen) Factorize(a, b Matrix, kind EigenKind) (ok bool) {
+	// kill previous factorization.
+	e.n = 0
+	e.kind = 0
+	e.alpha = nil
+	e.beta = nil
+	e.rVectors = nil
+	e.lVectors = nil
+
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	br, bc := b.Dims()
+	if br != r || bc != c {
+		panic(ErrShape)
+	}
+	n := r
+
+	// Copy a and b because they are modified during the Lapack call.
+	var ad, bd Dense
+	ad.CloneFrom(a)
+	bd.CloneFrom(b)
+
+	left := kind&EigenLeft != 0
+	right := kind&EigenRight != 0
+
+	var vl, vr Dense
+	jobvl := lapack.LeftEVNone
+	jobvr := lapack.RightEVNone
+	if left {
+		vl = *NewDense(n, n, nil)
+		jobvl = lapack.LeftEVCompute
+	}
+	if right {
+		vr = *NewDense(n, n, nil)
+		jobvr = lapack.RightEVCompute
+	}
+
+	alphar := getFloat64s(n, false)
+	defer putFloat64s(alphar)
+	alphai := getFloat64s(n, false)
+	defer putFloat64s(alphai)
+	beta := make([]float64, n)
+
+	work := []float64{0}
+	lapack64.Ggev(jobvl, jobvr, ad.mat, bd.mat, alphar, alphai, beta, vl.mat, vr.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	ok = lapack64.Ggev(jobvl, jobvr, ad.mat, bd.mat, alphar, alphai, beta, vl.mat, vr.mat, work, len(work))
+	putFloat64s(work)
+	if !ok {
+		return false
+	}
+	e.n = n
+	e.kind = kind
+
+	alpha := make([]complex128, n)
+	for i, v := range alphar {
+		alpha[i] = complex(v, alphai[i])
+	}
+	e.alpha = alpha
+	e.beta = beta
+
+	// Construct complex eigenvectors from float64 data.
+	if left {
+		cvl := NewCDense(n, n, nil)
+		complexEigenTo(cvl, &vl, alpha)
+		e.lVectors = cvl
+	}
+	if right {
+		cvr := NewCDense(n, n, nil)
+		complexEigenTo(cvr, &vr, alpha)
+		e.rVectors = cvr
+	}
+	return true
+}
+
+// Kind returns the EigenKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (e *GeneralizedEigen) Kind() EigenKind {
+	if !e.succFact() {
+		return -1
+	}
+	return e.kind
+}
+
+// Values extracts the generalized eigenvalues λ = α/β of the factorized pair
+// of matrices. Eigenvalues with β equal to zero are returned as complex
+// infinity. If dst is non-nil, the values are stored in-place into dst. In
+// this case dst must have length n, otherwise Values will panic. If dst is
+// nil, then a new slice will be allocated of the proper length and filled
+// with the eigenvalues.
+//
+// Values panics if the decomposition was not successful.
+func (e *GeneralizedEigen) Values(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	for i, alpha := range e.alpha {
+		if e.beta[i] == 0 {
+			dst[i] = cmplx.Inf()
+			continue
+		}
+		dst[i] = alpha / complex(e.beta[i], 0)
+	}
+	return dst
+}
+
+// Alphas extracts the numerators α of the generalized eigenvalues λ = α/β
+// of the factorized pair of matrices. If dst is non-nil, the values are stored
+// in-place into dst. In this case dst must have length n, otherwise Alphas will
+// panic. If dst is nil, then a new slice will be allocated of the proper length
+// and filled with the numerators.
+//
+// Alphas panics if the decomposition was not successful.
+func (e *GeneralizedEigen) Alphas(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.alpha)
+	return dst
+}
+
+// Betas extracts the non-negative denominators β of the generalized
+// eigenvalues λ = α/β of the factorized pair of matrices. If dst is non-nil,
+// the values are stored in-place into dst. In this case dst must have length
+// n, otherwise Betas will panic. If dst is nil, then a new slice will be
+// allocated of the proper length and filled with the denominators.
+//
+// Betas panics if the decomposition was not successful.
+func (e *GeneralizedEigen) Betas(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.beta)
+	return dst
+}
+
+// VectorsTo stores the right generalized eigenvectors of the decomposition
+// into the columns of dst. Each computed eigenvector is scaled so that its
+// largest component has |real part| + |imag. part| = 1.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
+// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
+// panic if the eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (e *GeneralizedEigen) VectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if e.kind&EigenRight == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.rVectors)
+}
+
+// LeftVectorsTo stores the left generalized eigenvectors of the decomposition
+// into the columns of dst. Each computed eigenvector is scaled so that its
+// largest component has |real part| + |imag. part| = 1.
+//
+// If dst is empty, LeftVectorsTo will resize dst to be n×n. When dst is
+// non-empty, LeftVectorsTo will panic if dst is not n×n. LeftVectorsTo will
+// also panic if the left eigenvectors were not computed during the
+// factorization, or if the receiver does not contain a successful
+// factorization.
+func (e *GeneralizedEigen) LeftVectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if e.kind&EigenLeft == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.lVectors)
+}
+
+// GeneralizedEigenSym is a type for computing the eigenvalues and, optionally,
+// the eigenvectors of the symmetric-definite generalized eigenproblem
+//
+//	A * x = λ * B * x
+//
+// where A and B are symmetric and B is positive definite.
+type GeneralizedEigenSym struct {
+	vectorsComputed bool
+
+	n       int
+	values  []float64
+	vectors *Dense
+}
+
+// Factorize computes the eigenvalues of the symmetric-definite pair (A, B),
+// and optionally the eigenvectors. The eigenvalues are real and are returned
+// in ascending order, and the eigenvectors X are normalized so that
+//
+//	Xᵀ * B * X = I.
+//
+// Factorize panics if A and B have different sizes.
+//
+// If vectors is false, the eigenvectors are not computed and later calls to
+// VectorsTo will panic.
+//
+// Factorize returns whether the factorization succeeded. It returns false if
+// B is not positive definite or if the computation of the eigenvalues failed.
+// If it returns false, methods that require a successful factorization will
+// panic.
+func (e *GeneralizedEigenSym) Factorize(a, b Symmetric, vectors bool) (ok bool) {
+	// kill previous decomposition
+	e.vectorsComputed = false
+	e.n = 0
+	e.values = nil
+	e.vectors = nil
+
+	n := a.SymmetricDim()
+	if b.SymmetricDim() != n {
+		panic(ErrShape)
+	}
+	ad := NewSymDense(n, nil)
+	ad.CopySym(a)
+	bd := NewSymDense(n, nil)
+	bd.CopySym(b)
+
+	jobz := lapack.EVNone
+	if vectors {
+		jobz = lapack.EVCompute
+	}
+	w := make([]float64, n)
+	work := []float64{0}
+	lapack64.Sygv(1, jobz, ad.mat, bd.mat, w, work, -1)
+
+	work = getFloat64s(int(work[0]), false)
+	ok = lapack64.Sygv(1, jobz, ad.mat, bd.mat, w, work, len(work))
+	putFloat64s(work)
+	if !ok {
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.n = n
+	e.values = w
+	if vectors {
+		e.vectors = NewDense(n, n, ad.mat.Data)
+	}
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *GeneralizedEigenSym) succFact() bool {
+	return e.n != 0
+}
+
+// Values extracts the eigenvalues of the factorized symmetric-definite pair in
+// ascending order. If dst is non-nil, the values are stored in-place into dst.
+// In this case dst must have length n, otherwise Values will panic. If dst is
+// nil, then a new slice will be allocated of the proper length and filled with
+// the eigenvalues.
+//
+// Values panics if the receiver does not contain a successful factorization.
+func (e *GeneralizedEigenSym) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo stores the B-orthonormal eigenvectors of the factorized
+// symmetric-definite pair into the columns of dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is non-empty,
+// VectorsTo will panic if dst is not n×n. VectorsTo will also panic if the
+// eigenvectors were not computed during the factorization, or if the receiver
+// does not contain a successful factorization.
+func (e *GeneralizedEigenSym) VectorsTo(dst *Dense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.vectors)
+}
@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestGeneralizedEigen(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	// Hand coded test with an infinite eigenvalue.
+	a := NewDense(3, 3, []float64{
+		1, 0, 0,
+		0, 2, 0,
+		0, 0, 3,
+	})
+	b := NewDense(3, 3, []float64{
+		2, 0, 0,
+		0, 4, 0,
+		0, 0, 0,
+	})
+	var ge GeneralizedEigen
+	if !ge.Factorize(a, b, EigenNone) {
+		t.Fatal("unexpected factorization failure")
+	}
+	var nfinite, ninf int
+	for _, v := range ge.Values(nil) {
+		switch {
+		case cmplx.IsInf(v):
+			ninf++
+		case cmplx.Abs(v-0.5) < tol:
+			nfinite++
+		default:
+			t.Errorf("unexpected eigenvalue %v", v)
+		}
+	}
+	if nfinite != 2 || ninf != 1 {
+		t.Errorf("unexpected eigenvalues %v", ge.Values(nil))
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for cas := 0; cas < 5; cas++ {
+			a := NewDense(n, n, nil)
+			b := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+					b.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			var ge GeneralizedEigen
+			if !ge.Factorize(a, b, EigenBoth) {
+				t.Errorf("n=%d,cas=%d: unexpected factorization failure", n, cas)
+				continue
+			}
+			if ge.Kind() != EigenBoth {
+				t.Errorf("n=%d,cas=%d: unexpected kind", n, cas)
+			}
+			alpha := ge.Alphas(nil)
+			beta := ge.Betas(nil)
+			values := ge.Values(nil)
+			for j := range values {
+				if beta[j] < 0 {
+					t.Errorf("n=%d,cas=%d: negative beta", n, cas)
+				}
+				if beta[j] != 0 && cmplx.Abs(values[j]-alpha[j]/complex(beta[j], 0)) > tol*cmplx.Abs(values[j]) {
+					t.Errorf("n=%d,cas=%d: value %d does not match alpha/beta", n, cas, j)
+				}
+			}
+
+			var vr, vl CDense
+			ge.VectorsTo(&vr)
+			ge.LeftVectorsTo(&vl)
+			anorm := Norm(a, 1)
+			bnorm := Norm(b, 1)
+			for j := 0; j < n; j++ {
+				// Check β*A*x - α*B*x ≈ 0 for the right eigenvectors and
+				// β*Aᵀ*y - conj(α)*Bᵀ*y ≈ 0 for the left eigenvectors.
+				var rr, rl, xnorm, ynorm float64
+				for i := 0; i < n; i++ {
+					var ax, bx, ay, by complex128
+					for k := 0; k < n; k++ {
+						ax += complex(a.At(i, k), 0) * vr.At(k, j)
+						bx += complex(b.At(i, k), 0) * vr.At(k, j)
+						ay += complex(a.At(k, i), 0) * vl.At(k, j)
+						by += complex(b.At(k, i), 0) * vl.At(k, j)
+					}
+					rr += cmplx.Abs(complex(beta[j], 0)*ax - alpha[j]*bx)
+					rl += cmplx.Abs(complex(beta[j], 0)*ay - cmplx.Conj(alpha[j])*by)
+					xnorm += cmplx.Abs(vr.At(i, j))
+					ynorm += cmplx.Abs(vl.At(i, j))
+				}
+				scale := beta[j]*anorm + cmplx.Abs(alpha[j])*bnorm
+				if rr > tol*scale*xnorm {
+					t.Errorf("n=%d,cas=%d: right eigenvector %d does not match", n, cas, j)
+				}
+				if rl > tol*scale*ynorm {
+					t.Errorf("n=%d,cas=%d: left eigenvector %d does not match", n, cas, j)
+				}
+			}
+
+			// With B = I the generalized eigenvalues are the eigenvalues of A.
+			var eig Eigen
+			if !eig.Factorize(a, EigenNone) {
+				t.Errorf("n=%d,cas=%d: unexpected Eigen failure", n, cas)
+				continue
+			}
+			var gi GeneralizedEigen
+			if !gi.Factorize(a, eye(n), EigenNone) {
+				t.Errorf("n=%d,cas=%d: unexpected failure for B = I", n, cas)
+				continue
+			}
+			want := eig.Values(nil)
+			for _, v := range gi.Values(nil) {
+				var found bool
+				for _, w := range want {
+					if cmplx.Abs(v-w) < 1e-10*math.Max(1, cmplx.Abs(w)) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("n=%d,cas=%d: eigenvalue %v not found for B = I", n, cas, v)
+				}
+			}
+		}
+	}
+}
+
+func TestGeneralizedEigenSym(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 70} {
+		for cas := 0; cas < 5; cas++ {
+			a := NewSymDense(n, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < n; j++ {
+					a.SetSym(i, j, rnd.NormFloat64())
+				}
+			}
+			x := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					x.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			b := NewSymDense(n, nil)
+			b.SymOuterK(1, x)
+			for i := 0; i < n; i++ {
+				b.SetSym(i, i, b.At(i, i)+1)
+			}
+
+			var ge GeneralizedEigenSym
+			if !ge.Factorize(a, b, true) {
+				t.Errorf("n=%d,cas=%d: unexpected factorization failure", n, cas)
+				continue
+			}
+			values := ge.Values(nil)
+			if !sort.Float64sAreSorted(values) {
+				t.Errorf("n=%d,cas=%d: eigenvalues not ascending", n, cas)
+			}
+			var vecs Dense
+			ge.VectorsTo(&vecs)
+
+			// Check that A*X = B*X*Λ.
+			var ax, bx Dense
+			ax.Mul(a, &vecs)
+			bx.Mul(b, &vecs)
+			bx.Mul(&bx, NewDiagDense(n, values))
+			if !EqualApprox(&ax, &bx, tol*Norm(a, 1)*Norm(b, 1)*Norm(&vecs, 1)) {
+				t.Errorf("n=%d,cas=%d: A*X != B*X*Λ", n, cas)
+			}
+
+			// Check that Xᵀ*B*X = I.
+			var xbx Dense
+			xbx.Product(vecs.T(), b, &vecs)
+			if !EqualApprox(&xbx, eye(n), tol*float64(n)*Norm(b, 1)) {
+				t.Errorf("n=%d,cas=%d: eigenvectors not B-orthonormal", n, cas)
+			}
+
+			var ge2 GeneralizedEigenSym
+			ge2.Factorize(a, b, false)
+			if !floats.EqualApprox(ge2.Values(nil), values, 1e-10) {
+				t.Errorf("n=%d,cas=%d: eigenvalue mismatch when no vectors computed", n, cas)
+			}
+		}
+	}
+
+	// B not positive definite.
+	a := NewSymDense(2, []float64{1, 0, 0, 1})
+	b := NewSymDense(2, []float64{1, 2, 2, 1})
+	var ge GeneralizedEigenSym
+	if ge.Factorize(a, b, true) {
+		t.Errorf("unexpected success for B not positive definite")
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+var (
+	hankel *Hankel
+	_      Matrix = hankel
+)
+
+// Hankel represents an r×c Hankel matrix, a matrix that is constant along each
+// of its anti-diagonals. A Hankel matrix is defined by its first column and its
+// last row, so that element (i, j) is col[i+j] if i+j < r and row[i+j-r+1]
+// otherwise.
+//
+// Reversing the order of the rows of a Hankel matrix gives a Toeplitz matrix,
+// so matrix-vector products are computed in O((r+c) log(r+c)) time using the
+// FFT and square systems are solved in O(n²) time by Levinson recursion.
+type Hankel struct {
+	col, row []float64
+}
+
+// NewHankel creates a new Hankel matrix with first column col and last row row.
+// The slices are used as the backing data, so changes to their elements will be
+// reflected in the returned matrix.
+//
+// NewHankel will panic if col or row has zero length or if the last element of
+// col and the first element of row differ.
+func NewHankel(col, row []float64) *Hankel {
+	if len(col) == 0 || len(row) == 0 {
+		panic(ErrZeroLength)
+	}
+	last := col[len(col)-1]
+	if last != row[0] && !(math.IsNaN(last) && math.IsNaN(row[0])) {
+		panic("mat: hankel column and row disagree on the anti-diagonal")
+	}
+	return &Hankel{col: col, row: row}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (h *Hankel) Dims() (r, c int) {
+	return len(h.col), len(h.row)
+}
+
+// At returns the element at row i, column j.
+func (h *Hankel) At(i, j int) float64 {
+	r := len(h.col)
+	if uint(i) >= uint(r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(len(h.row)) {
+		panic(ErrColAccess)
+	}
+	if i+j < r {
+		return h.col[i+j]
+	}
+	return h.row[i+j-r+1]
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (h *Hankel) T() Matrix {
+	return Transpose{h}
+}
+
+// Column returns the first column of the matrix. The returned slice is the
+// backing data of the receiver.
+func (h *Hankel) Column() []float64 {
+	return h.col
+}
+
+// Row returns the last row of the matrix. The returned slice is the backing
+// data of the receiver.
+func (h *Hankel) Row() []float64 {
+	return h.row
+}
+
+// MulVecTo computes H⋅x or Hᵀ⋅x storing the result into dst.
+func (h *Hankel) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	m, n := h.Dims()
+	if trans {
+		m, n = n, m
+	}
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+
+	// Both H and Hᵀ are defined by the same anti-diagonal sequence, and
+	// the product is its convolution with the reversed vector.
+	s := make([]float64, len(h.col)+len(h.row)-1)
+	copy(s, h.col)
+	copy(s[len(h.col):], h.row[1:])
+	xd := vecData(x)
+	reverse(xd)
+
+	y := make([]float64, m)
+	convolveValid(y, s, xd)
+	setVecData(dst, y)
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	H * x = b   if trans == false
+//	Hᵀ * x = b  if trans == true
+//
+// for the square Hankel matrix H, storing the result into dst. A square Hankel
+// matrix is symmetric, so trans does not change the solution. The system is
+// solved by Levinson recursion on the Toeplitz matrix obtained by reversing the
+// rows of H.
+//
+// If the recursion breaks down a Condition error is returned and the contents
+// of dst are undefined. See Toeplitz.SolveVecTo for the conditions under which
+// this may happen.
+//
+// SolveVecTo will panic with ErrSquare if H is not square.
+func (h *Hankel) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	n := len(h.col)
+	if len(h.row) != n {
+		panic(ErrSquare)
+	}
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	col := make([]float64, n)
+	copy(col, h.col)
+	reverse(col)
+	x := vecData(b)
+	reverse(x)
+	_, _, ok := levinson(col, h.row, x, 1)
+	setVecData(dst, x)
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestHankel(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ r, c int }{
+		{1, 1},
+		{2, 2},
+		{4, 7},
+		{7, 4},
+		{16, 16},
+		{3, 100},
+		{100, 80},
+		{90, 90},
+	} {
+		h := make([]float64, test.r+test.c-1)
+		randomSlice(h, rnd)
+		if test.r == test.c {
+			// Make the anti-diagonal dominant so that the reversed
+			// Toeplitz system can be solved by Levinson recursion.
+			h[test.r-1] = float64(2 * test.r)
+		}
+		a := NewHankel(h[:test.r], h[test.r-1:])
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				if got, want := a.At(i, j), h[i+j]; got != want {
+					t.Fatalf("unexpected element at (%d,%d): got %v want %v", i, j, got, want)
+				}
+			}
+		}
+		testStructured(t, fmt.Sprintf("%d×%d", test.r, test.c), a, 1e-10, rnd)
+	}
+
+	if p, _ := panics(func() { NewHankel([]float64{1, 2}, []float64{1, 2}) }); !p {
+		t.Errorf("expected panic for mismatched anti-diagonal")
+	}
+}
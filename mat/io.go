@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"io"
 	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
 )
 
 // version is the current on-disk codec version.
@@ -29,6 +31,10 @@ var (
 	errTooSmall  = errors.New("mat: input slice too small")
 	errBadBuffer = errors.New("mat: data buffer size mismatch")
 	errBadSize   = errors.New("mat: invalid dimension")
+	errBadFact   = errors.New(badFact)
+	errBadPivot  = errors.New("mat: invalid pivot")
+	errBadChol   = errors.New(badCholesky)
+	errBadCond   = errors.New("mat: invalid condition number")
 )
 
 // Type encoding scheme:
@@ -43,10 +49,29 @@ var (
 // Triangular 		'T' 	'F' 		ul 		Diag==Unit 	n 	n 	0 	0
 // TriangularBand 	'T' 	'B' 		ul 		Diag==Unit 	n 	n 	k 	k
 // TriangularPacked 	'T' 	'P' 		ul	 	Diag==Unit 	n 	n 	0 	0
+// ComplexGeneral 	'C' 	'F' 		'A' 		false 		r 	c 	0 	0
 //
-// G - general, S - symmetric, T - triangular
+// G - general, S - symmetric, T - triangular, C - complex general
 // F - full, B - band, P - packed
 // A - all, U - upper, L - lower
+//
+// Full, band and packed data elements are stored in row-major order, with
+// only the elements within the band or the triangle held for band and packed
+// types. Complex elements are stored as the real part followed by the
+// imaginary part.
+//
+// Factorizations are encoded with the form 'D', and the packing identifies the
+// kind of factorization:
+//
+// Type 		Form 	Packing 	Uplo 		Unit 		Rows 	Columns kU 	kL
+// Cholesky 		'D' 	'C' 		'U' 		false 		n 	n 	0 	0
+// LU 			'D' 	'L' 		'A' 		nonsingular 	n 	n 	0 	0
+// QR 			'D' 	'Q' 		'A' 		explicit Q 	m 	n 	0 	0
+// SVD 			'D' 	'S' 		'A' 		false 		k 	0 	kind 	0
+// EigenSym 		'D' 	'E' 		'A' 		vectors 	n 	m 	0 	0
+//
+// The layout of the data following the header of a factorization is described
+// by the factorization's MarshalBinary method.
 
 // MarshalBinary encodes the receiver into a binary form and returns the result.
 //
@@ -169,7 +194,10 @@ func (m *Dense) UnmarshalBinary(data []byte) error {
 	if size == 0 {
 		return ErrZeroLength
 	}
-	if int(size) < 0 || size > maxLen {
+	// rows*cols and the byte length of the data may overflow to small
+	// positive values that are consistent with the length of data, so
+	// check the dimensions before the products.
+	if rows > maxLen/cols || size > (maxLen-int64(headerSize))/int64(sizeFloat64) {
 		return errTooBig
 	}
 	if len(data) != headerSize+int(rows*cols)*sizeFloat64 {
@@ -225,7 +253,9 @@ func (m *Dense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
 	if size == 0 {
 		return n, ErrZeroLength
 	}
-	if int(size) < 0 || size > maxLen {
+	// rows*cols and the byte length of the data may overflow, so check
+	// the dimensions before the products.
+	if rows > maxLen/cols || size > (maxLen-int64(headerSize))/int64(sizeFloat64) {
 		return n, errTooBig
 	}
 
@@ -358,7 +388,9 @@ func (v *VecDense) UnmarshalBinary(data []byte) error {
 	if n < 0 {
 		return errBadSize
 	}
-	if int64(maxLen) < n {
+	// The byte length of the data may overflow to a small positive value
+	// that is consistent with the length of data.
+	if n > (maxLen-int64(headerSize))/int64(sizeFloat64) {
 		return errTooBig
 	}
 	if len(data) != headerSize+int(n)*sizeFloat64 {
@@ -407,7 +439,7 @@ func (v *VecDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
 	if l < 0 {
 		return n, errBadSize
 	}
-	if int64(maxLen) < l {
+	if l > (maxLen-int64(headerSize))/int64(sizeFloat64) {
 		return n, errTooBig
 	}
 
@@ -428,6 +460,1109 @@ func (v *VecDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
 	return n, nil
 }
 
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// SymDense is little-endian encoded with the SymmetricPacked header of the
+// type encoding scheme, followed by the n(n+1)/2 elements of the upper
+// triangle in row-major order.
+func (s SymDense) MarshalBinary() ([]byte, error) {
+	n := s.mat.N
+	e, err := newEncoder(storage{
+		Form: 'S', Packing: 'P', Uplo: 'U',
+		Rows: int64(n), Cols: int64(n),
+	}, packedLen(int64(n)))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			e.float64(s.at(i, j))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty SymDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (s *SymDense) UnmarshalBinary(data []byte) error {
+	if !s.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	n := header.Rows
+	if header.Cols != n {
+		return ErrShape
+	}
+	if header.clearDims() != (storage{Form: 'S', Packing: 'P', Uplo: 'U'}) {
+		return errWrongType
+	}
+	if err := checkDims(n, n); err != nil {
+		return err
+	}
+	if err := d.want(packedLen(n), n); err != nil {
+		return err
+	}
+	*s = *NewSymDense(int(n), nil)
+	for i := 0; i < int(n); i++ {
+		for j := i; j < int(n); j++ {
+			s.set(i, j, d.float64())
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// TriDense is little-endian encoded with the TriangularPacked header of the
+// type encoding scheme, followed by the n(n+1)/2 elements of the triangle in
+// row-major order.
+func (t TriDense) MarshalBinary() ([]byte, error) {
+	n := t.mat.N
+	kind := t.triKind()
+	e, err := newEncoder(storage{
+		Form: 'T', Packing: 'P', Uplo: uploByte(kind),
+		Rows: int64(n), Cols: int64(n),
+	}, packedLen(int64(n)))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		lo, hi := triRange(i, n, kind)
+		for j := lo; j < hi; j++ {
+			e.float64(t.at(i, j))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty TriDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (t *TriDense) UnmarshalBinary(data []byte) error {
+	if !t.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	n := header.Rows
+	if header.Cols != n {
+		return ErrShape
+	}
+	kind, ok := triKindOf(header.Uplo)
+	if !ok || header.clearDims() != (storage{Form: 'T', Packing: 'P', Uplo: header.Uplo}) {
+		return errWrongType
+	}
+	if err := checkDims(n, n); err != nil {
+		return err
+	}
+	if err := d.want(packedLen(n), n); err != nil {
+		return err
+	}
+	*t = *NewTriDense(int(n), kind, nil)
+	for i := 0; i < int(n); i++ {
+		lo, hi := triRange(i, int(n), kind)
+		for j := lo; j < hi; j++ {
+			t.set(i, j, d.float64())
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// BandDense is little-endian encoded with the Band header of the type encoding
+// scheme, followed by the elements within the band in row-major order.
+func (b BandDense) MarshalBinary() ([]byte, error) {
+	r, c, kl, ku := b.mat.Rows, b.mat.Cols, b.mat.KL, b.mat.KU
+	e, err := newEncoder(storage{
+		Form: 'G', Packing: 'B', Uplo: 'A',
+		Rows: int64(r), Cols: int64(c), KU: int64(ku), KL: int64(kl),
+	}, bandLen(int64(r), int64(c), int64(kl), int64(ku)))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < min(r, c+kl); i++ {
+		for j := max(0, i-kl); j < min(c, i+ku+1); j++ {
+			e.float64(b.at(i, j))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty BandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (b *BandDense) UnmarshalBinary(data []byte) error {
+	if !b.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d, r, c, kl, ku, err := decodeBand(data)
+	if err != nil {
+		return err
+	}
+	*b = *NewBandDense(r, c, kl, ku, nil)
+	for i := 0; i < min(r, c+kl); i++ {
+		for j := max(0, i-kl); j < min(c, i+ku+1); j++ {
+			b.set(i, j, d.float64())
+		}
+	}
+	return nil
+}
+
+// decodeBand decodes the header of a Band encoded matrix and checks that the
+// remaining data holds the elements of the band.
+func decodeBand(data []byte) (d *decoder, r, c, kl, ku int, err error) {
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return nil, 0, 0, 0, 0, err
+	}
+	if header.clearDims() != (storage{Form: 'G', Packing: 'B', Uplo: 'A'}) {
+		return nil, 0, 0, 0, 0, errWrongType
+	}
+	if err := checkDims(header.Rows, header.Cols); err != nil {
+		return nil, 0, 0, 0, 0, err
+	}
+	if header.KL < 0 || header.KU < 0 || header.KL >= header.Rows || header.KU >= header.Cols {
+		return nil, 0, 0, 0, 0, errBadSize
+	}
+	// Every row up to min(r, c+kl) and every diagonal in the band
+	// holds at least one element.
+	if err := d.within(header.KL, header.KU); err != nil {
+		return nil, 0, 0, 0, 0, err
+	}
+	rows := header.Rows
+	if header.Cols < rows-header.KL {
+		rows = header.Cols + header.KL
+	}
+	if err := d.within(rows, header.KL+header.KU+1); err != nil {
+		return nil, 0, 0, 0, 0, err
+	}
+	if err := d.want(bandLen(header.Rows, header.Cols, header.KL, header.KU)); err != nil {
+		return nil, 0, 0, 0, 0, err
+	}
+	return d, int(header.Rows), int(header.Cols), int(header.KL), int(header.KU), nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// SymBandDense is little-endian encoded with the SymmetricBand header of the
+// type encoding scheme, followed by the elements within the upper band in
+// row-major order.
+func (s SymBandDense) MarshalBinary() ([]byte, error) {
+	n, k := s.mat.N, s.mat.K
+	e, err := newEncoder(storage{
+		Form: 'S', Packing: 'B', Uplo: 'U',
+		Rows: int64(n), Cols: int64(n), KU: int64(k), KL: int64(k),
+	}, bandLen(int64(n), int64(n), 0, int64(k)))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < min(n, i+k+1); j++ {
+			e.float64(s.at(i, j))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty SymBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (s *SymBandDense) UnmarshalBinary(data []byte) error {
+	if !s.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	n, k := header.Rows, header.KU
+	if header.Cols != n || header.KL != k {
+		return ErrShape
+	}
+	if header.clearDims() != (storage{Form: 'S', Packing: 'B', Uplo: 'U'}) {
+		return errWrongType
+	}
+	if err := checkDims(n, n); err != nil {
+		return err
+	}
+	if k < 0 || k >= n {
+		return errBadSize
+	}
+	if err := d.within(n, k+1); err != nil {
+		return err
+	}
+	if err := d.want(bandLen(n, n, 0, k)); err != nil {
+		return err
+	}
+	*s = *NewSymBandDense(int(n), int(k), nil)
+	for i := 0; i < int(n); i++ {
+		for j := i; j < min(int(n), i+int(k)+1); j++ {
+			s.set(i, j, d.float64())
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// TriBandDense is little-endian encoded with the TriangularBand header of the
+// type encoding scheme, followed by the elements within the band in row-major
+// order.
+func (t TriBandDense) MarshalBinary() ([]byte, error) {
+	n, k, kind := t.mat.N, t.mat.K, t.triKind()
+	kl, ku := k, 0
+	if kind == Upper {
+		kl, ku = 0, k
+	}
+	e, err := newEncoder(storage{
+		Form: 'T', Packing: 'B', Uplo: uploByte(kind),
+		Rows: int64(n), Cols: int64(n), KU: int64(k), KL: int64(k),
+	}, bandLen(int64(n), int64(n), int64(kl), int64(ku)))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			e.float64(t.at(i, j))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty TriBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (t *TriBandDense) UnmarshalBinary(data []byte) error {
+	if !t.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	n, k := header.Rows, header.KU
+	if header.Cols != n || header.KL != k {
+		return ErrShape
+	}
+	kind, ok := triKindOf(header.Uplo)
+	if !ok || header.clearDims() != (storage{Form: 'T', Packing: 'B', Uplo: header.Uplo}) {
+		return errWrongType
+	}
+	if err := checkDims(n, n); err != nil {
+		return err
+	}
+	if k < 0 || k >= n {
+		return errBadSize
+	}
+	if err := d.within(n, k+1); err != nil {
+		return err
+	}
+	if err := d.want(bandLen(n, n, 0, k)); err != nil {
+		return err
+	}
+	kl, ku := int(k), 0
+	if kind == Upper {
+		kl, ku = 0, int(k)
+	}
+	*t = *NewTriBandDense(int(n), int(k), kind, nil)
+	for i := 0; i < int(n); i++ {
+		for j := max(0, i-kl); j < min(int(n), i+ku+1); j++ {
+			t.SetTriBand(i, j, d.float64())
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// Tridiag is encoded in the same way as a BandDense with a lower and upper
+// bandwidth of one, or zero if the matrix is 1×1.
+func (a Tridiag) MarshalBinary() ([]byte, error) {
+	n := a.mat.N
+	k := min(1, max(n-1, 0))
+	e, err := newEncoder(storage{
+		Form: 'G', Packing: 'B', Uplo: 'A',
+		Rows: int64(n), Cols: int64(n), KU: int64(k), KL: int64(k),
+	}, bandLen(int64(n), int64(n), int64(k), int64(k)))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		for j := max(0, i-k); j < min(n, i+k+1); j++ {
+			e.float64(a.at(i, j))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty Tridiag matrix.
+//
+// See MarshalBinary for the on-disk layout.
+func (a *Tridiag) UnmarshalBinary(data []byte) error {
+	if !a.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d, r, c, kl, ku, err := decodeBand(data)
+	if err != nil {
+		return err
+	}
+	k := min(1, r-1)
+	if r != c || kl != k || ku != k {
+		return ErrShape
+	}
+	*a = *NewTridiag(r, nil, nil, nil)
+	for i := 0; i < r; i++ {
+		for j := max(0, i-k); j < min(r, i+k+1); j++ {
+			a.set(i, j, d.float64())
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// DiagDense is encoded in the same way as a BandDense with a lower and upper
+// bandwidth of zero.
+func (d DiagDense) MarshalBinary() ([]byte, error) {
+	n := d.mat.N
+	e, err := newEncoder(storage{
+		Form: 'G', Packing: 'B', Uplo: 'A',
+		Rows: int64(n), Cols: int64(n),
+	}, int64(n))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		e.float64(d.mat.Data[i*d.mat.Inc])
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty DiagDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+func (d *DiagDense) UnmarshalBinary(data []byte) error {
+	if !d.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	dec, r, c, kl, ku, err := decodeBand(data)
+	if err != nil {
+		return err
+	}
+	if r != c || kl != 0 || ku != 0 {
+		return ErrShape
+	}
+	*d = *NewDiagDense(r, nil)
+	for i := range d.mat.Data {
+		d.mat.Data[i] = dec.float64()
+	}
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CDense is little-endian encoded with the ComplexGeneral header of the type
+// encoding scheme, followed by the elements in row-major order with each
+// element stored as its real part followed by its imaginary part.
+func (m CDense) MarshalBinary() ([]byte, error) {
+	r, c := m.mat.Rows, m.mat.Cols
+	e, err := newEncoder(storage{
+		Form: 'C', Packing: 'F', Uplo: 'A',
+		Rows: int64(r), Cols: int64(c),
+	}, 2*int64(r)*int64(c))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := m.at(i, j)
+			e.float64(real(v))
+			e.float64(imag(v))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-empty CDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (m *CDense) UnmarshalBinary(data []byte) error {
+	if !m.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	r, c := header.Rows, header.Cols
+	if header.clearDims() != (storage{Form: 'C', Packing: 'F', Uplo: 'A'}) {
+		return errWrongType
+	}
+	if err := checkDims(r, c); err != nil {
+		return err
+	}
+	if err := d.want(2*r*c, r, c); err != nil {
+		return err
+	}
+	*m = *NewCDense(int(r), int(c), nil)
+	for i := range m.mat.Data {
+		re := d.float64()
+		m.mat.Data[i] = complex(re, d.float64())
+	}
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+// MarshalBinary returns an error if the receiver does not hold a successful
+// factorization.
+//
+// Cholesky is little-endian encoded with the Cholesky header of the
+// factorization encoding scheme, followed by the condition number and the
+// n(n+1)/2 elements of the upper triangular factor U in row-major order.
+func (c Cholesky) MarshalBinary() ([]byte, error) {
+	if !c.valid() {
+		return nil, errBadFact
+	}
+	n := c.chol.mat.N
+	e, err := newEncoder(storage{
+		Form: 'D', Packing: 'C', Uplo: 'U',
+		Rows: int64(n), Cols: int64(n),
+	}, 1+packedLen(int64(n)))
+	if err != nil {
+		return nil, err
+	}
+	e.float64(c.cond)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			e.float64(c.chol.at(i, j))
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// UnmarshalBinary returns an error if the decoded factor has elements that
+// are not finite or diagonal elements that are not positive, or if the
+// decoded condition number is NaN or less than one.
+func (c *Cholesky) UnmarshalBinary(data []byte) error {
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	n := header.Rows
+	if header.Cols != n {
+		return ErrShape
+	}
+	if header.clearDims() != (storage{Form: 'D', Packing: 'C', Uplo: 'U'}) {
+		return errWrongType
+	}
+	if err := checkDims(n, n); err != nil {
+		return err
+	}
+	if err := d.want(1+packedLen(n), n); err != nil {
+		return err
+	}
+	cond := d.float64()
+	if math.IsNaN(cond) || cond < 1 {
+		return errBadCond
+	}
+	chol := NewTriDense(int(n), Upper, nil)
+	for i := 0; i < int(n); i++ {
+		for j := i; j < int(n); j++ {
+			v := d.float64()
+			if math.IsNaN(v) || math.IsInf(v, 0) || (i == j && v <= 0) {
+				return errBadChol
+			}
+			chol.set(i, j, v)
+		}
+	}
+	c.chol = chol
+	c.cond = cond
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+// MarshalBinary returns an error if the receiver does not hold a factorization.
+//
+// LU is little-endian encoded with the LU header of the factorization encoding
+// scheme, followed by the condition number, the n² elements of the combined L
+// and U factors in row-major order, and the n row interchanges performed during
+// the factorization as int64 values.
+func (lu LU) MarshalBinary() ([]byte, error) {
+	if !lu.isValid() {
+		return nil, errBadFact
+	}
+	n := lu.lu.mat.Rows
+	e, err := newEncoder(storage{
+		Form: 'D', Packing: 'L', Uplo: 'A', Unit: lu.ok,
+		Rows: int64(n), Cols: int64(n),
+	}, 1+int64(n)*int64(n)+int64(n))
+	if err != nil {
+		return nil, err
+	}
+	e.float64(lu.cond)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			e.float64(lu.lu.at(i, j))
+		}
+	}
+	for _, v := range lu.swaps {
+		e.int(v)
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+func (lu *LU) UnmarshalBinary(data []byte) error {
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	n := header.Rows
+	if header.Cols != n {
+		return ErrShape
+	}
+	ok := header.Unit
+	header.Unit = false
+	if header.clearDims() != (storage{Form: 'D', Packing: 'L', Uplo: 'A'}) {
+		return errWrongType
+	}
+	if err := checkDims(n, n); err != nil {
+		return err
+	}
+	if err := d.want(1+n*n+n, n, n); err != nil {
+		return err
+	}
+	cond := d.float64()
+	f := NewDense(int(n), int(n), nil)
+	for i := range f.mat.Data {
+		f.mat.Data[i] = d.float64()
+	}
+	swaps := make([]int, n)
+	for i := range swaps {
+		v := d.int()
+		if v < int64(i) || n <= v {
+			return errBadPivot
+		}
+		swaps[i] = int(v)
+	}
+	lu.lu = f
+	lu.swaps = swaps
+	lu.piv = make([]int, n)
+	lu.updatePivots(swaps)
+	lu.cond = cond
+	lu.ok = ok
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+// MarshalBinary returns an error if the receiver does not hold a factorization.
+//
+// QR is little-endian encoded with the QR header of the factorization encoding
+// scheme, followed by the condition number and the m×n elements of the
+// factorization in row-major order. If Q is held explicitly, the m×m elements
+// of Q follow in row-major order, otherwise the n scalar factors of the
+// elementary reflectors follow.
+func (qr QR) MarshalBinary() ([]byte, error) {
+	if !qr.isValid() {
+		return nil, errBadFact
+	}
+	m, n := qr.qr.Dims()
+	size := 1 + int64(m)*int64(n)
+	if qr.explicit {
+		size += int64(m) * int64(m)
+	} else {
+		size += int64(n)
+	}
+	e, err := newEncoder(storage{
+		Form: 'D', Packing: 'Q', Uplo: 'A', Unit: qr.explicit,
+		Rows: int64(m), Cols: int64(n),
+	}, size)
+	if err != nil {
+		return nil, err
+	}
+	e.float64(qr.cond)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			e.float64(qr.qr.at(i, j))
+		}
+	}
+	if qr.explicit {
+		for i := 0; i < m; i++ {
+			for j := 0; j < m; j++ {
+				e.float64(qr.q.at(i, j))
+			}
+		}
+	} else {
+		for _, v := range qr.tau {
+			e.float64(v)
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+func (qr *QR) UnmarshalBinary(data []byte) error {
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	m, n := header.Rows, header.Cols
+	explicit := header.Unit
+	header.Unit = false
+	if header.clearDims() != (storage{Form: 'D', Packing: 'Q', Uplo: 'A'}) {
+		return errWrongType
+	}
+	if err := checkDims(m, n); err != nil {
+		return err
+	}
+	if m < n {
+		return ErrShape
+	}
+	size := 1 + m*n + n
+	if explicit {
+		size = 1 + m*n + m*m
+	}
+	if err := d.want(size, m, n); err != nil {
+		return err
+	}
+	cond := d.float64()
+	f := NewDense(int(m), int(n), nil)
+	for i := range f.mat.Data {
+		f.mat.Data[i] = d.float64()
+	}
+	var q *Dense
+	var tau []float64
+	if explicit {
+		q = NewDense(int(m), int(m), nil)
+		for i := range q.mat.Data {
+			q.mat.Data[i] = d.float64()
+		}
+	} else {
+		tau = make([]float64, n)
+		for i := range tau {
+			tau[i] = d.float64()
+		}
+	}
+	qr.qr = f
+	qr.q = q
+	qr.tau = tau
+	qr.cond = cond
+	qr.explicit = explicit
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+// MarshalBinary returns an error if the receiver does not hold a successful
+// factorization.
+//
+// SVD is little-endian encoded with the SVD header of the factorization
+// encoding scheme, where k is the number of singular values. The header is
+// followed by the dimensions of U and Vᵀ as four int64 values, the k singular
+// values, and the elements of U and Vᵀ in row-major order. The dimensions of U
+// and Vᵀ are zero if they were not computed.
+func (svd SVD) MarshalBinary() ([]byte, error) {
+	if !svd.succFact() {
+		return nil, errBadFact
+	}
+	var u, vt blas64.General
+	if svd.kind&(SVDThinU|SVDFullU) != 0 {
+		u = svd.u
+	}
+	if svd.kind&(SVDThinV|SVDFullV) != 0 {
+		vt = svd.vt
+	}
+	k := len(svd.s)
+	e, err := newEncoder(storage{
+		Form: 'D', Packing: 'S', Uplo: 'A',
+		Rows: int64(k), KU: int64(svd.kind),
+	}, 4+int64(k)+int64(u.Rows)*int64(u.Cols)+int64(vt.Rows)*int64(vt.Cols))
+	if err != nil {
+		return nil, err
+	}
+	e.int(u.Rows)
+	e.int(u.Cols)
+	e.int(vt.Rows)
+	e.int(vt.Cols)
+	for _, v := range svd.s {
+		e.float64(v)
+	}
+	for _, g := range []blas64.General{u, vt} {
+		for i := 0; i < g.Rows; i++ {
+			for _, v := range g.Data[i*g.Stride : i*g.Stride+g.Cols] {
+				e.float64(v)
+			}
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+func (svd *SVD) UnmarshalBinary(data []byte) error {
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	k := header.Rows
+	if header.Cols != 0 || header.clearDims() != (storage{Form: 'D', Packing: 'S', Uplo: 'A'}) {
+		return errWrongType
+	}
+	if header.KL != 0 || header.KU&^int64(SVDFull|SVDThin|SVDDivideConquer) != 0 {
+		return errWrongType
+	}
+	kind := SVDKind(header.KU)
+	if k <= 0 {
+		if k == 0 {
+			return ErrZeroLength
+		}
+		return errBadSize
+	}
+	if d.remaining() < 4 {
+		return errBadBuffer
+	}
+	dims := [4]int64{d.int(), d.int(), d.int(), d.int()}
+	ur, uc, vr, vc := dims[0], dims[1], dims[2], dims[3]
+	wantU := kind&(SVDThinU|SVDFullU) != 0
+	wantV := kind&(SVDThinV|SVDFullV) != 0
+	// The singular vectors, when present, are the columns of U and the
+	// rows of Vᵀ and there is at least one of each for each singular value.
+	if wantU && (uc < k || ur < uc) || !wantU && (ur != 0 || uc != 0) {
+		return ErrShape
+	}
+	if wantV && (vr < k || vc < vr) || !wantV && (vr != 0 || vc != 0) {
+		return ErrShape
+	}
+	if err := d.want(k+ur*uc+vr*vc, k, ur, uc, vr, vc); err != nil {
+		return err
+	}
+	s := make([]float64, k)
+	for i := range s {
+		s[i] = d.float64()
+	}
+	var u, vt blas64.General
+	for _, g := range []struct {
+		dst  *blas64.General
+		r, c int64
+	}{{&u, ur, uc}, {&vt, vr, vc}} {
+		if g.r == 0 {
+			continue
+		}
+		*g.dst = blas64.General{
+			Rows:   int(g.r),
+			Cols:   int(g.c),
+			Stride: int(g.c),
+			Data:   make([]float64, g.r*g.c),
+		}
+		for i := range g.dst.Data {
+			g.dst.Data[i] = d.float64()
+		}
+	}
+	svd.kind = kind
+	svd.s = s
+	svd.u = u
+	svd.vt = vt
+	return nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+// MarshalBinary returns an error if the receiver does not hold a successful
+// factorization.
+//
+// EigenSym is little-endian encoded with the EigenSym header of the
+// factorization encoding scheme, where m is the number of computed
+// eigenvalues. The header is followed by the m eigenvalues and, if the
+// eigenvectors were computed, the elements of the n×m matrix of eigenvectors
+// in row-major order.
+func (e EigenSym) MarshalBinary() ([]byte, error) {
+	if !e.succFact() {
+		return nil, errBadFact
+	}
+	n, m := e.n, len(e.values)
+	vectors := e.vectorsComputed && m > 0
+	size := int64(m)
+	if vectors {
+		size += int64(n) * int64(m)
+	}
+	enc, err := newEncoder(storage{
+		Form: 'D', Packing: 'E', Uplo: 'A', Unit: e.vectorsComputed,
+		Rows: int64(n), Cols: int64(m),
+	}, size)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range e.values {
+		enc.float64(v)
+	}
+	if vectors {
+		for i := 0; i < n; i++ {
+			for j := 0; j < m; j++ {
+				enc.float64(e.vectors.at(i, j))
+			}
+		}
+	}
+	return enc.buf, nil
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+func (e *EigenSym) UnmarshalBinary(data []byte) error {
+	d, header, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	n, m := header.Rows, header.Cols
+	vectorsComputed := header.Unit
+	header.Unit = false
+	if header.clearDims() != (storage{Form: 'D', Packing: 'E', Uplo: 'A'}) {
+		return errWrongType
+	}
+	if n <= 0 || m < 0 {
+		if n == 0 {
+			return ErrZeroLength
+		}
+		return errBadSize
+	}
+	if m > n {
+		return ErrShape
+	}
+	vectors := vectorsComputed && m > 0
+	size := m
+	if vectors {
+		if err := d.within(n); err != nil {
+			return err
+		}
+		size += n * m
+	}
+	if err := d.want(size, m); err != nil {
+		return err
+	}
+	values := make([]float64, m)
+	for i := range values {
+		values[i] = d.float64()
+	}
+	var v *Dense
+	if vectors {
+		v = NewDense(int(n), int(m), nil)
+		for i := range v.mat.Data {
+			v.mat.Data[i] = d.float64()
+		}
+	}
+	e.n = int(n)
+	e.values = values
+	e.vectors = v
+	e.vectorsComputed = vectorsComputed
+	return nil
+}
+
+// encoder writes the little-endian binary form of a matrix or factorization.
+type encoder struct {
+	buf []byte
+}
+
+// newEncoder returns an encoder that has written the header h with the
+// current version and has space for n following 8-byte values.
+func newEncoder(h storage, n int64) (*encoder, error) {
+	if n < 0 || n > (maxLen-int64(headerSize))/int64(sizeFloat64) {
+		return nil, errTooBig
+	}
+	h.Version = version
+	buf := bytes.NewBuffer(make([]byte, 0, int64(headerSize)+n*int64(sizeFloat64)))
+	_, err := h.marshalBinaryTo(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &encoder{buf: buf.Bytes()}, nil
+}
+
+func (e *encoder) float64(v float64) {
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v))
+}
+
+func (e *encoder) int(v int) {
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, uint64(int64(v)))
+}
+
+// decoder reads the little-endian binary form of a matrix or factorization.
+type decoder struct {
+	data []byte
+}
+
+// newDecoder returns a decoder positioned after the header of data, and the
+// decoded header.
+func newDecoder(data []byte) (*decoder, storage, error) {
+	if len(data) < headerSize {
+		return nil, storage{}, errTooSmall
+	}
+	var header storage
+	err := header.unmarshalBinary(data[:headerSize])
+	if err != nil {
+		return nil, storage{}, err
+	}
+	return &decoder{data: data[headerSize:]}, header, nil
+}
+
+// remaining returns the number of 8-byte values remaining in the decoder.
+func (d *decoder) remaining() int64 {
+	return int64(len(d.data) / sizeFloat64)
+}
+
+// within returns an error if any of the bounds is greater than the number of
+// 8-byte values remaining in the decoder. It is used to reject dimensions that
+// cannot be satisfied by the data before a size is computed from them.
+func (d *decoder) within(bounds ...int64) error {
+	rem := d.remaining()
+	for _, b := range bounds {
+		if b > rem {
+			return errBadBuffer
+		}
+	}
+	return nil
+}
+
+// want returns an error if the decoder does not hold exactly n 8-byte values.
+// The bounds must each be no greater than n and are checked with within before
+// n is compared so that a size computed from invalid dimensions is never
+// trusted.
+func (d *decoder) want(n int64, bounds ...int64) error {
+	if err := d.within(bounds...); err != nil {
+		return err
+	}
+	if n != d.remaining() || len(d.data)%sizeFloat64 != 0 {
+		return errBadBuffer
+	}
+	return nil
+}
+
+func (d *decoder) float64() float64 {
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.data))
+	d.data = d.data[sizeFloat64:]
+	return v
+}
+
+func (d *decoder) int() int64 {
+	v := int64(binary.LittleEndian.Uint64(d.data))
+	d.data = d.data[sizeFloat64:]
+	return v
+}
+
+// clearDims returns a copy of the header with the version and the dimension
+// fields cleared, leaving only the fields describing the type.
+func (s storage) clearDims() storage {
+	s.Version = 0
+	s.Rows = 0
+	s.Cols = 0
+	s.KU = 0
+	s.KL = 0
+	return s
+}
+
+// checkDims returns an error if r or c are not valid matrix dimensions.
+func checkDims(r, c int64) error {
+	if r < 0 || c < 0 {
+		return errBadSize
+	}
+	if r == 0 || c == 0 {
+		return ErrZeroLength
+	}
+	if r > maxLen || c > maxLen {
+		return errTooBig
+	}
+	return nil
+}
+
+// packedLen returns the number of elements in a triangle of an n×n matrix.
+func packedLen(n int64) int64 {
+	return n * (n + 1) / 2
+}
+
+// bandLen returns the number of elements within the band of an r×c band
+// matrix with kl sub-diagonals and ku super-diagonals.
+func bandLen(r, c, kl, ku int64) int64 {
+	var n int64
+	for i := int64(0); i < min(r, c+kl); i++ {
+		n += min(c, i+ku+1) - max(0, i-kl)
+	}
+	return n
+}
+
+// triRange returns the half-open range of columns in row i of the given
+// triangle of an n×n matrix.
+func triRange(i, n int, kind TriKind) (lo, hi int) {
+	if kind == Upper {
+		return i, n
+	}
+	return 0, i + 1
+}
+
+// uploByte returns the encoding of the triangle kind.
+func uploByte(kind TriKind) byte {
+	if kind == Upper {
+		return 'U'
+	}
+	return 'L'
+}
+
+// triKindOf returns the triangle kind of an encoded uplo value and whether
+// it is valid.
+func triKindOf(uplo byte) (kind TriKind, ok bool) {
+	switch uplo {
+	case 'U':
+		return Upper, true
+	case 'L':
+		return Lower, true
+	default:
+		return false, false
+	}
+}
+
 // storage is the internal representation of the storage format of a
 // serialised matrix.
 type storage struct {
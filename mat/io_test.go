@@ -10,9 +10,12 @@ import (
 	"encoding/binary"
 	"io"
 	"math"
+	"math/rand/v2"
+	"reflect"
 	"testing"
 
 	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
 )
 
 var (
@@ -20,6 +23,32 @@ var (
 	_ encoding.BinaryUnmarshaler = (*Dense)(nil)
 	_ encoding.BinaryMarshaler   = (*VecDense)(nil)
 	_ encoding.BinaryUnmarshaler = (*VecDense)(nil)
+	_ encoding.BinaryMarshaler   = (*SymDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*SymDense)(nil)
+	_ encoding.BinaryMarshaler   = (*TriDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*TriDense)(nil)
+	_ encoding.BinaryMarshaler   = (*BandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*BandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*SymBandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*SymBandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*TriBandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*TriBandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*Tridiag)(nil)
+	_ encoding.BinaryUnmarshaler = (*Tridiag)(nil)
+	_ encoding.BinaryMarshaler   = (*DiagDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*DiagDense)(nil)
+	_ encoding.BinaryMarshaler   = (*CDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*CDense)(nil)
+	_ encoding.BinaryMarshaler   = (*Cholesky)(nil)
+	_ encoding.BinaryUnmarshaler = (*Cholesky)(nil)
+	_ encoding.BinaryMarshaler   = (*LU)(nil)
+	_ encoding.BinaryUnmarshaler = (*LU)(nil)
+	_ encoding.BinaryMarshaler   = (*QR)(nil)
+	_ encoding.BinaryUnmarshaler = (*QR)(nil)
+	_ encoding.BinaryMarshaler   = (*SVD)(nil)
+	_ encoding.BinaryUnmarshaler = (*SVD)(nil)
+	_ encoding.BinaryMarshaler   = (*EigenSym)(nil)
+	_ encoding.BinaryUnmarshaler = (*EigenSym)(nil)
 )
 
 var sizeInt64 = binary.Size(int64(0))
@@ -260,6 +289,60 @@ func TestDenseUnmarshalFromError(t *testing.T) {
 	}
 }
 
+func TestDenseUnmarshalOverflow(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name       string
+		rows, cols int64
+		elements   int
+	}{
+		{
+			// 7 * 7905747460161236407 overflows int64 to 1, so the header
+			// claims a single element that is present in the data.
+			name: "element count",
+			rows: 7, cols: 7905747460161236407,
+			elements: 1,
+		},
+		{
+			// 2^61 elements of 8 bytes overflow int64 to 0, so the header
+			// claims no data beyond itself.
+			name: "byte length",
+			rows: 1 << 61, cols: 1,
+		},
+	} {
+		header := storage{
+			Version: version,
+			Form:    'G', Packing: 'F', Uplo: 'A',
+			Rows: test.rows, Cols: test.cols,
+		}
+		var buf bytes.Buffer
+		if _, err := header.marshalBinaryTo(&buf); err != nil {
+			t.Fatalf("%s: unexpected error encoding header: %v", test.name, err)
+		}
+		buf.Write(make([]byte, test.elements*sizeFloat64))
+
+		var m Dense
+		if err := m.UnmarshalBinary(buf.Bytes()); err != errTooBig {
+			t.Errorf("%s: unexpected error from Dense.UnmarshalBinary: got %v, want %v", test.name, err, errTooBig)
+		}
+		var mr Dense
+		if _, err := mr.UnmarshalBinaryFrom(bytes.NewReader(buf.Bytes())); err != errTooBig {
+			t.Errorf("%s: unexpected error from Dense.UnmarshalBinaryFrom: got %v, want %v", test.name, err, errTooBig)
+		}
+		if test.cols != 1 {
+			continue
+		}
+		var v VecDense
+		if err := v.UnmarshalBinary(buf.Bytes()); err != errTooBig {
+			t.Errorf("%s: unexpected error from VecDense.UnmarshalBinary: got %v, want %v", test.name, err, errTooBig)
+		}
+		var vr VecDense
+		if _, err := vr.UnmarshalBinaryFrom(bytes.NewReader(buf.Bytes())); err != errTooBig {
+			t.Errorf("%s: unexpected error from VecDense.UnmarshalBinaryFrom: got %v, want %v", test.name, err, errTooBig)
+		}
+	}
+}
+
 func TestDenseIORoundTrip(t *testing.T) {
 	t.Parallel()
 	for i, test := range denseData {
@@ -581,6 +664,494 @@ func TestVecDenseIORoundTrip(t *testing.T) {
 	}
 }
 
+func TestMatrixIORoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	sym := NewSymDense(5, nil)
+	for i := 0; i < 5; i++ {
+		for j := i; j < 5; j++ {
+			sym.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	upper := NewTriDense(4, Upper, nil)
+	lower := NewTriDense(4, Lower, nil)
+	for i := 0; i < 4; i++ {
+		for j := i; j < 4; j++ {
+			upper.SetTri(i, j, rnd.NormFloat64())
+			lower.SetTri(j, i, rnd.NormFloat64())
+		}
+	}
+	newBand := func(r, c, kl, ku int) *BandDense {
+		b := NewBandDense(r, c, kl, ku, nil)
+		for i := 0; i < r; i++ {
+			for j := max(0, i-kl); j < min(c, i+ku+1); j++ {
+				b.SetBand(i, j, rnd.NormFloat64())
+			}
+		}
+		return b
+	}
+	symBand := NewSymBandDense(6, 2, nil)
+	upperBand := NewTriBandDense(6, 2, Upper, nil)
+	lowerBand := NewTriBandDense(6, 1, Lower, nil)
+	for i := 0; i < 6; i++ {
+		for j := i; j < min(6, i+3); j++ {
+			symBand.SetSymBand(i, j, rnd.NormFloat64())
+			upperBand.SetTriBand(i, j, rnd.NormFloat64())
+		}
+		for j := max(0, i-1); j <= i; j++ {
+			lowerBand.SetTriBand(i, j, rnd.NormFloat64())
+		}
+	}
+	tridiag := NewTridiag(5, nil, nil, nil)
+	for i := 0; i < 5; i++ {
+		for j := max(0, i-1); j < min(5, i+2); j++ {
+			tridiag.SetBand(i, j, rnd.NormFloat64())
+		}
+	}
+	diag := NewDiagDense(4, []float64{1, math.Inf(-1), -3, math.MaxFloat64})
+
+	for _, test := range []struct {
+		name string
+		a    interface {
+			Matrix
+			encoding.BinaryMarshaler
+		}
+		dst interface {
+			Matrix
+			encoding.BinaryUnmarshaler
+		}
+	}{
+		{name: "symdense", a: sym, dst: &SymDense{}},
+		{name: "symdense slice", a: sym.SliceSym(1, 4).(*SymDense), dst: &SymDense{}},
+		{name: "tridense upper", a: upper, dst: &TriDense{}},
+		{name: "tridense lower", a: lower, dst: &TriDense{}},
+		{name: "tridense slice", a: lower.SliceTri(1, 3).(*TriDense), dst: &TriDense{}},
+		{name: "banddense square", a: newBand(5, 5, 1, 2), dst: &BandDense{}},
+		{name: "banddense wide", a: newBand(3, 7, 2, 3), dst: &BandDense{}},
+		{name: "banddense tall", a: newBand(7, 3, 4, 0), dst: &BandDense{}},
+		{name: "banddense 1×1", a: newBand(1, 1, 0, 0), dst: &BandDense{}},
+		{name: "symbanddense", a: symBand, dst: &SymBandDense{}},
+		{name: "tribanddense upper", a: upperBand, dst: &TriBandDense{}},
+		{name: "tribanddense lower", a: lowerBand, dst: &TriBandDense{}},
+		{name: "tridiag", a: tridiag, dst: &Tridiag{}},
+		{name: "tridiag 1×1", a: NewTridiag(1, nil, []float64{2}, nil), dst: &Tridiag{}},
+		{name: "diagdense", a: diag, dst: &DiagDense{}},
+		{name: "diagdense as banddense", a: diag, dst: &BandDense{}},
+		{name: "tridiag as banddense", a: tridiag, dst: &BandDense{}},
+	} {
+		buf, err := test.a.MarshalBinary()
+		if err != nil {
+			t.Errorf("%s: unexpected error encoding: %v", test.name, err)
+			continue
+		}
+		err = test.dst.UnmarshalBinary(buf)
+		if err != nil {
+			t.Errorf("%s: unexpected error decoding: %v", test.name, err)
+			continue
+		}
+		if !Equal(test.dst, test.a) {
+			t.Errorf("%s: round trip does not match:\ngot:\n%v\nwant:\n%v", test.name, Formatted(test.dst), Formatted(test.a))
+		}
+	}
+
+	c := NewCDense(3, 2, nil)
+	for i := range c.mat.Data {
+		c.mat.Data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	for _, a := range []*CDense{c, c.Slice(1, 3, 0, 2).(*CDense)} {
+		buf, err := a.MarshalBinary()
+		if err != nil {
+			t.Errorf("unexpected error encoding CDense: %v", err)
+			continue
+		}
+		var got CDense
+		err = got.UnmarshalBinary(buf)
+		if err != nil {
+			t.Errorf("unexpected error decoding CDense: %v", err)
+			continue
+		}
+		if !CEqual(&got, a) {
+			t.Errorf("CDense round trip does not match")
+		}
+	}
+}
+
+func TestFactorizationIORoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 6
+	a := NewDense(n, n, nil)
+	for i := range a.mat.Data {
+		a.mat.Data[i] = rnd.NormFloat64()
+	}
+	tall := NewDense(n+2, n, nil)
+	for i := range tall.mat.Data {
+		tall.mat.Data[i] = rnd.NormFloat64()
+	}
+	var spd SymDense
+	spd.SymOuterK(1, a)
+	for i := 0; i < n; i++ {
+		spd.SetSym(i, i, spd.At(i, i)+1)
+	}
+	b := NewDense(n, 2, nil)
+	for i := range b.mat.Data {
+		b.mat.Data[i] = rnd.NormFloat64()
+	}
+	bTall := NewDense(n+2, 2, nil)
+	for i := range bTall.mat.Data {
+		bTall.mat.Data[i] = rnd.NormFloat64()
+	}
+
+	t.Run("Cholesky", func(t *testing.T) {
+		var want Cholesky
+		if !want.Factorize(&spd) {
+			t.Fatal("unexpected Cholesky factorization failure")
+		}
+		buf, err := want.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error encoding: %v", err)
+		}
+		// Decoding replaces an existing factorization.
+		var got Cholesky
+		got.Factorize(NewSymDense(1, []float64{2}))
+		err = got.UnmarshalBinary(buf)
+		if err != nil {
+			t.Fatalf("unexpected error decoding: %v", err)
+		}
+		if !Equal(&got, &want) || got.Cond() != want.Cond() {
+			t.Errorf("round trip does not match")
+		}
+		var x, wantX Dense
+		got.SolveTo(&x, b)
+		want.SolveTo(&wantX, b)
+		if !Equal(&x, &wantX) {
+			t.Errorf("solutions do not match")
+		}
+	})
+
+	t.Run("LU", func(t *testing.T) {
+		var want LU
+		want.Factorize(a)
+		for _, updated := range []bool{false, true} {
+			if updated {
+				x := NewVecDense(n, nil)
+				y := NewVecDense(n, nil)
+				for i := 0; i < n; i++ {
+					x.SetVec(i, rnd.NormFloat64())
+					y.SetVec(i, rnd.NormFloat64())
+				}
+				want.RankOne(&want, 0.5, x, y)
+			}
+			buf, err := want.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error encoding: %v", err)
+			}
+			var got LU
+			err = got.UnmarshalBinary(buf)
+			if err != nil {
+				t.Fatalf("unexpected error decoding: %v", err)
+			}
+			if !Equal(&got, &want) || got.Cond() != want.Cond() || got.Det() != want.Det() {
+				t.Errorf("updated=%t: round trip does not match", updated)
+			}
+			for _, trans := range []bool{false, true} {
+				var x, wantX Dense
+				errGot := got.SolveTo(&x, trans, b)
+				errWant := want.SolveTo(&wantX, trans, b)
+				if errGot != errWant || !Equal(&x, &wantX) {
+					t.Errorf("updated=%t trans=%t: solutions do not match", updated, trans)
+				}
+			}
+		}
+	})
+
+	t.Run("QR", func(t *testing.T) {
+		var want QR
+		want.Factorize(tall)
+		for _, explicit := range []bool{false, true} {
+			if explicit {
+				x := NewVecDense(n+2, nil)
+				y := NewVecDense(n, nil)
+				for i := 0; i < n; i++ {
+					x.SetVec(i, rnd.NormFloat64())
+					y.SetVec(i, rnd.NormFloat64())
+				}
+				want.RankOne(&want, 0.5, x, y)
+			}
+			buf, err := want.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error encoding: %v", err)
+			}
+			var got QR
+			err = got.UnmarshalBinary(buf)
+			if err != nil {
+				t.Fatalf("unexpected error decoding: %v", err)
+			}
+			if !Equal(&got, &want) || got.Cond() != want.Cond() {
+				t.Errorf("explicit=%t: round trip does not match", explicit)
+			}
+			var q, wantQ Dense
+			got.QTo(&q)
+			want.QTo(&wantQ)
+			if !Equal(&q, &wantQ) {
+				t.Errorf("explicit=%t: Q does not match", explicit)
+			}
+			var x, wantX Dense
+			got.SolveTo(&x, false, bTall)
+			want.SolveTo(&wantX, false, bTall)
+			if !Equal(&x, &wantX) {
+				t.Errorf("explicit=%t: solutions do not match", explicit)
+			}
+		}
+	})
+
+	t.Run("SVD", func(t *testing.T) {
+		for _, test := range []struct {
+			kind SVDKind
+			k    int
+		}{
+			{kind: SVDNone},
+			{kind: SVDThin},
+			{kind: SVDFull},
+			{kind: SVDThinU | SVDFullV},
+			{kind: SVDFullU | SVDDivideConquer},
+			{kind: SVDThin, k: 3},
+		} {
+			var want SVD
+			var ok bool
+			if test.k == 0 {
+				ok = want.Factorize(tall.T(), test.kind)
+			} else {
+				ok = want.FactorizeRandomized(tall, test.k, test.kind, nil)
+			}
+			if !ok {
+				t.Fatalf("kind=%v: unexpected SVD failure", test.kind)
+			}
+			buf, err := want.MarshalBinary()
+			if err != nil {
+				t.Fatalf("kind=%v: unexpected error encoding: %v", test.kind, err)
+			}
+			var got SVD
+			err = got.UnmarshalBinary(buf)
+			if err != nil {
+				t.Fatalf("kind=%v: unexpected error decoding: %v", test.kind, err)
+			}
+			if got.Kind() != want.Kind() || !floats.Equal(got.Values(nil), want.Values(nil)) || got.Cond() != want.Cond() {
+				t.Errorf("kind=%v k=%d: round trip does not match", test.kind, test.k)
+			}
+			if test.kind&(SVDThinU|SVDFullU) != 0 {
+				var u, wantU Dense
+				got.UTo(&u)
+				want.UTo(&wantU)
+				if !Equal(&u, &wantU) {
+					t.Errorf("kind=%v k=%d: U does not match", test.kind, test.k)
+				}
+			}
+			if test.kind&(SVDThinV|SVDFullV) != 0 {
+				var v, wantV Dense
+				got.VTo(&v)
+				want.VTo(&wantV)
+				if !Equal(&v, &wantV) {
+					t.Errorf("kind=%v k=%d: V does not match", test.kind, test.k)
+				}
+			}
+		}
+	})
+
+	t.Run("EigenSym", func(t *testing.T) {
+		for _, test := range []struct {
+			vectors bool
+			lo, hi  int
+		}{
+			{vectors: false, lo: 0, hi: n - 1},
+			{vectors: true, lo: 0, hi: n - 1},
+			{vectors: true, lo: 1, hi: 3},
+		} {
+			var want EigenSym
+			if !want.FactorizeIndex(&spd, test.lo, test.hi, test.vectors) {
+				t.Fatal("unexpected EigenSym failure")
+			}
+			buf, err := want.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error encoding: %v", err)
+			}
+			var got EigenSym
+			err = got.UnmarshalBinary(buf)
+			if err != nil {
+				t.Fatalf("unexpected error decoding: %v", err)
+			}
+			if got.SymmetricDim() != want.SymmetricDim() || !floats.Equal(got.Values(nil), want.Values(nil)) {
+				t.Errorf("vectors=%t lo=%d hi=%d: round trip does not match", test.vectors, test.lo, test.hi)
+			}
+			if test.vectors {
+				var v, wantV Dense
+				got.VectorsTo(&v)
+				want.VectorsTo(&wantV)
+				if !Equal(&v, &wantV) {
+					t.Errorf("vectors=%t lo=%d hi=%d: vectors do not match", test.vectors, test.lo, test.hi)
+				}
+			}
+		}
+	})
+}
+
+func TestIOUnmarshalBinaryError(t *testing.T) {
+	t.Parallel()
+	for _, a := range []encoding.BinaryMarshaler{
+		&Cholesky{},
+		&LU{},
+		&QR{},
+		&SVD{},
+		&EigenSym{},
+	} {
+		_, err := a.MarshalBinary()
+		if err != errBadFact {
+			t.Errorf("unexpected error encoding empty %T: got %v, want %v", a, err, errBadFact)
+		}
+	}
+
+	sym, _ := NewSymDense(3, []float64{1, 2, 3, 2, 4, 5, 3, 5, 6}).MarshalBinary()
+	band, _ := NewBandDense(3, 3, 1, 0, nil).MarshalBinary()
+	var lu LU
+	lu.Factorize(NewDense(2, 2, []float64{1, 2, 3, 4}))
+	luBuf, _ := lu.MarshalBinary()
+	badPivot := append([]byte(nil), luBuf...)
+	binary.LittleEndian.PutUint64(badPivot[len(badPivot)-sizeInt64:], math.MaxUint64)
+	var chol Cholesky
+	chol.Factorize(NewSymDense(2, []float64{4, 2, 2, 3}))
+	cholBuf, _ := chol.MarshalBinary()
+	// The condition number is followed by the elements U[0,0], U[0,1] and
+	// U[1,1].
+	cholWith := func(k int, v float64) []byte {
+		buf := append([]byte(nil), cholBuf...)
+		binary.LittleEndian.PutUint64(buf[headerSize+k*sizeFloat64:], math.Float64bits(v))
+		return buf
+	}
+
+	for _, test := range []struct {
+		name string
+		dst  encoding.BinaryUnmarshaler
+		data []byte
+		want error
+	}{
+		{name: "short header", dst: &SymDense{}, data: sym[:headerSize-1], want: errTooSmall},
+		{name: "short data", dst: &SymDense{}, data: sym[:len(sym)-1], want: errBadBuffer},
+		{name: "long data", dst: &SymDense{}, data: append(sym[:len(sym):len(sym)], make([]byte, sizeFloat64)...), want: errBadBuffer},
+		{name: "wrong type", dst: &TriDense{}, data: sym, want: errWrongType},
+		{name: "band not diagonal", dst: &DiagDense{}, data: band, want: ErrShape},
+		{name: "band not tridiagonal", dst: &Tridiag{}, data: band, want: ErrShape},
+		{name: "factorization from matrix", dst: &Cholesky{}, data: sym, want: errWrongType},
+		{name: "bad pivot", dst: &LU{}, data: badPivot, want: errBadPivot},
+		{name: "NaN Cholesky condition", dst: &Cholesky{}, data: cholWith(0, math.NaN()), want: errBadCond},
+		{name: "small Cholesky condition", dst: &Cholesky{}, data: cholWith(0, 0.5), want: errBadCond},
+		{name: "zero Cholesky diagonal", dst: &Cholesky{}, data: cholWith(1, 0), want: errBadChol},
+		{name: "negative Cholesky diagonal", dst: &Cholesky{}, data: cholWith(3, -1), want: errBadChol},
+		{name: "NaN Cholesky diagonal", dst: &Cholesky{}, data: cholWith(3, math.NaN()), want: errBadChol},
+		{name: "infinite Cholesky element", dst: &Cholesky{}, data: cholWith(2, math.Inf(1)), want: errBadChol},
+	} {
+		err := test.dst.UnmarshalBinary(test.data)
+		if err != test.want {
+			t.Errorf("%s: unexpected error: got %v, want %v", test.name, err, test.want)
+		}
+	}
+}
+
+func FuzzUnmarshalBinary(f *testing.F) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := NewDense(4, 4, nil)
+	for i := range a.mat.Data {
+		a.mat.Data[i] = rnd.NormFloat64()
+	}
+	var spd SymDense
+	spd.SymOuterK(1, a)
+	var chol Cholesky
+	chol.Factorize(&spd)
+	var lu LU
+	lu.Factorize(a)
+	var qr QR
+	qr.Factorize(a)
+	var svd SVD
+	svd.Factorize(a.Slice(0, 4, 0, 3), SVDThinU|SVDFullV)
+	var eig EigenSym
+	eig.FactorizeIndex(&spd, 1, 2, true)
+	for _, m := range []encoding.BinaryMarshaler{
+		a,
+		NewVecDense(3, []float64{1, 2, 3}),
+		&spd,
+		NewTriDense(3, Lower, []float64{1, 0, 0, 2, 3, 0, 4, 5, 6}),
+		NewBandDense(3, 4, 1, 2, nil),
+		NewSymBandDense(4, 1, nil),
+		NewTriBandDense(4, 2, Upper, nil),
+		NewTridiag(3, nil, nil, nil),
+		NewDiagDense(2, []float64{1, 2}),
+		NewCDense(2, 1, []complex128{1 + 2i, 3 - 4i}),
+		&chol,
+		&lu,
+		&qr,
+		&svd,
+		&eig,
+	} {
+		buf, err := m.MarshalBinary()
+		if err != nil {
+			f.Fatalf("unexpected error encoding %T: %v", m, err)
+		}
+		f.Add(buf)
+	}
+	// Dimensions whose products overflow.
+	f.Add([]byte("\x01\x00\x00\x00GBA\x00000000000000001x0000000X000000 0"))
+	f.Add(append([]byte("\x01\x00\x00\x00GFA\x00\x00\x00\x00\x00\x00\x00\x00\x20\x01"), make([]byte, 23)...))
+	f.Add(append([]byte("\x01\x00\x00\x00GFA\x00\x04\x00\x00\x00\x00\x00\x00X\x04\x00\x00\x00\x00\x00\x000"), make([]byte, 16+16*sizeFloat64)...))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, u := range []interface {
+			encoding.BinaryMarshaler
+			encoding.BinaryUnmarshaler
+		}{
+			&Dense{},
+			&VecDense{},
+			&SymDense{},
+			&TriDense{},
+			&BandDense{},
+			&SymBandDense{},
+			&TriBandDense{},
+			&Tridiag{},
+			&DiagDense{},
+			&CDense{},
+			&Cholesky{},
+			&LU{},
+			&QR{},
+			&SVD{},
+			&EigenSym{},
+		} {
+			if u.UnmarshalBinary(data) != nil {
+				continue
+			}
+			// Accepted data must re-encode to the same size and
+			// the re-encoding must be stable.
+			buf, err := u.MarshalBinary()
+			if err != nil {
+				t.Fatalf("%T: unexpected error re-encoding accepted data: %v", u, err)
+			}
+			if len(buf) != len(data) {
+				t.Fatalf("%T: re-encoded length mismatch: got %d, want %d", u, len(buf), len(data))
+			}
+			v := reflect.New(reflect.TypeOf(u).Elem()).Interface().(interface {
+				encoding.BinaryMarshaler
+				encoding.BinaryUnmarshaler
+			})
+			err = v.UnmarshalBinary(buf)
+			if err != nil {
+				t.Fatalf("%T: unexpected error decoding re-encoded data: %v", u, err)
+			}
+			again, _ := v.MarshalBinary()
+			if !bytes.Equal(again, buf) {
+				t.Fatalf("%T: re-encoding is not stable", u)
+			}
+		}
+	})
+}
+
 func BenchmarkMarshalDense10(b *testing.B)    { marshalBinaryBenchDense(b, 10) }
 func BenchmarkMarshalDense100(b *testing.B)   { marshalBinaryBenchDense(b, 100) }
 func BenchmarkMarshalDense1000(b *testing.B)  { marshalBinaryBenchDense(b, 1000) }
@@ -485,3 +485,294 @@ func (lu *LU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
 		return nil
 	}
 }
+
+// BandLU is a square n×n band matrix represented by its LU factorization with
+// partial pivoting.
+//
+// The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is a unit lower triangular band matrix
+// with kl sub-diagonals and U is an upper triangular band matrix with kl+ku
+// super-diagonals. The row interchanges are interleaved with the elimination
+// steps, so P and L are not stored explicitly.
+//
+// Note that this matrix representation is useful for certain operations, in
+// particular for solving linear systems of equations. It is very inefficient at
+// other operations, in particular At is slow.
+type BandLU struct {
+	// lu holds U in its diagonal and kl+ku super-diagonals and the
+	// multipliers of L in its kl sub-diagonals.
+	lu    *BandDense
+	swaps []int
+	cond  float64
+	ok    bool // Whether A is nonsingular
+}
+
+var _ Matrix = (*BandLU)(nil)
+
+// Factorize computes the LU factorization of the square band matrix A and
+// stores the result in the receiver. The LU factorization will complete
+// regardless of the singularity of a.
+func (lu *BandLU) Factorize(a Banded) {
+	m, n := a.Dims()
+	if m != n {
+		panic(ErrSquare)
+	}
+	kl, ku := a.Bandwidth()
+	kl = min(kl, n-1)
+	ku = min(ku, n-1)
+	var data []float64
+	if lu.lu != nil {
+		data = lu.lu.mat.Data
+	}
+	// The number of super-diagonals of U may exceed n-1, which NewBandDense
+	// does not allow, so the factor is constructed directly.
+	stride := 2*kl + ku + 1
+	lu.lu = &BandDense{mat: blas64.Band{
+		Rows:   n,
+		Cols:   n,
+		KL:     kl,
+		KU:     kl + ku,
+		Stride: stride,
+		Data:   useZeroed(data, n*stride),
+	}}
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+			lu.lu.set(i, j, a.At(i, j))
+		}
+	}
+	lu.swaps = useInt(lu.swaps, n)
+
+	// The band of A occupies the first kl+ku+1 elements of each row.
+	ab := blas64.Band{
+		Rows:   n,
+		Cols:   n,
+		KL:     kl,
+		KU:     ku,
+		Data:   lu.lu.mat.Data,
+		Stride: stride,
+	}
+	work := getFloat64s(3*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	anorm := lapack64.Langb(CondNorm, ab)
+	lu.lu.mat, lu.ok = lapack64.Gbtrf(ab, lu.swaps)
+	lu.cond = 1 / lapack64.Gbcon(CondNorm, lu.lu.mat, lu.swaps, anorm, work, iwork)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (lu *BandLU) isValid() bool {
+	return lu.lu != nil && !lu.lu.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *BandLU) Reset() {
+	if lu.lu != nil {
+		lu.lu.Reset()
+	}
+	lu.swaps = lu.swaps[:0]
+	lu.cond = math.Inf(1)
+	lu.ok = false
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for dimensionally restricted operations. The receiver can be emptied
+// using Reset.
+func (lu *BandLU) IsEmpty() bool {
+	return !lu.isValid()
+}
+
+// Dims returns the dimensions of the matrix A.
+func (lu *BandLU) Dims() (r, c int) {
+	if lu.lu == nil {
+		return 0, 0
+	}
+	return lu.lu.Dims()
+}
+
+// Bandwidth returns the lower and upper bandwidths of the matrix A.
+func (lu *BandLU) Bandwidth() (kl, ku int) {
+	if lu.lu == nil {
+		return 0, 0
+	}
+	return lu.lu.mat.KL, lu.lu.mat.KU - lu.lu.mat.KL
+}
+
+// At returns the element of A at row i, column j.
+func (lu *BandLU) At(i, j int) float64 {
+	n, _ := lu.Dims()
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+
+	// Form row i of P*L by applying the row interchanges and multipliers
+	// to the unit vector e_i, and multiply it by column j of U.
+	kl := lu.lu.mat.KL
+	row := getFloat64s(n, true)
+	defer putFloat64s(row)
+	row[i] = 1
+	for k := 0; k < n; k++ {
+		if p := lu.swaps[k]; p != k {
+			row[k], row[p] = row[p], row[k]
+		}
+		for r := k + 1; r <= min(n-1, k+kl); r++ {
+			row[k] += row[r] * lu.lu.at(r, k)
+		}
+	}
+	var val float64
+	for k := max(0, j-lu.lu.mat.KU); k <= j; k++ {
+		val += row[k] * lu.lu.at(k, j)
+	}
+	return val
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (lu *BandLU) T() Matrix {
+	return Transpose{lu}
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (lu *BandLU) Cond() float64 {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	return lu.cond
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (lu *BandLU) Det() float64 {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+	if !lu.ok {
+		return 0
+	}
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (lu *BandLU) LogDet() (det float64, sign float64) {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n, _ := lu.Dims()
+	sign = 1.0
+	for i := 0; i < n; i++ {
+		v := lu.lu.at(i, i)
+		if v < 0 {
+			sign *= -1
+		}
+		if lu.swaps[i] != i {
+			sign *= -1
+		}
+		det += math.Log(math.Abs(v))
+	}
+	return det, sign
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᵀ * X = B  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution matrix X
+// is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveTo will panic if the
+// receiver does not contain a factorization.
+func (lu *BandLU) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n, _ := lu.Dims()
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		dst.checkOverlap(rm.RawMatrix())
+	}
+
+	dst.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack64.Gbtrs(t, lu.lu.mat, lu.swaps, dst.mat)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b   if trans == false
+//	Aᵀ * x = b  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution matrix x
+// is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveVecTo will panic if the
+// receiver does not contain a factorization.
+func (lu *BandLU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !lu.isValid() {
+		panic(badLU)
+	}
+
+	n, _ := lu.Dims()
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	if rv, ok := b.(RawVectorer); ok && dst != b {
+		dst.checkOverlap(rv.RawVector())
+	}
+
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n)
+	if dst != b {
+		dst.CopyVec(b)
+	}
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack64.Gbtrs(t, lu.lu.mat, lu.swaps, dst.asGeneral())
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
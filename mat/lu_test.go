@@ -5,8 +5,11 @@
 package mat
 
 import (
+	"math"
 	"math/rand/v2"
 	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
 )
 
 func TestLU(t *testing.T) {
@@ -176,3 +179,113 @@ func TestLUSolveVecTo(t *testing.T) {
 	}
 	// TODO(btracey): Add testOneInput test when such a function exists.
 }
+
+func TestBandLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 31} {
+		for _, kl := range []int{0, 1, 3, n + 1} {
+			for _, ku := range []int{0, 2, n} {
+				kl := min(kl, n-1)
+				ku := min(ku, n-1)
+				a := NewBandDense(n, n, kl, ku, nil)
+				for i := 0; i < n; i++ {
+					for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+						a.SetBand(i, j, rnd.NormFloat64())
+					}
+					if kl == 0 || ku == 0 {
+						// Random triangular matrices are badly
+						// conditioned, so strengthen the diagonal.
+						a.SetBand(i, i, a.At(i, i)+math.Copysign(2, a.At(i, i)))
+					}
+				}
+
+				var lu BandLU
+				lu.Factorize(a)
+				if !EqualApprox(&lu, a, 1e-12) {
+					t.Errorf("n=%d kl=%d ku=%d: factorization does not reconstruct A", n, kl, ku)
+				}
+
+				var dense LU
+				dense.Factorize(a)
+				if got, want := lu.Det(), dense.Det(); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+					t.Errorf("n=%d kl=%d ku=%d: unexpected determinant: got %v want %v", n, kl, ku, got, want)
+				}
+
+				var inv Dense
+				if err := inv.Inverse(a); err != nil {
+					t.Fatalf("n=%d kl=%d ku=%d: bad test matrix: %v", n, kl, ku, err)
+				}
+				want := Norm(a, 1) * Norm(&inv, 1)
+				if got := lu.Cond(); got > 10*want || got < want/10 {
+					t.Errorf("n=%d kl=%d ku=%d: unexpected condition number: got %v want %v", n, kl, ku, got, want)
+				}
+
+				for _, trans := range []bool{false, true} {
+					var op Matrix = a
+					if trans {
+						op = a.T()
+					}
+					for _, bc := range []int{1, 3} {
+						b := NewDense(n, bc, nil)
+						randomSlice(b.mat.Data, rnd)
+						var x Dense
+						if err := lu.SolveTo(&x, trans, b); err != nil {
+							t.Errorf("n=%d kl=%d ku=%d: unexpected error: %v", n, kl, ku, err)
+							continue
+						}
+						var got Dense
+						got.Mul(op, &x)
+						if !EqualApprox(&got, b, 1e-10) {
+							t.Errorf("n=%d kl=%d ku=%d trans=%t: SolveTo mismatch", n, kl, ku, trans)
+						}
+					}
+
+					b := NewVecDense(n, nil)
+					randomSlice(b.mat.Data, rnd)
+					var x VecDense
+					if err := lu.SolveVecTo(&x, trans, b); err != nil {
+						t.Errorf("n=%d kl=%d ku=%d: unexpected error: %v", n, kl, ku, err)
+						continue
+					}
+					var got VecDense
+					got.MulVec(op, &x)
+					if !EqualApprox(&got, b, 1e-10) {
+						t.Errorf("n=%d kl=%d ku=%d trans=%t: SolveVecTo mismatch", n, kl, ku, trans)
+					}
+					if err := lu.SolveVecTo(b, trans, b); err != nil || !EqualApprox(b, &x, 1e-14) {
+						t.Errorf("n=%d kl=%d ku=%d trans=%t: SolveVecTo mismatch with aliased vectors", n, kl, ku, trans)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBandLUSingular(t *testing.T) {
+	t.Parallel()
+	// A tridiagonal matrix with two equal rows.
+	a := NewBandDense(3, 3, 1, 1, []float64{
+		0, 1, 2,
+		1, 2, 0,
+		0, 1, 2,
+	})
+	var lu BandLU
+	lu.Factorize(a)
+	if det := lu.Det(); det != 0 {
+		t.Errorf("unexpected determinant for singular matrix: %v", det)
+	}
+	var x VecDense
+	err := lu.SolveVecTo(&x, false, NewVecDense(3, []float64{1, 2, 3}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got %v", err)
+	}
+
+	lu.Reset()
+	if !lu.IsEmpty() {
+		t.Errorf("expected empty factorization after Reset")
+	}
+	if p, _ := panics(func() { lu.Cond() }); !p {
+		t.Errorf("expected panic for empty factorization")
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// Log calculates the principal logarithm of the matrix a, placing the result
+// in the receiver. The principal logarithm is the unique logarithm whose
+// eigenvalues have imaginary parts in the interval (-π, π). Log will panic
+// with ErrShape if a is not square.
+//
+// A real principal logarithm exists if a has no eigenvalues on the closed
+// negative real axis. If this is not the case, ErrNoPrincipal is returned and
+// the receiver is not modified.
+//
+// If a is Symmetric, the logarithm is computed from its eigendecomposition.
+// Otherwise the inverse scaling and squaring method is applied to the real
+// Schur form of a.
+func (m *Dense) Log(a Matrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+
+	if s, ok := a.(Symmetric); ok {
+		return m.symmetricFunc(s, func(v float64) bool { return v > 0 }, math.Log)
+	}
+
+	// The implementation used here is from Functions of Matrices: Theory and
+	// Computation, Chapter 11, Algorithm 11.10.
+	// https://doi.org/10.1137/1.9780898717778.ch11
+	var schur Schur
+	if !schur.Factorize(a) {
+		return ErrFailedEigen
+	}
+	for _, v := range schur.values {
+		if imag(v) == 0 && real(v) <= 0 {
+			return ErrNoPrincipal
+		}
+	}
+
+	// Take square roots of T until it is close enough to the identity for
+	// the Padé approximant of log(I+X) to be accurate.
+	const (
+		theta7   = 0.264
+		maxRoots = 64
+	)
+	t := schur.t
+	x := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(x)
+	var k int
+	for ; k < maxRoots; k++ {
+		x.Copy(t)
+		for i := 0; i < r; i++ {
+			x.set(i, i, x.at(i, i)-1)
+		}
+		if Norm(x, 1) <= theta7 {
+			break
+		}
+		err := sqrtQuasiTri(t, t)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Evaluate the [7/7] Padé approximant of log(I+X) in partial fraction
+	// form using the 7-point Gauss-Legendre quadrature of
+	//
+	//	log(I+X) = ∫_0^1 X * (I + t*X)⁻¹ dt.
+	nodes := [7]float64{
+		0,
+		-0.4058451513773972, 0.4058451513773972,
+		-0.7415311855993945, 0.7415311855993945,
+		-0.9491079123427585, 0.9491079123427585,
+	}
+	weights := [7]float64{
+		0.4179591836734694,
+		0.3818300505051189, 0.3818300505051189,
+		0.2797053914892766, 0.2797053914892766,
+		0.1294849661688697, 0.1294849661688697,
+	}
+	log := getDenseWorkspace(r, r, true)
+	defer putDenseWorkspace(log)
+	den := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(den)
+	term := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(term)
+	for j, node := range nodes {
+		tj := (node + 1) / 2
+		den.Scale(tj, x)
+		for i := 0; i < r; i++ {
+			den.set(i, i, den.at(i, i)+1)
+		}
+		err := term.Solve(den, x)
+		if err != nil {
+			return err
+		}
+		term.Scale(weights[j]/2, term)
+		log.Add(log, term)
+	}
+	log.Scale(math.Ldexp(1, k), log)
+
+	m.Product(schur.z, log, schur.z.T())
+	return nil
+}
+
+// Sqrt calculates the principal square root of the matrix a, placing the
+// result in the receiver. The principal square root is the unique square root
+// whose eigenvalues have positive real parts. Sqrt will panic with ErrShape if
+// a is not square.
+//
+// A real principal square root exists if a has no eigenvalues on the negative
+// real axis. If this is not the case, ErrNoPrincipal is returned and the
+// receiver is not modified. If a is singular, a Condition error may be returned
+// and the result may be inaccurate.
+//
+// If a is Symmetric, the square root is computed from its eigendecomposition.
+// Otherwise the real Schur method is used.
+func (m *Dense) Sqrt(a Matrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+
+	if s, ok := a.(Symmetric); ok {
+		return m.symmetricFunc(s, func(v float64) bool { return v >= 0 }, math.Sqrt)
+	}
+
+	// The implementation used here is from Functions of Matrices: Theory and
+	// Computation, Chapter 6, Algorithm 6.7.
+	// https://doi.org/10.1137/1.9780898717778.ch6
+	var schur Schur
+	if !schur.Factorize(a) {
+		return ErrFailedEigen
+	}
+	for _, v := range schur.values {
+		if imag(v) == 0 && real(v) < 0 {
+			return ErrNoPrincipal
+		}
+	}
+	root := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(root)
+	err := sqrtQuasiTri(root, schur.t)
+	m.Product(schur.z, root, schur.z.T())
+	return err
+}
+
+// sqrtQuasiTri computes the principal square root of the upper
+// quasi-triangular matrix t in Schur canonical form and stores it into dst.
+// All real eigenvalues of t must be non-negative. dst and t may be the same
+// matrix.
+func sqrtQuasiTri(dst, t *Dense) error {
+	n, _ := t.Dims()
+	blocks := make([][2]int, 0, n)
+	for k := 0; k < n; {
+		if k < n-1 && t.at(k+1, k) != 0 {
+			blocks = append(blocks, [2]int{k, 2})
+			k += 2
+			continue
+		}
+		blocks = append(blocks, [2]int{k, 1})
+		k++
+	}
+
+	var rr *Dense
+	if dst == t {
+		rr = getDenseWorkspace(n, n, true)
+		defer putDenseWorkspace(rr)
+	} else {
+		dst.reuseAsZeroed(n, n)
+		rr = dst
+	}
+	rm := rr.mat
+
+	// Compute the square roots of the diagonal blocks.
+	for _, b := range blocks {
+		k := b[0]
+		if b[1] == 1 {
+			rr.set(k, k, math.Sqrt(t.at(k, k)))
+			continue
+		}
+		// The 2×2 block has eigenvalues θ ± iμ, and its square root is
+		// α*I + (T_kk - θ*I)/(2*α) where α is the real part of the
+		// principal square root of θ + iμ.
+		theta := (t.at(k, k) + t.at(k+1, k+1)) / 2
+		mu := math.Sqrt(math.Abs(t.at(k, k+1))) * math.Sqrt(math.Abs(t.at(k+1, k)))
+		alpha := math.Sqrt((theta + math.Hypot(theta, mu)) / 2)
+		rr.set(k, k, alpha+(t.at(k, k)-theta)/(2*alpha))
+		rr.set(k, k+1, t.at(k, k+1)/(2*alpha))
+		rr.set(k+1, k, t.at(k+1, k)/(2*alpha))
+		rr.set(k+1, k+1, alpha+(t.at(k+1, k+1)-theta)/(2*alpha))
+	}
+
+	// Compute the off-diagonal blocks column by column from the
+	// Sylvester equations
+	//
+	//	R_ii * R_ij + R_ij * R_jj = T_ij - Σ_{i<k<j} R_ik * R_kj.
+	var err error
+	for bj := 1; bj < len(blocks); bj++ {
+		j, nj := blocks[bj][0], blocks[bj][1]
+		for bi := bj - 1; bi >= 0; bi-- {
+			i, ni := blocks[bi][0], blocks[bi][1]
+			for p := 0; p < ni; p++ {
+				copy(rm.Data[(i+p)*rm.Stride+j:(i+p)*rm.Stride+j+nj], t.mat.Data[(i+p)*t.mat.Stride+j:(i+p)*t.mat.Stride+j+nj])
+			}
+			cij := blas64.General{Rows: ni, Cols: nj, Stride: rm.Stride, Data: rm.Data[i*rm.Stride+j:]}
+			if i+ni < j {
+				blas64.Gemm(blas.NoTrans, blas.NoTrans,
+					-1, blas64.General{Rows: ni, Cols: j - i - ni, Stride: rm.Stride, Data: rm.Data[i*rm.Stride+i+ni:]},
+					blas64.General{Rows: j - i - ni, Cols: nj, Stride: rm.Stride, Data: rm.Data[(i+ni)*rm.Stride+j:]},
+					1, cij)
+			}
+			rii := blas64.General{Rows: ni, Cols: ni, Stride: rm.Stride, Data: rm.Data[i*rm.Stride+i:]}
+			rjj := blas64.General{Rows: nj, Cols: nj, Stride: rm.Stride, Data: rm.Data[j*rm.Stride+j:]}
+			scale, ok := lapack64.Trsyl(blas.NoTrans, blas.NoTrans, 1, rii, rjj, cij)
+			if scale != 1 {
+				for p := 0; p < ni; p++ {
+					blas64.Scal(1/scale, blas64.Vector{N: nj, Inc: 1, Data: cij.Data[p*cij.Stride:]})
+				}
+			}
+			if !ok {
+				err = Condition(math.Inf(1))
+			}
+		}
+	}
+	if dst == t {
+		dst.Copy(rr)
+	}
+	return err
+}
+
+// Cos calculates the cosine of the matrix a, placing the result in the
+// receiver. Cos will panic with ErrShape if a is not square.
+//
+// If a is Symmetric, the cosine is computed from its eigendecomposition.
+// Otherwise a scaling and doubling algorithm based on a truncated Taylor
+// series is used.
+func (m *Dense) Cos(a Matrix) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	if s, ok := a.(Symmetric); ok {
+		if m.symmetricFunc(s, nil, math.Cos) == nil {
+			return
+		}
+	}
+	cos := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(cos)
+	sinCos(nil, cos, a)
+	m.reuseAsNonZeroed(r, r)
+	m.Copy(cos)
+}
+
+// Sin calculates the sine of the matrix a, placing the result in the
+// receiver. Sin will panic with ErrShape if a is not square.
+//
+// If a is Symmetric, the sine is computed from its eigendecomposition.
+// Otherwise a scaling and doubling algorithm based on a truncated Taylor
+// series is used.
+func (m *Dense) Sin(a Matrix) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	if s, ok := a.(Symmetric); ok {
+		if m.symmetricFunc(s, nil, math.Sin) == nil {
+			return
+		}
+	}
+	sin := getDenseWorkspace(r, r, false)
+	defer putDenseWorkspace(sin)
+	sinCos(sin, nil, a)
+	m.reuseAsNonZeroed(r, r)
+	m.Copy(sin)
+}
+
+// sinCos computes the sine and cosine of the n×n matrix a and stores them
+// into sin and cos, which must be n×n. If sin is nil, the sine is only used
+// internally.
+func sinCos(sin, cos *Dense, a Matrix) {
+	// The implementation used here is from Functions of Matrices: Theory and
+	// Computation, Chapter 12, Section 12.5 using a Taylor approximation in
+	// place of the Padé approximant.
+	// https://doi.org/10.1137/1.9780898717778.ch12
+	n, _ := a.Dims()
+	if sin == nil {
+		sin = getDenseWorkspace(n, n, false)
+		defer putDenseWorkspace(sin)
+	}
+	if cos == nil {
+		cos = getDenseWorkspace(n, n, false)
+		defer putDenseWorkspace(cos)
+	}
+
+	// Scale A so that its norm is at most 1.
+	x := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(x)
+	x.Copy(a)
+	var s int
+	if n1 := Norm(x, 1); n1 > 1 {
+		s = int(math.Ceil(math.Log2(n1)))
+		x.Scale(math.Ldexp(1, -s), x)
+	}
+	x2 := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(x2)
+	x2.Mul(x, x)
+
+	// Evaluate the Taylor series
+	//
+	//	cos(X) = Σ_k (-1)^k X^{2k} / (2k)!,
+	//	sin(X) = X * Σ_k (-1)^k X^{2k} / (2k+1)!,
+	//
+	// truncated after the X^20 and X^21 terms using Horner's scheme in X².
+	const terms = 10
+	work := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(work)
+	horner := func(dst *Dense, coeff func(k int) float64) {
+		dst.Zero()
+		for i := 0; i < n; i++ {
+			dst.set(i, i, coeff(terms))
+		}
+		for k := terms - 1; k >= 0; k-- {
+			work.Mul(x2, dst)
+			dst.Copy(work)
+			for i := 0; i < n; i++ {
+				dst.set(i, i, dst.at(i, i)+coeff(k))
+			}
+		}
+	}
+	horner(cos, func(k int) float64 {
+		return math.Pow(-1, float64(k)) / math.Gamma(float64(2*k+1))
+	})
+	horner(sin, func(k int) float64 {
+		return math.Pow(-1, float64(k)) / math.Gamma(float64(2*k+2))
+	})
+	work.Mul(x, sin)
+	sin.Copy(work)
+
+	// Undo the scaling using the double angle formulae
+	//
+	//	cos(2X) = 2*cos(X)² - I,
+	//	sin(2X) = 2*sin(X)*cos(X).
+	for ; s > 0; s-- {
+		work.Mul(sin, cos)
+		sin.Scale(2, work)
+		work.Mul(cos, cos)
+		cos.Scale(2, work)
+		for i := 0; i < n; i++ {
+			cos.set(i, i, cos.at(i, i)-1)
+		}
+	}
+}
+
+// ExpFrechet calculates the Fréchet derivative of the matrix exponential at a
+// in the direction e, L(a, e), placing the result in the receiver. The Fréchet
+// derivative satisfies
+//
+//	e^(a+e) = e^a + L(a, e) + o(‖e‖).
+//
+// ExpFrechet will panic with ErrShape if a is not square or if a and e do not
+// have the same dimensions.
+func (m *Dense) ExpFrechet(a, e Matrix) {
+	// The derivative is computed from the identity
+	//
+	//	exp([A E]) = [e^A L(A,E)]
+	//	   ([0 A])   [ 0     e^A ],
+	//
+	// see Functions of Matrices: Theory and Computation, Chapter 3,
+	// Theorem 3.6. https://doi.org/10.1137/1.9780898717778.ch3
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	er, ec := e.Dims()
+	if er != r || ec != c {
+		panic(ErrShape)
+	}
+
+	// L is linear in E, so scale E to have the same norm as A to avoid
+	// unnecessary scaling and squaring steps in the exponential.
+	en := Norm(e, 1)
+	if en == 0 {
+		m.reuseAsNonZeroed(r, r)
+		m.Zero()
+		return
+	}
+	scale := 1.0
+	if an := Norm(a, 1); an != 0 {
+		scale = an / en
+	}
+
+	b := getDenseWorkspace(2*r, 2*r, true)
+	defer putDenseWorkspace(b)
+	b.Slice(0, r, 0, r).(*Dense).Copy(a)
+	b.Slice(r, 2*r, r, 2*r).(*Dense).Copy(a)
+	b.Slice(0, r, r, 2*r).(*Dense).Scale(scale, e)
+	exp := getDenseWorkspace(2*r, 2*r, false)
+	defer putDenseWorkspace(exp)
+	exp.Exp(b)
+
+	m.reuseAsNonZeroed(r, r)
+	m.Scale(1/scale, exp.Slice(0, r, r, 2*r))
+}
+
+// symmetricFunc computes f(a) for the symmetric matrix a from its
+// eigendecomposition and stores the result into the receiver. If domain is
+// not nil, it is used to check that all eigenvalues of a are in the domain of
+// f, and ErrNoPrincipal is returned if this is not the case.
+func (m *Dense) symmetricFunc(a Symmetric, domain func(float64) bool, f func(float64) float64) error {
+	var eig EigenSym
+	if !eig.Factorize(a, true) {
+		return ErrFailedEigen
+	}
+	n := a.SymmetricDim()
+	values := eig.RawValues()
+	if domain != nil {
+		for _, v := range values {
+			if !domain(v) {
+				return ErrNoPrincipal
+			}
+		}
+	}
+	// f(A) = Q * f(Λ) * Qᵀ.
+	q := eig.vectors
+	qf := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(qf)
+	for j, v := range values {
+		fv := f(v)
+		for i := 0; i < n; i++ {
+			qf.set(i, j, q.at(i, j)*fv)
+		}
+	}
+	m.Mul(qf, q.T())
+	return nil
+}
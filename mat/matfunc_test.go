@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// matFuncCases returns general test matrices for the matrix functions along
+// with symmetric positive definite ones.
+func matFuncCases(rnd *rand.Rand) []Matrix {
+	var cases []Matrix
+	for _, n := range []int{1, 2, 3, 5, 10, 20} {
+		// A general matrix with eigenvalues in the right half-plane.
+		a := randSquareDense(n, rnd)
+		a.Scale(1/math.Sqrt(float64(n)), a)
+		for i := 0; i < n; i++ {
+			a.Set(i, i, a.At(i, i)+3)
+		}
+		cases = append(cases, a, randomPosDef(n, rnd))
+	}
+	// A rotation by 90°, with eigenvalues ±i.
+	cases = append(cases, NewDense(2, 2, []float64{0, -1, 1, 0}))
+	// A matrix with a large norm that needs many square roots.
+	cases = append(cases, NewDense(2, 2, []float64{1e4, 3e3, -2e2, 1e3}))
+	return cases
+}
+
+func TestSqrt(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i, a := range matFuncCases(rnd) {
+		name := fmt.Sprintf("case %d (%T)", i, a)
+		var x Dense
+		err := x.Sqrt(a)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var got Dense
+		got.Mul(&x, &x)
+		if !EqualApprox(&got, a, 1e-10) {
+			t.Errorf("%s: X*X != A", name)
+		}
+		// The principal square root has eigenvalues in the right
+		// half-plane.
+		var eig Eigen
+		eig.Factorize(&x, EigenNone)
+		for _, v := range eig.Values(nil) {
+			if real(v) <= 0 {
+				t.Errorf("%s: square root is not principal: eigenvalue %v", name, v)
+			}
+		}
+		if s, ok := a.(*SymDense); ok {
+			// Compare the symmetric fast path with the general path.
+			var want Dense
+			err := want.Sqrt(DenseCopyOf(s))
+			if err != nil {
+				t.Errorf("%s: unexpected error for general path: %v", name, err)
+			}
+			if !EqualApprox(&x, &want, 1e-10) {
+				t.Errorf("%s: symmetric and general paths differ", name)
+			}
+		}
+	}
+}
+
+func TestLog(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i, a := range matFuncCases(rnd) {
+		name := fmt.Sprintf("case %d (%T)", i, a)
+		var l Dense
+		err := l.Log(a)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var got Dense
+		got.Exp(&l)
+		if !EqualApprox(&got, a, 1e-10) {
+			t.Errorf("%s: exp(log(A)) != A", name)
+		}
+		if s, ok := a.(*SymDense); ok {
+			var want Dense
+			err := want.Log(DenseCopyOf(s))
+			if err != nil {
+				t.Errorf("%s: unexpected error for general path: %v", name, err)
+			}
+			if !EqualApprox(&l, &want, 1e-10) {
+				t.Errorf("%s: symmetric and general paths differ", name)
+			}
+		}
+	}
+
+	// The logarithm of the rotation by θ is the generator θ*[0 -1; 1 0].
+	const theta = 2.5
+	rot := NewDense(2, 2, []float64{
+		math.Cos(theta), -math.Sin(theta),
+		math.Sin(theta), math.Cos(theta),
+	})
+	var l Dense
+	err := l.Log(rot)
+	if err != nil {
+		t.Fatalf("unexpected error for rotation: %v", err)
+	}
+	want := NewDense(2, 2, []float64{0, -theta, theta, 0})
+	if !EqualApprox(&l, want, 1e-12) {
+		t.Errorf("unexpected logarithm of rotation:\ngot  %v\nwant %v", Formatted(&l), Formatted(want))
+	}
+}
+
+func TestLogSqrtNoPrincipal(t *testing.T) {
+	t.Parallel()
+	for _, a := range []Matrix{
+		NewDense(2, 2, []float64{-1, 1, 0, 2}),
+		NewSymDense(2, []float64{-1, 0, 0, 2}),
+	} {
+		var x Dense
+		if err := x.Log(a); err != ErrNoPrincipal {
+			t.Errorf("unexpected error from Log for %T: got %v, want %v", a, err, ErrNoPrincipal)
+		}
+		if err := x.Sqrt(a); err != ErrNoPrincipal {
+			t.Errorf("unexpected error from Sqrt for %T: got %v, want %v", a, err, ErrNoPrincipal)
+		}
+	}
+	var x Dense
+	if err := x.Log(NewDense(2, 2, []float64{0, 1, 0, 2})); err != ErrNoPrincipal {
+		t.Errorf("unexpected error from Log for singular matrix: got %v, want %v", err, ErrNoPrincipal)
+	}
+}
+
+func TestSinCos(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	cases := matFuncCases(rnd)
+	// A diagonal matrix for which the result is known elementwise.
+	cases = append(cases, NewDense(3, 3, []float64{
+		0.5, 0, 0,
+		0, -7, 0,
+		0, 0, 40,
+	}))
+	for i, a := range cases {
+		name := fmt.Sprintf("case %d (%T)", i, a)
+		n, _ := a.Dims()
+		var s, c Dense
+		s.Sin(a)
+		c.Cos(a)
+
+		// sin²(A) + cos²(A) = I.
+		var got, tmp Dense
+		got.Mul(&s, &s)
+		tmp.Mul(&c, &c)
+		got.Add(&got, &tmp)
+		eye := NewDiagDense(n, nil)
+		for j := 0; j < n; j++ {
+			eye.SetDiag(j, 1)
+		}
+		if !EqualApprox(&got, eye, 1e-8) {
+			t.Errorf("%s: sin²(A) + cos²(A) != I", name)
+		}
+
+		// sin(2A) = 2*sin(A)*cos(A).
+		var a2, s2 Dense
+		a2.Scale(2, a)
+		s2.Sin(&a2)
+		tmp.Mul(&s, &c)
+		tmp.Scale(2, &tmp)
+		if !EqualApprox(&s2, &tmp, 1e-8) {
+			t.Errorf("%s: sin(2A) != 2*sin(A)*cos(A)", name)
+		}
+
+		if isDiagonal(a) {
+			for j := 0; j < n; j++ {
+				v := a.At(j, j)
+				if math.Abs(s.At(j, j)-math.Sin(v)) > 1e-12 || math.Abs(c.At(j, j)-math.Cos(v)) > 1e-12 {
+					t.Errorf("%s: unexpected result for diagonal element %d", name, j)
+				}
+			}
+		}
+	}
+}
+
+func TestExpFrechet(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10} {
+		a := randSquareDense(n, rnd)
+		e := randSquareDense(n, rnd)
+
+		var l Dense
+		l.ExpFrechet(a, e)
+
+		// Compare with a central finite difference.
+		const h = 1e-5
+		var ap, am, ep, em Dense
+		ap.Scale(h, e)
+		ap.Add(a, &ap)
+		am.Scale(-h, e)
+		am.Add(a, &am)
+		ep.Exp(&ap)
+		em.Exp(&am)
+		var fd Dense
+		fd.Sub(&ep, &em)
+		fd.Scale(1/(2*h), &fd)
+		if !EqualApprox(&l, &fd, 1e-6) {
+			t.Errorf("n=%d: Fréchet derivative does not match finite difference", n)
+		}
+
+		// For commuting A and E, L(A,E) = E*e^A.
+		var expA, want Dense
+		expA.Exp(a)
+		want.Mul(a, &expA)
+		l.ExpFrechet(a, a)
+		if !EqualApprox(&l, &want, 1e-10) {
+			t.Errorf("n=%d: L(A,A) != A*e^A", n)
+		}
+	}
+
+	var l Dense
+	l.ExpFrechet(NewDense(2, 2, []float64{1, 2, 3, 4}), NewDense(2, 2, nil))
+	if !Equal(&l, NewDense(2, 2, nil)) {
+		t.Errorf("unexpected non-zero derivative in zero direction")
+	}
+}
@@ -736,6 +736,16 @@ func TestMulVecToer(t *testing.T) {
 		NewTridiag(4, random(3), random(4), random(3)),
 		NewTridiag(7, random(6), random(7), random(6)),
 		NewTridiag(10, random(9), random(10), random(9)),
+		NewDense(1, 1, random(1)),
+		NewDense(3, 4, random(12)),
+		NewDense(7, 7, random(49)),
+		NewSymDense(1, random(1)),
+		NewSymDense(6, random(36)),
+		csrOf(randSparseDense(1, 1, 1, rnd)),
+		csrOf(randSparseDense(7, 10, 0.3, rnd)),
+		csrOf(randSparseDense(10, 10, 0.3, rnd)),
+		cscOf(randSparseDense(10, 7, 0.3, rnd)),
+		cscOf(randSparseDense(10, 10, 0.3, rnd)),
 	} {
 		// Dense copy of A used for computing the expected result.
 		var aDense Dense
@@ -0,0 +1,329 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const mmBanner = "%%MatrixMarket"
+
+var errMMEmpty = errors.New("mat: matrix market input has zero dimension")
+
+// Coordinate format inputs may have at most the larger of mmMinSparseDim and
+// mmSparseDimRatio times the number of elements rows or columns.
+const (
+	mmMinSparseDim   = 1 << 20
+	mmSparseDimRatio = 16
+)
+
+// ReadMatrixMarket reads a real matrix in the Matrix Market exchange format
+// from r. The type of the returned matrix depends on the format and symmetry
+// of the input:
+//
+//   - coordinate format is returned as a *CSR. For symmetric and
+//     skew-symmetric inputs both triangles are stored,
+//   - array format with general or skew-symmetric symmetry is returned as a
+//     *Dense,
+//   - array format with symmetric symmetry is returned as a *SymDense.
+//
+// The real, integer and pattern fields are supported. Elements of pattern
+// matrices are given the value 1. Duplicate elements in coordinate format
+// are summed. Complex and Hermitian matrices are not supported and result in
+// an error.
+//
+// The size of the returned matrix is bounded by the length of the input. An
+// error is returned for coordinate format inputs that have more than the
+// larger of 2^20 and 16×nnz rows or columns, where nnz is the number of
+// elements in the input.
+//
+// See https://math.nist.gov/MatrixMarket/formats.html for a description of the
+// format.
+func ReadMatrixMarket(r io.Reader) (Matrix, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	var line int
+	next := func() ([]string, error) {
+		for sc.Scan() {
+			line++
+			text := strings.TrimSpace(sc.Text())
+			if text == "" || text[0] == '%' {
+				continue
+			}
+			return strings.Fields(text), nil
+		}
+		if err := sc.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.ErrUnexpectedEOF
+	}
+	errorf := func(format string, args ...any) error {
+		return fmt.Errorf("mat: matrix market line %d: "+format, append([]any{line}, args...)...)
+	}
+
+	// Parse the banner.
+	if !sc.Scan() {
+		if err := sc.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.ErrUnexpectedEOF
+	}
+	line++
+	banner := strings.Fields(strings.ToLower(sc.Text()))
+	if len(banner) != 5 || banner[0] != strings.ToLower(mmBanner) || banner[1] != "matrix" {
+		return nil, errorf("invalid banner %q", sc.Text())
+	}
+	format, field, symmetry := banner[2], banner[3], banner[4]
+	switch format {
+	case "coordinate", "array":
+	default:
+		return nil, errorf("unknown format %q", format)
+	}
+	switch field {
+	case "real", "double", "integer":
+	case "pattern":
+		if format == "array" {
+			return nil, errorf("pattern field in array format")
+		}
+	case "complex":
+		return nil, errorf("complex field not supported")
+	default:
+		return nil, errorf("unknown field %q", field)
+	}
+	switch symmetry {
+	case "general", "symmetric", "skew-symmetric":
+	case "hermitian":
+		return nil, errorf("hermitian symmetry not supported")
+	default:
+		return nil, errorf("unknown symmetry %q", symmetry)
+	}
+
+	parseInts := func(fields []string, want int) ([]int, error) {
+		if len(fields) != want {
+			return nil, errorf("expected %d integers, got %d fields", want, len(fields))
+		}
+		v := make([]int, want)
+		for i, f := range fields {
+			var err error
+			v[i], err = strconv.Atoi(f)
+			if err != nil || v[i] < 0 {
+				return nil, errorf("invalid integer %q", f)
+			}
+		}
+		return v, nil
+	}
+	parseValue := func(f string) (float64, error) {
+		if field == "integer" {
+			v, err := strconv.ParseInt(f, 10, 64)
+			if err != nil {
+				return 0, errorf("invalid integer %q", f)
+			}
+			return float64(v), nil
+		}
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return 0, errorf("invalid value %q", f)
+		}
+		return v, nil
+	}
+
+	fields, err := next()
+	if err != nil {
+		return nil, err
+	}
+	want := 3
+	if format == "array" {
+		want = 2
+	}
+	size, err := parseInts(fields, want)
+	if err != nil {
+		return nil, err
+	}
+	rows, cols := size[0], size[1]
+	if rows == 0 || cols == 0 {
+		return nil, errMMEmpty
+	}
+	if symmetry != "general" && rows != cols {
+		return nil, errorf("%s matrix is not square", symmetry)
+	}
+
+	if format == "coordinate" {
+		nnz := size[2]
+		m := NewCOO(rows, cols, nil, nil, nil)
+		want := 3
+		if field == "pattern" {
+			want = 2
+		}
+		for k := 0; k < nnz; k++ {
+			fields, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if len(fields) != want {
+				return nil, errorf("expected %d fields, got %d", want, len(fields))
+			}
+			ij, err := parseInts(fields[:2], 2)
+			if err != nil {
+				return nil, err
+			}
+			i, j := ij[0]-1, ij[1]-1
+			if i < 0 || rows <= i || j < 0 || cols <= j {
+				return nil, errorf("index (%d, %d) out of range", i+1, j+1)
+			}
+			v := 1.0
+			if field != "pattern" {
+				v, err = parseValue(fields[2])
+				if err != nil {
+					return nil, err
+				}
+			}
+			m.Append(i, j, v)
+			if i != j {
+				switch symmetry {
+				case "symmetric":
+					m.Append(j, i, v)
+				case "skew-symmetric":
+					m.Append(j, i, -v)
+				}
+			}
+		}
+		// The row pointers of the CSR are allocated from the dimensions in
+		// the header, so bound them relative to the number of elements
+		// that have been read to keep memory use bounded by the length of
+		// the input.
+		if max(rows, cols) > max(mmMinSparseDim, mmSparseDimRatio*nnz) {
+			return nil, errTooBig
+		}
+		var csr CSR
+		csr.CloneFrom(m)
+		return &csr, nil
+	}
+
+	// Array format holds the elements in column-major order. Only the
+	// lower triangle is held for symmetric matrices, and only the
+	// strictly lower triangle for skew-symmetric matrices. The size in the
+	// header is not trusted, so the elements are read before the matrix is
+	// allocated and memory use is bounded by the length of the input.
+	if int64(rows) > maxLen/int64(cols)/int64(sizeFloat64) {
+		return nil, errTooBig
+	}
+	n := rows * cols
+	switch symmetry {
+	case "symmetric":
+		n = rows * (rows + 1) / 2
+	case "skew-symmetric":
+		n = rows * (rows - 1) / 2
+	}
+	data := make([]float64, 0, min(n, 1024))
+	for len(data) < n {
+		fields, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) != 1 {
+			return nil, errorf("expected 1 field, got %d", len(fields))
+		}
+		v, err := parseValue(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, v)
+	}
+	var k int
+	switch symmetry {
+	case "symmetric":
+		m := NewSymDense(rows, nil)
+		for j := 0; j < cols; j++ {
+			for i := j; i < rows; i++ {
+				m.SetSym(i, j, data[k])
+				k++
+			}
+		}
+		return m, nil
+	case "skew-symmetric":
+		m := NewDense(rows, cols, nil)
+		for j := 0; j < cols; j++ {
+			for i := j + 1; i < rows; i++ {
+				m.set(i, j, data[k])
+				m.set(j, i, -data[k])
+				k++
+			}
+		}
+		return m, nil
+	default:
+		m := NewDense(rows, cols, nil)
+		for j := 0; j < cols; j++ {
+			for i := 0; i < rows; i++ {
+				m.set(i, j, data[k])
+				k++
+			}
+		}
+		return m, nil
+	}
+}
+
+// WriteMatrixMarket writes a to w in the Matrix Market exchange format with
+// the real field. The sparse types *CSR, *CSC and *COO are written in
+// coordinate format with general symmetry. Matrices that implement the
+// Symmetric interface are written in array format with symmetric symmetry,
+// and all other matrices are written in array format with general symmetry.
+//
+// The values are written with the minimal number of digits needed to
+// represent them exactly, so a matrix that is written and read back is
+// unchanged.
+func WriteMatrixMarket(w io.Writer, a Matrix) error {
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		return errMMEmpty
+	}
+	bw := bufio.NewWriter(w)
+	var buf []byte
+	writeValue := func(v float64) {
+		buf = strconv.AppendFloat(buf[:0], v, 'g', -1, 64)
+		buf = append(buf, '\n')
+		bw.Write(buf)
+	}
+
+	switch a := a.(type) {
+	case *CSR, *CSC, *COO:
+		var m CSR
+		m.CloneFrom(a)
+		fmt.Fprintf(bw, "%s matrix coordinate real general\n", mmBanner)
+		fmt.Fprintf(bw, "%d %d %d\n", r, c, m.NNZ())
+		for i := 0; i < r; i++ {
+			for k := m.mat.indptr[i]; k < m.mat.indptr[i+1]; k++ {
+				buf = strconv.AppendInt(buf[:0], int64(i+1), 10)
+				buf = append(buf, ' ')
+				buf = strconv.AppendInt(buf, int64(m.mat.ind[k]+1), 10)
+				buf = append(buf, ' ')
+				buf = strconv.AppendFloat(buf, m.mat.data[k], 'g', -1, 64)
+				buf = append(buf, '\n')
+				bw.Write(buf)
+			}
+		}
+	case Symmetric:
+		fmt.Fprintf(bw, "%s matrix array real symmetric\n", mmBanner)
+		fmt.Fprintf(bw, "%d %d\n", r, c)
+		for j := 0; j < c; j++ {
+			for i := j; i < r; i++ {
+				writeValue(a.At(i, j))
+			}
+		}
+	default:
+		fmt.Fprintf(bw, "%s matrix array real general\n", mmBanner)
+		fmt.Fprintf(bw, "%d %d\n", r, c)
+		for j := 0; j < c; j++ {
+			for i := 0; i < r; i++ {
+				writeValue(a.At(i, j))
+			}
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,251 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestReadMatrixMarket(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		input string
+		want  Matrix
+		typ   string
+	}{
+		{
+			name: "coordinate real general",
+			input: `%%MatrixMarket matrix coordinate real general
+% A comment.
+
+3 4 4
+1 1 1.5
+2 3 -2e3
+3 4 7
+1 1 0.5
+`,
+			want: NewDense(3, 4, []float64{
+				2, 0, 0, 0,
+				0, 0, -2000, 0,
+				0, 0, 0, 7,
+			}),
+			typ: "*mat.CSR",
+		},
+		{
+			name: "coordinate pattern symmetric",
+			input: `%%MatrixMarket matrix coordinate pattern symmetric
+3 3 3
+1 1
+2 1
+3 2
+`,
+			want: NewDense(3, 3, []float64{
+				1, 1, 0,
+				1, 0, 1,
+				0, 1, 0,
+			}),
+			typ: "*mat.CSR",
+		},
+		{
+			name: "coordinate integer skew-symmetric",
+			input: `%%MatrixMarket matrix coordinate integer skew-symmetric
+3 3 2
+2 1 3
+3 1 -4
+`,
+			want: NewDense(3, 3, []float64{
+				0, -3, 4,
+				3, 0, 0,
+				-4, 0, 0,
+			}),
+			typ: "*mat.CSR",
+		},
+		{
+			name: "array real general",
+			input: `%%MatrixMarket matrix array real general
+2 3
+1
+4
+2
+5
+3
+6
+`,
+			want: NewDense(2, 3, []float64{
+				1, 2, 3,
+				4, 5, 6,
+			}),
+			typ: "*mat.Dense",
+		},
+		{
+			name: "array double symmetric",
+			input: `%%MATRIXMARKET Matrix Array Double Symmetric
+3 3
+1
+2
+3
+4
+5
+6
+`,
+			want: NewDense(3, 3, []float64{
+				1, 2, 3,
+				2, 4, 5,
+				3, 5, 6,
+			}),
+			typ: "*mat.SymDense",
+		},
+		{
+			name: "array integer skew-symmetric",
+			input: `%%MatrixMarket matrix array integer skew-symmetric
+3 3
+1
+2
+3
+`,
+			want: NewDense(3, 3, []float64{
+				0, -1, -2,
+				1, 0, -3,
+				2, 3, 0,
+			}),
+			typ: "*mat.Dense",
+		},
+	} {
+		got, err := ReadMatrixMarket(strings.NewReader(test.input))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if typ := fmt.Sprintf("%T", got); typ != test.typ {
+			t.Errorf("%s: unexpected type: got %s, want %s", test.name, typ, test.typ)
+		}
+		if !Equal(got, test.want) {
+			t.Errorf("%s: unexpected result:\ngot:\n%v\nwant:\n%v", test.name, Formatted(got), Formatted(test.want))
+		}
+	}
+}
+
+func TestReadMatrixMarketError(t *testing.T) {
+	t.Parallel()
+	for _, input := range []string{
+		"",
+		"%%MatrixMarket matrix coordinate real\n",
+		"%%NotMatrixMarket matrix coordinate real general\n1 1 0\n",
+		"%%MatrixMarket vector coordinate real general\n1 1 0\n",
+		"%%MatrixMarket matrix dense real general\n1 1\n1\n",
+		"%%MatrixMarket matrix coordinate complex general\n1 1 1\n1 1 1 0\n",
+		"%%MatrixMarket matrix coordinate real hermitian\n1 1 1\n1 1 1\n",
+		"%%MatrixMarket matrix coordinate quaternion general\n1 1 1\n1 1 1\n",
+		"%%MatrixMarket matrix coordinate real upper\n1 1 1\n1 1 1\n",
+		"%%MatrixMarket matrix array pattern general\n1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2\n",
+		"%%MatrixMarket matrix coordinate real general\n0 2 0\n",
+		"%%MatrixMarket matrix coordinate real general\n2 -2 0\n",
+		"%%MatrixMarket matrix coordinate real symmetric\n2 3 0\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n0 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1 x\n",
+		"%%MatrixMarket matrix coordinate integer general\n2 2 1\n1 1 1.5\n",
+		"%%MatrixMarket matrix coordinate pattern general\n2 2 1\n1 1 1\n",
+		"%%MatrixMarket matrix array real general\n2 1\n1\n",
+		"%%MatrixMarket matrix array real general\n2 1\n1 2\n",
+		"%%MatrixMarket matrix array real symmetric\n2 2\n1\n2\n",
+		// The header sizes are not trusted. These would need terabytes
+		// of memory if the matrix was allocated before reading the data.
+		"%%MatrixMarket matrix array real general\n1000000 1000000\n1\n",
+		"%%MatrixMarket matrix array real symmetric\n1000000 1000000\n1\n",
+		"%%MatrixMarket matrix array real general\n9223372036854775807 2\n1\n",
+		"%%MatrixMarket matrix coordinate real general\n9223372036854775807 9223372036854775807 1\n1 1 1\n",
+		"%%MatrixMarket matrix coordinate real general\n1099511627776 1 0\n",
+		"%%MatrixMarket matrix coordinate real general\n1 1099511627776 1\n1 1 1\n",
+		"%%MatrixMarket matrix coordinate real symmetric\n1099511627776 1099511627776 2\n1 1 1\n2 1 1\n",
+	} {
+		_, err := ReadMatrixMarket(strings.NewReader(input))
+		if err == nil {
+			t.Errorf("expected error for input %q", input)
+		}
+	}
+
+	// Large sparse matrices with few elements are accepted when the
+	// dimensions are within the bound.
+	m, err := ReadMatrixMarket(strings.NewReader("%%MatrixMarket matrix coordinate real general\n1048576 3 1\n1048576 3 2\n"))
+	if err != nil {
+		t.Fatalf("unexpected error for large sparse matrix: %v", err)
+	}
+	if r, c := m.Dims(); r != 1048576 || c != 3 || m.At(1048575, 2) != 2 {
+		t.Errorf("unexpected large sparse matrix: dims %d×%d", r, c)
+	}
+}
+
+func TestMatrixMarketRoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	sparse := randSparseDense(20, 15, 0.1, rnd)
+	sym := NewSymDense(6, nil)
+	for i := 0; i < 6; i++ {
+		for j := i; j < 6; j++ {
+			sym.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	dense := NewDense(5, 7, nil)
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 7; j++ {
+			dense.Set(i, j, rnd.NormFloat64()*1e10)
+		}
+	}
+	coo := NewCOO(4, 4, nil, nil, nil)
+	coo.Append(0, 3, 1.0/3)
+	coo.Append(2, 1, 0)
+	for _, test := range []struct {
+		name string
+		a    Matrix
+		typ  string
+	}{
+		{name: "csr", a: csrOf(sparse), typ: "*mat.CSR"},
+		{name: "csc", a: cscOf(sparse), typ: "*mat.CSR"},
+		{name: "coo", a: coo, typ: "*mat.CSR"},
+		{name: "symdense", a: sym, typ: "*mat.SymDense"},
+		{name: "dense", a: dense, typ: "*mat.Dense"},
+		{name: "transpose", a: dense.T(), typ: "*mat.Dense"},
+		{name: "tridense", a: NewTriDense(3, Upper, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6}), typ: "*mat.Dense"},
+		{name: "vecdense", a: NewVecDense(4, []float64{1, -2, 3.25, 4}), typ: "*mat.Dense"},
+	} {
+		var buf bytes.Buffer
+		if err := WriteMatrixMarket(&buf, test.a); err != nil {
+			t.Errorf("%s: unexpected error writing: %v", test.name, err)
+			continue
+		}
+		got, err := ReadMatrixMarket(&buf)
+		if err != nil {
+			t.Errorf("%s: unexpected error reading: %v", test.name, err)
+			continue
+		}
+		if typ := fmt.Sprintf("%T", got); typ != test.typ {
+			t.Errorf("%s: unexpected type: got %s, want %s", test.name, typ, test.typ)
+		}
+		if !Equal(got, test.a) {
+			t.Errorf("%s: round trip does not match", test.name)
+		}
+	}
+	// Explicit zeros in sparse matrices are retained.
+	var buf bytes.Buffer
+	WriteMatrixMarket(&buf, coo)
+	got, _ := ReadMatrixMarket(&buf)
+	if nnz := got.(*CSR).NNZ(); nnz != 2 {
+		t.Errorf("unexpected number of stored elements: got %d, want 2", nnz)
+	}
+
+	if err := WriteMatrixMarket(&buf, &Dense{}); err == nil {
+		t.Errorf("expected error for empty matrix")
+	}
+}
@@ -0,0 +1,547 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// npyMagic is the magic string at the start of every .npy file.
+const npyMagic = "\x93NUMPY"
+
+// npyAlign is the alignment of the start of the data in a .npy file.
+const npyAlign = 64
+
+var (
+	errNpyMagic     = errors.New("mat: invalid npy magic string")
+	errNpyHeader    = errors.New("mat: invalid npy header")
+	errNpyShape     = errors.New("mat: unsupported npy array shape")
+	errNpyEmpty     = errors.New("mat: npy array has zero length")
+	errNpyComplex   = errors.New("mat: npy array is complex")
+	errNotSymmetric = errors.New("mat: matrix is not symmetric")
+	errNotTriangle  = errors.New("mat: matrix is not triangular")
+)
+
+// npyHeader is the decoded header of a .npy file.
+type npyHeader struct {
+	order   binary.ByteOrder
+	kind    byte // 'f' or 'c'
+	size    int  // size in bytes of an element
+	fortran bool
+	shape   []int
+}
+
+// readNpyHeader reads and decodes the header of a .npy file from r.
+func readNpyHeader(r io.Reader) (npyHeader, error) {
+	var pre [len(npyMagic) + 2]byte
+	if _, err := io.ReadFull(r, pre[:]); err != nil {
+		return npyHeader{}, err
+	}
+	if string(pre[:len(npyMagic)]) != npyMagic {
+		return npyHeader{}, errNpyMagic
+	}
+	var hlen int
+	switch major := pre[len(npyMagic)]; major {
+	case 1:
+		var b [2]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return npyHeader{}, err
+		}
+		hlen = int(binary.LittleEndian.Uint16(b[:]))
+	case 2, 3:
+		var b [4]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return npyHeader{}, err
+		}
+		l := binary.LittleEndian.Uint32(b[:])
+		if int64(l) > 1<<24 {
+			return npyHeader{}, errNpyHeader
+		}
+		hlen = int(l)
+	default:
+		return npyHeader{}, fmt.Errorf("mat: unsupported npy version %d", major)
+	}
+	buf := make([]byte, hlen)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return npyHeader{}, err
+	}
+	return parseNpyHeader(string(buf))
+}
+
+// parseNpyHeader parses the Python dictionary literal that forms the header
+// of a .npy file, for example
+//
+//	{'descr': '<f8', 'fortran_order': False, 'shape': (3, 4), }
+func parseNpyHeader(s string) (npyHeader, error) {
+	var h npyHeader
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return h, errNpyHeader
+	}
+	s = s[1 : len(s)-1]
+
+	var haveDescr, haveOrder, haveShape bool
+	for {
+		s = strings.TrimLeft(s, " ,\t\n")
+		if s == "" {
+			break
+		}
+		key, rest, ok := parseQuoted(s)
+		if !ok {
+			return h, errNpyHeader
+		}
+		rest = strings.TrimLeft(rest, " ")
+		if rest == "" || rest[0] != ':' {
+			return h, errNpyHeader
+		}
+		s = strings.TrimLeft(rest[1:], " ")
+		switch key {
+		case "descr":
+			var descr string
+			descr, s, ok = parseQuoted(s)
+			if !ok {
+				return h, errNpyHeader
+			}
+			if err := h.setDescr(descr); err != nil {
+				return h, err
+			}
+			haveDescr = true
+		case "fortran_order":
+			switch {
+			case strings.HasPrefix(s, "True"):
+				h.fortran = true
+				s = s[len("True"):]
+			case strings.HasPrefix(s, "False"):
+				s = s[len("False"):]
+			default:
+				return h, errNpyHeader
+			}
+			haveOrder = true
+		case "shape":
+			if s == "" || s[0] != '(' {
+				return h, errNpyHeader
+			}
+			end := strings.IndexByte(s, ')')
+			if end < 0 {
+				return h, errNpyHeader
+			}
+			for _, f := range strings.Split(s[1:end], ",") {
+				f = strings.TrimSpace(f)
+				if f == "" {
+					continue
+				}
+				// Shapes written by Python 2 may have a long suffix.
+				d, err := strconv.Atoi(strings.TrimSuffix(f, "L"))
+				if err != nil || d < 0 {
+					return h, errNpyHeader
+				}
+				h.shape = append(h.shape, d)
+			}
+			s = s[end+1:]
+			haveShape = true
+		default:
+			return h, errNpyHeader
+		}
+	}
+	if !haveDescr || !haveOrder || !haveShape {
+		return h, errNpyHeader
+	}
+	return h, nil
+}
+
+// parseQuoted returns the content of the single or double quoted string at
+// the start of s and the remainder of s.
+func parseQuoted(s string) (str, rest string, ok bool) {
+	if s == "" || (s[0] != '\'' && s[0] != '"') {
+		return "", s, false
+	}
+	end := strings.IndexByte(s[1:], s[0])
+	if end < 0 {
+		return "", s, false
+	}
+	return s[1 : end+1], s[end+2:], true
+}
+
+// setDescr sets the element type of the header from a NumPy type descriptor.
+func (h *npyHeader) setDescr(descr string) error {
+	if len(descr) < 3 {
+		return fmt.Errorf("mat: unsupported npy type %q", descr)
+	}
+	switch descr[0] {
+	case '<', '=':
+		h.order = binary.LittleEndian
+	case '>':
+		h.order = binary.BigEndian
+	default:
+		return fmt.Errorf("mat: unsupported npy type %q", descr)
+	}
+	switch descr[1:] {
+	case "f8":
+		h.kind, h.size = 'f', 8
+	case "f4":
+		h.kind, h.size = 'f', 4
+	case "c16":
+		h.kind, h.size = 'c', 16
+	case "c8":
+		h.kind, h.size = 'c', 8
+	default:
+		return fmt.Errorf("mat: unsupported npy type %q", descr)
+	}
+	return nil
+}
+
+// npyChunk is the number of elements of a .npy array that are read and
+// decoded at a time.
+const npyChunk = 4096
+
+// readNpy reads the header of a one or two dimensional .npy array from r and
+// returns it with the shape of the array. The data of the array is left in r
+// to be read by readNpyData.
+func readNpy(r io.Reader) (h npyHeader, rows, cols int, vec bool, err error) {
+	h, err = readNpyHeader(r)
+	if err != nil {
+		return h, 0, 0, false, err
+	}
+	switch len(h.shape) {
+	case 1:
+		rows, cols, vec = h.shape[0], 1, true
+	case 2:
+		rows, cols = h.shape[0], h.shape[1]
+	default:
+		return h, 0, 0, false, errNpyShape
+	}
+	if rows == 0 || cols == 0 {
+		return h, 0, 0, false, errNpyEmpty
+	}
+	if int64(rows) > maxLen/int64(cols)/int64(h.size) {
+		return h, 0, 0, false, errTooBig
+	}
+	return h, rows, cols, vec, nil
+}
+
+// readNpyData reads the rows×cols elements of a .npy array described by h
+// from r in chunks, decodes them with decode and returns them in row-major
+// order. The shape in the header is not trusted, so the elements are
+// appended to a growing slice and memory use is bounded by the length of
+// the input.
+func readNpyData[T float64 | complex128](h *npyHeader, r io.Reader, rows, cols int, vec bool, decode func(b []byte) T) ([]T, error) {
+	n := rows * cols
+	buf := make([]byte, min(n, npyChunk)*h.size)
+	data := make([]T, 0, min(n, npyChunk))
+	for len(data) < n {
+		b := buf[:min(npyChunk, n-len(data))*h.size]
+		if _, err := io.ReadFull(r, b); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		for ; len(b) != 0; b = b[h.size:] {
+			data = append(data, decode(b[:h.size]))
+		}
+	}
+	if !h.fortran || vec {
+		return data, nil
+	}
+	// Convert column-major to row-major order.
+	t := make([]T, n)
+	for k, v := range data {
+		i, j := k%rows, k/rows
+		t[i*cols+j] = v
+	}
+	return t, nil
+}
+
+// readNpyReal reads a real .npy array from r.
+func readNpyReal(r io.Reader) (*Dense, bool, error) {
+	h, rows, cols, vec, err := readNpy(r)
+	if err != nil {
+		return nil, false, err
+	}
+	if h.kind == 'c' {
+		return nil, false, errNpyComplex
+	}
+	decode := func(b []byte) float64 { return math.Float64frombits(h.order.Uint64(b)) }
+	if h.size == 4 {
+		decode = func(b []byte) float64 { return float64(math.Float32frombits(h.order.Uint32(b))) }
+	}
+	data, err := readNpyData(&h, r, rows, cols, vec, decode)
+	if err != nil {
+		return nil, false, err
+	}
+	return NewDense(rows, cols, data), vec, nil
+}
+
+// ReadNpy reads a real array in the NumPy .npy format from r and returns it as
+// a Dense. Arrays of type float64 and float32 in either byte order, and in C or
+// Fortran order, are supported. A one dimensional array of length n is
+// returned as an n×1 matrix. Arrays with more than two dimensions or with zero
+// length are not supported.
+//
+// See https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html
+// for a description of the format.
+func ReadNpy(r io.Reader) (*Dense, error) {
+	m, _, err := readNpyReal(r)
+	return m, err
+}
+
+// ReadNpyVec reads a real array in the NumPy .npy format from r and returns it
+// as a VecDense. The array must be one dimensional or have a single row or
+// column. See ReadNpy for the supported arrays.
+func ReadNpyVec(r io.Reader) (*VecDense, error) {
+	m, _, err := readNpyReal(r)
+	if err != nil {
+		return nil, err
+	}
+	rows, cols := m.Dims()
+	if rows != 1 && cols != 1 {
+		return nil, ErrShape
+	}
+	return NewVecDense(rows*cols, m.mat.Data), nil
+}
+
+// ReadNpySym reads a real square array in the NumPy .npy format from r and
+// returns it as a SymDense. ReadNpySym returns an error if the array is not
+// exactly symmetric. See ReadNpy for the supported arrays.
+func ReadNpySym(r io.Reader) (*SymDense, error) {
+	m, vec, err := readNpyReal(r)
+	if err != nil {
+		return nil, err
+	}
+	n, c := m.Dims()
+	if vec || n != c {
+		return nil, ErrSquare
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if m.at(i, j) != m.at(j, i) {
+				return nil, errNotSymmetric
+			}
+		}
+	}
+	return NewSymDense(n, m.mat.Data), nil
+}
+
+// ReadNpyTri reads a real square array in the NumPy .npy format from r and
+// returns it as a TriDense of the given kind. ReadNpyTri returns an error if
+// the array has non-zero elements outside of the triangle. See ReadNpy for the
+// supported arrays.
+func ReadNpyTri(r io.Reader, kind TriKind) (*TriDense, error) {
+	m, vec, err := readNpyReal(r)
+	if err != nil {
+		return nil, err
+	}
+	n, c := m.Dims()
+	if vec || n != c {
+		return nil, ErrSquare
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (kind == Upper && j < i || kind == Lower && j > i) && m.at(i, j) != 0 {
+				return nil, errNotTriangle
+			}
+		}
+	}
+	return NewTriDense(n, kind, m.mat.Data), nil
+}
+
+// ReadNpyComplex reads an array in the NumPy .npy format from r and returns it
+// as a CDense. In addition to the real arrays supported by ReadNpy, arrays of
+// type complex128 and complex64 are supported.
+func ReadNpyComplex(r io.Reader) (*CDense, error) {
+	h, rows, cols, vec, err := readNpy(r)
+	if err != nil {
+		return nil, err
+	}
+	order := h.order
+	var decode func(b []byte) complex128
+	switch {
+	case h.kind == 'f' && h.size == 4:
+		decode = func(b []byte) complex128 { return complex(float64(math.Float32frombits(order.Uint32(b))), 0) }
+	case h.kind == 'f':
+		decode = func(b []byte) complex128 { return complex(math.Float64frombits(order.Uint64(b)), 0) }
+	case h.size == 8:
+		decode = func(b []byte) complex128 {
+			return complex(float64(math.Float32frombits(order.Uint32(b[:4]))), float64(math.Float32frombits(order.Uint32(b[4:]))))
+		}
+	default:
+		decode = func(b []byte) complex128 {
+			return complex(math.Float64frombits(order.Uint64(b[:8])), math.Float64frombits(order.Uint64(b[8:])))
+		}
+	}
+	data, err := readNpyData(&h, r, rows, cols, vec, decode)
+	if err != nil {
+		return nil, err
+	}
+	return NewCDense(rows, cols, data), nil
+}
+
+// writeNpyHeader writes a version 1.0 .npy header for a little-endian array
+// with the given type descriptor and shape to w.
+func writeNpyHeader(w io.Writer, descr string, shape ...int) error {
+	var dims []string
+	for _, d := range shape {
+		dims = append(dims, strconv.Itoa(d))
+	}
+	shapeStr := strings.Join(dims, ", ")
+	if len(shape) == 1 {
+		shapeStr += ","
+	}
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, shapeStr)
+
+	// The header is padded with spaces and terminated by a newline
+	// so that the data is aligned.
+	pre := len(npyMagic) + 4
+	total := pre + len(dict) + 1
+	total += (npyAlign - total%npyAlign) % npyAlign
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(total-pre))
+	buf.WriteString(dict)
+	buf.WriteString(strings.Repeat(" ", total-pre-len(dict)-1))
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteNpy writes a to w as a float64 array in the NumPy .npy format with C
+// order. If a is a Vector, a one dimensional array is written, otherwise a
+// two dimensional array is written.
+func WriteNpy(w io.Writer, a Matrix) error {
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		return errNpyEmpty
+	}
+	var err error
+	if v, ok := a.(Vector); ok && c == 1 {
+		err = writeNpyHeader(w, "<f8", v.Len())
+	} else {
+		err = writeNpyHeader(w, "<f8", r, c)
+	}
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 8*c)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			binary.LittleEndian.PutUint64(buf[8*j:], math.Float64bits(a.At(i, j)))
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteNpyComplex writes a to w as a two dimensional complex128 array in the
+// NumPy .npy format with C order.
+func WriteNpyComplex(w io.Writer, a CMatrix) error {
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		return errNpyEmpty
+	}
+	if err := writeNpyHeader(w, "<c16", r, c); err != nil {
+		return err
+	}
+	buf := make([]byte, 16*c)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := a.At(i, j)
+			binary.LittleEndian.PutUint64(buf[16*j:], math.Float64bits(real(v)))
+			binary.LittleEndian.PutUint64(buf[16*j+8:], math.Float64bits(imag(v)))
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NpzWriter writes arrays into a NumPy .npz archive, which is a zip archive
+// holding one .npy file for each array.
+type NpzWriter struct {
+	z *zip.Writer
+}
+
+// NewNpzWriter returns a new NpzWriter writing an archive to w.
+func NewNpzWriter(w io.Writer) *NpzWriter {
+	return &NpzWriter{z: zip.NewWriter(w)}
+}
+
+// Write adds a to the archive as an array with the given name, as written by
+// WriteNpy.
+func (w *NpzWriter) Write(name string, a Matrix) error {
+	f, err := w.z.Create(name + ".npy")
+	if err != nil {
+		return err
+	}
+	return WriteNpy(f, a)
+}
+
+// WriteComplex adds a to the archive as an array with the given name, as
+// written by WriteNpyComplex.
+func (w *NpzWriter) WriteComplex(name string, a CMatrix) error {
+	f, err := w.z.Create(name + ".npy")
+	if err != nil {
+		return err
+	}
+	return WriteNpyComplex(f, a)
+}
+
+// Close finishes writing the archive. It does not close the underlying writer.
+func (w *NpzWriter) Close() error {
+	return w.z.Close()
+}
+
+// NpzReader reads arrays from a NumPy .npz archive. The archive may be
+// compressed, as written by numpy.savez_compressed.
+type NpzReader struct {
+	files map[string]*zip.File
+}
+
+// NewNpzReader returns a new NpzReader reading an archive of the given size
+// from r.
+func NewNpzReader(r io.ReaderAt, size int64) (*NpzReader, error) {
+	z, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]*zip.File)
+	for _, f := range z.File {
+		files[strings.TrimSuffix(f.Name, ".npy")] = f
+	}
+	return &NpzReader{files: files}, nil
+}
+
+// Names returns the sorted names of the arrays in the archive.
+func (r *NpzReader) Names() []string {
+	names := make([]string, 0, len(r.files))
+	for name := range r.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Open returns a reader of the .npy file holding the array with the given
+// name. The array can be read using ReadNpy or one of its variants. The
+// returned reader must be closed after use.
+func (r *NpzReader) Open(name string) (io.ReadCloser, error) {
+	f, ok := r.files[name]
+	if !ok {
+		return nil, fmt.Errorf("mat: no array %q in npz archive", name)
+	}
+	return f.Open()
+}
@@ -0,0 +1,435 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+// npyBytes returns a .npy file with the given header dictionary, padded as
+// numpy.save does, followed by data.
+func npyBytes(dict string, data []byte) []byte {
+	pre := len(npyMagic) + 4
+	total := pre + len(dict) + 1
+	total += (npyAlign - total%npyAlign) % npyAlign
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(total-pre))
+	buf.WriteString(dict)
+	buf.WriteString(strings.Repeat(" ", total-pre-len(dict)-1))
+	buf.WriteByte('\n')
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+// npyData returns the elements of v encoded with the given byte order as
+// float64 values, or as float32 values if single is true.
+func npyData(order binary.ByteOrder, single bool, v ...float64) []byte {
+	var buf bytes.Buffer
+	for _, f := range v {
+		if single {
+			binary.Write(&buf, order, float32(f))
+		} else {
+			binary.Write(&buf, order, f)
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestReadNpy(t *testing.T) {
+	t.Parallel()
+	want := NewDense(2, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+	})
+	for _, test := range []struct {
+		name  string
+		input []byte
+		want  *Dense
+	}{
+		{
+			name:  "c order",
+			input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }", npyData(binary.LittleEndian, false, 1, 2, 3, 4, 5, 6)),
+			want:  want,
+		},
+		{
+			name:  "fortran order",
+			input: npyBytes("{'descr': '<f8', 'fortran_order': True, 'shape': (2, 3), }", npyData(binary.LittleEndian, false, 1, 4, 2, 5, 3, 6)),
+			want:  want,
+		},
+		{
+			name:  "big endian",
+			input: npyBytes("{'descr': '>f8', 'fortran_order': False, 'shape': (2, 3), }", npyData(binary.BigEndian, false, 1, 2, 3, 4, 5, 6)),
+			want:  want,
+		},
+		{
+			name:  "float32",
+			input: npyBytes("{'descr': '<f4', 'fortran_order': True, 'shape': (2, 3), }", npyData(binary.LittleEndian, true, 1, 4, 2, 5, 3, 6)),
+			want:  want,
+		},
+		{
+			name:  "one dimensional",
+			input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }", npyData(binary.LittleEndian, false, 1, 2, 3)),
+			want:  NewDense(3, 1, []float64{1, 2, 3}),
+		},
+		{
+			name:  "reordered keys",
+			input: npyBytes(`{"shape": (1L, 2L), "fortran_order": False, "descr": "=f8"}`, npyData(binary.LittleEndian, false, -1, math.Inf(1))),
+			want:  NewDense(1, 2, []float64{-1, math.Inf(1)}),
+		},
+	} {
+		got, err := ReadNpy(bytes.NewReader(test.input))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !Equal(got, test.want) {
+			t.Errorf("%s: unexpected result:\ngot:\n%v\nwant:\n%v", test.name, Formatted(got), Formatted(test.want))
+		}
+	}
+}
+
+func TestReadNpyChunks(t *testing.T) {
+	t.Parallel()
+	// The data are read in chunks of npyChunk elements, so use arrays
+	// that span several chunks with a partial last one.
+	const r, c = 70, 100
+	want := NewDense(r, c, nil)
+	colMajor := make([]float64, 0, r*c)
+	for j := 0; j < c; j++ {
+		for i := 0; i < r; i++ {
+			want.Set(i, j, float64(i*c+j))
+			colMajor = append(colMajor, float64(i*c+j))
+		}
+	}
+	for _, test := range []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "C order",
+			input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (70, 100), }", npyData(binary.LittleEndian, false, want.RawMatrix().Data...)),
+		},
+		{
+			name:  "fortran order",
+			input: npyBytes("{'descr': '>f4', 'fortran_order': True, 'shape': (70, 100), }", npyData(binary.BigEndian, true, colMajor...)),
+		},
+	} {
+		got, err := ReadNpy(bytes.NewReader(test.input))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !Equal(got, want) {
+			t.Errorf("%s: unexpected result", test.name)
+		}
+		cgot, err := ReadNpyComplex(bytes.NewReader(test.input))
+		if err != nil {
+			t.Errorf("%s: unexpected error reading as complex: %v", test.name, err)
+			continue
+		}
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				if cgot.At(i, j) != complex(want.At(i, j), 0) {
+					t.Fatalf("%s: unexpected complex result at (%d, %d)", test.name, i, j)
+				}
+			}
+		}
+	}
+
+	// Truncated data in the last chunk.
+	input := npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (70, 100), }", npyData(binary.LittleEndian, false, want.RawMatrix().Data[:r*c-1]...))
+	if _, err := ReadNpy(bytes.NewReader(input)); err != io.ErrUnexpectedEOF {
+		t.Errorf("unexpected error for truncated data: got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestReadNpyError(t *testing.T) {
+	t.Parallel()
+	data := npyData(binary.LittleEndian, false, 1, 2, 3, 4)
+	for _, test := range []struct {
+		name  string
+		input []byte
+	}{
+		{name: "empty input", input: nil},
+		{name: "bad magic", input: []byte("\x93NUMPZ\x01\x00\x00\x00")},
+		{name: "bad version", input: []byte("\x93NUMPY\x04\x00\x00\x00")},
+		{name: "short header", input: []byte("\x93NUMPY\x01\x00\x40\x00{}")},
+		{name: "not a dict", input: npyBytes("'descr': '<f8'", data)},
+		{name: "missing key", input: npyBytes("{'descr': '<f8', 'shape': (2, 2), }", data)},
+		{name: "unknown key", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 2), 'x': 1}", data)},
+		{name: "unquoted key", input: npyBytes("{descr: '<f8', 'fortran_order': False, 'shape': (2, 2), }", data)},
+		{name: "missing colon", input: npyBytes("{'descr' '<f8', 'fortran_order': False, 'shape': (2, 2), }", data)},
+		{name: "unterminated string", input: npyBytes("{'descr': '<f8, 'fortran_order': False, 'shape': (2, 2), }", data)},
+		{name: "bad order", input: npyBytes("{'descr': '<f8', 'fortran_order': 0, 'shape': (2, 2), }", data)},
+		{name: "bad shape", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': [2, 2], }", data)},
+		{name: "unterminated shape", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 2, }", data)},
+		{name: "negative shape", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (-2, 2), }", data)},
+		{name: "integer type", input: npyBytes("{'descr': '<i8', 'fortran_order': False, 'shape': (2, 2), }", data)},
+		{name: "byte order", input: npyBytes("{'descr': '|f8', 'fortran_order': False, 'shape': (2, 2), }", data)},
+		{name: "short type", input: npyBytes("{'descr': 'f8', 'fortran_order': False, 'shape': (2, 2), }", data)},
+		{name: "three dimensional", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (1, 2, 2), }", data)},
+		{name: "zero dimensional", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (), }", data)},
+		{name: "zero length", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (0, 2), }", data)},
+		{name: "short data", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (3, 2), }", data)},
+		{name: "no data", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (3, 2), }", nil)},
+		{name: "complex", input: npyBytes("{'descr': '<c16', 'fortran_order': False, 'shape': (1, 2), }", data)},
+		// The header shape is not trusted. These would need terabytes of
+		// memory if the matrix was allocated before reading the data.
+		{name: "huge shape", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (1099511627776, 1), }", data)},
+		{name: "huge fortran shape", input: npyBytes("{'descr': '<f4', 'fortran_order': True, 'shape': (1, 1099511627776), }", data)},
+		{name: "huge vector", input: npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (1099511627776,), }", nil)},
+	} {
+		_, err := ReadNpy(bytes.NewReader(test.input))
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+		if test.name == "complex" {
+			continue
+		}
+		_, err = ReadNpyComplex(bytes.NewReader(test.input))
+		if err == nil {
+			t.Errorf("%s: expected error from ReadNpyComplex", test.name)
+		}
+	}
+}
+
+func TestReadNpyVariants(t *testing.T) {
+	t.Parallel()
+	header := "{'descr': '<f8', 'fortran_order': False, 'shape': (3, 3), }"
+	sym := npyBytes(header, npyData(binary.LittleEndian, false, 1, 2, 3, 2, 4, 5, 3, 5, 6))
+	upper := npyBytes(header, npyData(binary.LittleEndian, false, 1, 2, 3, 0, 4, 5, 0, 0, 6))
+	row := npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (1, 3), }", npyData(binary.LittleEndian, false, 1, 2, 3))
+	vec := npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }", npyData(binary.LittleEndian, false, 1, 2, 3))
+	rect := npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }", npyData(binary.LittleEndian, false, 1, 2, 3, 4, 5, 6))
+
+	s, err := ReadNpySym(bytes.NewReader(sym))
+	if err != nil {
+		t.Errorf("unexpected error reading symmetric matrix: %v", err)
+	} else if !Equal(s, NewDense(3, 3, []float64{1, 2, 3, 2, 4, 5, 3, 5, 6})) {
+		t.Errorf("unexpected symmetric matrix")
+	}
+	for _, input := range [][]byte{upper, rect, vec, nil} {
+		if _, err := ReadNpySym(bytes.NewReader(input)); err == nil {
+			t.Errorf("expected error reading symmetric matrix")
+		}
+	}
+
+	tri, err := ReadNpyTri(bytes.NewReader(upper), Upper)
+	if err != nil {
+		t.Errorf("unexpected error reading triangular matrix: %v", err)
+	} else if kind := tri.triKind(); kind != Upper || !Equal(tri, NewTriDense(3, Upper, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6})) {
+		t.Errorf("unexpected triangular matrix")
+	}
+	for _, input := range [][]byte{sym, rect, vec, nil} {
+		if _, err := ReadNpyTri(bytes.NewReader(input), Upper); err == nil {
+			t.Errorf("expected error reading triangular matrix")
+		}
+	}
+	if _, err := ReadNpyTri(bytes.NewReader(upper), Lower); err == nil {
+		t.Errorf("expected error reading upper triangular matrix as lower")
+	}
+
+	for _, input := range [][]byte{row, vec} {
+		v, err := ReadNpyVec(bytes.NewReader(input))
+		if err != nil {
+			t.Errorf("unexpected error reading vector: %v", err)
+		} else if !Equal(v, NewVecDense(3, []float64{1, 2, 3})) {
+			t.Errorf("unexpected vector")
+		}
+	}
+	for _, input := range [][]byte{rect, nil} {
+		if _, err := ReadNpyVec(bytes.NewReader(input)); err == nil {
+			t.Errorf("expected error reading vector")
+		}
+	}
+}
+
+func TestReadNpyComplex(t *testing.T) {
+	t.Parallel()
+	want := NewCDense(2, 2, []complex128{1 + 2i, 3, -4i, 5 - 6i})
+	for _, test := range []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "complex128",
+			input: npyBytes("{'descr': '<c16', 'fortran_order': False, 'shape': (2, 2), }", npyData(binary.LittleEndian, false, 1, 2, 3, 0, 0, -4, 5, -6)),
+		},
+		{
+			name:  "complex64 fortran order",
+			input: npyBytes("{'descr': '>c8', 'fortran_order': True, 'shape': (2, 2), }", npyData(binary.BigEndian, true, 1, 2, 0, -4, 3, 0, 5, -6)),
+		},
+	} {
+		got, err := ReadNpyComplex(bytes.NewReader(test.input))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !CEqual(got, want) {
+			t.Errorf("%s: unexpected result", test.name)
+		}
+	}
+
+	real := npyBytes("{'descr': '<f4', 'fortran_order': False, 'shape': (2,), }", npyData(binary.LittleEndian, true, 1, 2))
+	got, err := ReadNpyComplex(bytes.NewReader(real))
+	if err != nil {
+		t.Errorf("unexpected error reading real array: %v", err)
+	} else if !CEqual(got, NewCDense(2, 1, []complex128{1, 2})) {
+		t.Errorf("unexpected result for real array")
+	}
+	if _, err := ReadNpyComplex(bytes.NewReader(nil)); err == nil {
+		t.Errorf("expected error for empty input")
+	}
+}
+
+func TestWriteNpy(t *testing.T) {
+	t.Parallel()
+	// The header must match the one written by numpy.save exactly.
+	var buf bytes.Buffer
+	a := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	if err := WriteNpy(&buf, a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }", npyData(binary.LittleEndian, false, 1, 2, 3, 4, 5, 6))
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("unexpected output:\ngot: %q\nwant:%q", buf.Bytes(), want)
+	}
+	if len(want)%npyAlign != 6*8 {
+		t.Errorf("data not aligned")
+	}
+
+	buf.Reset()
+	if err := WriteNpy(&buf, NewVecDense(3, []float64{1, 2, 3})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = npyBytes("{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }", npyData(binary.LittleEndian, false, 1, 2, 3))
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("unexpected output for vector:\ngot: %q\nwant:%q", buf.Bytes(), want)
+	}
+
+	buf.Reset()
+	if err := WriteNpyComplex(&buf, NewCDense(1, 2, []complex128{1 + 2i, -3i})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = npyBytes("{'descr': '<c16', 'fortran_order': False, 'shape': (1, 2), }", npyData(binary.LittleEndian, false, 1, 2, 0, -3))
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("unexpected complex output:\ngot: %q\nwant:%q", buf.Bytes(), want)
+	}
+
+	if err := WriteNpy(&buf, &Dense{}); err == nil {
+		t.Errorf("expected error for empty matrix")
+	}
+	if err := WriteNpyComplex(&buf, &CDense{}); err == nil {
+		t.Errorf("expected error for empty matrix")
+	}
+}
+
+func TestNpyRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, a := range []Matrix{
+		NewDense(3, 2, []float64{1, -2, math.Pi, 4e300, math.NaN(), math.Inf(-1)}),
+		NewDense(2, 3, []float64{1, -2, math.Pi, 4e300, 5, 6}).T(),
+		NewSymDense(2, []float64{1, 2, 2, 3}),
+		NewTriDense(2, Lower, []float64{1, 0, 2, 3}),
+		NewVecDense(2, []float64{1, 2}),
+		NewDiagDense(2, []float64{1, 2}),
+	} {
+		var buf bytes.Buffer
+		if err := WriteNpy(&buf, a); err != nil {
+			t.Errorf("unexpected error writing: %v", err)
+			continue
+		}
+		got, err := ReadNpy(&buf)
+		if err != nil {
+			t.Errorf("unexpected error reading: %v", err)
+			continue
+		}
+		r, c := a.Dims()
+		if gr, gc := got.Dims(); gr != r || gc != c {
+			t.Errorf("unexpected dimensions: got %d×%d, want %d×%d", gr, gc, r, c)
+			continue
+		}
+		same := true
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				if math.Float64bits(got.At(i, j)) != math.Float64bits(a.At(i, j)) {
+					same = false
+				}
+			}
+		}
+		if !same {
+			t.Errorf("round trip does not match:\ngot:\n%v\nwant:\n%v", Formatted(got), Formatted(a))
+		}
+	}
+}
+
+func TestNpz(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 2, []float64{1, 2, 3, 4})
+	v := NewVecDense(3, []float64{5, 6, 7})
+	c := NewCDense(1, 1, []complex128{8 + 9i})
+
+	var buf bytes.Buffer
+	w := NewNpzWriter(&buf)
+	if err := w.Write("a", a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Write("v", v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.WriteComplex("c", c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r, err := NewNpzReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := r.Names(), []string{"a", "c", "v"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("unexpected names: got %v, want %v", got, want)
+	}
+	read := func(name string) io.ReadCloser {
+		f, err := r.Open(name)
+		if err != nil {
+			t.Fatalf("unexpected error opening %q: %v", name, err)
+		}
+		return f
+	}
+	f := read("a")
+	gotA, err := ReadNpy(f)
+	f.Close()
+	if err != nil || !Equal(gotA, a) {
+		t.Errorf("unexpected result for a: %v", err)
+	}
+	f = read("v")
+	gotV, err := ReadNpyVec(f)
+	f.Close()
+	if err != nil || !Equal(gotV, v) {
+		t.Errorf("unexpected result for v: %v", err)
+	}
+	f = read("c")
+	gotC, err := ReadNpyComplex(f)
+	f.Close()
+	if err != nil || !CEqual(gotC, c) {
+		t.Errorf("unexpected result for c: %v", err)
+	}
+
+	if _, err := r.Open("missing"); err == nil {
+		t.Errorf("expected error for missing array")
+	}
+	if _, err := NewNpzReader(bytes.NewReader([]byte("not a zip")), 9); err == nil {
+		t.Errorf("expected error for invalid archive")
+	}
+}
@@ -21,6 +21,12 @@ type QR struct {
 	q    *Dense
 	tau  []float64
 	cond float64
+
+	// explicit indicates that the factorization has been
+	// updated and is held as the explicit orthonormal Q in q
+	// and the upper trapezoidal R in qr. Otherwise Q is held as
+	// elementary reflectors in qr and tau.
+	explicit bool
 }
 
 // Dims returns the dimensions of the matrix.
@@ -135,6 +141,7 @@ func (qr *QR) factorize(a Matrix, norm lapack.MatrixNorm) {
 	work = getFloat64s(int(work[0]), false)
 	lapack64.Geqrf(qr.qr.mat, qr.tau, work, len(work))
 	putFloat64s(work)
+	qr.explicit = false
 	qr.updateCond(norm)
 	if qr.q != nil {
 		qr.q.Reset()
@@ -157,6 +164,28 @@ func (qr *QR) updateQ() {
 	putFloat64s(work)
 }
 
+// mulQ computes b = Q * b if trans is blas.NoTrans and b = Qᵀ * b otherwise,
+// where b has as many rows as the factorized matrix.
+func (qr *QR) mulQ(trans blas.Transpose, b *Dense) {
+	if qr.explicit {
+		r, c := b.Dims()
+		w := getDenseWorkspace(r, c, false)
+		if trans == blas.NoTrans {
+			w.Mul(qr.q, b)
+		} else {
+			w.Mul(qr.q.T(), b)
+		}
+		b.Copy(w)
+		putDenseWorkspace(w)
+		return
+	}
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, trans, qr.qr.mat, qr.tau, b.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, trans, qr.qr.mat, qr.tau, b.mat, work, len(work))
+	putFloat64s(work)
+}
+
 // isValid returns whether the receiver contains a factorization.
 func (qr *QR) isValid() bool {
 	return qr.qr != nil && !qr.qr.IsEmpty()
@@ -287,17 +316,9 @@ func (qr *QR) SolveTo(dst *Dense, trans bool, b Matrix) error {
 		for i := c; i < r; i++ {
 			zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
 		}
-		work := []float64{0}
-		lapack64.Ormqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, -1)
-		work = getFloat64s(int(work[0]), false)
-		lapack64.Ormqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, len(work))
-		putFloat64s(work)
+		qr.mulQ(blas.NoTrans, w)
 	} else {
-		work := []float64{0}
-		lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.mat, work, -1)
-		work = getFloat64s(int(work[0]), false)
-		lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.mat, work, len(work))
-		putFloat64s(work)
+		qr.mulQ(blas.Trans, w)
 
 		ok := lapack64.Trtrs(blas.NoTrans, t, w.mat)
 		if !ok {
@@ -347,3 +368,322 @@ func (qr *QR) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
 	}
 	return qr.SolveTo(dst.asDense(), trans, bm)
 }
+
+// RankOne updates a QR factorization as if a rank-one update had been applied to
+// the original matrix A, storing the result into the receiver. That is, if in
+// the original QR decomposition Q * R = A, in the updated decomposition
+//
+//	Q' * R' = A + alpha * x * yᵀ.
+//
+// The length of x must equal the number of rows and the length of y must equal
+// the number of columns of A, otherwise RankOne will panic. RankOne will also
+// panic if orig does not contain a factorization.
+//
+// RankOne updates the factorization in O(m²) time using Givens rotations,
+// provided that the factorization has already been updated once. Otherwise the
+// orthonormal matrix Q is constructed explicitly at a cost of O(m²n).
+func (qr *QR) RankOne(orig *QR, alpha float64, x, y Vector) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if x.Len() != m || y.Len() != n {
+		panic(ErrShape)
+	}
+	qr.setExplicitFrom(orig)
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// Compute w = Qᵀ * x.
+	w := getFloat64s(m, false)
+	defer putFloat64s(w)
+	xs := getFloat64s(m, false)
+	defer putFloat64s(xs)
+	for i := range xs {
+		xs[i] = x.AtVec(i)
+	}
+	q := qr.q.mat
+	blas64.Gemv(blas.Trans, 1, q, blas64.Vector{N: m, Inc: 1, Data: xs}, 0, blas64.Vector{N: m, Inc: 1, Data: w})
+
+	// Reduce w to a multiple of e_0 which turns R into upper Hessenberg form.
+	r := qr.qr.mat
+	for k := m - 2; k >= 0; k-- {
+		var c, s float64
+		c, s, w[k], _ = blas64.Rotg(w[k], w[k+1])
+		w[k+1] = 0
+		qr.rotate(k, k, c, s)
+	}
+
+	// Add the update to the first row of R.
+	for j := 0; j < n; j++ {
+		r.Data[j] += alpha * w[0] * y.AtVec(j)
+	}
+
+	// Restore the upper triangular form of R.
+	qr.retriangularize(0)
+	qr.updateCond(CondNorm)
+}
+
+// InsertCol computes the QR factorization of the m×(n+1) matrix obtained by
+// inserting the vector v as the column j into the original m×n matrix A whose
+// QR factorization is in orig, and stores the result into the receiver. The
+// columns of A with index j and larger are moved one to the right.
+//
+// InsertCol will panic if j is not between 0 and n, if the length of v is not
+// m, if m < n+1, or if orig does not contain a factorization.
+//
+// InsertCol updates the factorization in O(m²) time using Givens rotations,
+// provided that the factorization has already been updated once. Otherwise the
+// orthonormal matrix Q is constructed explicitly at a cost of O(m²n).
+func (qr *QR) InsertCol(orig *QR, j int, v Vector) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if j < 0 || n < j {
+		panic(ErrColAccess)
+	}
+	if v.Len() != m || m < n+1 {
+		panic(ErrShape)
+	}
+	qr.setExplicitFrom(orig)
+
+	// Compute w = Qᵀ * v.
+	w := getFloat64s(m, false)
+	defer putFloat64s(w)
+	vs := getFloat64s(m, false)
+	defer putFloat64s(vs)
+	for i := range vs {
+		vs[i] = v.AtVec(i)
+	}
+	blas64.Gemv(blas.Trans, 1, qr.q.mat, blas64.Vector{N: m, Inc: 1, Data: vs}, 0, blas64.Vector{N: m, Inc: 1, Data: w})
+
+	// Insert w as the column j of R.
+	r := NewDense(m, n+1, nil)
+	for i := 0; i < m; i++ {
+		row := qr.qr.RawRowView(i)
+		copy(r.mat.Data[i*r.mat.Stride:i*r.mat.Stride+j], row[:j])
+		r.mat.Data[i*r.mat.Stride+j] = w[i]
+		copy(r.mat.Data[i*r.mat.Stride+j+1:i*r.mat.Stride+n+1], row[j:])
+	}
+	qr.qr = r
+
+	// Zero the column j below the diagonal from the bottom up. This
+	// preserves the upper triangular form of the other columns because
+	// the rows below j are shifted one column to the right.
+	for k := m - 2; k >= j; k-- {
+		c, s, _, _ := blas64.Rotg(r.mat.Data[k*r.mat.Stride+j], r.mat.Data[(k+1)*r.mat.Stride+j])
+		qr.rotate(k, j, c, s)
+		r.mat.Data[(k+1)*r.mat.Stride+j] = 0
+	}
+	qr.updateCond(CondNorm)
+}
+
+// DeleteCol computes the QR factorization of the m×(n-1) matrix obtained by
+// deleting the column j from the original m×n matrix A whose QR factorization
+// is in orig, and stores the result into the receiver. The columns of A with
+// index larger than j are moved one to the left.
+//
+// DeleteCol will panic if j is not between 0 and n-1, if n == 1, or if orig
+// does not contain a factorization.
+//
+// DeleteCol updates the factorization in O(m²) time using Givens rotations,
+// provided that the factorization has already been updated once. Otherwise the
+// orthonormal matrix Q is constructed explicitly at a cost of O(m²n).
+func (qr *QR) DeleteCol(orig *QR, j int) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if j < 0 || n <= j {
+		panic(ErrColAccess)
+	}
+	if n == 1 {
+		panic(ErrShape)
+	}
+	qr.setExplicitFrom(orig)
+
+	// Delete the column j of R which leaves the columns from j onwards
+	// in upper Hessenberg form.
+	r := NewDense(m, n-1, nil)
+	for i := 0; i < m; i++ {
+		row := qr.qr.RawRowView(i)
+		copy(r.mat.Data[i*r.mat.Stride:i*r.mat.Stride+j], row[:j])
+		copy(r.mat.Data[i*r.mat.Stride+j:i*r.mat.Stride+n-1], row[j+1:])
+	}
+	qr.qr = r
+
+	qr.retriangularize(j)
+	qr.updateCond(CondNorm)
+}
+
+// InsertRow computes the QR factorization of the (m+1)×n matrix obtained by
+// inserting the vector v as the row i into the original m×n matrix A whose
+// QR factorization is in orig, and stores the result into the receiver. The
+// rows of A with index i and larger are moved one down.
+//
+// InsertRow will panic if i is not between 0 and m, if the length of v is not
+// n, or if orig does not contain a factorization.
+//
+// InsertRow updates the factorization in O(m²) time using Givens rotations,
+// provided that the factorization has already been updated once. Otherwise the
+// orthonormal matrix Q is constructed explicitly at a cost of O(m²n).
+func (qr *QR) InsertRow(orig *QR, i int, v Vector) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if i < 0 || m < i {
+		panic(ErrRowAccess)
+	}
+	if v.Len() != n {
+		panic(ErrShape)
+	}
+	qr.setExplicitFrom(orig)
+
+	// With P the permutation that moves the row i to the top,
+	//
+	//	P * [A with v inserted] = [1 0] * [vᵀ]
+	//	                          [0 Q]   [R ]
+	//
+	// where the right factor is upper Hessenberg. The rows of the
+	// left factor are permuted back to obtain Q'.
+	r := NewDense(m+1, n, nil)
+	for j := 0; j < n; j++ {
+		r.mat.Data[j] = v.AtVec(j)
+	}
+	for k := 0; k < m; k++ {
+		copy(r.RawRowView(k+1), qr.qr.RawRowView(k))
+	}
+	qr.qr = r
+
+	q := NewDense(m+1, m+1, nil)
+	for k := 0; k < m; k++ {
+		dst := k
+		if k >= i {
+			dst++
+		}
+		copy(q.RawRowView(dst)[1:], qr.q.RawRowView(k))
+	}
+	q.mat.Data[i*q.mat.Stride] = 1
+	qr.q = q
+
+	qr.retriangularize(0)
+	qr.updateCond(CondNorm)
+}
+
+// DeleteRow computes the QR factorization of the (m-1)×n matrix obtained by
+// deleting the row i from the original m×n matrix A whose QR factorization is
+// in orig, and stores the result into the receiver. The rows of A with index
+// larger than i are moved one up.
+//
+// DeleteRow will panic if i is not between 0 and m-1, if m-1 < n, or if orig
+// does not contain a factorization.
+//
+// DeleteRow updates the factorization in O(m²) time using Givens rotations,
+// provided that the factorization has already been updated once. Otherwise the
+// orthonormal matrix Q is constructed explicitly at a cost of O(m²n).
+func (qr *QR) DeleteRow(orig *QR, i int) {
+	if !orig.isValid() {
+		panic(badQR)
+	}
+	m, n := orig.Dims()
+	if i < 0 || m <= i {
+		panic(ErrRowAccess)
+	}
+	if m-1 < n {
+		panic(ErrShape)
+	}
+	qr.setExplicitFrom(orig)
+
+	// Reduce the row i of Q to a multiple of e_0 which turns R into upper
+	// Hessenberg form. The column 0 of the rotated Q is then ±e_i, so
+	// deleting the row i and the column 0 of Q and the row 0 of R gives the
+	// factorization of A with the row i deleted.
+	q := qr.q.mat
+	for k := m - 2; k >= 0; k-- {
+		c, s, _, _ := blas64.Rotg(q.Data[i*q.Stride+k], q.Data[i*q.Stride+k+1])
+		qr.rotate(k, k, c, s)
+		q.Data[i*q.Stride+k+1] = 0
+	}
+
+	var r Dense
+	r.CloneFrom(qr.qr.Slice(1, m, 0, n))
+	qr.qr = &r
+	qn := NewDense(m-1, m-1, nil)
+	for k := 0; k < m; k++ {
+		switch {
+		case k < i:
+			copy(qn.RawRowView(k), qr.q.RawRowView(k)[1:])
+		case k > i:
+			copy(qn.RawRowView(k-1), qr.q.RawRowView(k)[1:])
+		}
+	}
+	qr.q = qn
+	qr.updateCond(CondNorm)
+}
+
+// setExplicitFrom copies the factorization in orig into the receiver and
+// converts it to the explicit form where Q is stored in q and R in qr.
+func (qr *QR) setExplicitFrom(orig *QR) {
+	if orig != qr {
+		if qr.qr == nil {
+			qr.qr = &Dense{}
+		}
+		qr.qr.CloneFrom(orig.qr)
+		if qr.q == nil {
+			qr.q = &Dense{}
+		}
+		if orig.q != nil && !orig.q.IsEmpty() {
+			qr.q.CloneFrom(orig.q)
+		} else {
+			qr.q.Reset()
+		}
+		qr.tau = append(qr.tau[:0], orig.tau...)
+		qr.explicit = orig.explicit
+	}
+	if qr.explicit {
+		return
+	}
+	if qr.q == nil || qr.q.IsEmpty() {
+		qr.updateQ()
+	}
+	m, n := qr.qr.Dims()
+	for i := 1; i < m; i++ {
+		zero(qr.qr.mat.Data[i*qr.qr.mat.Stride : i*qr.qr.mat.Stride+min(i, n)])
+	}
+	qr.tau = qr.tau[:0]
+	qr.explicit = true
+}
+
+// rotate applies the Givens rotation defined by c and s to the rows k and k+1
+// of R from the column j onwards, and the transposed rotation to the columns k
+// and k+1 of Q, keeping the product Q * R unchanged.
+func (qr *QR) rotate(k, j int, c, s float64) {
+	r := qr.qr.mat
+	if j < r.Cols {
+		blas64.Rot(
+			blas64.Vector{N: r.Cols - j, Inc: 1, Data: r.Data[k*r.Stride+j:]},
+			blas64.Vector{N: r.Cols - j, Inc: 1, Data: r.Data[(k+1)*r.Stride+j:]},
+			c, s,
+		)
+	}
+	q := qr.q.mat
+	blas64.Rot(
+		blas64.Vector{N: q.Rows, Inc: q.Stride, Data: q.Data[k:]},
+		blas64.Vector{N: q.Rows, Inc: q.Stride, Data: q.Data[k+1:]},
+		c, s,
+	)
+}
+
+// retriangularize reduces R, which is upper Hessenberg in the columns from j
+// onwards, to upper triangular form.
+func (qr *QR) retriangularize(j int) {
+	r := qr.qr.mat
+	for k := j; k < min(r.Rows-1, r.Cols); k++ {
+		c, s, _, _ := blas64.Rotg(r.Data[k*r.Stride+k], r.Data[(k+1)*r.Stride+k])
+		qr.rotate(k, k, c, s)
+		r.Data[(k+1)*r.Stride+k] = 0
+	}
+}
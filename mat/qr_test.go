@@ -248,3 +248,189 @@ func TestQRSolveCondTo(t *testing.T) {
 		}
 	}
 }
+
+func TestQRUpdate(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	randVec := func(n int) *VecDense {
+		v := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			v.SetVec(i, rnd.NormFloat64())
+		}
+		return v
+	}
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{2, 1},
+		{4, 4},
+		{7, 3},
+		{12, 8},
+		{30, 30},
+	} {
+		m, n := test.m, test.n
+		a := NewDense(m, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+		}
+		var orig QR
+		orig.Factorize(a)
+
+		// Apply a sequence of updates, alternating between updating
+		// a new receiver and updating in place, and compare with the
+		// factorization of the updated matrix computed from scratch.
+		qr := &orig
+		for step := 0; step < 20; step++ {
+			var dst *QR
+			if step%2 == 0 {
+				dst = &QR{}
+			} else {
+				dst = qr
+			}
+			var op string
+			r, c := a.Dims()
+			switch rnd.IntN(5) {
+			case 0:
+				op = "RankOne"
+				alpha := rnd.NormFloat64()
+				x := randVec(r)
+				y := randVec(c)
+				dst.RankOne(qr, alpha, x, y)
+				a.RankOne(a, alpha, x, y)
+			case 1:
+				if r < c+1 {
+					continue
+				}
+				op = "InsertCol"
+				j := rnd.IntN(c + 1)
+				v := randVec(r)
+				dst.InsertCol(qr, j, v)
+				b := NewDense(r, c+1, nil)
+				for k := 0; k < c+1; k++ {
+					switch {
+					case k < j:
+						b.SetCol(k, Col(nil, k, a))
+					case k == j:
+						b.SetCol(k, v.RawVector().Data)
+					default:
+						b.SetCol(k, Col(nil, k-1, a))
+					}
+				}
+				a = b
+			case 2:
+				if c == 1 {
+					continue
+				}
+				op = "DeleteCol"
+				j := rnd.IntN(c)
+				dst.DeleteCol(qr, j)
+				b := NewDense(r, c-1, nil)
+				for k := 0; k < c-1; k++ {
+					if k < j {
+						b.SetCol(k, Col(nil, k, a))
+					} else {
+						b.SetCol(k, Col(nil, k+1, a))
+					}
+				}
+				a = b
+			case 3:
+				op = "InsertRow"
+				i := rnd.IntN(r + 1)
+				v := randVec(c)
+				dst.InsertRow(qr, i, v)
+				b := NewDense(r+1, c, nil)
+				for k := 0; k < r+1; k++ {
+					switch {
+					case k < i:
+						b.SetRow(k, a.RawRowView(k))
+					case k == i:
+						b.SetRow(k, v.RawVector().Data)
+					default:
+						b.SetRow(k, a.RawRowView(k-1))
+					}
+				}
+				a = b
+			case 4:
+				if r-1 < c {
+					continue
+				}
+				op = "DeleteRow"
+				i := rnd.IntN(r)
+				dst.DeleteRow(qr, i)
+				b := NewDense(r-1, c, nil)
+				for k := 0; k < r-1; k++ {
+					if k < i {
+						b.SetRow(k, a.RawRowView(k))
+					} else {
+						b.SetRow(k, a.RawRowView(k+1))
+					}
+				}
+				a = b
+			}
+			qr = dst
+
+			r, c = a.Dims()
+			if qr.qr.mat.Rows != r || qr.qr.mat.Cols != c {
+				t.Fatalf("m=%d,n=%d,step=%d,%s: unexpected dimensions", m, n, step, op)
+			}
+			var q, rr Dense
+			qr.QTo(&q)
+			qr.RTo(&rr)
+			if !isOrthonormal(&q, tol) {
+				t.Errorf("m=%d,n=%d,step=%d,%s: Q is not orthonormal", m, n, step, op)
+			}
+			for i := 0; i < r; i++ {
+				for j := 0; j < min(i, c); j++ {
+					if rr.At(i, j) != 0 {
+						t.Errorf("m=%d,n=%d,step=%d,%s: R is not upper triangular", m, n, step, op)
+					}
+				}
+			}
+			if !EqualApprox(qr, a, tol*Norm(a, 1)) {
+				t.Errorf("m=%d,n=%d,step=%d,%s: Q*R does not match the updated matrix", m, n, step, op)
+			}
+
+			// R is unique up to the signs of its rows.
+			var fresh QR
+			fresh.Factorize(a)
+			var want Dense
+			fresh.RTo(&want)
+			for i := 0; i < c; i++ {
+				for j := i; j < c; j++ {
+					if math.Abs(math.Abs(rr.At(i, j))-math.Abs(want.At(i, j))) > tol*Norm(a, 1) {
+						t.Errorf("m=%d,n=%d,step=%d,%s: R does not match fresh factorization", m, n, step, op)
+						i, j = c, c
+					}
+				}
+			}
+
+			// Check the solution of the least squares problem.
+			b := NewDense(r, 2, nil)
+			for i := range b.mat.Data {
+				b.mat.Data[i] = rnd.NormFloat64()
+			}
+			var x, wantX Dense
+			err := qr.SolveTo(&x, false, b)
+			errFresh := fresh.SolveTo(&wantX, false, b)
+			if err == nil && errFresh == nil && !EqualApprox(&x, &wantX, 1e-8) {
+				t.Errorf("m=%d,n=%d,step=%d,%s: solution mismatch", m, n, step, op)
+			}
+			bt := NewDense(c, 2, nil)
+			for i := range bt.mat.Data {
+				bt.mat.Data[i] = rnd.NormFloat64()
+			}
+			x.Reset()
+			wantX.Reset()
+			err = qr.SolveTo(&x, true, bt)
+			errFresh = fresh.SolveTo(&wantX, true, bt)
+			if err == nil && errFresh == nil && !EqualApprox(&x, &wantX, 1e-8) {
+				t.Errorf("m=%d,n=%d,step=%d,%s: transposed solution mismatch", m, n, step, op)
+			}
+			if math.Abs(qr.Cond()-fresh.Cond()) > 1e-6*fresh.Cond() {
+				t.Errorf("m=%d,n=%d,step=%d,%s: condition number mismatch", m, n, step, op)
+			}
+		}
+	}
+}
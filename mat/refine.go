@@ -0,0 +1,262 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/internal/lapack32"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badRefineSettings = "mat: negative refinement setting"
+
+const (
+	// defaultRefineIter is the default maximum number of refinement steps.
+	defaultRefineIter = 30
+	// refineStall is the factor by which the backward error must decrease
+	// in each refinement step for the refinement to be considered
+	// progressing.
+	refineStall = 0.5
+	// dlamchE is the machine epsilon of double precision.
+	dlamchE = 0x1p-53
+)
+
+// RefineSettings holds the parameters of the mixed-precision iterative
+// refinement performed by Dense.SolveMixed and VecDense.SolveVecMixed.
+type RefineSettings struct {
+	// MaxIter is the maximum number of refinement steps. If MaxIter is
+	// zero, a default value of 30 is used.
+	MaxIter int
+
+	// Tol is the normwise backward error at which the refinement is
+	// considered to have converged. If Tol is zero, a default value of
+	// sqrt(n)*eps is used, where eps is the machine epsilon of double
+	// precision.
+	Tol float64
+}
+
+// RefineResult describes the outcome of a mixed-precision solve.
+type RefineResult struct {
+	// Iterations is the number of refinement steps that were performed
+	// using the single precision factorization.
+	Iterations int
+
+	// BackwardError is the normwise backward error of the returned
+	// solution,
+	//  max_j |b_j - A*x_j|_∞ / (|A|_∞ * |x_j|_∞ + |b_j|_∞),
+	// where x_j and b_j are the columns of X and B.
+	BackwardError float64
+
+	// Fallback is true if the refinement failed to converge and the
+	// solution was computed using a double precision LU factorization.
+	Fallback bool
+}
+
+// SolveMixed solves the system of linear equations
+//
+//	A * X = B
+//
+// where A is a square n×n matrix and B is an n×k matrix, and stores the
+// solution into the receiver.
+//
+// SolveMixed computes an LU factorization of A in single precision and
+// iteratively refines the solution with residuals computed in double
+// precision. For moderately conditioned matrices this yields a solution with
+// the accuracy of a double precision solve at the cost of a single precision
+// factorization. If A cannot be represented in single precision, if its
+// single precision factorization is singular, or if the refinement does not
+// converge within the given number of steps or stops making progress, the
+// system is solved using a double precision LU factorization of A instead. The
+// returned RefineResult reports which of the two paths was taken and the
+// backward error of the solution.
+//
+// If settings is nil, default values are used. SolveMixed will panic if A is
+// not square, if the number of rows of B does not equal n, or if any of the
+// settings are negative.
+//
+// If the double precision fallback finds A to be singular or near-singular, a
+// Condition error is returned. See the documentation for Condition for more
+// information.
+func (m *Dense) SolveMixed(a, b Matrix, settings *RefineSettings) (RefineResult, error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	maxIter := defaultRefineIter
+	tol := math.Sqrt(float64(n)) * dlamchE
+	if settings != nil {
+		if settings.MaxIter < 0 || settings.Tol < 0 {
+			panic(badRefineSettings)
+		}
+		if settings.MaxIter > 0 {
+			maxIter = settings.MaxIter
+		}
+		if settings.Tol > 0 {
+			tol = settings.Tol
+		}
+	}
+
+	// Copy the inputs so that the receiver may alias a or b.
+	aw := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(aw)
+	aw.Copy(a)
+	bw := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bw)
+	bw.Copy(b)
+	m.reuseAsNonZeroed(n, bc)
+
+	work := getFloat64s(n, false)
+	anorm := lapack64.Lange(lapack.MaxRowSum, aw.mat, work)
+	putFloat64s(work)
+
+	var res RefineResult
+	x := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(x)
+	r := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(r)
+	if refineMixed(&res, x, r, aw, bw, anorm, maxIter, tol) {
+		m.Copy(x)
+		return res, nil
+	}
+
+	res.Fallback = true
+	var lu LU
+	lu.Factorize(aw)
+	err := lu.SolveTo(m, false, bw)
+	if !lu.ok {
+		res.BackwardError = math.Inf(1)
+		return res, err
+	}
+	r.Copy(bw)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, aw.mat, m.mat, 1, r.mat)
+	res.BackwardError = backwardError(anorm, m.mat, r.mat, bw.mat)
+	return res, err
+}
+
+// SolveVecMixed solves the system of linear equations
+//
+//	A * x = b
+//
+// where A is a square n×n matrix, using a single precision LU factorization
+// of A followed by iterative refinement in double precision, and stores the
+// solution into the receiver. See the documentation of Dense.SolveMixed for
+// more information.
+func (v *VecDense) SolveVecMixed(a Matrix, b Vector, settings *RefineSettings) (RefineResult, error) {
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+	_, c := a.Dims()
+	v.reuseAsNonZeroed(c)
+	return v.asDense().SolveMixed(a, b, settings)
+}
+
+// refineMixed solves A * X = B using a single precision LU factorization of a
+// followed by iterative refinement in double precision. The solution is stored
+// into x and r is used as workspace for the residual. refineMixed returns
+// whether the refinement converged and records its progress in res.
+func refineMixed(res *RefineResult, x, r, a, b *Dense, anorm float64, maxIter int, tol float64) bool {
+	n, nrhs := b.Dims()
+
+	lu := make([]float32, n*n)
+	if !toFloat32(lu, n, a.mat) {
+		return false
+	}
+	ipiv := getInts(n, false)
+	defer putInts(ipiv)
+	if !lapack32.Sgetrf(n, lu, n, ipiv) {
+		return false
+	}
+
+	ws := make([]float32, n*nrhs)
+	if !toFloat32(ws, nrhs, b.mat) {
+		return false
+	}
+	lapack32.Sgetrs(blas.NoTrans, n, nrhs, lu, n, ipiv, ws, nrhs)
+	for i := 0; i < n; i++ {
+		row := x.mat.Data[i*x.mat.Stride : i*x.mat.Stride+nrhs]
+		for j := range row {
+			row[j] = float64(ws[i*nrhs+j])
+		}
+	}
+
+	prev := math.Inf(1)
+	for {
+		// Compute the residual R = B - A*X in double precision.
+		r.Copy(b)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, a.mat, x.mat, 1, r.mat)
+		berr := backwardError(anorm, x.mat, r.mat, b.mat)
+		res.BackwardError = berr
+		if berr <= tol {
+			return true
+		}
+		if math.IsNaN(berr) || berr > refineStall*prev || res.Iterations == maxIter {
+			return false
+		}
+		prev = berr
+
+		// Solve for the correction in single precision and update X.
+		if !toFloat32(ws, nrhs, r.mat) {
+			return false
+		}
+		lapack32.Sgetrs(blas.NoTrans, n, nrhs, lu, n, ipiv, ws, nrhs)
+		for i := 0; i < n; i++ {
+			row := x.mat.Data[i*x.mat.Stride : i*x.mat.Stride+nrhs]
+			for j := range row {
+				row[j] += float64(ws[i*nrhs+j])
+			}
+		}
+		res.Iterations++
+	}
+}
+
+// backwardError returns the normwise backward error
+//
+//	max_j |r_j|_∞ / (anorm * |x_j|_∞ + |b_j|_∞)
+//
+// of the solution x with the residual r = b - A*x, where anorm is the infinity
+// norm of A.
+func backwardError(anorm float64, x, r, b blas64.General) float64 {
+	var berr float64
+	for j := 0; j < x.Cols; j++ {
+		var xnorm, rnorm, bnorm float64
+		for i := 0; i < x.Rows; i++ {
+			xnorm = math.Max(xnorm, math.Abs(x.Data[i*x.Stride+j]))
+			rnorm = math.Max(rnorm, math.Abs(r.Data[i*r.Stride+j]))
+			bnorm = math.Max(bnorm, math.Abs(b.Data[i*b.Stride+j]))
+		}
+		if math.IsNaN(rnorm) || math.IsNaN(xnorm) {
+			return math.NaN()
+		}
+		if rnorm == 0 {
+			continue
+		}
+		berr = math.Max(berr, rnorm/(anorm*xnorm+bnorm))
+	}
+	return berr
+}
+
+// toFloat32 converts the elements of src to single precision and stores them
+// into dst with stride ld. It returns false if an element of src overflows
+// in single precision.
+func toFloat32(dst []float32, ld int, src blas64.General) bool {
+	for i := 0; i < src.Rows; i++ {
+		row := src.Data[i*src.Stride : i*src.Stride+src.Cols]
+		for j, v := range row {
+			if math.Abs(v) > math.MaxFloat32 {
+				return false
+			}
+			dst[i*ld+j] = float32(v)
+		}
+	}
+	return true
+}
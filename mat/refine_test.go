@@ -0,0 +1,189 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// conditionedMatrix returns a random n×n matrix whose singular values are
+// spaced geometrically between 1 and 1/cond.
+func conditionedMatrix(n int, cond float64, rnd *rand.Rand) *Dense {
+	u := randomOrthonormal(n, n, rnd)
+	v := randomOrthonormal(n, n, rnd)
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = 1
+		if n > 1 {
+			s[i] = math.Pow(cond, -float64(i)/float64(n-1))
+		}
+	}
+	var a Dense
+	a.Mul(u, NewDiagDense(n, s))
+	a.Mul(&a, v.T())
+	return &a
+}
+
+func TestSolveMixed(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 63, 64, 65, 130} {
+		for _, nrhs := range []int{1, 3} {
+			for _, cond := range []float64{1, 1e4} {
+				name := fmt.Sprintf("n=%d,nrhs=%d,cond=%g", n, nrhs, cond)
+				a := conditionedMatrix(n, cond, rnd)
+				b := NewDense(n, nrhs, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < nrhs; j++ {
+						b.Set(i, j, rnd.NormFloat64())
+					}
+				}
+
+				var x Dense
+				res, err := x.SolveMixed(a, b, nil)
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if res.Fallback {
+					t.Errorf("%s: unexpected fallback to double precision", name)
+				}
+				tol := math.Sqrt(float64(n)) * dlamchE
+				if res.BackwardError > tol {
+					t.Errorf("%s: backward error too large: got %v, want <= %v", name, res.BackwardError, tol)
+				}
+
+				var r Dense
+				r.Mul(a, &x)
+				r.Sub(b, &r)
+				if berr := backwardError(Norm(a, math.Inf(1)), x.mat, r.mat, b.mat); berr > tol {
+					t.Errorf("%s: residual of returned solution too large: %v", name, berr)
+				}
+
+				var want Dense
+				err = want.Solve(a, b)
+				if err != nil {
+					t.Fatalf("%s: unexpected error from Solve: %v", name, err)
+				}
+				if !EqualApprox(&x, &want, 1e-12*cond) {
+					t.Errorf("%s: solution does not match double precision solve", name)
+				}
+
+				// Check that the receiver may alias the right-hand side.
+				bc := DenseCopyOf(b)
+				_, err = bc.SolveMixed(a, bc, nil)
+				if err != nil {
+					t.Errorf("%s: unexpected error when aliased: %v", name, err)
+				}
+				if !Equal(bc, &x) {
+					t.Errorf("%s: aliased solution does not match", name)
+				}
+
+				if nrhs == 1 {
+					var xv VecDense
+					res, err := xv.SolveVecMixed(a, b.ColView(0), nil)
+					if err != nil {
+						t.Errorf("%s: unexpected error from SolveVecMixed: %v", name, err)
+					}
+					if res.Fallback || res.BackwardError > tol {
+						t.Errorf("%s: unexpected SolveVecMixed result %+v", name, res)
+					}
+					if !Equal(&xv, x.ColView(0)) {
+						t.Errorf("%s: SolveVecMixed solution does not match SolveMixed", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSolveMixedFallback(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const n = 40
+	b := NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		b.Set(i, 0, rnd.NormFloat64())
+		b.Set(i, 1, rnd.NormFloat64())
+	}
+
+	for _, test := range []struct {
+		name string
+		a    *Dense
+	}{
+		{name: "ill-conditioned", a: conditionedMatrix(n, 1e11, rnd)},
+		{name: "overflow", a: func() *Dense {
+			a := conditionedMatrix(n, 10, rnd)
+			a.Scale(1e40, a)
+			return a
+		}()},
+	} {
+		var x Dense
+		res, err := x.SolveMixed(test.a, b, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !res.Fallback {
+			t.Errorf("%s: expected fallback to double precision", test.name)
+		}
+		var want Dense
+		want.Solve(test.a, b)
+		if !Equal(&x, &want) {
+			t.Errorf("%s: fallback solution does not match double precision solve", test.name)
+		}
+		if res.BackwardError > 1e-14 {
+			t.Errorf("%s: backward error too large: %v", test.name, res.BackwardError)
+		}
+	}
+
+	// Limiting the number of steps forces the fallback for a matrix that
+	// would otherwise converge.
+	a := conditionedMatrix(n, 1e5, rnd)
+	var x Dense
+	res, _ := x.SolveMixed(a, b, &RefineSettings{MaxIter: 1})
+	if !res.Fallback || res.Iterations != 1 {
+		t.Errorf("unexpected result with one refinement step: %+v", res)
+	}
+	// A loose tolerance accepts the single precision solution.
+	res, _ = x.SolveMixed(a, b, &RefineSettings{Tol: 1e-3})
+	if res.Fallback || res.Iterations != 0 {
+		t.Errorf("unexpected result with loose tolerance: %+v", res)
+	}
+
+	// A singular matrix is reported by the fallback.
+	s := conditionedMatrix(n, 10, rnd)
+	for i := 0; i < n; i++ {
+		s.Set(i, 3, 0)
+	}
+	res, err := x.SolveMixed(s, b, nil)
+	if !res.Fallback {
+		t.Errorf("singular: expected fallback to double precision")
+	}
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("singular: expected Condition error, got %v", err)
+	}
+}
+
+func TestSolveMixedPanics(t *testing.T) {
+	t.Parallel()
+	var x Dense
+	for _, test := range []struct {
+		name     string
+		a, b     Matrix
+		settings *RefineSettings
+	}{
+		{name: "non-square", a: NewDense(3, 2, nil), b: NewDense(3, 1, nil)},
+		{name: "shape", a: eye(3), b: NewDense(2, 1, nil)},
+		{name: "iterations", a: eye(3), b: NewDense(3, 1, nil), settings: &RefineSettings{MaxIter: -1}},
+		{name: "tolerance", a: eye(3), b: NewDense(3, 1, nil), settings: &RefineSettings{Tol: -1}},
+	} {
+		if panicked, _ := panics(func() { x.SolveMixed(test.a, test.b, test.settings) }); !panicked {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
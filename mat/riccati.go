@@ -0,0 +1,177 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+)
+
+// SolveContinuousRiccati solves the continuous-time algebraic Riccati equation
+//
+//	Aᵀ * X + X * A - X * B * R⁻¹ * Bᵀ * X + Q = 0
+//
+// for the stabilizing symmetric solution X, placing the result in the
+// receiver. The solution is stabilizing if all eigenvalues of A - B*R⁻¹*Bᵀ*X
+// have negative real part. A must be an n×n matrix, B an n×m matrix, Q an n×n
+// symmetric matrix and R an m×m symmetric positive definite matrix, otherwise
+// SolveContinuousRiccati will panic.
+//
+// The solution is computed by the Schur method from the stable invariant
+// subspace of the 2n×2n Hamiltonian matrix
+//
+//	[ A  -B*R⁻¹*Bᵀ ]
+//	[ -Q     -Aᵀ   ].
+//
+// If R is not positive definite, ErrNotPSD is returned. If the Hamiltonian
+// matrix has eigenvalues on the imaginary axis, no stabilizing solution exists
+// and ErrNoStabilizing is returned. If the problem is ill-conditioned, a
+// Condition error is returned and the solution stored in the receiver may be
+// inaccurate.
+func (s *SymDense) SolveContinuousRiccati(a, b Matrix, q, r Symmetric) error {
+	n, _ := checkRiccatiDims(a, b, q, r)
+
+	g, err := riccatiG(b, r)
+	if err != nil {
+		return err
+	}
+
+	h := NewDense(2*n, 2*n, nil)
+	h.Slice(0, n, 0, n).(*Dense).Copy(a)
+	h.Slice(0, n, n, 2*n).(*Dense).Scale(-1, g)
+	h.Slice(n, 2*n, 0, n).(*Dense).Scale(-1, q)
+	h.Slice(n, 2*n, n, 2*n).(*Dense).Scale(-1, a.T())
+
+	return s.riccatiFromSubspace(h, n, func(v complex128) bool {
+		return real(v) < 0
+	})
+}
+
+// SolveDiscreteRiccati solves the discrete-time algebraic Riccati equation
+//
+//	Aᵀ * X * A - X - Aᵀ * X * B * (R + Bᵀ * X * B)⁻¹ * Bᵀ * X * A + Q = 0
+//
+// for the stabilizing symmetric solution X, placing the result in the
+// receiver. The solution is stabilizing if all eigenvalues of
+// A - B*(R + Bᵀ*X*B)⁻¹*Bᵀ*X*A lie inside the unit circle. A must be an n×n
+// nonsingular matrix, B an n×m matrix, Q an n×n symmetric matrix and R an m×m
+// symmetric positive definite matrix, otherwise SolveDiscreteRiccati will
+// panic.
+//
+// The solution is computed by the Schur method from the stable invariant
+// subspace of the 2n×2n symplectic matrix
+//
+//	[ A + G*A⁻ᵀ*Q  -G*A⁻ᵀ ]
+//	[   -A⁻ᵀ*Q      A⁻ᵀ   ]
+//
+// where G = B*R⁻¹*Bᵀ.
+//
+// If A is singular or near singular, a Condition error is returned and the
+// receiver is not modified. If R is not positive definite, ErrNotPSD is
+// returned. If the symplectic matrix has eigenvalues on the unit circle, no
+// stabilizing solution exists and ErrNoStabilizing is returned. If the problem
+// is otherwise ill-conditioned, a Condition error is returned and the solution
+// stored in the receiver may be inaccurate.
+func (s *SymDense) SolveDiscreteRiccati(a, b Matrix, q, r Symmetric) error {
+	n, _ := checkRiccatiDims(a, b, q, r)
+
+	g, err := riccatiG(b, r)
+	if err != nil {
+		return err
+	}
+
+	// Compute A⁻ᵀ.
+	var ait Dense
+	err = ait.Inverse(a.T())
+	if err != nil {
+		return err
+	}
+
+	h := NewDense(2*n, 2*n, nil)
+	var gait, aitq Dense
+	gait.Mul(g, &ait)
+	aitq.Mul(&ait, q)
+	h11 := h.Slice(0, n, 0, n).(*Dense)
+	h11.Mul(g, &aitq)
+	h11.Add(h11, a)
+	h.Slice(0, n, n, 2*n).(*Dense).Scale(-1, &gait)
+	h.Slice(n, 2*n, 0, n).(*Dense).Scale(-1, &aitq)
+	h.Slice(n, 2*n, n, 2*n).(*Dense).Copy(&ait)
+
+	return s.riccatiFromSubspace(h, n, func(v complex128) bool {
+		return math.Hypot(real(v), imag(v)) < 1
+	})
+}
+
+// checkRiccatiDims checks the dimensions of the parameters of an algebraic
+// Riccati equation and returns the number of states n and inputs m.
+func checkRiccatiDims(a, b Matrix, q, r Symmetric) (n, m int) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	br, m := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	if q.SymmetricDim() != n || r.SymmetricDim() != m {
+		panic(ErrShape)
+	}
+	return n, m
+}
+
+// riccatiG returns B * R⁻¹ * Bᵀ.
+func riccatiG(b Matrix, r Symmetric) (*SymDense, error) {
+	var chol Cholesky
+	if !chol.Factorize(r) {
+		return nil, ErrNotPSD
+	}
+	// With R = Uᵀ*U, B*R⁻¹*Bᵀ = (B*U⁻¹) * (B*U⁻¹)ᵀ.
+	var u TriDense
+	chol.UTo(&u)
+	var bu Dense
+	err := bu.Solve(u.T(), b.T())
+	if err != nil {
+		return nil, err
+	}
+	var g SymDense
+	g.SymOuterK(1, bu.T())
+	return &g, nil
+}
+
+// riccatiFromSubspace computes the solution of an algebraic Riccati equation
+// from the invariant subspace of the 2n×2n matrix h corresponding to the
+// eigenvalues for which stable returns true.
+func (s *SymDense) riccatiFromSubspace(h *Dense, n int, stable func(complex128) bool) error {
+	var schur Schur
+	if !schur.Factorize(h) {
+		return ErrFailedEigen
+	}
+	values := schur.Values(nil)
+	selected := make([]bool, len(values))
+	for i, v := range values {
+		selected[i] = stable(v)
+	}
+	m, ok := schur.Reorder(selected)
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	if m != n {
+		return ErrNoStabilizing
+	}
+
+	// The columns of [U11; U21] span the stable invariant subspace and
+	// X = U21 * U11⁻¹, so solve U11ᵀ * Xᵀ = U21ᵀ.
+	u11 := schur.z.Slice(0, n, 0, n)
+	u21 := schur.z.Slice(n, 2*n, 0, n)
+	var xt Dense
+	err := xt.Solve(u11.T(), u21.T())
+	if err != nil {
+		if _, ok := err.(Condition); !ok {
+			return err
+		}
+	}
+	s.symmetrizeFrom(&xt, 1)
+	return err
+}
@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSolveRiccati(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, m int
+	}{
+		{1, 1}, {2, 1}, {3, 2}, {5, 5}, {8, 3}, {15, 4},
+	} {
+		n, m := test.n, test.m
+		name := fmt.Sprintf("n=%d,m=%d", n, m)
+		a := randSquareDense(n, rnd)
+		b := NewDense(n, m, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < m; j++ {
+				b.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		q := randomPosDef(n, rnd)
+		r := randomPosDef(m, rnd)
+
+		var rInv SymDense
+		var chol Cholesky
+		chol.Factorize(r)
+		chol.InverseTo(&rInv)
+
+		var xc SymDense
+		err := xc.SolveContinuousRiccati(a, b, q, r)
+		if err != nil {
+			t.Errorf("%s: unexpected error in continuous Riccati: %v", name, err)
+		} else {
+			// Aᵀ*X + X*A - X*B*R⁻¹*Bᵀ*X + Q = 0.
+			var res, tmp, k Dense
+			res.Mul(a.T(), &xc)
+			tmp.Mul(&xc, a)
+			res.Add(&res, &tmp)
+			k.Product(&rInv, b.T(), &xc)
+			tmp.Product(&xc, b, &k)
+			res.Sub(&res, &tmp)
+			res.Add(&res, q)
+			if resid := riccatiResidual(&res, &xc, a); resid > 1e-10 {
+				t.Errorf("%s: continuous Riccati residual too large: %v", name, resid)
+			}
+			// A - B*K must be stable.
+			var cl Dense
+			cl.Mul(b, &k)
+			cl.Sub(a, &cl)
+			var eig Eigen
+			eig.Factorize(&cl, EigenNone)
+			for _, v := range eig.Values(nil) {
+				if real(v) >= 0 {
+					t.Errorf("%s: continuous closed loop not stable: eigenvalue %v", name, v)
+				}
+			}
+		}
+
+		var xd SymDense
+		err = xd.SolveDiscreteRiccati(a, b, q, r)
+		if err != nil {
+			t.Errorf("%s: unexpected error in discrete Riccati: %v", name, err)
+		} else {
+			// Aᵀ*X*A - X - Aᵀ*X*B*(R + Bᵀ*X*B)⁻¹*Bᵀ*X*A + Q = 0.
+			var s Dense
+			s.Product(b.T(), &xd, b)
+			s.Add(&s, r)
+			var k, bxa Dense
+			bxa.Product(b.T(), &xd, a)
+			err := k.Solve(&s, &bxa)
+			if err != nil {
+				t.Fatalf("%s: unexpected error computing gain: %v", name, err)
+			}
+			var res, tmp Dense
+			res.Product(a.T(), &xd, a)
+			res.Sub(&res, &xd)
+			tmp.Product(a.T(), &xd, b, &k)
+			res.Sub(&res, &tmp)
+			res.Add(&res, q)
+			if resid := riccatiResidual(&res, &xd, a); resid > 1e-10 {
+				t.Errorf("%s: discrete Riccati residual too large: %v", name, resid)
+			}
+			// A - B*K must be stable.
+			var cl Dense
+			cl.Mul(b, &k)
+			cl.Sub(a, &cl)
+			var eig Eigen
+			eig.Factorize(&cl, EigenNone)
+			for _, v := range eig.Values(nil) {
+				if cmplx.Abs(v) >= 1 {
+					t.Errorf("%s: discrete closed loop not stable: eigenvalue %v", name, v)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveRiccatiNoStabilizing(t *testing.T) {
+	t.Parallel()
+	// The Hamiltonian matrix is zero and so all its eigenvalues lie on the
+	// imaginary axis.
+	a := NewDense(1, 1, []float64{0})
+	b := NewDense(1, 1, []float64{0})
+	q := NewSymDense(1, []float64{0})
+	r := NewSymDense(1, []float64{1})
+	var x SymDense
+	err := x.SolveContinuousRiccati(a, b, q, r)
+	if err != ErrNoStabilizing {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrNoStabilizing)
+	}
+
+	notPD := NewSymDense(1, []float64{-1})
+	err = x.SolveContinuousRiccati(a, b, q, notPD)
+	if err != ErrNotPSD {
+		t.Errorf("unexpected error for indefinite R: got %v, want %v", err, ErrNotPSD)
+	}
+}
+
+// riccatiResidual returns the norm of the residual res of a Riccati equation
+// relative to the solution x and the matrix a.
+func riccatiResidual(res *Dense, x Matrix, a Matrix) float64 {
+	anorm := math.Max(1, Norm(a, 1))
+	return Norm(res, 1) / (math.Max(1, Norm(x, 1)) * anorm * anorm)
+}
+
+// randomPosDef returns a random n×n symmetric positive definite matrix.
+func randomPosDef(n int, rnd *rand.Rand) *SymDense {
+	a := randSquareDense(n, rnd)
+	var s SymDense
+	s.SymOuterK(1, a)
+	for i := 0; i < n; i++ {
+		s.SetSym(i, i, s.At(i, i)+1)
+	}
+	return &s
+}
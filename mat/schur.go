@@ -0,0 +1,264 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badSchur = "mat: invalid Schur factorization"
+
+// Schur is a type for creating and using the real Schur decomposition of a
+// square matrix.
+//
+// The real Schur decomposition of an n×n matrix A is
+//
+//	A = Z * T * Zᵀ
+//
+// where Z is an n×n orthogonal matrix whose columns are the Schur vectors, and
+// T is an n×n upper quasi-triangular matrix, the Schur form of A. T is block
+// upper triangular with 1×1 and 2×2 blocks on its diagonal. Each 1×1 block is
+// a real eigenvalue of A, and each 2×2 block has equal diagonal elements and
+// off-diagonal elements of opposite sign, and corresponds to a complex
+// conjugate pair of eigenvalues of A.
+//
+// The first k columns of Z span the invariant subspace of A corresponding to
+// the first k eigenvalues on the diagonal of T, provided that the leading k×k
+// block of T does not split a 2×2 diagonal block.
+type Schur struct {
+	t, z   *Dense
+	values []complex128
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (s *Schur) succFact() bool {
+	return s.t != nil && !s.t.IsEmpty()
+}
+
+// Factorize computes the real Schur decomposition of the square matrix a.
+// Factorize will panic if a is not square.
+//
+// Factorize returns whether the decomposition succeeded. The decomposition
+// fails if the QR algorithm failed to compute all eigenvalues, which is rare.
+// If the decomposition failed, methods that require a successful
+// factorization will panic.
+func (s *Schur) Factorize(a Matrix) (ok bool) {
+	// Kill the previous factorization.
+	s.values = s.values[:0]
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	n := r
+	if s.t == nil {
+		s.t = &Dense{}
+	}
+	if s.z == nil {
+		s.z = &Dense{}
+	}
+	s.t.Reset()
+	s.t.CloneFrom(a)
+	s.z.Reset()
+	s.z.ReuseAs(n, n)
+
+	if n == 0 {
+		return true
+	}
+
+	ilo, ihi := 0, n-1
+	tau := getFloat64s(n-1, false)
+	defer putFloat64s(tau)
+	wr := getFloat64s(n, false)
+	defer putFloat64s(wr)
+	wi := getFloat64s(n, false)
+	defer putFloat64s(wi)
+	work := []float64{0}
+	lapack64.Gehrd(s.t.mat, ilo, ihi, tau, work, -1)
+	lwork := int(work[0])
+	lapack64.Orghr(s.z.mat, ilo, ihi, tau, work, -1)
+	lwork = max(lwork, int(work[0]))
+	lapack64.Hseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, s.t.mat, ilo, ihi, wr, wi, s.z.mat, work, -1)
+	lwork = max(lwork, int(work[0]), n)
+	work = getFloat64s(lwork, false)
+	defer putFloat64s(work)
+
+	// Reduce A to upper Hessenberg form, A = Q * H * Qᵀ.
+	lapack64.Gehrd(s.t.mat, ilo, ihi, tau, work, lwork)
+	// Form Q explicitly from the elementary reflectors stored below the
+	// first subdiagonal of H.
+	s.z.Copy(s.t)
+	lapack64.Orghr(s.z.mat, ilo, ihi, tau, work, lwork)
+	// Clear the reflectors from the lower part of H.
+	for i := 2; i < n; i++ {
+		zero(s.t.mat.Data[i*s.t.mat.Stride : i*s.t.mat.Stride+i-1])
+	}
+
+	// Compute the Schur decomposition H = U * T * Uᵀ and accumulate
+	// Z = Q * U.
+	unconverged := lapack64.Hseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, s.t.mat, ilo, ihi, wr, wi, s.z.mat, work, lwork)
+	if unconverged != 0 {
+		s.t.Reset()
+		s.z.Reset()
+		return false
+	}
+	s.values = useC(s.values, n)
+	for i := range s.values {
+		s.values[i] = complex(wr[i], wi[i])
+	}
+	return true
+}
+
+// Dims returns the dimensions of the factorized matrix.
+func (s *Schur) Dims() (r, c int) {
+	if !s.succFact() {
+		return 0, 0
+	}
+	return s.t.Dims()
+}
+
+// Values extracts the eigenvalues of the factorized matrix in the order in
+// which they appear on the diagonal of T. Complex conjugate pairs of
+// eigenvalues appear consecutively with the eigenvalue having the positive
+// imaginary part first.
+//
+// If dst is non-nil, the values are stored in-place into dst. In this case dst
+// must have length n, otherwise Values will panic. If dst is nil, then a new
+// slice will be allocated of the proper length and filled with the
+// eigenvalues.
+//
+// Values panics if the receiver does not contain a successful factorization.
+func (s *Schur) Values(dst []complex128) []complex128 {
+	if !s.succFact() {
+		panic(badSchur)
+	}
+	if dst == nil {
+		dst = make([]complex128, len(s.values))
+	}
+	if len(dst) != len(s.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, s.values)
+	return dst
+}
+
+// TTo stores the upper quasi-triangular Schur form T into dst.
+//
+// If dst is empty, TTo will resize dst to be n×n. When dst is non-empty, TTo
+// will panic if dst is not n×n. TTo will also panic if the receiver does not
+// contain a successful factorization.
+func (s *Schur) TTo(dst *Dense) {
+	if !s.succFact() {
+		panic(badSchur)
+	}
+	s.copyTo(dst, s.t)
+}
+
+// ZTo stores the orthogonal matrix Z of Schur vectors into dst.
+//
+// If dst is empty, ZTo will resize dst to be n×n. When dst is non-empty, ZTo
+// will panic if dst is not n×n. ZTo will also panic if the receiver does not
+// contain a successful factorization.
+func (s *Schur) ZTo(dst *Dense) {
+	if !s.succFact() {
+		panic(badSchur)
+	}
+	s.copyTo(dst, s.z)
+}
+
+func (s *Schur) copyTo(dst, src *Dense) {
+	r, c := src.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(src)
+}
+
+// Reorder reorders the Schur decomposition so that the eigenvalues for which
+// selected is true are moved to the leading diagonal blocks of T, while the
+// Schur vectors in Z are updated accordingly. The relative order of the
+// selected eigenvalues and of the remaining eigenvalues is preserved.
+//
+// selected must have length n and refers to the eigenvalues in the order
+// returned by Values before the call to Reorder. A complex conjugate pair of
+// eigenvalues is selected if either of its members is selected.
+//
+// On return, m will be the number of selected eigenvalues, counting both
+// members of selected complex conjugate pairs, and the first m columns of Z
+// will form an orthonormal basis of the invariant subspace of A corresponding
+// to the selected eigenvalues.
+//
+// If ok is false, two adjacent blocks of T were too close to swap because the
+// problem is very ill-conditioned. In this case the decomposition is still
+// valid, but it may have been only partially reordered. The eigenvalues
+// returned by Values always reflect the current order.
+//
+// Reorder panics if the receiver does not contain a successful factorization
+// or if selected has the wrong length.
+func (s *Schur) Reorder(selected []bool) (m int, ok bool) {
+	if !s.succFact() {
+		panic(badSchur)
+	}
+	n := len(s.values)
+	if len(selected) != n {
+		panic(ErrSliceLengthMismatch)
+	}
+
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	t := s.t.mat
+	ok = true
+	for k := 0; k < n; k++ {
+		sel := selected[k]
+		pair := k < n-1 && t.Data[(k+1)*t.Stride+k] != 0
+		if pair {
+			sel = sel || selected[k+1]
+		}
+		if sel {
+			if k != m {
+				// All blocks between rows m and k have not been
+				// selected, so the block at row k can be moved
+				// directly to row m.
+				_, _, ok = lapack64.Trexc(lapack.UpdateSchur, t, s.z.mat, k, m, work)
+				if !ok {
+					break
+				}
+			}
+			m++
+			if pair {
+				m++
+			}
+		}
+		if pair {
+			k++
+		}
+	}
+	s.updateValues()
+	return m, ok
+}
+
+// updateValues recomputes the eigenvalues from the diagonal blocks of T.
+func (s *Schur) updateValues() {
+	t := s.t.mat
+	n := t.Rows
+	for k := 0; k < n; k++ {
+		d := t.Data[k*t.Stride+k]
+		if k < n-1 && t.Data[(k+1)*t.Stride+k] != 0 {
+			im := math.Sqrt(math.Abs(t.Data[k*t.Stride+k+1])) * math.Sqrt(math.Abs(t.Data[(k+1)*t.Stride+k]))
+			s.values[k] = complex(d, im)
+			s.values[k+1] = complex(d, -im)
+			k++
+			continue
+		}
+		s.values[k] = complex(d, 0)
+	}
+}
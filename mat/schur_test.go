@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"sort"
+	"testing"
+)
+
+func TestSchur(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name string
+		a    *Dense
+	}{
+		{name: "1×1", a: NewDense(1, 1, []float64{3})},
+		{name: "rotation", a: NewDense(2, 2, []float64{0, -1, 1, 0})},
+		{name: "triangular", a: NewDense(3, 3, []float64{
+			1, 2, 3,
+			0, 4, 5,
+			0, 0, 6,
+		})},
+		{name: "random 5×5", a: randSquareDense(5, rnd)},
+		{name: "random 10×10", a: randSquareDense(10, rnd)},
+		{name: "random 31×31", a: randSquareDense(31, rnd)},
+	} {
+		var schur Schur
+		ok := schur.Factorize(test.a)
+		if !ok {
+			t.Fatalf("%s: unexpected factorization failure", test.name)
+		}
+		n, _ := test.a.Dims()
+		checkSchur(t, test.name, &schur, test.a)
+
+		var eig Eigen
+		ok = eig.Factorize(test.a, EigenNone)
+		if !ok {
+			t.Fatalf("%s: unexpected eigen failure", test.name)
+		}
+		got := schur.Values(nil)
+		want := eig.Values(nil)
+		sortComplex(got)
+		sortComplex(want)
+		for i := 0; i < n; i++ {
+			if cmplx.Abs(got[i]-want[i]) > 1e-10 {
+				t.Errorf("%s: eigenvalue mismatch at %d: got %v, want %v", test.name, i, got[i], want[i])
+			}
+		}
+
+		// Move the eigenvalues with negative real part to the top-left.
+		values := schur.Values(nil)
+		selected := make([]bool, n)
+		var wantM int
+		for i, v := range values {
+			selected[i] = real(v) < 0
+			if selected[i] {
+				wantM++
+			}
+		}
+		m, ok := schur.Reorder(selected)
+		if !ok {
+			t.Errorf("%s: unexpected reordering failure", test.name)
+			continue
+		}
+		if m != wantM {
+			t.Errorf("%s: unexpected number of selected eigenvalues: got %d, want %d", test.name, m, wantM)
+		}
+		checkSchur(t, test.name+" reordered", &schur, test.a)
+		reordered := schur.Values(nil)
+		for i, v := range reordered {
+			if (i < m) != (real(v) < 0) {
+				t.Errorf("%s: eigenvalue %v at position %d not reordered, m=%d", test.name, v, i, m)
+			}
+		}
+		sortComplex(reordered)
+		for i := range reordered {
+			if cmplx.Abs(reordered[i]-want[i]) > 1e-10 {
+				t.Errorf("%s: eigenvalue changed by reordering at %d: got %v, want %v", test.name, i, reordered[i], want[i])
+			}
+		}
+
+		// The leading m columns of Z must span an invariant subspace of A.
+		if m > 0 && m < n {
+			var z, tm Dense
+			schur.ZTo(&z)
+			schur.TTo(&tm)
+			z1 := z.Slice(0, n, 0, m)
+			var az, zt Dense
+			az.Mul(test.a, z1)
+			zt.Mul(z1, tm.Slice(0, m, 0, m))
+			if !EqualApprox(&az, &zt, 1e-10) {
+				t.Errorf("%s: leading Schur vectors do not span an invariant subspace", test.name)
+			}
+		}
+	}
+}
+
+func checkSchur(t *testing.T, name string, schur *Schur, a *Dense) {
+	t.Helper()
+	n, _ := a.Dims()
+	var tm, z Dense
+	schur.TTo(&tm)
+	schur.ZTo(&z)
+	if !isOrthonormal(&z, 1e-12) {
+		t.Errorf("%s: Z is not orthogonal", name)
+	}
+	var got Dense
+	got.Product(&z, &tm, z.T())
+	if !EqualApprox(&got, a, 1e-10) {
+		t.Errorf("%s: A != Z*T*Zᵀ", name)
+	}
+	// Check that T is in Schur canonical form.
+	for i := 0; i < n; i++ {
+		for j := 0; j < i-1; j++ {
+			if tm.At(i, j) != 0 {
+				t.Errorf("%s: T[%d,%d] = %v is not zero", name, i, j, tm.At(i, j))
+			}
+		}
+	}
+	for i := 0; i < n-1; i++ {
+		if tm.At(i+1, i) == 0 {
+			continue
+		}
+		if i > 0 && tm.At(i, i-1) != 0 {
+			t.Errorf("%s: overlapping 2×2 blocks at row %d", name, i)
+		}
+		if tm.At(i, i) != tm.At(i+1, i+1) || tm.At(i+1, i)*tm.At(i, i+1) >= 0 {
+			t.Errorf("%s: 2×2 block at row %d not in standard form", name, i)
+		}
+	}
+}
+
+func randSquareDense(n int, rnd *rand.Rand) *Dense {
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+func sortComplex(v []complex128) {
+	sort.Slice(v, func(i, j int) bool {
+		if math.Abs(real(v[i])-real(v[j])) > 1e-10 {
+			return real(v[i]) < real(v[j])
+		}
+		return imag(v[i]) < imag(v[j])
+	})
+}
+
+func TestSchurPanics(t *testing.T) {
+	t.Parallel()
+	var schur Schur
+	if !panicsWith(func() { schur.Values(nil) }, badSchur) {
+		t.Errorf("expected panic for unfactorized Schur")
+	}
+	if !panicsWith(func() { schur.Factorize(NewDense(2, 3, nil)) }, ErrSquare.Error()) {
+		t.Errorf("expected panic for non-square matrix")
+	}
+	schur.Factorize(NewDense(2, 2, []float64{1, 2, 3, 4}))
+	if !panicsWith(func() { schur.Reorder(make([]bool, 3)) }, ErrSliceLengthMismatch.Error()) {
+		t.Errorf("expected panic for wrong selection length")
+	}
+}
+
+func panicsWith(fn func(), msg string) bool {
+	panicked, message := panics(fn)
+	return panicked && message == msg
+}
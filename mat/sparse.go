@@ -0,0 +1,893 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+const badSparse = "mat: malformed sparse matrix"
+
+var (
+	csr *CSR
+	_   Matrix         = csr
+	_   allMatrix      = csr
+	_   NonZeroDoer    = csr
+	_   RowNonZeroDoer = csr
+	_   ColNonZeroDoer = csr
+
+	csc *CSC
+	_   Matrix         = csc
+	_   allMatrix      = csc
+	_   NonZeroDoer    = csc
+	_   RowNonZeroDoer = csc
+	_   ColNonZeroDoer = csc
+
+	coo *COO
+	_   Matrix      = coo
+	_   allMatrix   = coo
+	_   NonZeroDoer = coo
+)
+
+// compressed is the storage shared by the CSR and CSC types. The elements of
+// major index p are stored in ind[indptr[p]:indptr[p+1]] and
+// data[indptr[p]:indptr[p+1]], with ind holding the minor indices in strictly
+// increasing order. For CSR the major index is the row, for CSC it is the
+// column.
+type compressed struct {
+	major, minor int
+	indptr       []int
+	ind          []int
+	data         []float64
+}
+
+// newCompressed returns a compressed matrix with the provided storage after
+// checking that it is well formed.
+func newCompressed(major, minor int, indptr, ind []int, data []float64) compressed {
+	if major < 0 || minor < 0 {
+		panic(ErrNegativeDimension)
+	}
+	if indptr == nil && ind == nil && data == nil {
+		return compressed{
+			major:  major,
+			minor:  minor,
+			indptr: make([]int, major+1),
+		}
+	}
+	if len(indptr) != major+1 || len(ind) != len(data) {
+		panic(ErrShape)
+	}
+	if indptr[0] != 0 || indptr[major] != len(ind) {
+		panic(badSparse)
+	}
+	for p := 0; p < major; p++ {
+		if indptr[p] > indptr[p+1] {
+			panic(badSparse)
+		}
+		last := -1
+		for _, q := range ind[indptr[p]:indptr[p+1]] {
+			if q <= last || q >= minor {
+				panic(badSparse)
+			}
+			last = q
+		}
+	}
+	return compressed{
+		major:  major,
+		minor:  minor,
+		indptr: indptr,
+		ind:    ind,
+		data:   data,
+	}
+}
+
+func (c *compressed) isEmpty() bool {
+	return c.major == 0 && c.minor == 0
+}
+
+func (c *compressed) reset() {
+	c.major = 0
+	c.minor = 0
+	c.indptr = c.indptr[:0]
+	c.ind = c.ind[:0]
+	c.data = c.data[:0]
+}
+
+func (c *compressed) nnz() int {
+	if len(c.indptr) == 0 {
+		return 0
+	}
+	return c.indptr[c.major]
+}
+
+// at returns the element at major index p and minor index q.
+func (c *compressed) at(p, q int) float64 {
+	lo, hi := c.indptr[p], c.indptr[p+1]
+	k := lo + sort.SearchInts(c.ind[lo:hi], q)
+	if k < hi && c.ind[k] == q {
+		return c.data[k]
+	}
+	return 0
+}
+
+// doNonZero calls fn for each non-zero stored element with its major and
+// minor index.
+func (c *compressed) doNonZero(fn func(p, q int, v float64)) {
+	for p := 0; p < c.major; p++ {
+		c.doMajorNonZero(p, fn)
+	}
+}
+
+// doMajorNonZero calls fn for each non-zero stored element of major index p.
+func (c *compressed) doMajorNonZero(p int, fn func(p, q int, v float64)) {
+	for k := c.indptr[p]; k < c.indptr[p+1]; k++ {
+		if v := c.data[k]; v != 0 {
+			fn(p, c.ind[k], v)
+		}
+	}
+}
+
+// doMinorNonZero calls fn for each non-zero stored element of minor index q.
+func (c *compressed) doMinorNonZero(q int, fn func(p, q int, v float64)) {
+	for p := 0; p < c.major; p++ {
+		if v := c.at(p, q); v != 0 {
+			fn(p, q, v)
+		}
+	}
+}
+
+// setTriplets sets the receiver to the major×minor matrix described by the
+// triplets (pi[k], qi[k], v[k]). Elements with repeated indices are summed.
+func (c *compressed) setTriplets(major, minor int, pi, qi []int, v []float64) {
+	nz := len(v)
+
+	// Order the triplets by minor index with a counting sort.
+	next := make([]int, max(major, minor)+1)
+	for _, q := range qi {
+		next[q+1]++
+	}
+	for q := 0; q < minor; q++ {
+		next[q+1] += next[q]
+	}
+	perm := make([]int, nz)
+	for k, q := range qi {
+		perm[next[q]] = k
+		next[q]++
+	}
+
+	// Scatter the triplets by major index. The previous ordering ensures that
+	// the minor indices of each major index are sorted.
+	indptr := useInt(c.indptr, major+1)
+	for i := range indptr {
+		indptr[i] = 0
+	}
+	for _, p := range pi {
+		indptr[p+1]++
+	}
+	for p := 0; p < major; p++ {
+		indptr[p+1] += indptr[p]
+	}
+	copy(next, indptr[:major])
+	ind := useInt(c.ind, nz)
+	data := use(c.data, nz)
+	for _, k := range perm {
+		p := pi[k]
+		ind[next[p]] = qi[k]
+		data[next[p]] = v[k]
+		next[p]++
+	}
+
+	// Sum duplicate entries in place.
+	var w int
+	for p := 0; p < major; p++ {
+		start, end := indptr[p], indptr[p+1]
+		indptr[p] = w
+		for k := start; k < end; k++ {
+			if w > indptr[p] && ind[w-1] == ind[k] {
+				data[w-1] += data[k]
+				continue
+			}
+			ind[w] = ind[k]
+			data[w] = data[k]
+			w++
+		}
+	}
+	indptr[major] = w
+
+	*c = compressed{
+		major:  major,
+		minor:  minor,
+		indptr: indptr,
+		ind:    ind[:w],
+		data:   data[:w],
+	}
+}
+
+// setMatrix sets the receiver to the compressed representation of a. If
+// rowMajor is true, the major index of the receiver corresponds to the rows of
+// a, otherwise it corresponds to the columns.
+func (c *compressed) setMatrix(a Matrix, rowMajor bool) {
+	r, cols := a.Dims()
+	var pi, qi []int
+	var v []float64
+	add := func(i, j int, aij float64) {
+		if !rowMajor {
+			i, j = j, i
+		}
+		pi = append(pi, i)
+		qi = append(qi, j)
+		v = append(v, aij)
+	}
+	switch a := a.(type) {
+	case *COO:
+		pi, qi, v = a.rows, a.cols, a.data
+		if !rowMajor {
+			pi, qi = qi, pi
+		}
+		// COO may hold explicit zeros and duplicates that are summed
+		// by setTriplets, so they are passed through unaltered.
+	case NonZeroDoer:
+		a.DoNonZero(add)
+	default:
+		for i := 0; i < r; i++ {
+			for j := 0; j < cols; j++ {
+				if aij := a.At(i, j); aij != 0 {
+					add(i, j, aij)
+				}
+			}
+		}
+	}
+	if rowMajor {
+		c.setTriplets(r, cols, pi, qi, v)
+	} else {
+		c.setTriplets(cols, r, pi, qi, v)
+	}
+}
+
+// convertTo stores into dst the same matrix as held in c, but with the roles
+// of the major and minor indices exchanged.
+func (c *compressed) convertTo(dst *compressed) {
+	nz := c.nnz()
+	indptr := make([]int, c.minor+1)
+	for _, q := range c.ind[:nz] {
+		indptr[q+1]++
+	}
+	for q := 0; q < c.minor; q++ {
+		indptr[q+1] += indptr[q]
+	}
+	next := make([]int, c.minor)
+	copy(next, indptr[:c.minor])
+	ind := make([]int, nz)
+	data := make([]float64, nz)
+	for p := 0; p < c.major; p++ {
+		for k := c.indptr[p]; k < c.indptr[p+1]; k++ {
+			q := c.ind[k]
+			ind[next[q]] = p
+			data[next[q]] = c.data[k]
+			next[q]++
+		}
+	}
+	*dst = compressed{
+		major:  c.minor,
+		minor:  c.major,
+		indptr: indptr,
+		ind:    ind,
+		data:   data,
+	}
+}
+
+// clone returns a deep copy of c.
+func (c *compressed) clone() compressed {
+	nz := c.nnz()
+	return compressed{
+		major:  c.major,
+		minor:  c.minor,
+		indptr: append([]int(nil), c.indptr[:c.major+1]...),
+		ind:    append([]int(nil), c.ind[:nz]...),
+		data:   append([]float64(nil), c.data[:nz]...),
+	}
+}
+
+// addScaled stores alpha*a + beta*b into the receiver. The sparsity pattern
+// of the result is the union of the patterns of a and b.
+func (c *compressed) addScaled(alpha float64, a *compressed, beta float64, b *compressed) {
+	if a.major != b.major || a.minor != b.minor {
+		panic(ErrShape)
+	}
+	nz := a.nnz() + b.nnz()
+	indptr := make([]int, a.major+1)
+	ind := make([]int, 0, nz)
+	data := make([]float64, 0, nz)
+	for p := 0; p < a.major; p++ {
+		ka, enda := a.indptr[p], a.indptr[p+1]
+		kb, endb := b.indptr[p], b.indptr[p+1]
+		for ka < enda || kb < endb {
+			switch {
+			case kb == endb || (ka < enda && a.ind[ka] < b.ind[kb]):
+				ind = append(ind, a.ind[ka])
+				data = append(data, alpha*a.data[ka])
+				ka++
+			case ka == enda || b.ind[kb] < a.ind[ka]:
+				ind = append(ind, b.ind[kb])
+				data = append(data, beta*b.data[kb])
+				kb++
+			default:
+				ind = append(ind, a.ind[ka])
+				data = append(data, alpha*a.data[ka]+beta*b.data[kb])
+				ka++
+				kb++
+			}
+		}
+		indptr[p+1] = len(ind)
+	}
+	*c = compressed{
+		major:  a.major,
+		minor:  a.minor,
+		indptr: indptr,
+		ind:    ind,
+		data:   data,
+	}
+}
+
+// mulVec computes y = A*x if trans is false and y = Aᵀ*x if trans is true,
+// where A is the major×minor matrix held in the receiver.
+func (c *compressed) mulVec(y blas64.Vector, trans bool, x blas64.Vector) {
+	if !trans {
+		for p := 0; p < c.major; p++ {
+			var sum float64
+			for k := c.indptr[p]; k < c.indptr[p+1]; k++ {
+				sum += c.data[k] * x.Data[c.ind[k]*x.Inc]
+			}
+			y.Data[p*y.Inc] = sum
+		}
+		return
+	}
+	for q := 0; q < c.minor; q++ {
+		y.Data[q*y.Inc] = 0
+	}
+	for p := 0; p < c.major; p++ {
+		xp := x.Data[p*x.Inc]
+		if xp == 0 {
+			continue
+		}
+		for k := c.indptr[p]; k < c.indptr[p+1]; k++ {
+			y.Data[c.ind[k]*y.Inc] += c.data[k] * xp
+		}
+	}
+}
+
+// mulDense computes Y = A*X if trans is false and Y = Aᵀ*X if trans is true,
+// where A is the major×minor matrix held in the receiver.
+func (c *compressed) mulDense(y blas64.General, trans bool, x blas64.General) {
+	for i := 0; i < y.Rows; i++ {
+		zero(y.Data[i*y.Stride : i*y.Stride+y.Cols])
+	}
+	n := x.Cols
+	for p := 0; p < c.major; p++ {
+		for k := c.indptr[p]; k < c.indptr[p+1]; k++ {
+			q := c.ind[k]
+			if trans {
+				f64.AxpyUnitary(c.data[k], x.Data[p*x.Stride:p*x.Stride+n], y.Data[q*y.Stride:q*y.Stride+n])
+			} else {
+				f64.AxpyUnitary(c.data[k], x.Data[q*x.Stride:q*x.Stride+n], y.Data[p*y.Stride:p*y.Stride+n])
+			}
+		}
+	}
+}
+
+// sparseMulVecTo is the shared implementation of MulVecTo for compressed
+// matrices. rowMajor indicates whether the major index of c is the row index.
+func sparseMulVecTo(c *compressed, rowMajor bool, dst *VecDense, trans bool, x Vector) {
+	r, cols := c.major, c.minor
+	if !rowMajor {
+		r, cols = cols, r
+	}
+	if trans {
+		r, cols = cols, r
+	}
+	if x.Len() != cols {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(r)
+	xVec, ok := x.(*VecDense)
+	if !ok || xVec == dst {
+		xVec = getVecDenseWorkspace(cols, false)
+		defer putVecDenseWorkspace(xVec)
+		xVec.CloneFromVec(x)
+	} else {
+		dst.checkOverlap(xVec.mat)
+	}
+	c.mulVec(dst.mat, trans == rowMajor, xVec.mat)
+}
+
+// sparseMulDenseTo is the shared implementation of MulDenseTo for compressed
+// matrices. rowMajor indicates whether the major index of c is the row index.
+func sparseMulDenseTo(c *compressed, rowMajor bool, dst *Dense, trans bool, b Matrix) {
+	r, cols := c.major, c.minor
+	if !rowMajor {
+		r, cols = cols, r
+	}
+	if trans {
+		r, cols = cols, r
+	}
+	br, bc := b.Dims()
+	if br != cols {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(r, bc)
+	bDense, ok := b.(*Dense)
+	if !ok || bDense == dst {
+		bDense = getDenseWorkspace(br, bc, false)
+		defer putDenseWorkspace(bDense)
+		bDense.Copy(b)
+	} else {
+		dst.checkOverlap(bDense.mat)
+	}
+	c.mulDense(dst.mat, trans == rowMajor, bDense.mat)
+}
+
+// CSR is a sparse matrix in compressed sparse row format. The column indices
+// and values of the stored elements in row i are held in positions
+// indptr[i] to indptr[i+1]-1 of the index and data slices, with the column
+// indices in strictly increasing order.
+//
+// CSR is efficient for row access and matrix-vector products, but not for
+// modification of its sparsity pattern. Matrices are usually assembled using
+// a COO and then converted.
+type CSR struct {
+	mat compressed
+}
+
+// NewCSR creates a new r×c sparse matrix in compressed sparse row format. If
+// indptr, ind and data are all nil, the returned matrix has no stored
+// elements. Otherwise indptr must have length r+1 and ind and data must have
+// length indptr[r]; the column indices of each row must be strictly increasing
+// and less than c. The slices are used as backing data and changes to the
+// elements of the returned CSR will be reflected in them. NewCSR will panic if
+// the inputs do not describe a valid matrix.
+func NewCSR(r, c int, indptr, ind []int, data []float64) *CSR {
+	return &CSR{mat: newCompressed(r, c, indptr, ind, data)}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSR) Dims() (r, c int) {
+	return m.mat.major, m.mat.minor
+}
+
+// At returns the element at row i, column j.
+func (m *CSR) At(i, j int) float64 {
+	if uint(i) >= uint(m.mat.major) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.mat.minor) {
+		panic(ErrColAccess)
+	}
+	return m.mat.at(i, j)
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (m *CSR) T() Matrix {
+	return Transpose{m}
+}
+
+// TCSC returns the transpose of the receiver as a CSC matrix. The returned
+// matrix shares the backing data of the receiver.
+func (m *CSR) TCSC() *CSC {
+	return &CSC{mat: m.mat}
+}
+
+// NNZ returns the number of stored elements in the matrix, including any
+// explicitly stored zeros.
+func (m *CSR) NNZ() int {
+	return m.mat.nnz()
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *CSR) IsEmpty() bool {
+	return m.mat.isEmpty()
+}
+
+// Reset empties the matrix so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data. See the Reseter
+// interface for more information.
+func (m *CSR) Reset() {
+	m.mat.reset()
+}
+
+// Zero sets all of the stored matrix elements to zero. The sparsity pattern
+// of the receiver is retained.
+func (m *CSR) Zero() {
+	zero(m.mat.data)
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. Only the non-zero elements of a are stored. If a is
+// a NonZeroDoer, its DoNonZero method is used to find the elements, otherwise
+// every element of a is visited. CloneFrom does not place any restrictions on
+// the receiver shape.
+func (m *CSR) CloneFrom(a Matrix) {
+	switch a := a.(type) {
+	case *CSR:
+		m.mat = a.mat.clone()
+	case *CSC:
+		a.mat.convertTo(&m.mat)
+	default:
+		m.mat.setMatrix(a, true)
+	}
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of the
+// receiver. The function fn takes a row/column index and the element value.
+func (m *CSR) DoNonZero(fn func(i, j int, v float64)) {
+	m.mat.doNonZero(fn)
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of row
+// i of the receiver. The function fn takes a row/column index and the element
+// value.
+func (m *CSR) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(m.mat.major) {
+		panic(ErrRowAccess)
+	}
+	m.mat.doMajorNonZero(i, fn)
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of the receiver. The function fn takes a row/column index and the
+// element value. DoColNonZero performs a search in every row of the matrix; if
+// column access is required frequently, a CSC matrix should be used.
+func (m *CSR) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(m.mat.minor) {
+		panic(ErrColAccess)
+	}
+	m.mat.doMinorNonZero(j, fn)
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (m *CSR) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	sparseMulVecTo(&m.mat, true, dst, trans, x)
+}
+
+// MulDenseTo computes A⋅B or Aᵀ⋅B storing the result into dst.
+func (m *CSR) MulDenseTo(dst *Dense, trans bool, b Matrix) {
+	sparseMulDenseTo(&m.mat, true, dst, trans, b)
+}
+
+// Add adds a and b element-wise, placing the result in the receiver. The
+// sparsity pattern of the result is the union of the patterns of a and b.
+// Add will panic if the two matrices do not have the same shape.
+func (m *CSR) Add(a, b *CSR) {
+	m.mat.addScaled(1, &a.mat, 1, &b.mat)
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. The
+// sparsity pattern of the result is the union of the patterns of a and b.
+// Sub will panic if the two matrices do not have the same shape.
+func (m *CSR) Sub(a, b *CSR) {
+	m.mat.addScaled(1, &a.mat, -1, &b.mat)
+}
+
+// Scale multiplies the elements of a by f, placing the result in the
+// receiver. The sparsity pattern of a is retained.
+func (m *CSR) Scale(f float64, a *CSR) {
+	if m != a {
+		m.mat = a.mat.clone()
+	}
+	f64.ScalUnitary(f, m.mat.data)
+}
+
+// CSC is a sparse matrix in compressed sparse column format. The row indices
+// and values of the stored elements in column j are held in positions
+// indptr[j] to indptr[j+1]-1 of the index and data slices, with the row
+// indices in strictly increasing order.
+//
+// CSC is efficient for column access and transposed matrix-vector products,
+// but not for modification of its sparsity pattern. Matrices are usually
+// assembled using a COO and then converted.
+type CSC struct {
+	mat compressed
+}
+
+// NewCSC creates a new r×c sparse matrix in compressed sparse column format.
+// If indptr, ind and data are all nil, the returned matrix has no stored
+// elements. Otherwise indptr must have length c+1 and ind and data must have
+// length indptr[c]; the row indices of each column must be strictly increasing
+// and less than r. The slices are used as backing data and changes to the
+// elements of the returned CSC will be reflected in them. NewCSC will panic if
+// the inputs do not describe a valid matrix.
+func NewCSC(r, c int, indptr, ind []int, data []float64) *CSC {
+	return &CSC{mat: newCompressed(c, r, indptr, ind, data)}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSC) Dims() (r, c int) {
+	return m.mat.minor, m.mat.major
+}
+
+// At returns the element at row i, column j.
+func (m *CSC) At(i, j int) float64 {
+	if uint(i) >= uint(m.mat.minor) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.mat.major) {
+		panic(ErrColAccess)
+	}
+	return m.mat.at(j, i)
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (m *CSC) T() Matrix {
+	return Transpose{m}
+}
+
+// TCSR returns the transpose of the receiver as a CSR matrix. The returned
+// matrix shares the backing data of the receiver.
+func (m *CSC) TCSR() *CSR {
+	return &CSR{mat: m.mat}
+}
+
+// NNZ returns the number of stored elements in the matrix, including any
+// explicitly stored zeros.
+func (m *CSC) NNZ() int {
+	return m.mat.nnz()
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *CSC) IsEmpty() bool {
+	return m.mat.isEmpty()
+}
+
+// Reset empties the matrix so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data. See the Reseter
+// interface for more information.
+func (m *CSC) Reset() {
+	m.mat.reset()
+}
+
+// Zero sets all of the stored matrix elements to zero. The sparsity pattern
+// of the receiver is retained.
+func (m *CSC) Zero() {
+	zero(m.mat.data)
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. Only the non-zero elements of a are stored. If a is
+// a NonZeroDoer, its DoNonZero method is used to find the elements, otherwise
+// every element of a is visited. CloneFrom does not place any restrictions on
+// the receiver shape.
+func (m *CSC) CloneFrom(a Matrix) {
+	switch a := a.(type) {
+	case *CSC:
+		m.mat = a.mat.clone()
+	case *CSR:
+		a.mat.convertTo(&m.mat)
+	default:
+		m.mat.setMatrix(a, false)
+	}
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of the
+// receiver. The function fn takes a row/column index and the element value.
+func (m *CSC) DoNonZero(fn func(i, j int, v float64)) {
+	m.mat.doNonZero(func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of row
+// i of the receiver. The function fn takes a row/column index and the element
+// value. DoRowNonZero performs a search in every column of the matrix; if row
+// access is required frequently, a CSR matrix should be used.
+func (m *CSC) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(m.mat.minor) {
+		panic(ErrRowAccess)
+	}
+	m.mat.doMinorNonZero(i, func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of the receiver. The function fn takes a row/column index and the
+// element value.
+func (m *CSC) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(m.mat.major) {
+		panic(ErrColAccess)
+	}
+	m.mat.doMajorNonZero(j, func(j, i int, v float64) { fn(i, j, v) })
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (m *CSC) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	sparseMulVecTo(&m.mat, false, dst, trans, x)
+}
+
+// MulDenseTo computes A⋅B or Aᵀ⋅B storing the result into dst.
+func (m *CSC) MulDenseTo(dst *Dense, trans bool, b Matrix) {
+	sparseMulDenseTo(&m.mat, false, dst, trans, b)
+}
+
+// Add adds a and b element-wise, placing the result in the receiver. The
+// sparsity pattern of the result is the union of the patterns of a and b.
+// Add will panic if the two matrices do not have the same shape.
+func (m *CSC) Add(a, b *CSC) {
+	m.mat.addScaled(1, &a.mat, 1, &b.mat)
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. The
+// sparsity pattern of the result is the union of the patterns of a and b.
+// Sub will panic if the two matrices do not have the same shape.
+func (m *CSC) Sub(a, b *CSC) {
+	m.mat.addScaled(1, &a.mat, -1, &b.mat)
+}
+
+// Scale multiplies the elements of a by f, placing the result in the
+// receiver. The sparsity pattern of a is retained.
+func (m *CSC) Scale(f float64, a *CSC) {
+	if m != a {
+		m.mat = a.mat.clone()
+	}
+	f64.ScalUnitary(f, m.mat.data)
+}
+
+// COO is a sparse matrix in coordinate format, holding a list of
+// (row, column, value) triplets. Triplets with the same row and column are
+// summed to give the value of the element. COO is intended for the assembly
+// of sparse matrices which are then converted to CSR or CSC format using
+// their CloneFrom methods.
+type COO struct {
+	r, c int
+	rows []int
+	cols []int
+	data []float64
+}
+
+// NewCOO creates a new r×c sparse matrix in coordinate format. If rows, cols
+// and data are all nil, the returned matrix has no stored elements. Otherwise
+// they must have the same length and hold the row index, column index and
+// value of each triplet. The slices are used as backing data. NewCOO will
+// panic if any index is out of range.
+func NewCOO(r, c int, rows, cols []int, data []float64) *COO {
+	if r < 0 || c < 0 {
+		panic(ErrNegativeDimension)
+	}
+	if len(rows) != len(data) || len(cols) != len(data) {
+		panic(ErrShape)
+	}
+	for k := range data {
+		if uint(rows[k]) >= uint(r) {
+			panic(ErrRowAccess)
+		}
+		if uint(cols[k]) >= uint(c) {
+			panic(ErrColAccess)
+		}
+	}
+	return &COO{r: r, c: c, rows: rows, cols: cols, data: data}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *COO) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element at row i, column j. At performs a linear search of
+// the stored triplets.
+func (m *COO) At(i, j int) float64 {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	var v float64
+	for k, ri := range m.rows {
+		if ri == i && m.cols[k] == j {
+			v += m.data[k]
+		}
+	}
+	return v
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (m *COO) T() Matrix {
+	return Transpose{m}
+}
+
+// NNZ returns the number of stored triplets, including duplicates and any
+// explicitly stored zeros.
+func (m *COO) NNZ() int {
+	return len(m.data)
+}
+
+// Append adds the triplet (i, j, v) to the matrix. If an element at (i, j) is
+// already stored, v is added to its value.
+func (m *COO) Append(i, j int, v float64) {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	m.rows = append(m.rows, i)
+	m.cols = append(m.cols, j)
+	m.data = append(m.data, v)
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (m *COO) IsEmpty() bool {
+	return m.r == 0 && m.c == 0
+}
+
+// Reset empties the matrix so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data. See the Reseter
+// interface for more information.
+func (m *COO) Reset() {
+	m.r = 0
+	m.c = 0
+	m.rows = m.rows[:0]
+	m.cols = m.cols[:0]
+	m.data = m.data[:0]
+}
+
+// Zero removes all of the stored triplets from the receiver.
+func (m *COO) Zero() {
+	m.rows = m.rows[:0]
+	m.cols = m.cols[:0]
+	m.data = m.data[:0]
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. Only the non-zero elements of a are stored.
+func (m *COO) CloneFrom(a Matrix) {
+	if a, ok := a.(*COO); ok && a == m {
+		return
+	}
+	r, c := a.Dims()
+	m.r, m.c = r, c
+	m.Zero()
+	switch a := a.(type) {
+	case *COO:
+		m.rows = append(m.rows, a.rows...)
+		m.cols = append(m.cols, a.cols...)
+		m.data = append(m.data, a.data...)
+	case NonZeroDoer:
+		a.DoNonZero(m.Append)
+	default:
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				if v := a.At(i, j); v != 0 {
+					m.Append(i, j, v)
+				}
+			}
+		}
+	}
+}
+
+// DoNonZero calls the function fn for each of the non-zero triplets stored in
+// the receiver. The function fn takes a row/column index and the element value.
+// If the receiver holds duplicate triplets, fn is called for each of them, so
+// the value of the element is the sum of the values passed to fn for its
+// indices.
+func (m *COO) DoNonZero(fn func(i, j int, v float64)) {
+	for k, v := range m.data {
+		if v != 0 {
+			fn(m.rows[k], m.cols[k], v)
+		}
+	}
+}
@@ -0,0 +1,568 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+const badSparsePattern = "mat: sparsity pattern differs from analyzed pattern"
+
+// SparseCholesky is a sparse symmetric positive definite matrix represented by
+// its Cholesky decomposition
+//
+//	P * A * Pᵀ = L * Lᵀ
+//
+// where P is a fill-reducing permutation matrix and L is a sparse lower
+// triangular matrix.
+//
+// The factorization is computed in two phases. Analyze computes the
+// permutation and the sparsity pattern of L from the sparsity pattern of A,
+// and Factorize computes the values of L with a left-looking algorithm. The
+// result of Analyze can be reused for any number of matrices with the same
+// sparsity pattern, as for example the Jacobians in a sequence of Newton
+// iterations.
+//
+// SparseCholesky methods other than Analyze and Factorize may only be called
+// on a value that has been successfully initialized by a call to Factorize
+// that has returned true. Calls to methods of an unsuccessful factorization
+// will panic.
+type SparseCholesky struct {
+	n int
+
+	// perm[k] is the row and column of A that is
+	// at position k in P * A * Pᵀ, and iperm is its
+	// inverse.
+	perm, iperm []int
+	analyzed    bool
+
+	// l holds L in compressed sparse column form
+	// with the diagonal element first in each column.
+	l    compressed
+	ok   bool
+	cond float64
+}
+
+// Analyze computes the fill-reducing permutation of the sparse symmetric matrix
+// A using the given ordering, and the sparsity pattern of its Cholesky factor.
+// Only the sparsity pattern of a is used. Elements that are stored in a sparse
+// matrix are part of the pattern even if their value is zero.
+//
+// Analyze will panic if a is not square.
+func (c *SparseCholesky) Analyze(a Matrix, order SparseOrdering) {
+	n, nc := a.Dims()
+	if n != nc {
+		panic(ErrSquare)
+	}
+	ptr, ind := symmetricPattern(sparseCols(a))
+	perm := fillReducingOrder(order, n, ptr, ind)
+	iperm := make([]int, n)
+	for k, i := range perm {
+		iperm[i] = k
+	}
+
+	// Compute the elimination tree of P * A * Pᵀ. Each node keeps a
+	// path-compressed pointer to its current root.
+	parent := make([]int, n)
+	ancestor := make([]int, n)
+	for k := 0; k < n; k++ {
+		parent[k] = -1
+		ancestor[k] = -1
+		v := perm[k]
+		for _, u := range ind[ptr[v]:ptr[v+1]] {
+			for i := iperm[u]; i != -1 && i < k; {
+				inext := ancestor[i]
+				ancestor[i] = k
+				if inext == -1 {
+					parent[i] = k
+				}
+				i = inext
+			}
+		}
+	}
+
+	// The pattern of row k of L is the subtree of the elimination tree
+	// rooted at k that is spanned by the elements of row k of P * A * Pᵀ
+	// left of the diagonal. The subtrees are traversed twice, first to
+	// count the elements in each column and then to store them.
+	flag := ancestor
+	for i := range flag {
+		flag[i] = -1
+	}
+	rowSubtree := func(k int, fn func(j int)) {
+		flag[k] = k
+		v := perm[k]
+		for _, u := range ind[ptr[v]:ptr[v+1]] {
+			for j := iperm[u]; j < k && flag[j] != k; j = parent[j] {
+				flag[j] = k
+				fn(j)
+			}
+		}
+	}
+	colptr := make([]int, n+1)
+	for k := 0; k < n; k++ {
+		colptr[k+1]++
+		rowSubtree(k, func(j int) { colptr[j+1]++ })
+	}
+	for j := 0; j < n; j++ {
+		colptr[j+1] += colptr[j]
+	}
+	next := make([]int, n)
+	copy(next, colptr[:n])
+	rowind := make([]int, colptr[n])
+	for i := range flag {
+		flag[i] = -1
+	}
+	for k := 0; k < n; k++ {
+		rowind[next[k]] = k
+		next[k]++
+		rowSubtree(k, func(j int) {
+			rowind[next[j]] = k
+			next[j]++
+		})
+	}
+
+	*c = SparseCholesky{
+		n:        n,
+		perm:     perm,
+		iperm:    iperm,
+		analyzed: true,
+		l: compressed{
+			major:  n,
+			minor:  n,
+			indptr: colptr,
+			ind:    rowind,
+			data:   make([]float64, len(rowind)),
+		},
+		cond: math.Inf(1),
+	}
+}
+
+// Factorize computes the Cholesky factorization of the sparse symmetric matrix
+// A and returns whether the matrix is positive definite. If Factorize returns
+// false, the factorization must not be used. Only the elements of a on and
+// above the diagonal are used.
+//
+// If the receiver does not hold the result of a previous call to Analyze,
+// Factorize first analyzes a using OrderAMD. Otherwise the sparsity pattern of
+// a must be contained in the pattern that was analyzed, and Factorize will
+// panic if it is not.
+func (c *SparseCholesky) Factorize(a Matrix) (ok bool) {
+	n, nc := a.Dims()
+	if n != nc {
+		panic(ErrSquare)
+	}
+	if !c.analyzed {
+		c.Analyze(a, OrderAMD)
+	}
+	if n != c.n {
+		panic(ErrShape)
+	}
+	c.ok = false
+	c.cond = math.Inf(1)
+
+	// Form the lower triangle of P * A * Pᵀ in compressed sparse column
+	// form from the upper triangle of A, and compute the 1-norm of A.
+	cols := sparseCols(a)
+	colSum := make([]float64, n)
+	count := make([]int, n+1)
+	for j := 0; j < n; j++ {
+		for k := cols.indptr[j]; k < cols.indptr[j+1]; k++ {
+			if i := cols.ind[k]; i <= j {
+				count[min(c.iperm[i], c.iperm[j])+1]++
+			}
+		}
+	}
+	for j := 0; j < n; j++ {
+		count[j+1] += count[j]
+	}
+	rows := make([]int, count[n])
+	vals := make([]float64, count[n])
+	next := make([]int, n)
+	copy(next, count[:n])
+	for j := 0; j < n; j++ {
+		for k := cols.indptr[j]; k < cols.indptr[j+1]; k++ {
+			i := cols.ind[k]
+			if i > j {
+				continue
+			}
+			v := cols.data[k]
+			colSum[j] += math.Abs(v)
+			if i != j {
+				colSum[i] += math.Abs(v)
+			}
+			pi, pj := c.iperm[i], c.iperm[j]
+			col := min(pi, pj)
+			rows[next[col]] = max(pi, pj)
+			vals[next[col]] = v
+			next[col]++
+		}
+	}
+	anorm := floats.Max(append(colSum, 0))
+
+	// Compute L column by column. Column j is formed from column j of
+	// P * A * Pᵀ by subtracting the contributions of the columns k < j
+	// with a non-zero element L[j,k]. These columns are held in linked
+	// lists indexed by the row of their next non-zero element below the
+	// already processed part.
+	l := &c.l
+	x := getFloat64s(n, true)
+	defer putFloat64s(x)
+	mark := getInts(n, false)
+	defer putInts(mark)
+	head := getInts(n, false)
+	defer putInts(head)
+	link := getInts(n, false)
+	defer putInts(link)
+	first := getInts(n, false)
+	defer putInts(first)
+	for j := range head {
+		head[j] = -1
+		mark[j] = -1
+	}
+	for j := 0; j < n; j++ {
+		start, end := l.indptr[j], l.indptr[j+1]
+		for _, i := range l.ind[start:end] {
+			mark[i] = j
+		}
+		for p := count[j]; p < count[j+1]; p++ {
+			i := rows[p]
+			if mark[i] != j {
+				panic(badSparsePattern)
+			}
+			x[i] += vals[p]
+		}
+
+		for k := head[j]; k != -1; {
+			knext := link[k]
+			p := first[k]
+			ljk := l.data[p]
+			for q := p; q < l.indptr[k+1]; q++ {
+				x[l.ind[q]] -= l.data[q] * ljk
+			}
+			p++
+			first[k] = p
+			if p < l.indptr[k+1] {
+				i := l.ind[p]
+				link[k] = head[i]
+				head[i] = k
+			}
+			k = knext
+		}
+
+		d := x[j]
+		x[j] = 0
+		if !(d > 0) {
+			for _, i := range l.ind[start:end] {
+				x[i] = 0
+			}
+			return false
+		}
+		ljj := math.Sqrt(d)
+		l.data[start] = ljj
+		for p := start + 1; p < end; p++ {
+			i := l.ind[p]
+			l.data[p] = x[i] / ljj
+			x[i] = 0
+		}
+		if start+1 < end {
+			first[j] = start + 1
+			i := l.ind[start+1]
+			link[j] = head[i]
+			head[i] = j
+		}
+	}
+	c.ok = true
+
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	c.cond = anorm * sparseInvNormEst(n, func(x []float64, _ bool) {
+		c.solve(x, work)
+	})
+	return true
+}
+
+// valid returns whether the receiver holds a successful factorization.
+func (c *SparseCholesky) valid() bool {
+	return c.ok
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation. Reset discards the result of Analyze.
+func (c *SparseCholesky) Reset() {
+	*c = SparseCholesky{cond: math.Inf(1)}
+}
+
+// SymmetricDim returns the number of rows and columns of the factorized matrix.
+func (c *SparseCholesky) SymmetricDim() int {
+	return c.n
+}
+
+// NNZ returns the number of stored elements in the Cholesky factor L,
+// including the diagonal.
+func (c *SparseCholesky) NNZ() int {
+	if !c.analyzed {
+		return 0
+	}
+	return c.l.nnz()
+}
+
+// Cond returns an estimate of the condition number of the factorized matrix in
+// the 1-norm.
+func (c *SparseCholesky) Cond() float64 {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	return c.cond
+}
+
+// Det returns the determinant of the matrix that has been factorized.
+func (c *SparseCholesky) Det() float64 {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been
+// factorized.
+func (c *SparseCholesky) LogDet() float64 {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	var det float64
+	for j := 0; j < c.n; j++ {
+		det += 2 * math.Log(c.l.data[c.l.indptr[j]])
+	}
+	return det
+}
+
+// Permutation returns the fill-reducing permutation P of the factorization
+//
+//	P * A * Pᵀ = L * Lᵀ.
+//
+// Element k of the returned slice is the row and column of A that is at
+// position k in P * A * Pᵀ. If dst is nil, a new slice is allocated and
+// returned. If dst is not nil and its length does not equal the size of the
+// factorized matrix, Permutation will panic. Permutation will panic if the
+// receiver does not hold the result of Analyze.
+func (c *SparseCholesky) Permutation(dst []int) []int {
+	if !c.analyzed {
+		panic(badCholesky)
+	}
+	if dst == nil {
+		dst = make([]int, c.n)
+	}
+	if len(dst) != c.n {
+		panic(badSliceLength)
+	}
+	copy(dst, c.perm)
+	return dst
+}
+
+// LTo stores the lower triangular Cholesky factor L of the permuted matrix
+// P * A * Pᵀ into dst.
+func (c *SparseCholesky) LTo(dst *CSC) {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	dst.mat = c.l.clone()
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented by
+// the sparse Cholesky decomposition. The result is stored into dst.
+// If A is near-singular a Condition error is returned. See the documentation
+// for Condition for more information.
+func (c *SparseCholesky) SolveTo(dst *Dense, b Matrix) error {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	br, bc := b.Dims()
+	if br != c.n {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(br, bc)
+	if b != dst {
+		dst.Copy(b)
+	}
+	x := getFloat64s(c.n, false)
+	defer putFloat64s(x)
+	work := getFloat64s(c.n, false)
+	defer putFloat64s(work)
+	for j := 0; j < bc; j++ {
+		for i := range x {
+			x[i] = dst.at(i, j)
+		}
+		c.solve(x, work)
+		for i, v := range x {
+			dst.set(i, j, v)
+		}
+	}
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
+
+// SolveVecTo finds the vector x that solves A * x = b where A is represented
+// by the sparse Cholesky decomposition. The result is stored into dst.
+// If A is near-singular a Condition error is returned. See the documentation
+// for Condition for more information.
+func (c *SparseCholesky) SolveVecTo(dst *VecDense, b Vector) error {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	if br, bc := b.Dims(); br != c.n || bc != 1 {
+		panic(ErrShape)
+	}
+	if rv, ok := b.(RawVectorer); ok && dst != b {
+		dst.checkOverlap(rv.RawVector())
+	}
+	dst.reuseAsNonZeroed(c.n)
+	if dst != b {
+		dst.CopyVec(b)
+	}
+	x := getFloat64s(c.n, false)
+	defer putFloat64s(x)
+	work := getFloat64s(c.n, false)
+	defer putFloat64s(work)
+	for i := range x {
+		x[i] = dst.at(i)
+	}
+	c.solve(x, work)
+	for i, v := range x {
+		dst.setVec(i, v)
+	}
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
+
+// solve overwrites x with the solution of A * x = b where x holds b on entry.
+// The length of work must be at least n.
+func (c *SparseCholesky) solve(x, work []float64) {
+	l := &c.l
+	w := work[:c.n]
+	for k, i := range c.perm {
+		w[k] = x[i]
+	}
+	for j := 0; j < c.n; j++ {
+		start := l.indptr[j]
+		w[j] /= l.data[start]
+		wj := w[j]
+		for p := start + 1; p < l.indptr[j+1]; p++ {
+			w[l.ind[p]] -= l.data[p] * wj
+		}
+	}
+	for j := c.n - 1; j >= 0; j-- {
+		start := l.indptr[j]
+		wj := w[j]
+		for p := start + 1; p < l.indptr[j+1]; p++ {
+			wj -= l.data[p] * w[l.ind[p]]
+		}
+		w[j] = wj / l.data[start]
+	}
+	for k, i := range c.perm {
+		x[i] = w[k]
+	}
+}
+
+// sparseCols returns the elements of a in compressed sparse column form. The
+// returned value may share the backing data of a and must not be modified.
+func sparseCols(a Matrix) *compressed {
+	switch a := a.(type) {
+	case *CSC:
+		return &a.mat
+	case *CSR:
+		var c compressed
+		a.mat.convertTo(&c)
+		return &c
+	case Transpose:
+		if m, ok := a.Matrix.(*CSR); ok {
+			return &m.mat
+		}
+	}
+	var c compressed
+	c.setMatrix(a, false)
+	return &c
+}
+
+// sparseInvNormEst returns an estimate of the 1-norm of the inverse of the n×n
+// matrix A, where solve overwrites x with the solution of A * x = b, or of
+// Aᵀ * x = b if trans is true, with b held in x on entry.
+//
+// The estimate is computed with the algorithm of Hager as refined by Higham,
+// which is also used by the LAPACK routine Dlacn2.
+func sparseInvNormEst(n int, solve func(x []float64, trans bool)) float64 {
+	if n == 0 {
+		return 0
+	}
+	const maxIter = 5
+	x := make([]float64, n)
+	sgn := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+	solve(x, false)
+	est := floats.Norm(x, 1)
+	if n == 1 {
+		return est
+	}
+	for i, v := range x {
+		sgn[i] = math.Copysign(1, v)
+	}
+	copy(x, sgn)
+	solve(x, true)
+	j := floats.MaxIdx(absAll(x))
+	for iter := 0; iter < maxIter; iter++ {
+		for i := range x {
+			x[i] = 0
+		}
+		x[j] = 1
+		solve(x, false)
+		prev := est
+		est = floats.Norm(x, 1)
+		same := true
+		for i, v := range x {
+			s := math.Copysign(1, v)
+			if s != sgn[i] {
+				same = false
+			}
+			sgn[i] = s
+		}
+		if same || est <= prev {
+			est = max(est, prev)
+			break
+		}
+		copy(x, sgn)
+		solve(x, true)
+		jlast := j
+		j = floats.MaxIdx(absAll(x))
+		if math.Abs(x[jlast]) == math.Abs(x[j]) {
+			break
+		}
+	}
+
+	// Guard against the estimate being far too small with an
+	// alternative test vector.
+	alt := 1.0
+	for i := range x {
+		x[i] = alt * (1 + float64(i)/float64(n-1))
+		alt = -alt
+	}
+	solve(x, false)
+	return max(est, 2*floats.Norm(x, 1)/float64(3*n))
+}
+
+// absAll replaces the elements of x with their absolute values and returns x.
+func absAll(x []float64) []float64 {
+	for i, v := range x {
+		x[i] = math.Abs(v)
+	}
+	return x
+}
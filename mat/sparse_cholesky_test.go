@@ -0,0 +1,283 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// randSparseSPD returns an n×n sparse symmetric positive definite matrix with
+// approximately the given fraction of non-zero off-diagonal elements.
+func randSparseSPD(n int, density float64, rnd *rand.Rand) *CSR {
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if rnd.Float64() < density {
+				v := rnd.NormFloat64()
+				a.Set(i, j, v)
+				a.Set(j, i, v)
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += math.Abs(a.At(i, j))
+		}
+		a.Set(i, i, sum+1+rnd.Float64())
+	}
+	return csrOf(a)
+}
+
+// permuteSym returns P * A * Pᵀ where element k of perm is the row and column
+// of A that is at position k.
+func permuteSym(a Matrix, perm []int) *Dense {
+	n := len(perm)
+	p := NewDense(n, n, nil)
+	for k, i := range perm {
+		for l, j := range perm {
+			p.Set(k, l, a.At(i, j))
+		}
+	}
+	return p
+}
+
+func TestSparseCholesky(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name string
+		a    *CSR
+	}{
+		{name: "empty", a: NewCSR(0, 0, nil, nil, nil)},
+		{name: "single", a: NewCSR(1, 1, []int{0, 1}, []int{0}, []float64{4})},
+		{name: "laplacian", a: laplacian2D(9)},
+		{name: "random-sparse", a: randSparseSPD(50, 0.05, rnd)},
+		{name: "random-dense", a: randSparseSPD(20, 0.8, rnd)},
+	} {
+		n, _ := test.a.Dims()
+		var want Cholesky
+		if n > 0 {
+			dense := NewSymDense(n, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < n; j++ {
+					dense.SetSym(i, j, test.a.At(i, j))
+				}
+			}
+			want.Factorize(dense)
+		}
+
+		for _, order := range []SparseOrdering{OrderAMD, OrderNestedDissection, OrderNatural} {
+			name := fmt.Sprintf("%s,order=%d", test.name, order)
+			var chol SparseCholesky
+			chol.Analyze(test.a, order)
+			if !chol.Factorize(test.a) {
+				t.Errorf("%s: unexpected factorization failure", name)
+				continue
+			}
+			if chol.SymmetricDim() != n {
+				t.Errorf("%s: unexpected dimension %d", name, chol.SymmetricDim())
+			}
+
+			// Check that P * A * Pᵀ = L * Lᵀ.
+			perm := chol.Permutation(nil)
+			var l CSC
+			chol.LTo(&l)
+			if l.NNZ() != chol.NNZ() {
+				t.Errorf("%s: mismatched number of stored elements", name)
+			}
+			if n > 0 {
+				var llt Dense
+				llt.Mul(&l, l.T())
+				if !EqualApprox(&llt, permuteSym(test.a, perm), 1e-12) {
+					t.Errorf("%s: L * Lᵀ does not match P * A * Pᵀ", name)
+				}
+				for i := 0; i < n; i++ {
+					for j := i + 1; j < n; j++ {
+						if l.At(i, j) != 0 {
+							t.Errorf("%s: L is not lower triangular", name)
+						}
+					}
+				}
+			}
+
+			if n == 0 {
+				continue
+			}
+			if got, want := chol.LogDet(), want.LogDet(); math.Abs(got-want) > 1e-10*math.Max(1, math.Abs(want)) {
+				t.Errorf("%s: unexpected log determinant: got %v, want %v", name, got, want)
+			}
+			if got, want := chol.Det(), math.Exp(want.LogDet()); math.Abs(got-want) > 1e-10*math.Abs(want) {
+				t.Errorf("%s: unexpected determinant: got %v, want %v", name, got, want)
+			}
+			// Both condition numbers are estimates of the same quantity.
+			if got, want := chol.Cond(), want.Cond(); got < want/10 || got > 10*want {
+				t.Errorf("%s: unexpected condition number: got %v, want %v", name, got, want)
+			}
+
+			b := NewDense(n, 3, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < 3; j++ {
+					b.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			var x, r Dense
+			if err := chol.SolveTo(&x, b); err != nil {
+				t.Errorf("%s: unexpected error from SolveTo: %v", name, err)
+			}
+			r.Mul(test.a, &x)
+			if !EqualApprox(&r, b, 1e-12) {
+				t.Errorf("%s: SolveTo residual too large", name)
+			}
+			xv := NewVecDense(n, nil)
+			if err := chol.SolveVecTo(xv, b.ColView(1)); err != nil {
+				t.Errorf("%s: unexpected error from SolveVecTo: %v", name, err)
+			}
+			if !EqualApprox(xv, x.ColView(1), 1e-13) {
+				t.Errorf("%s: SolveVecTo does not match SolveTo", name)
+			}
+			// The receiver may be the right-hand side.
+			bc := DenseCopyOf(b)
+			chol.SolveTo(bc, bc)
+			if !Equal(bc, &x) {
+				t.Errorf("%s: aliased SolveTo does not match", name)
+			}
+		}
+	}
+}
+
+func TestSparseCholeskyFill(t *testing.T) {
+	t.Parallel()
+	a := laplacian2D(30)
+	fill := make(map[SparseOrdering]int)
+	for _, order := range []SparseOrdering{OrderAMD, OrderNestedDissection, OrderNatural} {
+		var chol SparseCholesky
+		chol.Analyze(a, order)
+		fill[order] = chol.NNZ()
+		if !chol.Factorize(a) {
+			t.Fatalf("order=%d: unexpected factorization failure", order)
+		}
+	}
+	// The natural ordering of the grid gives a banded factor with about
+	// k³ elements.
+	if fill[OrderNatural] < 25000 {
+		t.Errorf("unexpected fill for natural ordering: %d", fill[OrderNatural])
+	}
+	for _, order := range []SparseOrdering{OrderAMD, OrderNestedDissection} {
+		if 2*fill[order] > fill[OrderNatural] {
+			t.Errorf("order=%d: fill %d not less than half of natural ordering fill %d", order, fill[order], fill[OrderNatural])
+		}
+	}
+}
+
+func TestSparseCholeskyReuse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := laplacian2D(10)
+	n, _ := a.Dims()
+
+	var chol SparseCholesky
+	chol.Analyze(a, OrderNestedDissection)
+	perm := chol.Permutation(make([]int, n))
+	nnz := chol.NNZ()
+	for iter := 0; iter < 3; iter++ {
+		// Matrices with the same pattern but different values, as
+		// for the Jacobians of a Newton iteration. Explicit zeros are
+		// part of the pattern.
+		var m CSR
+		m.CloneFrom(a)
+		for k := range m.mat.data {
+			m.mat.data[k] *= 1 + 0.1*rnd.Float64()
+		}
+		for i := 0; i < n; i++ {
+			lo, hi := m.mat.indptr[i], m.mat.indptr[i+1]
+			for k := lo; k < hi; k++ {
+				j := m.mat.ind[k]
+				if i == j {
+					m.mat.data[k] = 8
+				} else if j > i {
+					m.mat.data[k] = m.At(j, i)
+				}
+			}
+		}
+		if iter == 2 {
+			m.mat.data[1] = 0
+			m.mat.data[m.mat.indptr[1]] = 0
+		}
+		if !chol.Factorize(&m) {
+			t.Fatalf("iteration %d: unexpected factorization failure", iter)
+		}
+		if chol.NNZ() != nnz || !equalInts(chol.Permutation(nil), perm) {
+			t.Errorf("iteration %d: analysis not reused", iter)
+		}
+		b := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			b.SetVec(i, rnd.NormFloat64())
+		}
+		var x, r VecDense
+		chol.SolveVecTo(&x, b)
+		r.MulVec(&m, &x)
+		if !EqualApprox(&r, b, 1e-12) {
+			t.Errorf("iteration %d: residual too large", iter)
+		}
+	}
+
+	// An element outside the analyzed pattern is not allowed.
+	d := DenseCopyOf(a)
+	d.Set(0, n-1, 0.5)
+	d.Set(n-1, 0, 0.5)
+	if panicked, _ := panics(func() { chol.Factorize(d) }); !panicked {
+		t.Errorf("expected panic for element outside of analyzed pattern")
+	}
+	// A different size is not allowed.
+	if panicked, _ := panics(func() { chol.Factorize(laplacian2D(3)) }); !panicked {
+		t.Errorf("expected panic for different size")
+	}
+	// Reset discards the analysis.
+	chol.Reset()
+	if !chol.Factorize(laplacian2D(3)) {
+		t.Errorf("unexpected factorization failure after Reset")
+	}
+}
+
+func TestSparseCholeskyNotPD(t *testing.T) {
+	t.Parallel()
+	a := laplacian2D(5)
+	var m CSR
+	m.Scale(-1, a)
+	var chol SparseCholesky
+	if chol.Factorize(&m) {
+		t.Errorf("expected failure for negative definite matrix")
+	}
+	if panicked, _ := panics(func() { chol.LogDet() }); !panicked {
+		t.Errorf("expected panic for use of failed factorization")
+	}
+	var x VecDense
+	if panicked, _ := panics(func() { chol.SolveVecTo(&x, NewVecDense(25, nil)) }); !panicked {
+		t.Errorf("expected panic for use of failed factorization")
+	}
+	// The receiver can be reused after a failure.
+	if !chol.Factorize(a) {
+		t.Errorf("unexpected factorization failure")
+	}
+	if panicked, _ := panics(func() { chol.Analyze(NewDense(2, 3, nil), OrderAMD) }); !panicked {
+		t.Errorf("expected panic for non-square matrix")
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if b[i] != v {
+			return false
+		}
+	}
+	return true
+}
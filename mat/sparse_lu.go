@@ -0,0 +1,589 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// sparseLUPivotTol is the threshold for the partial pivoting of SparseLU. The
+// diagonal element is chosen as the pivot if its magnitude is at least
+// sparseLUPivotTol times the largest magnitude of the candidates.
+const sparseLUPivotTol = 0.1
+
+// SparseLU is a sparse square n×n matrix represented by its LU factorization
+// with threshold partial pivoting
+//
+//	P * A * Q = L * U
+//
+// where P and Q are permutation matrices, L is a sparse lower triangular
+// matrix with unit diagonal elements and U is a sparse upper triangular matrix.
+//
+// The factorization is computed in two phases. Analyze computes the column
+// permutation Q from the sparsity pattern of A using a fill-reducing ordering
+// of A+Aᵀ, and Factorize computes L, U and the row permutation P with the
+// left-looking algorithm of Gilbert and Peierls. The result of Analyze can be
+// reused for any number of matrices with the same sparsity pattern, as for
+// example the Jacobians in a sequence of Newton iterations, and the storage of
+// the factors is reused across calls to Factorize.
+//
+// Row k of the permuted matrix is chosen as the pivot of column k whenever
+// its magnitude is not much smaller than the largest candidate, so that the
+// ordering of A+Aᵀ is preserved for matrices with a strong diagonal.
+type SparseLU struct {
+	n int
+
+	// q[k] is the column of A that is at position k
+	// in A * Q, and pinv[i] is the position of row i
+	// of A in P * A.
+	q        []int
+	pinv     []int
+	analyzed bool
+
+	// l and u hold L and U in compressed sparse column
+	// form. The diagonal element of L is first and that
+	// of U is last in each column. The row indices are
+	// not sorted.
+	l, u compressed
+	ok   bool // Whether A is nonsingular
+	cond float64
+}
+
+// Analyze computes the fill-reducing column permutation of the sparse square
+// matrix A using the given ordering of the pattern of A+Aᵀ. Only the sparsity
+// pattern of a is used.
+//
+// Analyze will panic if a is not square.
+func (lu *SparseLU) Analyze(a Matrix, order SparseOrdering) {
+	n, nc := a.Dims()
+	if n != nc {
+		panic(ErrSquare)
+	}
+	ptr, ind := symmetricPattern(sparseCols(a))
+	lu.n = n
+	lu.q = fillReducingOrder(order, n, ptr, ind)
+	lu.pinv = lu.pinv[:0]
+	lu.analyzed = true
+	lu.ok = false
+	lu.cond = math.Inf(1)
+}
+
+// Factorize computes the LU factorization of the sparse square matrix A. If A
+// is singular, the factorization is stopped at the first column that does not
+// have a non-zero pivot and subsequent solves will return a Condition error.
+//
+// If the receiver does not hold the result of a previous call to Analyze,
+// Factorize first analyzes a using OrderAMD. Factorize will panic if a is not
+// square or its size differs from the analyzed matrix.
+func (lu *SparseLU) Factorize(a Matrix) {
+	n, nc := a.Dims()
+	if n != nc {
+		panic(ErrSquare)
+	}
+	if !lu.analyzed {
+		lu.Analyze(a, OrderAMD)
+	}
+	if n != lu.n {
+		panic(ErrShape)
+	}
+	cols := sparseCols(a)
+	var anorm float64
+	for j := 0; j < n; j++ {
+		anorm = max(anorm, floats.Norm(cols.data[cols.indptr[j]:cols.indptr[j+1]], 1))
+	}
+
+	lu.pinv = useInt(lu.pinv, n)
+	for i := range lu.pinv {
+		lu.pinv[i] = -1
+	}
+	l := &lu.l
+	u := &lu.u
+	*l = compressed{
+		major:  n,
+		minor:  n,
+		indptr: useInt(l.indptr, n+1),
+		ind:    l.ind[:0],
+		data:   l.data[:0],
+	}
+	*u = compressed{
+		major:  n,
+		minor:  n,
+		indptr: useInt(u.indptr, n+1),
+		ind:    u.ind[:0],
+		data:   u.data[:0],
+	}
+	lu.ok = false
+	lu.cond = math.Inf(1)
+
+	x := getFloat64s(n, true)
+	defer putFloat64s(x)
+	xi := getInts(2*n, false)
+	defer putInts(xi)
+	mark := make([]bool, n)
+	l.indptr[0] = 0
+	u.indptr[0] = 0
+	for k := 0; k < n; k++ {
+		// Solve L * x = A[:,q[k]] for the rows that have been pivotal.
+		col := lu.q[k]
+		top := lu.reach(cols, col, xi, mark)
+		for _, i := range xi[top:n] {
+			x[i] = 0
+		}
+		for p := cols.indptr[col]; p < cols.indptr[col+1]; p++ {
+			x[cols.ind[p]] = cols.data[p]
+		}
+		for _, j := range xi[top:n] {
+			jl := lu.pinv[j]
+			if jl < 0 {
+				continue
+			}
+			xj := x[j]
+			for p := l.indptr[jl] + 1; p < l.indptr[jl+1]; p++ {
+				x[l.ind[p]] -= l.data[p] * xj
+			}
+		}
+
+		// Find the pivot among the rows that have not been pivotal and
+		// store column k of U.
+		ipiv := -1
+		var amax float64
+		for _, i := range xi[top:n] {
+			if lu.pinv[i] < 0 {
+				if t := math.Abs(x[i]); t > amax {
+					amax = t
+					ipiv = i
+				}
+			} else {
+				u.ind = append(u.ind, lu.pinv[i])
+				u.data = append(u.data, x[i])
+			}
+		}
+		if ipiv == -1 || !(amax > 0) || math.IsInf(amax, 0) {
+			for _, i := range xi[top:n] {
+				x[i] = 0
+			}
+			return
+		}
+		if lu.pinv[col] < 0 && math.Abs(x[col]) >= sparseLUPivotTol*amax {
+			ipiv = col
+		}
+		pivot := x[ipiv]
+		u.ind = append(u.ind, k)
+		u.data = append(u.data, pivot)
+		u.indptr[k+1] = len(u.ind)
+		lu.pinv[ipiv] = k
+
+		// Store column k of L. The row indices are those of A until
+		// the factorization is complete.
+		l.ind = append(l.ind, ipiv)
+		l.data = append(l.data, 1)
+		for _, i := range xi[top:n] {
+			if lu.pinv[i] < 0 {
+				l.ind = append(l.ind, i)
+				l.data = append(l.data, x[i]/pivot)
+			}
+			x[i] = 0
+		}
+		l.indptr[k+1] = len(l.ind)
+	}
+	for p, i := range l.ind {
+		l.ind[p] = lu.pinv[i]
+	}
+	lu.ok = true
+
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	lu.cond = anorm * sparseInvNormEst(n, func(x []float64, trans bool) {
+		lu.solve(x, trans, work)
+	})
+}
+
+// reach computes the rows of the non-zero elements of the solution of
+// L * x = A[:,col] by depth-first search in the graph of L, where the rows of
+// L that have not been pivotal have no outgoing edges. The rows are stored
+// in topological order in xi[top:n] and top is returned. The second half of
+// xi is used as workspace, and mark must be all false on entry and is left
+// all false on return.
+func (lu *SparseLU) reach(a *compressed, col int, xi []int, mark []bool) (top int) {
+	n := lu.n
+	l := &lu.l
+	top = n
+	stack := xi[:n]
+	pstack := xi[n:]
+	for _, root := range a.ind[a.indptr[col]:a.indptr[col+1]] {
+		if mark[root] {
+			continue
+		}
+		head := 0
+		stack[0] = root
+		for head >= 0 {
+			j := stack[head]
+			jl := lu.pinv[j]
+			if !mark[j] {
+				mark[j] = true
+				if jl >= 0 {
+					pstack[head] = l.indptr[jl] + 1
+				}
+			}
+			done := true
+			if jl >= 0 {
+				for p := pstack[head]; p < l.indptr[jl+1]; p++ {
+					i := l.ind[p]
+					if mark[i] {
+						continue
+					}
+					pstack[head] = p + 1
+					head++
+					stack[head] = i
+					done = false
+					break
+				}
+			}
+			if done {
+				head--
+				top--
+				// The finished nodes are stored from the end of
+				// xi, which does not overlap the active part of
+				// the stack since each node is on the stack at
+				// most once.
+				stack[top] = j
+			}
+		}
+	}
+	for _, i := range xi[top:n] {
+		mark[i] = false
+	}
+	return top
+}
+
+// valid returns whether the receiver holds a factorization.
+func (lu *SparseLU) valid() bool {
+	return lu.analyzed && len(lu.pinv) == lu.n
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation. Reset discards the result of Analyze.
+func (lu *SparseLU) Reset() {
+	*lu = SparseLU{cond: math.Inf(1)}
+}
+
+// Dims returns the dimensions of the factorized matrix.
+func (lu *SparseLU) Dims() (r, c int) {
+	return lu.n, lu.n
+}
+
+// NNZ returns the total number of stored elements in the factors L and U,
+// including their diagonals.
+func (lu *SparseLU) NNZ() int {
+	if !lu.ok {
+		return 0
+	}
+	return lu.l.nnz() + lu.u.nnz()
+}
+
+// Cond returns an estimate of the condition number of the factorized matrix in
+// the 1-norm. Cond returns +Inf if the matrix is singular. Cond will panic if
+// the receiver does not contain a factorization.
+func (lu *SparseLU) Cond() float64 {
+	if !lu.valid() {
+		panic(badLU)
+	}
+	return lu.cond
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (lu *SparseLU) Det() float64 {
+	if !lu.valid() {
+		panic(badLU)
+	}
+	if !lu.ok {
+		return 0
+	}
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. LogDet will panic if the receiver
+// does not contain a factorization.
+func (lu *SparseLU) LogDet() (det float64, sign float64) {
+	if !lu.valid() {
+		panic(badLU)
+	}
+	if !lu.ok {
+		return math.Inf(-1), 0
+	}
+	sign = float64(permSign(lu.pinv) * permSign(lu.q))
+	for k := 0; k < lu.n; k++ {
+		v := lu.u.data[lu.u.indptr[k+1]-1]
+		if v < 0 {
+			sign = -sign
+		}
+		det += math.Log(math.Abs(v))
+	}
+	return det, sign
+}
+
+// permSign returns the sign of the permutation p.
+func permSign(p []int) int {
+	visited := make([]bool, len(p))
+	sign := 1
+	for i := range p {
+		if visited[i] {
+			continue
+		}
+		var length int
+		for j := i; !visited[j]; j = p[j] {
+			visited[j] = true
+			length++
+		}
+		if length%2 == 0 {
+			sign = -sign
+		}
+	}
+	return sign
+}
+
+// RowPivots returns the row permutation P of the factorization
+//
+//	P * A * Q = L * U.
+//
+// Element k of the returned slice is the row of A that is at position k in
+// P * A. If dst is nil, a new slice is allocated and returned. If dst is not
+// nil and its length does not equal the size of the factorized matrix,
+// RowPivots will panic. RowPivots will panic if the receiver does not contain
+// a successful factorization.
+func (lu *SparseLU) RowPivots(dst []int) []int {
+	if !lu.ok {
+		panic(badLU)
+	}
+	if dst == nil {
+		dst = make([]int, lu.n)
+	}
+	if len(dst) != lu.n {
+		panic(badSliceLength)
+	}
+	for i, k := range lu.pinv {
+		dst[k] = i
+	}
+	return dst
+}
+
+// ColPivots returns the column permutation Q of the factorization
+//
+//	P * A * Q = L * U.
+//
+// Element k of the returned slice is the column of A that is at position k in
+// A * Q. If dst is nil, a new slice is allocated and returned. If dst is not
+// nil and its length does not equal the size of the factorized matrix,
+// ColPivots will panic. ColPivots will panic if the receiver does not contain
+// a successful factorization.
+func (lu *SparseLU) ColPivots(dst []int) []int {
+	if !lu.ok {
+		panic(badLU)
+	}
+	if dst == nil {
+		dst = make([]int, lu.n)
+	}
+	if len(dst) != lu.n {
+		panic(badSliceLength)
+	}
+	copy(dst, lu.q)
+	return dst
+}
+
+// LTo stores the unit lower triangular factor L into dst. LTo will panic if
+// the receiver does not contain a successful factorization.
+func (lu *SparseLU) LTo(dst *CSC) {
+	if !lu.ok {
+		panic(badLU)
+	}
+	dst.mat = sortedClone(&lu.l)
+}
+
+// UTo stores the upper triangular factor U into dst. UTo will panic if the
+// receiver does not contain a successful factorization.
+func (lu *SparseLU) UTo(dst *CSC) {
+	if !lu.ok {
+		panic(badLU)
+	}
+	dst.mat = sortedClone(&lu.u)
+}
+
+// sortedClone returns a copy of c with the minor indices of each major index
+// sorted.
+func sortedClone(c *compressed) compressed {
+	s := c.clone()
+	for p := 0; p < s.major; p++ {
+		lo, hi := s.indptr[p], s.indptr[p+1]
+		sort.Sort(minorSorter{ind: s.ind[lo:hi], data: s.data[lo:hi]})
+	}
+	return s
+}
+
+// minorSorter sorts the elements of a major index of a compressed matrix by
+// their minor index.
+type minorSorter struct {
+	ind  []int
+	data []float64
+}
+
+func (s minorSorter) Len() int           { return len(s.ind) }
+func (s minorSorter) Less(i, j int) bool { return s.ind[i] < s.ind[j] }
+func (s minorSorter) Swap(i, j int) {
+	s.ind[i], s.ind[j] = s.ind[j], s.ind[i]
+	s.data[i], s.data[j] = s.data[j], s.data[i]
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᵀ * X = B  if trans == true
+//
+// using the sparse LU factorization of A stored in the receiver. The solution
+// matrix X is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveTo will panic if the
+// receiver does not contain a factorization.
+func (lu *SparseLU) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !lu.valid() {
+		panic(badLU)
+	}
+	br, bc := b.Dims()
+	if br != lu.n {
+		panic(ErrShape)
+	}
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+	dst.reuseAsNonZeroed(br, bc)
+	if b != dst {
+		dst.Copy(b)
+	}
+	x := getFloat64s(lu.n, false)
+	defer putFloat64s(x)
+	work := getFloat64s(lu.n, false)
+	defer putFloat64s(work)
+	for j := 0; j < bc; j++ {
+		for i := range x {
+			x[i] = dst.at(i, j)
+		}
+		lu.solve(x, trans, work)
+		for i, v := range x {
+			dst.set(i, j, v)
+		}
+	}
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b   if trans == false
+//	Aᵀ * x = b  if trans == true
+//
+// using the sparse LU factorization of A stored in the receiver. The solution
+// vector x is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveVecTo will panic if
+// the receiver does not contain a factorization.
+func (lu *SparseLU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !lu.valid() {
+		panic(badLU)
+	}
+	if br, bc := b.Dims(); br != lu.n || bc != 1 {
+		panic(ErrShape)
+	}
+	if !lu.ok {
+		return Condition(math.Inf(1))
+	}
+	if rv, ok := b.(RawVectorer); ok && dst != b {
+		dst.checkOverlap(rv.RawVector())
+	}
+	dst.reuseAsNonZeroed(lu.n)
+	if dst != b {
+		dst.CopyVec(b)
+	}
+	x := getFloat64s(lu.n, false)
+	defer putFloat64s(x)
+	work := getFloat64s(lu.n, false)
+	defer putFloat64s(work)
+	for i := range x {
+		x[i] = dst.at(i)
+	}
+	lu.solve(x, trans, work)
+	for i, v := range x {
+		dst.setVec(i, v)
+	}
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// solve overwrites x with the solution of A * x = b, or of Aᵀ * x = b if trans
+// is true, where x holds b on entry. The length of work must be at least n.
+func (lu *SparseLU) solve(x []float64, trans bool, work []float64) {
+	n := lu.n
+	l := &lu.l
+	u := &lu.u
+	w := work[:n]
+	if !trans {
+		// x = Q * U⁻¹ * L⁻¹ * P * b.
+		for i, k := range lu.pinv {
+			w[k] = x[i]
+		}
+		for j := 0; j < n; j++ {
+			wj := w[j]
+			for p := l.indptr[j] + 1; p < l.indptr[j+1]; p++ {
+				w[l.ind[p]] -= l.data[p] * wj
+			}
+		}
+		for j := n - 1; j >= 0; j-- {
+			diag := u.indptr[j+1] - 1
+			w[j] /= u.data[diag]
+			wj := w[j]
+			for p := u.indptr[j]; p < diag; p++ {
+				w[u.ind[p]] -= u.data[p] * wj
+			}
+		}
+		for k, j := range lu.q {
+			x[j] = w[k]
+		}
+		return
+	}
+
+	// x = Pᵀ * L⁻ᵀ * U⁻ᵀ * Qᵀ * b.
+	for k, j := range lu.q {
+		w[k] = x[j]
+	}
+	for j := 0; j < n; j++ {
+		diag := u.indptr[j+1] - 1
+		wj := w[j]
+		for p := u.indptr[j]; p < diag; p++ {
+			wj -= u.data[p] * w[u.ind[p]]
+		}
+		w[j] = wj / u.data[diag]
+	}
+	for j := n - 1; j >= 0; j-- {
+		wj := w[j]
+		for p := l.indptr[j] + 1; p < l.indptr[j+1]; p++ {
+			wj -= l.data[p] * w[l.ind[p]]
+		}
+		w[j] = wj
+	}
+	for i, k := range lu.pinv {
+		x[i] = w[k]
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// convectionDiffusion2D returns the matrix of an upwind discretization of a
+// convection-diffusion operator on a k×k grid, which is structurally
+// symmetric but not symmetric.
+func convectionDiffusion2D(k int, wind float64) *CSR {
+	n := k * k
+	coo := NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			p := i*k + j
+			coo.Append(p, p, 4+wind)
+			if i > 0 {
+				coo.Append(p, p-k, -1-wind)
+			}
+			if i < k-1 {
+				coo.Append(p, p+k, -1)
+			}
+			if j > 0 {
+				coo.Append(p, p-1, -1)
+			}
+			if j < k-1 {
+				coo.Append(p, p+1, -1)
+			}
+		}
+	}
+	return csrOf(coo)
+}
+
+func TestSparseLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name string
+		a    Matrix
+	}{
+		{name: "empty", a: NewCSR(0, 0, nil, nil, nil)},
+		{name: "single", a: NewCSC(1, 1, []int{0, 1}, []int{0}, []float64{-3})},
+		{name: "convection", a: convectionDiffusion2D(8, 2)},
+		{name: "random", a: func() Matrix {
+			a := randSparseDense(40, 40, 0.08, rnd)
+			for i := 0; i < 40; i++ {
+				a.Set(i, i, rnd.NormFloat64())
+			}
+			return cscOf(a)
+		}()},
+		{name: "zero-diagonal", a: func() Matrix {
+			// A row permutation of a well conditioned matrix
+			// with a zero diagonal requires pivoting.
+			a := randSparseDense(30, 30, 0.1, rnd)
+			for i := 0; i < 30; i++ {
+				a.Set(i, i, 10)
+			}
+			p := NewDense(30, 30, nil)
+			for i := 0; i < 30; i++ {
+				p.SetRow((i+1)%30, a.RawRowView(i))
+			}
+			return csrOf(p)
+		}()},
+		{name: "transpose", a: csrOf(randSparseSPD(25, 0.1, rnd)).T()},
+		{name: "dense", a: func() Matrix {
+			a := randSparseDense(15, 15, 1, rnd)
+			return a
+		}()},
+	} {
+		n, _ := test.a.Dims()
+		for _, order := range []SparseOrdering{OrderAMD, OrderNestedDissection, OrderNatural} {
+			name := fmt.Sprintf("%s,order=%d", test.name, order)
+			var lu SparseLU
+			lu.Analyze(test.a, order)
+			lu.Factorize(test.a)
+			if r, c := lu.Dims(); r != n || c != n {
+				t.Errorf("%s: unexpected dimensions %d×%d", name, r, c)
+			}
+			if n == 0 {
+				continue
+			}
+
+			// Check that P * A * Q = L * U.
+			rows := lu.RowPivots(nil)
+			cols := lu.ColPivots(nil)
+			var l, u CSC
+			lu.LTo(&l)
+			lu.UTo(&u)
+			if l.NNZ()+u.NNZ() != lu.NNZ() {
+				t.Errorf("%s: mismatched number of stored elements", name)
+			}
+			paq := NewDense(n, n, nil)
+			for k, i := range rows {
+				for m, j := range cols {
+					paq.Set(k, m, test.a.At(i, j))
+				}
+			}
+			var prod Dense
+			prod.Mul(&l, &u)
+			if !EqualApprox(&prod, paq, 1e-12) {
+				t.Errorf("%s: L * U does not match P * A * Q", name)
+			}
+			for i := 0; i < n; i++ {
+				if l.At(i, i) != 1 {
+					t.Errorf("%s: L does not have unit diagonal", name)
+				}
+				for j := i + 1; j < n; j++ {
+					if l.At(i, j) != 0 || u.At(j, i) != 0 {
+						t.Errorf("%s: factors not triangular", name)
+					}
+				}
+				for j := 0; j < i; j++ {
+					if math.Abs(l.At(i, j)) > 1/sparseLUPivotTol {
+						t.Errorf("%s: element of L exceeds pivot threshold", name)
+					}
+				}
+			}
+
+			var want LU
+			want.Factorize(test.a)
+			if got, want := lu.Det(), want.Det(); math.Abs(got-want) > 1e-10*math.Abs(want) {
+				t.Errorf("%s: unexpected determinant: got %v, want %v", name, got, want)
+			}
+			if got, want := lu.Cond(), want.Cond(); got < want/10 || got > 10*want {
+				t.Errorf("%s: unexpected condition number: got %v, want %v", name, got, want)
+			}
+
+			for _, trans := range []bool{false, true} {
+				b := NewDense(n, 2, nil)
+				for i := 0; i < n; i++ {
+					b.Set(i, 0, rnd.NormFloat64())
+					b.Set(i, 1, rnd.NormFloat64())
+				}
+				var x, r Dense
+				if err := lu.SolveTo(&x, trans, b); err != nil {
+					t.Errorf("%s,trans=%t: unexpected error from SolveTo: %v", name, trans, err)
+				}
+				if trans {
+					r.Mul(test.a.T(), &x)
+				} else {
+					r.Mul(test.a, &x)
+				}
+				if !EqualApprox(&r, b, 1e-10) {
+					t.Errorf("%s,trans=%t: SolveTo residual too large", name, trans)
+				}
+				var xv VecDense
+				if err := lu.SolveVecTo(&xv, trans, b.ColView(1)); err != nil {
+					t.Errorf("%s,trans=%t: unexpected error from SolveVecTo: %v", name, trans, err)
+				}
+				if !EqualApprox(&xv, x.ColView(1), 1e-13) {
+					t.Errorf("%s,trans=%t: SolveVecTo does not match SolveTo", name, trans)
+				}
+			}
+		}
+	}
+}
+
+func TestSparseLUReuse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := convectionDiffusion2D(12, 1)
+	n, _ := a.Dims()
+
+	var lu SparseLU
+	lu.Analyze(a, OrderNestedDissection)
+	for iter := 0; iter < 3; iter++ {
+		var m CSR
+		m.Scale(1, a)
+		for k := range m.mat.data {
+			m.mat.data[k] *= 1 + rnd.Float64()
+		}
+		lu.Factorize(&m)
+		b := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			b.SetVec(i, rnd.NormFloat64())
+		}
+		var x, r VecDense
+		if err := lu.SolveVecTo(&x, false, b); err != nil {
+			t.Errorf("iteration %d: unexpected error: %v", iter, err)
+		}
+		r.MulVec(&m, &x)
+		if !EqualApprox(&r, b, 1e-11) {
+			t.Errorf("iteration %d: residual too large", iter)
+		}
+	}
+	if panicked, _ := panics(func() { lu.Factorize(convectionDiffusion2D(3, 1)) }); !panicked {
+		t.Errorf("expected panic for different size")
+	}
+	lu.Reset()
+	lu.Factorize(convectionDiffusion2D(3, 1))
+	if _, c := lu.Dims(); c != 9 {
+		t.Errorf("unexpected size after Reset: %d", c)
+	}
+}
+
+func TestSparseLUSingular(t *testing.T) {
+	t.Parallel()
+	a := convectionDiffusion2D(5, 1)
+	d := DenseCopyOf(a)
+	for i := 0; i < 25; i++ {
+		d.Set(i, 7, 0)
+	}
+	for _, m := range []Matrix{d, cscOf(d)} {
+		var lu SparseLU
+		lu.Factorize(m)
+		if !math.IsInf(lu.Cond(), 1) {
+			t.Errorf("unexpected condition number for singular matrix: %v", lu.Cond())
+		}
+		if lu.Det() != 0 {
+			t.Errorf("unexpected determinant for singular matrix: %v", lu.Det())
+		}
+		var x VecDense
+		err := lu.SolveVecTo(&x, false, NewVecDense(25, nil))
+		if c, ok := err.(Condition); !ok || !math.IsInf(float64(c), 1) {
+			t.Errorf("expected infinite Condition error, got %v", err)
+		}
+		if panicked, _ := panics(func() { lu.RowPivots(nil) }); !panicked {
+			t.Errorf("expected panic for pivots of singular matrix")
+		}
+	}
+
+	var lu SparseLU
+	if panicked, _ := panics(func() { lu.Cond() }); !panicked {
+		t.Errorf("expected panic for unfactorized receiver")
+	}
+	if panicked, _ := panics(func() { lu.Factorize(NewDense(2, 3, nil)) }); !panicked {
+		t.Errorf("expected panic for non-square matrix")
+	}
+}
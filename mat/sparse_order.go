@@ -0,0 +1,503 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+// SparseOrdering specifies the fill-reducing ordering that is applied to the
+// rows and columns of a sparse matrix before it is factorized.
+//
+// The orderings are computed from the symmetric sparsity pattern of A+Aᵀ,
+// which is the adjacency structure of an undirected graph with one node for
+// each row of A.
+type SparseOrdering int
+
+const (
+	// OrderAMD orders the matrix by approximate minimum degree. At each
+	// step the node with the smallest approximate external degree in the
+	// graph of the partially eliminated matrix is ordered next. OrderAMD
+	// is a good general purpose ordering.
+	OrderAMD SparseOrdering = iota
+
+	// OrderNestedDissection orders the matrix by recursively splitting
+	// its graph into two parts with a vertex separator found from a
+	// breadth-first level structure. The separator is ordered after
+	// both parts. Small parts are ordered by approximate minimum degree.
+	// OrderNestedDissection usually gives less fill than OrderAMD for
+	// matrices arising from the discretization of two and three
+	// dimensional meshes.
+	OrderNestedDissection
+
+	// OrderNatural keeps the original order of the rows and columns.
+	OrderNatural
+)
+
+const badOrdering = "mat: invalid sparse ordering"
+
+// ndLeafSize is the size of the subgraphs below which nested dissection
+// switches to minimum degree ordering.
+const ndLeafSize = 64
+
+// symmetricPattern returns the adjacency structure of the graph of A+Aᵀ,
+// excluding self loops, where a holds A in compressed form. The neighbors of
+// node i are held in ind[ptr[i]:ptr[i+1]].
+func symmetricPattern(a *compressed) (ptr, ind []int) {
+	n := a.major
+	ptr = make([]int, n+1)
+	nz := a.nnz()
+	for p := 0; p < n; p++ {
+		for _, q := range a.ind[a.indptr[p]:a.indptr[p+1]] {
+			if q != p {
+				ptr[p+1]++
+				ptr[q+1]++
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		ptr[i+1] += ptr[i]
+	}
+	next := make([]int, n)
+	copy(next, ptr[:n])
+	all := make([]int, ptr[n])
+	for p := 0; p < n; p++ {
+		for _, q := range a.ind[a.indptr[p]:a.indptr[p+1]] {
+			if q != p {
+				all[next[p]] = q
+				next[p]++
+				all[next[q]] = p
+				next[q]++
+			}
+		}
+	}
+
+	// Remove the duplicates that arise from structurally
+	// symmetric pairs of elements.
+	mark := next
+	for i := range mark {
+		mark[i] = -1
+	}
+	ind = make([]int, 0, min(ptr[n], 2*nz))
+	start := 0
+	for i := 0; i < n; i++ {
+		end := ptr[i+1]
+		ptr[i] = len(ind)
+		for _, j := range all[start:end] {
+			if mark[j] != i {
+				mark[j] = i
+				ind = append(ind, j)
+			}
+		}
+		start = end
+	}
+	ptr[n] = len(ind)
+	return ptr, ind
+}
+
+// fillReducingOrder returns the permutation computed by the given ordering
+// for the graph with n nodes and the adjacency structure ptr, ind. Element k
+// of the returned slice is the node that is ordered at position k.
+func fillReducingOrder(order SparseOrdering, n int, ptr, ind []int) []int {
+	switch order {
+	default:
+		panic(badOrdering)
+	case OrderNatural:
+		perm := make([]int, n)
+		for i := range perm {
+			perm[i] = i
+		}
+		return perm
+	case OrderAMD:
+		return minDegreeOrder(n, ptr, ind)
+	case OrderNestedDissection:
+		return nestedDissectionOrder(n, ptr, ind)
+	}
+}
+
+// minDegreeOrder returns an approximate minimum degree ordering of the graph
+// with n nodes and the adjacency structure ptr, ind.
+//
+// The elimination is simulated on a quotient graph in which each eliminated
+// node is represented by an element whose members are the uneliminated nodes
+// that form a clique after its elimination. The external degree of a node is
+// bounded from above as in the AMD algorithm of Amestoy, Davis and Duff, using
+// the sizes of the adjacent elements outside the most recently formed element.
+// Elements that become subsets of the new element are absorbed.
+func minDegreeOrder(n int, ptr, ind []int) []int {
+	perm := make([]int, 0, n)
+	if n == 0 {
+		return perm
+	}
+
+	// adj[i] holds the nodes adjacent to node i that are not covered by an
+	// element, elems[i] the elements adjacent to node i and members[e] the
+	// nodes of element e. The lists may hold stale entries which are
+	// removed when they are next visited.
+	adj := make([][]int, n)
+	elems := make([][]int, n)
+	members := make([][]int, n)
+	for i := 0; i < n; i++ {
+		adj[i] = append([]int(nil), ind[ptr[i]:ptr[i+1]]...)
+	}
+	eliminated := make([]bool, n)
+	absorbed := make([]bool, n)
+
+	// The nodes are held in doubly linked lists of equal degree.
+	degree := make([]int, n)
+	head := make([]int, n)
+	next := make([]int, n)
+	prev := make([]int, n)
+	for d := range head {
+		head[d] = -1
+	}
+	insert := func(i int) {
+		d := degree[i]
+		next[i] = head[d]
+		prev[i] = -1
+		if head[d] >= 0 {
+			prev[head[d]] = i
+		}
+		head[d] = i
+	}
+	remove := func(i int) {
+		if prev[i] >= 0 {
+			next[prev[i]] = next[i]
+		} else {
+			head[degree[i]] = next[i]
+		}
+		if next[i] >= 0 {
+			prev[next[i]] = prev[i]
+		}
+	}
+	for i := 0; i < n; i++ {
+		degree[i] = len(adj[i])
+		insert(i)
+	}
+
+	// flag marks the members of the new element and ext holds the number of
+	// members of an element outside of it.
+	flag := make([]int, n)
+	for i := range flag {
+		flag[i] = -1
+	}
+	ext := make([]int, n)
+	extFlag := make([]int, n)
+	for i := range extFlag {
+		extFlag[i] = -1
+	}
+
+	mindeg := 0
+	for k := 0; k < n; k++ {
+		for head[mindeg] < 0 {
+			mindeg++
+		}
+		p := head[mindeg]
+		remove(p)
+		perm = append(perm, p)
+		eliminated[p] = true
+
+		// Form the new element from the neighbors of p and absorb the
+		// elements adjacent to p.
+		var lp []int
+		flag[p] = k
+		for _, e := range elems[p] {
+			if absorbed[e] {
+				continue
+			}
+			for _, i := range members[e] {
+				if !eliminated[i] && flag[i] != k {
+					flag[i] = k
+					lp = append(lp, i)
+				}
+			}
+			absorbed[e] = true
+			members[e] = nil
+		}
+		for _, i := range adj[p] {
+			if !eliminated[i] && flag[i] != k {
+				flag[i] = k
+				lp = append(lp, i)
+			}
+		}
+		adj[p] = nil
+		elems[p] = nil
+		members[p] = lp
+
+		// Compute the number of members of each adjacent element that
+		// lie outside the new element.
+		for _, i := range lp {
+			for _, e := range elems[i] {
+				if absorbed[e] {
+					continue
+				}
+				if extFlag[e] != k {
+					extFlag[e] = k
+					ext[e] = len(members[e])
+				}
+				ext[e]--
+			}
+		}
+
+		// Update the quotient graph and the approximate degree of the
+		// members of the new element.
+		remaining := n - k - 1
+		for _, i := range lp {
+			remove(i)
+			var d int
+			w := 0
+			for _, e := range elems[i] {
+				if absorbed[e] {
+					continue
+				}
+				if ext[e] == 0 {
+					// Element e is a subset of the new element.
+					absorbed[e] = true
+					members[e] = nil
+					continue
+				}
+				d += ext[e]
+				elems[i][w] = e
+				w++
+			}
+			elems[i] = append(elems[i][:w], p)
+			w = 0
+			for _, j := range adj[i] {
+				if eliminated[j] || flag[j] == k {
+					continue
+				}
+				d++
+				adj[i][w] = j
+				w++
+			}
+			adj[i] = adj[i][:w]
+			d += len(lp) - 1
+			degree[i] = min(d, degree[i]+len(lp)-1, remaining-1)
+			insert(i)
+			mindeg = min(mindeg, degree[i])
+		}
+	}
+	return perm
+}
+
+// nestedDissectionOrder returns a nested dissection ordering of the graph with
+// n nodes and the adjacency structure ptr, ind.
+func nestedDissectionOrder(n int, ptr, ind []int) []int {
+	nd := dissector{
+		ptr:   ptr,
+		ind:   ind,
+		label: make([]int, n),
+		level: make([]int, n),
+		perm:  make([]int, 0, n),
+	}
+	for i := range nd.label {
+		nd.label[i] = -1
+	}
+	nodes := make([]int, n)
+	for i := range nodes {
+		nodes[i] = i
+	}
+	nd.dissect(nodes)
+	return nd.perm
+}
+
+// dissector holds the state of a nested dissection ordering. Each subgraph
+// that is considered is given a new label, and the nodes of the subgraph are
+// marked with it so that searches do not leave the subgraph.
+type dissector struct {
+	ptr, ind []int
+
+	label []int
+	nlab  int
+	level []int
+
+	perm []int
+}
+
+// dissect appends the nested dissection ordering of the subgraph induced by
+// nodes to the ordering.
+func (nd *dissector) dissect(nodes []int) {
+	if len(nodes) <= ndLeafSize {
+		nd.minDegree(nodes)
+		return
+	}
+	id := nd.relabel(nodes)
+
+	// Order each connected component separately.
+	levels := nd.levelStructure(nodes[0], id)
+	if size := levelSize(levels); size < len(nodes) {
+		id = nd.relabel(nodes)
+		for _, root := range nodes {
+			if nd.label[root] != id {
+				continue
+			}
+			comp := levelNodes(nd.levelStructure(root, id))
+			for _, i := range comp {
+				nd.label[i] = -1
+			}
+			nd.dissect(comp)
+		}
+		return
+	}
+
+	levels = nd.levelStructure(nd.pseudoPeripheral(nodes[0], id), id)
+	if len(levels) < 3 {
+		// The graph has no small separator.
+		nd.minDegree(nodes)
+		return
+	}
+
+	// Split at the level that holds the median node. The separator is
+	// the part of that level that is adjacent to the following level.
+	var count, m int
+	for m = 0; m < len(levels)-2; m++ {
+		count += len(levels[m])
+		if 2*count >= len(nodes) {
+			break
+		}
+	}
+	m = max(m, 1)
+	for l, lev := range levels {
+		for _, i := range lev {
+			nd.level[i] = l
+		}
+	}
+	var part, sep []int
+	for _, lev := range levels[:m] {
+		part = append(part, lev...)
+	}
+	for _, i := range levels[m] {
+		isSep := false
+		for _, j := range nd.ind[nd.ptr[i]:nd.ptr[i+1]] {
+			if nd.label[j] == id && nd.level[j] == m+1 {
+				isSep = true
+				break
+			}
+		}
+		if isSep {
+			sep = append(sep, i)
+		} else {
+			part = append(part, i)
+		}
+	}
+	var rest []int
+	for _, lev := range levels[m+1:] {
+		rest = append(rest, lev...)
+	}
+	for _, i := range sep {
+		nd.label[i] = -1
+	}
+	nd.dissect(part)
+	nd.dissect(rest)
+	nd.perm = append(nd.perm, sep...)
+}
+
+// relabel marks nodes with a new label and returns it.
+func (nd *dissector) relabel(nodes []int) int {
+	id := nd.nlab
+	nd.nlab++
+	for _, i := range nodes {
+		nd.label[i] = id
+	}
+	return id
+}
+
+// levelStructure returns the breadth-first level structure rooted at root
+// of the connected component of the subgraph labeled id that holds root.
+func (nd *dissector) levelStructure(root, id int) [][]int {
+	// Visited nodes are temporarily given the complement of the label.
+	visited := -id - 2
+	nd.label[root] = visited
+	levels := [][]int{{root}}
+	for {
+		var lev []int
+		for _, i := range levels[len(levels)-1] {
+			for _, j := range nd.ind[nd.ptr[i]:nd.ptr[i+1]] {
+				if nd.label[j] == id {
+					nd.label[j] = visited
+					lev = append(lev, j)
+				}
+			}
+		}
+		if len(lev) == 0 {
+			break
+		}
+		levels = append(levels, lev)
+	}
+	for _, lev := range levels {
+		for _, i := range lev {
+			nd.label[i] = id
+		}
+	}
+	return levels
+}
+
+// pseudoPeripheral returns a node of the subgraph labeled id with a large
+// eccentricity, found with the algorithm of Gibbs, Poole and Stockmeyer as
+// modified by George and Liu.
+func (nd *dissector) pseudoPeripheral(root, id int) int {
+	levels := nd.levelStructure(root, id)
+	for {
+		// Choose the node of minimum degree in the last level.
+		last := levels[len(levels)-1]
+		next, mindeg := -1, -1
+		for _, i := range last {
+			var d int
+			for _, j := range nd.ind[nd.ptr[i]:nd.ptr[i+1]] {
+				if nd.label[j] == id {
+					d++
+				}
+			}
+			if mindeg < 0 || d < mindeg {
+				next, mindeg = i, d
+			}
+		}
+		nextLevels := nd.levelStructure(next, id)
+		if len(nextLevels) <= len(levels) {
+			return root
+		}
+		root, levels = next, nextLevels
+	}
+}
+
+// minDegree appends the minimum degree ordering of the subgraph induced by
+// nodes to the ordering.
+func (nd *dissector) minDegree(nodes []int) {
+	id := nd.relabel(nodes)
+	local := nd.level
+	for k, i := range nodes {
+		local[i] = k
+	}
+	ptr := make([]int, len(nodes)+1)
+	var ind []int
+	for k, i := range nodes {
+		for _, j := range nd.ind[nd.ptr[i]:nd.ptr[i+1]] {
+			if nd.label[j] == id {
+				ind = append(ind, local[j])
+			}
+		}
+		ptr[k+1] = len(ind)
+	}
+	for _, k := range minDegreeOrder(len(nodes), ptr, ind) {
+		nd.perm = append(nd.perm, nodes[k])
+	}
+	for _, i := range nodes {
+		nd.label[i] = -1
+	}
+}
+
+// levelSize returns the number of nodes in a level structure.
+func levelSize(levels [][]int) int {
+	var n int
+	for _, lev := range levels {
+		n += len(lev)
+	}
+	return n
+}
+
+// levelNodes returns the nodes of a level structure in a single slice.
+func levelNodes(levels [][]int) []int {
+	nodes := make([]int, 0, levelSize(levels))
+	for _, lev := range levels {
+		nodes = append(nodes, lev...)
+	}
+	return nodes
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// laplacian2D returns the k²×k² matrix of the five-point finite difference
+// Laplacian on a k×k grid with Dirichlet boundary conditions.
+func laplacian2D(k int) *CSR {
+	n := k * k
+	coo := NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			p := i*k + j
+			coo.Append(p, p, 4)
+			if i > 0 {
+				coo.Append(p, p-k, -1)
+			}
+			if i < k-1 {
+				coo.Append(p, p+k, -1)
+			}
+			if j > 0 {
+				coo.Append(p, p-1, -1)
+			}
+			if j < k-1 {
+				coo.Append(p, p+1, -1)
+			}
+		}
+	}
+	return csrOf(coo)
+}
+
+func TestFillReducingOrder(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name string
+		a    Matrix
+	}{
+		{name: "empty", a: NewCSR(0, 0, nil, nil, nil)},
+		{name: "single", a: NewDense(1, 1, []float64{2})},
+		{name: "diagonal", a: NewDiagDense(10, nil)},
+		{name: "random", a: randSparseDense(40, 40, 0.1, rnd)},
+		{name: "dense", a: randSparseDense(30, 30, 1, rnd)},
+		{name: "laplacian", a: laplacian2D(15)},
+		{name: "disconnected", a: func() Matrix {
+			// Two grids that are not connected to each other.
+			a := NewDense(2*144, 2*144, nil)
+			l := laplacian2D(12)
+			l.DoNonZero(func(i, j int, v float64) {
+				a.Set(i, j, v)
+				a.Set(i+144, j+144, v)
+			})
+			return a
+		}()},
+	} {
+		n, _ := test.a.Dims()
+		ptr, ind := symmetricPattern(sparseCols(test.a))
+		for i := 0; i < n; i++ {
+			for _, j := range ind[ptr[i]:ptr[i+1]] {
+				if j == i {
+					t.Errorf("%s: self loop at node %d", test.name, i)
+				}
+				if test.a.At(i, j) == 0 && test.a.At(j, i) == 0 {
+					t.Errorf("%s: unexpected edge %d-%d", test.name, i, j)
+				}
+			}
+		}
+		for _, order := range []SparseOrdering{OrderAMD, OrderNestedDissection, OrderNatural} {
+			name := fmt.Sprintf("%s,order=%d", test.name, order)
+			perm := fillReducingOrder(order, n, ptr, ind)
+			if len(perm) != n {
+				t.Errorf("%s: unexpected permutation length %d", name, len(perm))
+				continue
+			}
+			seen := make([]bool, n)
+			for _, i := range perm {
+				if i < 0 || n <= i || seen[i] {
+					t.Errorf("%s: invalid permutation %v", name, perm)
+					break
+				}
+				seen[i] = true
+			}
+		}
+	}
+
+	if panicked, _ := panics(func() { fillReducingOrder(-1, 0, []int{0}, nil) }); !panicked {
+		t.Errorf("expected panic for invalid ordering")
+	}
+}
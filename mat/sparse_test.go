@@ -0,0 +1,348 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// randSparseDense returns an r×c Dense with approximately the given fraction of
+// non-zero elements.
+func randSparseDense(r, c int, density float64, rnd *rand.Rand) *Dense {
+	a := NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if rnd.Float64() < density {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+	}
+	return a
+}
+
+func csrOf(a Matrix) *CSR {
+	var m CSR
+	m.CloneFrom(a)
+	return &m
+}
+
+func cscOf(a Matrix) *CSC {
+	var m CSC
+	m.CloneFrom(a)
+	return &m
+}
+
+func TestSparseCloneFrom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, r := range []int{1, 2, 5, 17} {
+		for _, c := range []int{1, 3, 5, 13} {
+			for _, density := range []float64{0, 0.1, 0.5, 1} {
+				a := randSparseDense(r, c, density, rnd)
+				var nnz int
+				for _, v := range a.RawMatrix().Data {
+					if v != 0 {
+						nnz++
+					}
+				}
+
+				var coo COO
+				coo.CloneFrom(a)
+				var csrFromDense, csrFromCOO, csrFromCSC CSR
+				csrFromDense.CloneFrom(a)
+				csrFromCOO.CloneFrom(&coo)
+				var cscFromDense, cscFromCOO, cscFromCSR CSC
+				cscFromDense.CloneFrom(a)
+				cscFromCOO.CloneFrom(&coo)
+				cscFromCSR.CloneFrom(&csrFromDense)
+				csrFromCSC.CloneFrom(&cscFromDense)
+
+				for _, test := range []struct {
+					name string
+					m    Matrix
+					nnz  int
+				}{
+					{name: "COO", m: &coo, nnz: coo.NNZ()},
+					{name: "CSR from Dense", m: &csrFromDense, nnz: csrFromDense.NNZ()},
+					{name: "CSR from COO", m: &csrFromCOO, nnz: csrFromCOO.NNZ()},
+					{name: "CSR from CSC", m: &csrFromCSC, nnz: csrFromCSC.NNZ()},
+					{name: "CSC from Dense", m: &cscFromDense, nnz: cscFromDense.NNZ()},
+					{name: "CSC from COO", m: &cscFromCOO, nnz: cscFromCOO.NNZ()},
+					{name: "CSC from CSR", m: &cscFromCSR, nnz: cscFromCSR.NNZ()},
+				} {
+					prefix := fmt.Sprintf("r=%d,c=%d,density=%v,%s", r, c, density, test.name)
+					if test.nnz != nnz {
+						t.Errorf("%s: unexpected number of stored elements: got %d, want %d", prefix, test.nnz, nnz)
+					}
+					if !Equal(test.m, a) {
+						t.Errorf("%s: unexpected value via At:\ngot:\n%v\nwant:\n%v", prefix, Formatted(test.m), Formatted(a))
+					}
+					if !Equal(test.m.T(), a.T()) {
+						t.Errorf("%s: unexpected value of transpose", prefix)
+					}
+					var d Dense
+					d.CloneFrom(test.m)
+					if !Equal(&d, a) {
+						t.Errorf("%s: unexpected value after Dense.CloneFrom", prefix)
+					}
+					var dt Dense
+					dt.CloneFrom(test.m.T())
+					if !Equal(&dt, a.T()) {
+						t.Errorf("%s: unexpected value after Dense.CloneFrom of transpose", prefix)
+					}
+					got := NewDense(r, c, nil)
+					test.m.(NonZeroDoer).DoNonZero(func(i, j int, v float64) {
+						got.Set(i, j, got.At(i, j)+v)
+					})
+					if !Equal(got, a) {
+						t.Errorf("%s: unexpected value via DoNonZero", prefix)
+					}
+					if rnz, ok := test.m.(RowNonZeroDoer); ok {
+						got.Zero()
+						for i := 0; i < r; i++ {
+							rnz.DoRowNonZero(i, func(ii, j int, v float64) {
+								if ii != i {
+									t.Errorf("%s: unexpected row index in DoRowNonZero: got %d, want %d", prefix, ii, i)
+								}
+								got.Set(ii, j, v)
+							})
+						}
+						if !Equal(got, a) {
+							t.Errorf("%s: unexpected value via DoRowNonZero", prefix)
+						}
+					}
+					if cnz, ok := test.m.(ColNonZeroDoer); ok {
+						got.Zero()
+						for j := 0; j < c; j++ {
+							cnz.DoColNonZero(j, func(i, jj int, v float64) {
+								if jj != j {
+									t.Errorf("%s: unexpected column index in DoColNonZero: got %d, want %d", prefix, jj, j)
+								}
+								got.Set(i, jj, v)
+							})
+						}
+						if !Equal(got, a) {
+							t.Errorf("%s: unexpected value via DoColNonZero", prefix)
+						}
+					}
+				}
+
+				if !Equal(csrFromDense.TCSC(), a.T()) {
+					t.Errorf("r=%d,c=%d,density=%v: unexpected value of CSR.TCSC", r, c, density)
+				}
+				if !Equal(cscFromDense.TCSR(), a.T()) {
+					t.Errorf("r=%d,c=%d,density=%v: unexpected value of CSC.TCSR", r, c, density)
+				}
+			}
+		}
+	}
+}
+
+func TestCOODuplicates(t *testing.T) {
+	t.Parallel()
+	coo := NewCOO(3, 4, nil, nil, nil)
+	coo.Append(0, 1, 1)
+	coo.Append(2, 3, 2)
+	coo.Append(0, 1, 3)
+	coo.Append(1, 0, 0)
+	coo.Append(2, 3, -2)
+	want := NewDense(3, 4, []float64{
+		0, 4, 0, 0,
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+	})
+	if !Equal(coo, want) {
+		t.Errorf("unexpected COO value:\ngot:\n%v\nwant:\n%v", Formatted(coo), Formatted(want))
+	}
+	var csr CSR
+	csr.CloneFrom(coo)
+	if !Equal(&csr, want) {
+		t.Errorf("unexpected CSR value:\ngot:\n%v\nwant:\n%v", Formatted(&csr), Formatted(want))
+	}
+	// Duplicates are merged, but explicit zeros from the COO are retained.
+	if csr.NNZ() != 3 {
+		t.Errorf("unexpected number of stored elements: got %d, want 3", csr.NNZ())
+	}
+}
+
+func TestNewCSRPanics(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		r, c   int
+		indptr []int
+		ind    []int
+		data   []float64
+	}{
+		{r: 2, c: 2, indptr: []int{0, 1}, ind: []int{0}, data: []float64{1}},
+		{r: 2, c: 2, indptr: []int{0, 1, 2}, ind: []int{0, 1}, data: []float64{1}},
+		{r: 2, c: 2, indptr: []int{1, 1, 2}, ind: []int{0, 1}, data: []float64{1, 2}},
+		{r: 2, c: 2, indptr: []int{0, 2, 1}, ind: []int{0, 1}, data: []float64{1, 2}},
+		{r: 2, c: 2, indptr: []int{0, 2, 2}, ind: []int{1, 0}, data: []float64{1, 2}},
+		{r: 2, c: 2, indptr: []int{0, 2, 2}, ind: []int{1, 1}, data: []float64{1, 2}},
+		{r: 2, c: 2, indptr: []int{0, 1, 2}, ind: []int{0, 2}, data: []float64{1, 2}},
+		{r: -1, c: 2},
+	} {
+		if panicked, _ := panics(func() { NewCSR(test.r, test.c, test.indptr, test.ind, test.data) }); !panicked {
+			t.Errorf("test %d: expected panic for malformed input", i)
+		}
+	}
+	if panicked, msg := panics(func() { NewCSR(2, 3, []int{0, 2, 3}, []int{0, 2, 1}, []float64{1, 2, 3}) }); panicked {
+		t.Errorf("unexpected panic for valid input: %s", msg)
+	}
+}
+
+func TestSparseCloneFromSelf(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := randSparseDense(5, 7, 0.3, rnd)
+	var coo COO
+	coo.CloneFrom(a)
+	var csr CSR
+	csr.CloneFrom(a)
+	var csc CSC
+	csc.CloneFrom(a)
+	for _, test := range []struct {
+		name string
+		m    interface {
+			Matrix
+			CloneFrom(Matrix)
+		}
+	}{
+		{name: "COO", m: &coo},
+		{name: "CSR", m: &csr},
+		{name: "CSC", m: &csc},
+	} {
+		test.m.CloneFrom(test.m)
+		if !Equal(test.m, a) {
+			t.Errorf("%s: unexpected value after self CloneFrom:\ngot:\n%v\nwant:\n%v", test.name, Formatted(test.m), Formatted(a))
+		}
+	}
+}
+
+func TestSparseMul(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, r := range []int{1, 3, 10} {
+		for _, c := range []int{1, 4, 7} {
+			for _, k := range []int{1, 3} {
+				a := randSparseDense(r, c, 0.3, rnd)
+				var csr CSR
+				csr.CloneFrom(a)
+				var csc CSC
+				csc.CloneFrom(a)
+				for _, trans := range []bool{false, true} {
+					var op Matrix = a
+					n := c
+					if trans {
+						op = a.T()
+						n = r
+					}
+					x := NewVecDense(n, nil)
+					for i := 0; i < n; i++ {
+						x.SetVec(i, rnd.NormFloat64())
+					}
+					b := NewDense(n, k, nil)
+					for i := 0; i < n; i++ {
+						for j := 0; j < k; j++ {
+							b.Set(i, j, rnd.NormFloat64())
+						}
+					}
+					var wantVec VecDense
+					wantVec.MulVec(op, x)
+					var want Dense
+					want.Mul(op, b)
+
+					for _, test := range []struct {
+						name string
+						m    interface {
+							MulVecTo(*VecDense, bool, Vector)
+							MulDenseTo(*Dense, bool, Matrix)
+						}
+					}{
+						{name: "CSR", m: &csr},
+						{name: "CSC", m: &csc},
+					} {
+						prefix := fmt.Sprintf("r=%d,c=%d,k=%d,trans=%t,%s", r, c, k, trans, test.name)
+						var gotVec VecDense
+						test.m.MulVecTo(&gotVec, trans, x)
+						if !EqualApprox(&gotVec, &wantVec, 1e-14) {
+							t.Errorf("%s: unexpected MulVecTo result:\ngot  %v\nwant %v", prefix, Formatted(gotVec.T()), Formatted(wantVec.T()))
+						}
+						// Strided input vector.
+						xs := NewDense(n, 2, nil)
+						xs.SetCol(1, x.RawVector().Data)
+						gotVec.Reset()
+						test.m.MulVecTo(&gotVec, trans, xs.ColView(1))
+						if !EqualApprox(&gotVec, &wantVec, 1e-14) {
+							t.Errorf("%s: unexpected MulVecTo result for strided vector", prefix)
+						}
+						var got Dense
+						test.m.MulDenseTo(&got, trans, b)
+						if !EqualApprox(&got, &want, 1e-14) {
+							t.Errorf("%s: unexpected MulDenseTo result:\ngot:\n%v\nwant:\n%v", prefix, Formatted(&got), Formatted(&want))
+						}
+						got.Reset()
+						test.m.MulDenseTo(&got, trans, b.T().T())
+						if !EqualApprox(&got, &want, 1e-14) {
+							t.Errorf("%s: unexpected MulDenseTo result for non-Dense input", prefix)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSparseAddScale(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, r := range []int{1, 4, 9} {
+		for _, c := range []int{1, 5, 8} {
+			a := randSparseDense(r, c, 0.3, rnd)
+			b := randSparseDense(r, c, 0.3, rnd)
+			var wantAdd, wantSub, wantScale Dense
+			wantAdd.Add(a, b)
+			wantSub.Sub(a, b)
+			wantScale.Scale(-2.5, a)
+
+			var csrA, csrB, csrGot CSR
+			csrA.CloneFrom(a)
+			csrB.CloneFrom(b)
+			csrGot.Add(&csrA, &csrB)
+			if !EqualApprox(&csrGot, &wantAdd, 1e-15) {
+				t.Errorf("r=%d,c=%d: unexpected CSR Add result", r, c)
+			}
+			csrGot.Sub(&csrA, &csrB)
+			if !EqualApprox(&csrGot, &wantSub, 1e-15) {
+				t.Errorf("r=%d,c=%d: unexpected CSR Sub result", r, c)
+			}
+			csrGot.Scale(-2.5, &csrA)
+			if !EqualApprox(&csrGot, &wantScale, 1e-15) {
+				t.Errorf("r=%d,c=%d: unexpected CSR Scale result", r, c)
+			}
+			if !Equal(&csrA, a) {
+				t.Errorf("r=%d,c=%d: CSR Scale modified its input", r, c)
+			}
+
+			var cscA, cscB, cscGot CSC
+			cscA.CloneFrom(a)
+			cscB.CloneFrom(b)
+			cscGot.Add(&cscA, &cscB)
+			if !EqualApprox(&cscGot, &wantAdd, 1e-15) {
+				t.Errorf("r=%d,c=%d: unexpected CSC Add result", r, c)
+			}
+			cscGot.Sub(&cscA, &cscB)
+			if !EqualApprox(&cscGot, &wantSub, 1e-15) {
+				t.Errorf("r=%d,c=%d: unexpected CSC Sub result", r, c)
+			}
+			cscA.Scale(-2.5, &cscA)
+			if !EqualApprox(&cscA, &wantScale, 1e-15) {
+				t.Errorf("r=%d,c=%d: unexpected CSC Scale result in place", r, c)
+			}
+		}
+	}
+}
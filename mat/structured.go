@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "gonum.org/v1/gonum/dsp/fourier"
+
+// minFFTLen is the shortest operand length for which the structured matrix
+// types use FFT-based products. Shorter products are evaluated directly.
+const minFFTLen = 64
+
+// vecData returns a copy of the elements of x.
+func vecData(x Vector) []float64 {
+	n := x.Len()
+	data := make([]float64, n)
+	if rv, ok := x.(RawVectorer); ok {
+		v := rv.RawVector()
+		for i := range data {
+			data[i] = v.Data[i*v.Inc]
+		}
+		return data
+	}
+	for i := range data {
+		data[i] = x.AtVec(i)
+	}
+	return data
+}
+
+// setVecData copies data into dst, resizing dst if it is empty.
+func setVecData(dst *VecDense, data []float64) {
+	dst.reuseAsNonZeroed(len(data))
+	for i, v := range data {
+		dst.setVec(i, v)
+	}
+}
+
+// reverse reverses the order of the elements of s in place.
+func reverse(s []float64) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// convolveValid stores the fully overlapping part of the convolution of s
+// and x into dst, so that
+//
+//	dst[i] = sum_j s[i+len(x)-1-j] * x[j]
+//
+// for 0 <= i <= len(s)-len(x). The product is computed with the FFT when both
+// dst and x are long enough for it to be profitable.
+func convolveValid(dst, s, x []float64) {
+	if len(dst) != len(s)-len(x)+1 {
+		panic(ErrShape)
+	}
+	off := len(x) - 1
+	if len(dst) < minFFTLen || len(x) < minFFTLen {
+		for i := range dst {
+			var sum float64
+			for j, v := range x {
+				sum += s[i+off-j] * v
+			}
+			dst[i] = sum
+		}
+		return
+	}
+
+	// Wrap-around in the circular convolution only affects elements
+	// before off, so a transform as long as s is sufficient.
+	n := fastFFTLen(len(s))
+	fft := fourier.NewFFT(n)
+	work := make([]float64, n)
+	copy(work, s)
+	cs := fft.Coefficients(nil, work)
+	clear(work)
+	copy(work, x)
+	cx := fft.Coefficients(nil, work)
+	for i, v := range cx {
+		cs[i] *= v
+	}
+	work = fft.Sequence(work, cs)
+	scale := 1 / float64(n)
+	for i := range dst {
+		dst[i] = work[i+off] * scale
+	}
+}
+
+// fastFFTLen returns the smallest integer not less than n whose only prime
+// factors are 2, 3 and 5.
+func fastFFTLen(n int) int {
+	for m := n; ; m++ {
+		k := m
+		for _, p := range []int{2, 3, 5} {
+			for k%p == 0 {
+				k /= p
+			}
+		}
+		if k == 1 {
+			return m
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// structuredMatrix is the set of methods shared by the structured matrix
+// types.
+type structuredMatrix interface {
+	Matrix
+	MulVecTo(dst *VecDense, trans bool, x Vector)
+	SolveVecTo(dst *VecDense, trans bool, b Vector) error
+}
+
+// testStructured checks the products and, for square matrices, the solutions
+// computed by a against those of its dense representation.
+func testStructured(t *testing.T, name string, a structuredMatrix, tol float64, rnd *rand.Rand) {
+	t.Helper()
+	r, c := a.Dims()
+	dense := DenseCopyOf(a)
+	for _, trans := range []bool{false, true} {
+		var op Matrix = dense
+		m, n := r, c
+		if trans {
+			op = dense.T()
+			m, n = n, m
+		}
+		x := NewVecDense(n, nil)
+		randomSlice(x.RawVector().Data, rnd)
+
+		var got, want VecDense
+		want.MulVec(op, x)
+		a.MulVecTo(&got, trans, x)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("%s: unexpected product for trans=%t:\ngot: %v\nwant:%v", name, trans, Formatted(&got), Formatted(&want))
+		}
+		if m == n {
+			alias := VecDenseCopyOf(x)
+			a.MulVecTo(alias, trans, alias)
+			if !EqualApprox(alias, &want, tol) {
+				t.Errorf("%s: unexpected product for trans=%t with aliased vectors", name, trans)
+			}
+		}
+
+		if r != c {
+			continue
+		}
+		b := NewVecDense(n, nil)
+		randomSlice(b.RawVector().Data, rnd)
+		err := a.SolveVecTo(&got, trans, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error for trans=%t: %v", name, trans, err)
+			continue
+		}
+		var resid VecDense
+		resid.MulVec(op, &got)
+		if !EqualApprox(&resid, b, tol) {
+			t.Errorf("%s: solution does not satisfy system for trans=%t", name, trans)
+		}
+		if err := a.SolveVecTo(b, trans, b); err != nil || !EqualApprox(b, &got, tol) {
+			t.Errorf("%s: unexpected solution for trans=%t with aliased vectors", name, trans)
+		}
+	}
+}
+
+func TestConvolveValid(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ ns, nx int }{
+		{1, 1},
+		{10, 3},
+		{100, 1},
+		{100, 64},
+		{200, 64},
+		{257, 100},
+	} {
+		s := make([]float64, test.ns)
+		x := make([]float64, test.nx)
+		randomSlice(s, rnd)
+		randomSlice(x, rnd)
+		got := make([]float64, test.ns-test.nx+1)
+		convolveValid(got, s, x)
+		for i, v := range got {
+			var want float64
+			for j := range x {
+				want += s[i+test.nx-1-j] * x[j]
+			}
+			if !EqualApprox(NewVecDense(1, []float64{v}), NewVecDense(1, []float64{want}), 1e-12) {
+				t.Errorf("unexpected convolution for ns=%d nx=%d at %d: got %v want %v", test.ns, test.nx, i, v, want)
+			}
+		}
+	}
+}
+
+func TestFastFFTLen(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct{ n, want int }{
+		{1, 1},
+		{7, 8},
+		{11, 12},
+		{31, 32},
+		{97, 100},
+		{121, 125},
+	} {
+		if got := fastFFTLen(test.n); got != test.want {
+			t.Errorf("unexpected length for %d: got %d want %d", test.n, got, test.want)
+		}
+	}
+}
@@ -5,6 +5,9 @@
 package mat
 
 import (
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
@@ -39,6 +42,12 @@ const (
 	SVDThinV
 	// SVDFullV specifies the full decomposition for V should be computed.
 	SVDFullV
+	// SVDDivideConquer specifies that the decomposition should be computed
+	// using the divide and conquer method. It may be combined with any of
+	// the other kinds and is usually significantly faster for large
+	// matrices when singular vectors are requested. SVDDivideConquer is
+	// ignored by CSVD.
+	SVDDivideConquer
 
 	// SVDThin is a convenience value for computing both thin vectors.
 	SVDThin SVDKind = SVDThinU | SVDThinV
@@ -74,6 +83,10 @@ func (svd *SVD) succFact() bool {
 // where U~ is of size m×min(m,n), Σ is a diagonal matrix of size min(m,n)×min(m,n)
 // and V~ is of size n×min(m,n).
 //
+// If kind includes SVDDivideConquer, the decomposition is computed using the
+// divide and conquer method which is usually significantly faster for large
+// matrices when singular vectors are requested.
+//
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, routines that require a successful factorization will panic.
 func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
@@ -81,6 +94,10 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	svd.s = svd.s[:0]
 	svd.kind = kind
 
+	if kind&SVDDivideConquer != 0 {
+		return svd.factorizeDivideConquer(a, kind)
+	}
+
 	m, n := a.Dims()
 	var jobU, jobVT lapack.SVDJob
 
@@ -144,6 +161,229 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	return ok
 }
 
+// factorizeDivideConquer computes the singular value decomposition of a
+// using Dgesdd. Dgesdd computes either both or none of the sets of singular
+// vectors, so the vectors for both U and V are always stored when any are
+// requested.
+func (svd *SVD) factorizeDivideConquer(a Matrix, kind SVDKind) (ok bool) {
+	m, n := a.Dims()
+	k := min(m, n)
+
+	jobz := lapack.SVDNone
+	switch {
+	case kind&(SVDFullU|SVDFullV) != 0:
+		jobz = lapack.SVDAll
+		svd.u = blas64.General{
+			Rows:   m,
+			Cols:   m,
+			Stride: m,
+			Data:   use(svd.u.Data, m*m),
+		}
+		svd.vt = blas64.General{
+			Rows:   n,
+			Cols:   n,
+			Stride: n,
+			Data:   use(svd.vt.Data, n*n),
+		}
+	case kind&(SVDThinU|SVDThinV) != 0:
+		jobz = lapack.SVDStore
+		svd.u = blas64.General{
+			Rows:   m,
+			Cols:   k,
+			Stride: k,
+			Data:   use(svd.u.Data, m*k),
+		}
+		svd.vt = blas64.General{
+			Rows:   k,
+			Cols:   n,
+			Stride: n,
+			Data:   use(svd.vt.Data, k*n),
+		}
+	}
+
+	// A is destroyed on call, so copy the matrix.
+	aCopy := DenseCopyOf(a)
+	svd.s = use(svd.s, k)
+
+	iwork := getInts(8*k, false)
+	defer putInts(iwork)
+	work := []float64{0}
+	lapack64.Gesdd(jobz, aCopy.mat, svd.s, svd.u, svd.vt, work, -1, iwork)
+	work = getFloat64s(int(work[0]), false)
+	ok = lapack64.Gesdd(jobz, aCopy.mat, svd.s, svd.u, svd.vt, work, len(work), iwork)
+	putFloat64s(work)
+	if !ok {
+		svd.kind = 0
+		return false
+	}
+
+	// Restrict the stored vectors to the thin decomposition where the full
+	// one was only computed for the other set of singular vectors.
+	if jobz == lapack.SVDAll {
+		if kind&SVDFullU == 0 {
+			svd.u.Cols = k
+		}
+		if kind&SVDFullV == 0 {
+			svd.vt.Rows = k
+		}
+	}
+	return true
+}
+
+// RandomizedSVDSettings holds the parameters of the randomized algorithm used
+// by SVD.FactorizeRandomized.
+type RandomizedSVDSettings struct {
+	// Oversample is the number of random samples of the range of A that
+	// are taken in addition to the number of requested singular values.
+	// Oversampling improves the accuracy of the approximated range.
+	Oversample int
+
+	// PowerIterations is the number of power iterations used to refine
+	// the approximated range. Power iterations improve the accuracy when
+	// the singular values of A decay slowly.
+	PowerIterations int
+
+	// Src is the source of random numbers. If Src is nil, the global
+	// source of math/rand/v2 is used.
+	Src rand.Source
+}
+
+// FactorizeRandomized computes an approximation of the k largest singular values
+// of the m×n matrix A and, optionally, of the corresponding singular vectors
+// using the randomized algorithm of Halko, Martinsson and Tropp.
+//
+// The range of A is sampled as
+//
+//	Y = (A * Aᵀ)^q * A * Ω
+//
+// where Ω is a random n×l Gaussian matrix with l = min(k+p, m, n), p is the
+// oversampling and q is the number of power iterations. With Q an orthonormal
+// basis of the range of Y, the singular value decomposition of the small l×n
+// matrix Qᵀ * A = Ũ * Σ * Vᵀ gives the approximation
+//
+//	A ≈ (Q * Ũ) * Σ * Vᵀ
+//
+// which is truncated to the k largest singular values. A is only accessed
+// through products with dense matrices, so A may be any Matrix, and the
+// computation is efficient when k is much smaller than min(m,n).
+//
+// kind must be SVDNone, SVDThinU, SVDThinV or SVDThin, optionally combined
+// with SVDDivideConquer which is ignored. After a successful factorization,
+// Values returns the k singular values in descending order, and UTo and VTo
+// return the m×k and n×k matrices of the corresponding singular vectors.
+//
+// If settings is nil, an oversampling of 10 and 2 power iterations are used.
+// FactorizeRandomized panics if k is not between 1 and min(m,n), if kind
+// requests full singular vectors, or if the oversampling or the number of
+// power iterations in settings is negative.
+//
+// FactorizeRandomized returns whether the decomposition succeeded. If the
+// decomposition failed, routines that require a successful factorization will
+// panic.
+//
+// The algorithm is described in
+//
+//	Halko, N., Martinsson, P. G., & Tropp, J. A. (2011). Finding structure
+//	with randomness: Probabilistic algorithms for constructing approximate
+//	matrix decompositions. SIAM Review, 53(2), 217-288.
+func (svd *SVD) FactorizeRandomized(a Matrix, k int, kind SVDKind, settings *RandomizedSVDSettings) (ok bool) {
+	// kill previous factorization
+	svd.s = svd.s[:0]
+	svd.kind = kind
+
+	if kind&(SVDFullU|SVDFullV) != 0 {
+		panic("svd: full vectors not available for randomized decomposition")
+	}
+	m, n := a.Dims()
+	if k < 1 || min(m, n) < k {
+		panic("svd: k out of range")
+	}
+	p, q := 10, 2
+	normal := rand.NormFloat64
+	if settings != nil {
+		if settings.Oversample < 0 || settings.PowerIterations < 0 {
+			panic("svd: negative randomized decomposition setting")
+		}
+		p = settings.Oversample
+		q = settings.PowerIterations
+		if settings.Src != nil {
+			normal = rand.New(settings.Src).NormFloat64
+		}
+	}
+	l := min(k+p, m, n)
+
+	// Sample the range of A and orthonormalize the samples after each
+	// multiplication to avoid the loss of the small singular directions.
+	omega := NewDense(n, l, nil)
+	for i := range omega.mat.Data {
+		omega.mat.Data[i] = normal()
+	}
+	y := NewDense(m, l, nil)
+	y.Mul(a, omega)
+	orthonormalizeColumns(y)
+	z := omega
+	for i := 0; i < q; i++ {
+		z.Mul(a.T(), y)
+		orthonormalizeColumns(z)
+		y.Mul(a, z)
+		orthonormalizeColumns(y)
+	}
+
+	// Compute the decomposition of A projected onto the sampled range.
+	var b Dense
+	b.Mul(y.T(), a)
+	smallKind := SVDDivideConquer
+	if kind&(SVDThinU|SVDThinV) != 0 {
+		smallKind |= SVDThin
+	}
+	var small SVD
+	if !small.Factorize(&b, smallKind) {
+		svd.kind = 0
+		return false
+	}
+
+	svd.s = use(svd.s, k)
+	copy(svd.s, small.s)
+	if kind&SVDThinU != 0 {
+		svd.u = blas64.General{
+			Rows:   m,
+			Cols:   k,
+			Stride: k,
+			Data:   use(svd.u.Data, m*k),
+		}
+		uk := small.u
+		uk.Cols = k
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, y.mat, uk, 0, svd.u)
+	}
+	if kind&SVDThinV != 0 {
+		svd.vt = blas64.General{
+			Rows:   k,
+			Cols:   n,
+			Stride: n,
+			Data:   use(svd.vt.Data, k*n),
+		}
+		copy(svd.vt.Data, small.vt.Data[:k*n])
+	}
+	return true
+}
+
+// orthonormalizeColumns overwrites the m×n matrix a, m >= n, with the
+// orthonormal factor Q of its QR decomposition.
+func orthonormalizeColumns(a *Dense) {
+	_, c := a.Dims()
+	tau := getFloat64s(c, false)
+	defer putFloat64s(tau)
+	work := []float64{0}
+	lapack64.Geqrf(a.mat, tau, work, -1)
+	lwork := int(work[0])
+	lapack64.Orgqr(a.mat, tau, work, -1)
+	lwork = max(lwork, int(work[0]))
+	work = getFloat64s(lwork, false)
+	lapack64.Geqrf(a.mat, tau, work, lwork)
+	lapack64.Orgqr(a.mat, tau, work, lwork)
+	putFloat64s(work)
+}
+
 // Kind returns the SVDKind of the decomposition. If no decomposition has been
 // computed, Kind returns -1.
 func (svd *SVD) Kind() SVDKind {
@@ -155,6 +395,11 @@ func (svd *SVD) Kind() SVDKind {
 
 // Rank returns the rank of A based on the count of singular values greater than
 // rcond scaled by the largest singular value.
+//
+// If the decomposition was computed by FactorizeRandomized with k < min(m,n),
+// Rank returns the rank of the rank-k approximation of A, which is at most k,
+// and not the rank of A.
+//
 // Rank will panic if the receiver does not contain a successful factorization or
 // rcond is negative.
 func (svd *SVD) Rank(rcond float64) int {
@@ -173,8 +418,14 @@ func (svd *SVD) Rank(rcond float64) int {
 	return len(svd.s)
 }
 
-// Cond returns the 2-norm condition number for the factorized matrix. Cond will
-// panic if the receiver does not contain a successful factorization.
+// Cond returns the 2-norm condition number for the factorized matrix.
+//
+// If the decomposition was computed by FactorizeRandomized with k < min(m,n),
+// Cond returns the ratio of the largest to the k-th largest singular value,
+// which is a lower bound on the condition number of A and not the condition
+// number itself.
+//
+// Cond will panic if the receiver does not contain a successful factorization.
 func (svd *SVD) Cond() float64 {
 	if !svd.succFact() {
 		panic(badFact)
@@ -185,7 +436,8 @@ func (svd *SVD) Cond() float64 {
 // Values returns the singular values of the factorized matrix in descending order.
 //
 // If the input slice is non-nil, the values will be stored in-place into
-// the slice. In this case, the slice must have length min(m,n), and Values will
+// the slice. In this case, the slice must have length min(m,n), or k if the
+// decomposition was computed by FactorizeRandomized, and Values will
 // panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
 // slice of the appropriate length will be allocated and returned.
 //
@@ -208,8 +460,9 @@ func (svd *SVD) Values(s []float64) []float64 {
 // min(m,n) columns are the left singular vectors and correspond to the singular
 // values as returned from SVD.Values.
 //
-// If dst is empty, UTo will resize dst to be m×m if the full U was computed
-// and size m×min(m,n) if the thin U was computed. When dst is non-empty, then
+// If dst is empty, UTo will resize dst to be m×m if the full U was computed,
+// size m×min(m,n) if the thin U was computed and size m×k if U was computed by
+// FactorizeRandomized. When dst is non-empty, then
 // UTo will panic if dst is not the appropriate size. UTo will also panic if
 // the receiver does not contain a successful factorization, or if U was
 // not computed during factorization.
@@ -244,8 +497,9 @@ func (svd *SVD) UTo(dst *Dense) {
 // min(m,n) columns are the right singular vectors and correspond to the singular
 // values as returned from SVD.Values.
 //
-// If dst is empty, VTo will resize dst to be n×n if the full V was computed
-// and size n×min(m,n) if the thin V was computed. When dst is non-empty, then
+// If dst is empty, VTo will resize dst to be n×n if the full V was computed,
+// size n×min(m,n) if the thin V was computed and size n×k if V was computed by
+// FactorizeRandomized. When dst is non-empty, then
 // VTo will panic if dst is not the appropriate size. VTo will also panic if
 // the receiver does not contain a successful factorization, or if V was
 // not computed during factorization.
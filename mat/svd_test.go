@@ -5,6 +5,7 @@
 package mat
 
 import (
+	"math"
 	"math/rand/v2"
 	"testing"
 
@@ -212,6 +213,223 @@ func extractSVD(svd *SVD) (s []float64, u, v *Dense) {
 	return svd.Values(nil), u, v
 }
 
+func TestSVDDivideConquer(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{5, 3},
+		{3, 5},
+		{60, 60},
+		{200, 40},
+		{40, 200},
+	} {
+		m := test.m
+		n := test.n
+		a := NewDense(m, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+		}
+		aCopy := DenseCopyOf(a)
+
+		var want SVD
+		if !want.Factorize(a, SVDNone) {
+			t.Fatalf("m=%d,n=%d: SVD factorization failed", m, n)
+		}
+		wantValues := want.Values(nil)
+
+		for _, kind := range []SVDKind{
+			SVDNone, SVDThinU, SVDThinV, SVDThin, SVDFullU, SVDFullV, SVDFull, SVDThinU | SVDFullV,
+		} {
+			var svd SVD
+			ok := svd.Factorize(a, kind|SVDDivideConquer)
+			if !ok {
+				t.Errorf("m=%d,n=%d,kind=%d: SVD factorization failed", m, n, kind)
+				continue
+			}
+			if !Equal(a, aCopy) {
+				t.Errorf("m=%d,n=%d,kind=%d: A changed during call to SVD", m, n, kind)
+			}
+			if svd.Kind() != kind|SVDDivideConquer {
+				t.Errorf("m=%d,n=%d,kind=%d: unexpected kind %d", m, n, kind, svd.Kind())
+			}
+			s := svd.Values(nil)
+			if !floats.EqualApprox(s, wantValues, 1e-10) {
+				t.Errorf("m=%d,n=%d,kind=%d: singular value mismatch", m, n, kind)
+			}
+			if kind&(SVDThinU|SVDFullU) == 0 || kind&(SVDThinV|SVDFullV) == 0 {
+				continue
+			}
+
+			var u, v Dense
+			svd.UTo(&u)
+			svd.VTo(&v)
+			ur, uc := u.Dims()
+			vr, vc := v.Dims()
+			wantUCols := min(m, n)
+			if kind&SVDFullU != 0 {
+				wantUCols = m
+			}
+			wantVCols := min(m, n)
+			if kind&SVDFullV != 0 {
+				wantVCols = n
+			}
+			if ur != m || uc != wantUCols || vr != n || vc != wantVCols {
+				t.Errorf("m=%d,n=%d,kind=%d: unexpected dimensions U %d×%d, V %d×%d", m, n, kind, ur, uc, vr, vc)
+				continue
+			}
+			var utu, vtv Dense
+			utu.Mul(u.T(), &u)
+			vtv.Mul(v.T(), &v)
+			if !EqualApprox(&utu, eye(uc), 1e-12) {
+				t.Errorf("m=%d,n=%d,kind=%d: U not orthonormal", m, n, kind)
+			}
+			if !EqualApprox(&vtv, eye(vc), 1e-12) {
+				t.Errorf("m=%d,n=%d,kind=%d: V not orthonormal", m, n, kind)
+			}
+			sigma := NewDense(uc, vc, nil)
+			for i := range s {
+				sigma.Set(i, i, s[i])
+			}
+			var ans Dense
+			ans.Product(&u, sigma, v.T())
+			if !EqualApprox(&ans, a, 1e-10) {
+				t.Errorf("m=%d,n=%d,kind=%d: A reconstruction mismatch", m, n, kind)
+			}
+		}
+	}
+}
+
+func TestSVDRandomized(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n, k int
+	}{
+		{1, 1, 1},
+		{10, 10, 3},
+		{50, 20, 5},
+		{20, 50, 5},
+		{300, 100, 10},
+		{100, 300, 20},
+	} {
+		m, n, k := test.m, test.n, test.k
+
+		// Construct a matrix with quickly decaying singular values.
+		minmn := min(m, n)
+		q := randomOrthonormal(m, minmn, rnd)
+		z := randomOrthonormal(n, minmn, rnd)
+		sigma := NewDiagDense(minmn, nil)
+		for i := 0; i < minmn; i++ {
+			sigma.SetDiag(i, math.Pow(0.5, float64(i)))
+		}
+		var a Dense
+		a.Product(q, sigma, z.T())
+
+		var want SVD
+		if !want.Factorize(&a, SVDThin) {
+			t.Fatalf("m=%d,n=%d: SVD factorization failed", m, n)
+		}
+		wantValues, wantU, wantV := extractSVD(&want)
+
+		var svd SVD
+		ok := svd.FactorizeRandomized(&a, k, SVDThin, &RandomizedSVDSettings{
+			Oversample:      5,
+			PowerIterations: 2,
+			Src:             rand.NewPCG(2, 2),
+		})
+		if !ok {
+			t.Errorf("m=%d,n=%d,k=%d: randomized SVD failed", m, n, k)
+			continue
+		}
+		s, u, v := extractSVD(&svd)
+		if len(s) != k {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected number of singular values %d", m, n, k, len(s))
+			continue
+		}
+		if !floats.EqualApprox(s, wantValues[:k], 1e-10) {
+			t.Errorf("m=%d,n=%d,k=%d: singular value mismatch\ngot: %v\nwant:%v", m, n, k, s, wantValues[:k])
+		}
+		// Rank and Cond describe the rank-k approximation of A.
+		if r := svd.Rank(0); r != k {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected rank %d", m, n, k, r)
+		}
+		if c, want := svd.Cond(), math.Pow(2, float64(k-1)); math.Abs(c-want) > 1e-8*want {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected condition number: got %v want %v", m, n, k, c, want)
+		}
+		if c := svd.Cond(); c > want.Cond()*(1+1e-8) {
+			t.Errorf("m=%d,n=%d,k=%d: condition number %v exceeds that of A %v", m, n, k, c, want.Cond())
+		}
+		ur, uc := u.Dims()
+		vr, vc := v.Dims()
+		if ur != m || uc != k || vr != n || vc != k {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected dimensions U %d×%d, V %d×%d", m, n, k, ur, uc, vr, vc)
+			continue
+		}
+		// The singular vectors are unique up to sign.
+		for j := 0; j < k; j++ {
+			if d := math.Abs(Dot(u.ColView(j), wantU.ColView(j))); math.Abs(d-1) > 1e-8 {
+				t.Errorf("m=%d,n=%d,k=%d: left singular vector %d mismatch", m, n, k, j)
+			}
+			if d := math.Abs(Dot(v.ColView(j), wantV.ColView(j))); math.Abs(d-1) > 1e-8 {
+				t.Errorf("m=%d,n=%d,k=%d: right singular vector %d mismatch", m, n, k, j)
+			}
+		}
+
+		// Check that only the values are computed with SVDNone and the
+		// default settings.
+		var none SVD
+		if !none.FactorizeRandomized(&a, k, SVDNone, nil) {
+			t.Errorf("m=%d,n=%d,k=%d: randomized SVD failed", m, n, k)
+			continue
+		}
+		if !floats.EqualApprox(none.Values(nil), wantValues[:k], 1e-10) {
+			t.Errorf("m=%d,n=%d,k=%d: singular value mismatch with SVDNone", m, n, k)
+		}
+		panicked, message := panics(func() {
+			var dst Dense
+			none.UTo(&dst)
+		})
+		if !panicked || message != "svd: u not computed during factorization" {
+			t.Errorf("m=%d,n=%d,k=%d: expected panic with no U matrix requested", m, n, k)
+		}
+	}
+
+	a := NewDense(3, 4, nil)
+	for _, test := range []struct {
+		k        int
+		kind     SVDKind
+		settings *RandomizedSVDSettings
+		want     string
+	}{
+		{k: 0, kind: SVDThin, want: "svd: k out of range"},
+		{k: 4, kind: SVDThin, want: "svd: k out of range"},
+		{k: 2, kind: SVDFullU, want: "svd: full vectors not available for randomized decomposition"},
+		{k: 2, kind: SVDThin, settings: &RandomizedSVDSettings{Oversample: -1}, want: "svd: negative randomized decomposition setting"},
+	} {
+		panicked, message := panics(func() {
+			var svd SVD
+			svd.FactorizeRandomized(a, test.k, test.kind, test.settings)
+		})
+		if !panicked || message != test.want {
+			t.Errorf("unexpected panic for k=%d,kind=%d: got %q want %q", test.k, test.kind, message, test.want)
+		}
+	}
+}
+
+// randomOrthonormal returns a random m×n matrix with orthonormal columns.
+func randomOrthonormal(m, n int, rnd *rand.Rand) *Dense {
+	a := NewDense(m, n, nil)
+	for i := range a.mat.Data {
+		a.mat.Data[i] = rnd.NormFloat64()
+	}
+	orthonormalizeColumns(a)
+	return a
+}
+
 func TestSVDSolveTo(t *testing.T) {
 	t.Parallel()
 	rnd := rand.New(rand.NewPCG(1, 1))
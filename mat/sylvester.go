@@ -0,0 +1,262 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// SolveSylvester solves the Sylvester equation
+//
+//	A * X + X * B = C
+//
+// for X using the Bartels-Stewart algorithm, placing the result in the
+// receiver. A must be an m×m, B an n×n and C an m×n matrix, otherwise
+// SolveSylvester will panic.
+//
+// The equation has a unique solution if and only if A and -B have no
+// eigenvalues in common. If they have common or very close eigenvalues, the
+// equation is solved with perturbed values and a Condition error with value
+// +Inf is returned. In this case the solution stored in the receiver may be
+// inaccurate. If the Schur decomposition of A or B fails, ErrFailedEigen is
+// returned.
+func (m *Dense) SolveSylvester(a, b, c Matrix) error {
+	ar, ac := a.Dims()
+	if ar != ac {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if br != bc {
+		panic(ErrSquare)
+	}
+	cr, cc := c.Dims()
+	if cr != ar || cc != br {
+		panic(ErrShape)
+	}
+
+	var sa, sb Schur
+	if !sa.Factorize(a) || !sb.Factorize(b) {
+		return ErrFailedEigen
+	}
+
+	// Transform the equation to S_A * Y + Y * S_B = Z_Aᵀ * C * Z_B where
+	// S_A and S_B are the Schur forms of A and B.
+	y := getDenseWorkspace(cr, cc, false)
+	defer putDenseWorkspace(y)
+	y.Product(sa.z.T(), c, sb.z)
+	scale, ok := lapack64.Trsyl(blas.NoTrans, blas.NoTrans, 1, sa.t.mat, sb.t.mat, y.mat)
+
+	m.Product(sa.z, y, sb.z.T())
+	if scale != 1 {
+		m.Scale(1/scale, m)
+	}
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// SolveContinuousLyapunov solves the continuous Lyapunov equation
+//
+//	A * X + X * Aᵀ = Q
+//
+// for the symmetric matrix X, placing the result in the receiver. A must be
+// an n×n matrix and Q an n×n symmetric matrix, otherwise
+// SolveContinuousLyapunov will panic.
+//
+// The equation has a unique solution if and only if no two eigenvalues of A
+// sum to zero. If A is stable, that is all its eigenvalues have negative real
+// part, and -Q is positive semidefinite, then X is positive semidefinite. See
+// SolveSylvester for the returned errors.
+func (s *SymDense) SolveContinuousLyapunov(a Matrix, q Symmetric) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	if q.SymmetricDim() != n {
+		panic(ErrShape)
+	}
+
+	var sa Schur
+	if !sa.Factorize(a) {
+		return ErrFailedEigen
+	}
+
+	// Transform the equation to S * Y + Y * Sᵀ = Zᵀ * Q * Z where S is the
+	// Schur form of A.
+	y := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(y)
+	y.Product(sa.z.T(), q, sa.z)
+	scale, ok := lapack64.Trsyl(blas.NoTrans, blas.Trans, 1, sa.t.mat, sa.t.mat, y.mat)
+
+	x := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(x)
+	x.Product(sa.z, y, sa.z.T())
+	s.symmetrizeFrom(x, 1/scale)
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// SolveDiscreteLyapunov solves the discrete Lyapunov (Stein) equation
+//
+//	A * X * Aᵀ - X + Q = 0
+//
+// for the symmetric matrix X, placing the result in the receiver. A must be
+// an n×n matrix and Q an n×n symmetric matrix, otherwise SolveDiscreteLyapunov
+// will panic.
+//
+// The equation has a unique solution if and only if λ_i*λ_j != 1 for all
+// eigenvalues λ_i, λ_j of A. If A is stable, that is all its eigenvalues lie
+// inside the unit circle, and Q is positive semidefinite, then X is positive
+// semidefinite. If the equation is singular, a Condition error with value +Inf
+// is returned and the contents of the receiver are undefined. If the Schur
+// decomposition of A fails, ErrFailedEigen is returned.
+func (s *SymDense) SolveDiscreteLyapunov(a Matrix, q Symmetric) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	if q.SymmetricDim() != n {
+		panic(ErrShape)
+	}
+
+	var sa Schur
+	if !sa.Factorize(a) {
+		return ErrFailedEigen
+	}
+
+	// Transform the equation to S * Y * Sᵀ - Y = -Zᵀ * Q * Z where S is
+	// the Schur form of A.
+	y := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(y)
+	y.Product(sa.z.T(), q, sa.z)
+	ok := solveTriStein(sa.t.mat, y.mat)
+
+	x := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(x)
+	x.Product(sa.z, y, sa.z.T())
+	s.symmetrizeFrom(x, 1)
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// symmetrizeFrom stores f*(a + aᵀ)/2 into the receiver, resizing it to
+// n×n if it is empty.
+func (s *SymDense) symmetrizeFrom(a *Dense, f float64) {
+	n, _ := a.Dims()
+	s.reuseAsNonZeroed(n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.set(i, j, f*(a.at(i, j)+a.at(j, i))/2)
+		}
+	}
+}
+
+// solveTriStein solves the Stein equation
+//
+//	S * Y * Sᵀ - Y = -F
+//
+// where S is an n×n upper quasi-triangular matrix in Schur canonical form.
+// On entry f contains F and on return it is overwritten by the solution Y.
+// solveTriStein returns whether the equation was found to be nonsingular.
+func solveTriStein(s, f blas64.General) (ok bool) {
+	n := s.Rows
+	if n == 0 {
+		return true
+	}
+	// Determine the diagonal blocks of S.
+	var blocks [][2]int
+	for k := 0; k < n; {
+		if k < n-1 && s.Data[(k+1)*s.Stride+k] != 0 {
+			blocks = append(blocks, [2]int{k, 2})
+			k += 2
+			continue
+		}
+		blocks = append(blocks, [2]int{k, 1})
+		k++
+	}
+
+	// The equation for the (k,l) block of Y is
+	//
+	//	Y_kl - S_kk * Y_kl * S_llᵀ = F_kl + Σ S_ki * Y_ij * S_ljᵀ
+	//
+	// where the sum runs over blocks i >= k and j >= l, excluding the
+	// block (k,l) itself. The blocks of Y are computed from the bottom
+	// right, and w = Y * Sᵀ is accumulated for the rows of Y that have
+	// already been computed.
+	w := getDenseWorkspace(n, n, true)
+	defer putDenseWorkspace(w)
+	bi := blas64.Implementation()
+	var sys [16]float64
+	var rhs [4]float64
+	var ipiv [4]int
+	for bk := len(blocks) - 1; bk >= 0; bk-- {
+		k, nk := blocks[bk][0], blocks[bk][1]
+		// Add the contribution of the computed rows of Y below row k,
+		// Σ_{i>k} S_ki * (Y*Sᵀ)_il, to the right-hand side F_k,:.
+		if k+nk < n {
+			bi.Dgemm(blas.NoTrans, blas.NoTrans, nk, n, n-k-nk,
+				1, s.Data[k*s.Stride+k+nk:], s.Stride,
+				w.mat.Data[(k+nk)*w.mat.Stride:], w.mat.Stride,
+				1, f.Data[k*f.Stride:], f.Stride)
+		}
+		for bl := len(blocks) - 1; bl >= 0; bl-- {
+			l, nl := blocks[bl][0], blocks[bl][1]
+			// Form the right-hand side by adding
+			// S_kk * Σ_{j>l} Y_kj * S_ljᵀ.
+			for p := 0; p < nk; p++ {
+				for q := 0; q < nl; q++ {
+					var sum float64
+					if l+nl < n {
+						for p2 := 0; p2 < nk; p2++ {
+							sum += s.Data[(k+p)*s.Stride+k+p2] * bi.Ddot(n-l-nl, f.Data[(k+p2)*f.Stride+l+nl:], 1, s.Data[(l+q)*s.Stride+l+nl:], 1)
+						}
+					}
+					rhs[p*nl+q] = f.Data[(k+p)*f.Stride+l+q] + sum
+				}
+			}
+			// Solve the small system (I - S_ll ⊗ S_kk) vec(Y_kl) = rhs
+			// with unknowns ordered by row.
+			dim := nk * nl
+			for p := 0; p < nk; p++ {
+				for q := 0; q < nl; q++ {
+					row := p*nl + q
+					for p2 := 0; p2 < nk; p2++ {
+						for q2 := 0; q2 < nl; q2++ {
+							v := -s.Data[(k+p)*s.Stride+k+p2] * s.Data[(l+q)*s.Stride+l+q2]
+							if p == p2 && q == q2 {
+								v++
+							}
+							sys[row*dim+p2*nl+q2] = v
+						}
+					}
+				}
+			}
+			a := blas64.General{Rows: dim, Cols: dim, Stride: dim, Data: sys[:dim*dim]}
+			if !lapack64.Getrf(a, ipiv[:dim]) {
+				return false
+			}
+			b := blas64.General{Rows: dim, Cols: 1, Stride: 1, Data: rhs[:dim]}
+			lapack64.Getrs(blas.NoTrans, a, b, ipiv[:dim])
+			for p := 0; p < nk; p++ {
+				copy(f.Data[(k+p)*f.Stride+l:(k+p)*f.Stride+l+nl], rhs[p*nl:p*nl+nl])
+			}
+		}
+		// Compute the rows k:k+nk of w = Y * Sᵀ.
+		bi.Dgemm(blas.NoTrans, blas.Trans, nk, n, n,
+			1, f.Data[k*f.Stride:], f.Stride,
+			s.Data, s.Stride,
+			0, w.mat.Data[k*w.mat.Stride:], w.mat.Stride)
+	}
+	return true
+}
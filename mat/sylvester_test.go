@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSolveSylvester(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1}, {1, 3}, {3, 1}, {2, 2}, {5, 7}, {10, 4}, {20, 20},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d", test.m, test.n)
+		a := randSquareDense(test.m, rnd)
+		b := randSquareDense(test.n, rnd)
+		// Shift the spectra so that A and -B have no common eigenvalues.
+		for i := 0; i < test.m; i++ {
+			a.Set(i, i, a.At(i, i)+10)
+		}
+		for i := 0; i < test.n; i++ {
+			b.Set(i, i, b.At(i, i)+10)
+		}
+		c := NewDense(test.m, test.n, nil)
+		for i := 0; i < test.m; i++ {
+			for j := 0; j < test.n; j++ {
+				c.Set(i, j, rnd.NormFloat64())
+			}
+		}
+
+		var x Dense
+		err := x.SolveSylvester(a, b, c)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var ax, xb Dense
+		ax.Mul(a, &x)
+		xb.Mul(&x, b)
+		ax.Add(&ax, &xb)
+		if !EqualApprox(&ax, c, 1e-10) {
+			t.Errorf("%s: A*X + X*B != C", name)
+		}
+	}
+}
+
+func TestSolveSylvesterSingular(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 2, []float64{1, 0, 0, 2})
+	b := NewDense(2, 2, []float64{-1, 0, 0, 3})
+	c := NewDense(2, 2, []float64{1, 2, 3, 4})
+	var x Dense
+	err := x.SolveSylvester(a, b, c)
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for singular equation: got %v, want Condition", err)
+	}
+}
+
+func TestSolveLyapunov(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 10, 25} {
+		// Construct a stable matrix for the continuous equation by
+		// shifting the spectrum into the left half-plane, and one for the
+		// discrete equation by scaling it into the unit disc.
+		a := randSquareDense(n, rnd)
+		var eig Eigen
+		eig.Factorize(a, EigenNone)
+		var maxRe, maxAbs float64
+		for _, v := range eig.Values(nil) {
+			maxRe = max(maxRe, real(v))
+			maxAbs = max(maxAbs, cmplx.Abs(v))
+		}
+		ac := DenseCopyOf(a)
+		for i := 0; i < n; i++ {
+			ac.Set(i, i, ac.At(i, i)-maxRe-1)
+		}
+		ad := DenseCopyOf(a)
+		ad.Scale(0.9/maxAbs, ad)
+
+		q := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				q.SetSym(i, j, rnd.NormFloat64())
+			}
+		}
+
+		var xc SymDense
+		err := xc.SolveContinuousLyapunov(ac, q)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error in continuous Lyapunov: %v", n, err)
+		} else {
+			var got, tmp Dense
+			got.Mul(ac, &xc)
+			tmp.Mul(&xc, ac.T())
+			got.Add(&got, &tmp)
+			if !EqualApprox(&got, q, 1e-10) {
+				t.Errorf("n=%d: A*X + X*Aᵀ != Q", n)
+			}
+		}
+
+		var xd SymDense
+		err = xd.SolveDiscreteLyapunov(ad, q)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error in discrete Lyapunov: %v", n, err)
+		} else {
+			var got Dense
+			got.Product(ad, &xd, ad.T())
+			got.Sub(&got, &xd)
+			got.Add(&got, q)
+			if !EqualApprox(&got, NewDense(n, n, nil), 1e-10) {
+				t.Errorf("n=%d: A*X*Aᵀ - X + Q != 0", n)
+			}
+		}
+	}
+}
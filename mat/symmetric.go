@@ -620,6 +620,11 @@ func (s *SymDense) Trace() float64 {
 	return v
 }
 
+// MulVecTo computes S⋅x storing the result into dst.
+func (s *SymDense) MulVecTo(dst *VecDense, _ bool, x Vector) {
+	dst.MulVec(s, x)
+}
+
 // GrowSym returns the receiver expanded by n rows and n columns. If the
 // dimensions of the expanded matrix are outside the capacity of the receiver
 // a new allocation is made, otherwise not. Note that the receiver itself is
@@ -0,0 +1,285 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+var (
+	toeplitz *Toeplitz
+	_        Matrix = toeplitz
+)
+
+// Toeplitz represents an r×c Toeplitz matrix, a matrix that is constant along
+// each of its diagonals. A Toeplitz matrix is defined by its first column and
+// its first row, so that element (i, j) is col[i-j] if i >= j and row[j-i]
+// otherwise.
+//
+// Matrix-vector products are computed in O((r+c) log(r+c)) time using the FFT
+// and square systems are solved in O(n²) time by Levinson recursion.
+type Toeplitz struct {
+	col, row []float64
+}
+
+// NewToeplitz creates a new Toeplitz matrix with first column col and first
+// row row. If row is nil, the matrix is symmetric and row is taken to be col.
+// The slices are used as the backing data, so changes to their elements will
+// be reflected in the returned matrix.
+//
+// NewToeplitz will panic if col or row has zero length or if col[0] and row[0]
+// differ.
+func NewToeplitz(col, row []float64) *Toeplitz {
+	if row == nil {
+		row = col
+	}
+	if len(col) == 0 || len(row) == 0 {
+		panic(ErrZeroLength)
+	}
+	if col[0] != row[0] && !(math.IsNaN(col[0]) && math.IsNaN(row[0])) {
+		panic("mat: toeplitz column and row disagree on the diagonal")
+	}
+	return &Toeplitz{col: col, row: row}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (t *Toeplitz) Dims() (r, c int) {
+	return len(t.col), len(t.row)
+}
+
+// At returns the element at row i, column j.
+func (t *Toeplitz) At(i, j int) float64 {
+	if uint(i) >= uint(len(t.col)) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(len(t.row)) {
+		panic(ErrColAccess)
+	}
+	if i >= j {
+		return t.col[i-j]
+	}
+	return t.row[j-i]
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (t *Toeplitz) T() Matrix {
+	return Transpose{t}
+}
+
+// Column returns the first column of the matrix. The returned slice is the
+// backing data of the receiver.
+func (t *Toeplitz) Column() []float64 {
+	return t.col
+}
+
+// Row returns the first row of the matrix. The returned slice is the backing
+// data of the receiver.
+func (t *Toeplitz) Row() []float64 {
+	return t.row
+}
+
+// vectors returns the first column and first row of T or Tᵀ.
+func (t *Toeplitz) vectors(trans bool) (col, row []float64) {
+	if trans {
+		return t.row, t.col
+	}
+	return t.col, t.row
+}
+
+// MulVecTo computes T⋅x or Tᵀ⋅x storing the result into dst.
+func (t *Toeplitz) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	col, row := t.vectors(trans)
+	m, n := len(col), len(row)
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+
+	// Lay out the diagonals of the matrix from the top-right element to
+	// the bottom-left element so that the product is a convolution.
+	s := make([]float64, m+n-1)
+	for k := 0; k < n-1; k++ {
+		s[k] = row[n-1-k]
+	}
+	copy(s[n-1:], col)
+
+	y := make([]float64, m)
+	convolveValid(y, s, vecData(x))
+	setVecData(dst, y)
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	T * x = b   if trans == false
+//	Tᵀ * x = b  if trans == true
+//
+// for the square Toeplitz matrix T using Levinson recursion, storing the
+// result into dst. For a symmetric matrix this is the Levinson–Durbin
+// recursion used to solve the Yule–Walker equations.
+//
+// Levinson recursion requires all leading principal submatrices of T to be
+// nonsingular, and it is only guaranteed to be stable for symmetric positive
+// definite matrices. If the recursion breaks down a Condition error is
+// returned and the contents of dst are undefined.
+//
+// SolveVecTo will panic with ErrSquare if T is not square.
+func (t *Toeplitz) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	col, row := t.vectors(trans)
+	n := len(col)
+	if len(row) != n {
+		panic(ErrSquare)
+	}
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	x := vecData(b)
+	_, _, ok := levinson(col, row, x, 1)
+	setVecData(dst, x)
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// SolveTo solves a system of linear equations
+//
+//	T * X = B   if trans == false
+//	Tᵀ * X = B  if trans == true
+//
+// for the square Toeplitz matrix T using Levinson recursion, storing the
+// result into dst. The cost is O(n²) for each column of B.
+//
+// If the recursion breaks down a Condition error is returned and the contents
+// of dst are undefined. See SolveVecTo for the conditions under which this
+// may happen.
+//
+// SolveTo will panic with ErrSquare if T is not square.
+func (t *Toeplitz) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	col, row := t.vectors(trans)
+	n := len(col)
+	if len(row) != n {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	x := make([]float64, n*bc)
+	for i := 0; i < n; i++ {
+		for j := 0; j < bc; j++ {
+			x[i*bc+j] = b.At(i, j)
+		}
+	}
+	_, _, ok := levinson(col, row, x, bc)
+	dst.reuseAsNonZeroed(n, bc)
+	for i := 0; i < n; i++ {
+		copy(dst.rawRowView(i), x[i*bc:(i+1)*bc])
+	}
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// InverseTo computes the inverse of the square Toeplitz matrix T, storing the
+// result into dst. The inverse is constructed in O(n²) time from the first and
+// last columns and rows of T⁻¹ using the Trench recurrence
+//
+//	T⁻¹[i+1,j+1] = T⁻¹[i,j] + (x[i+1]*u[j+1] - y[i]*v[j]) / x[0]
+//
+// where x and y are the first and last columns of T⁻¹ and u and v are its first
+// and last rows.
+//
+// If the Levinson recursion used to find the generating vectors breaks down
+// a Condition error is returned and the contents of dst are undefined.
+//
+// InverseTo will panic with ErrSquare if T is not square.
+func (t *Toeplitz) InverseTo(dst *Dense) error {
+	n := len(t.col)
+	if len(t.row) != n {
+		panic(ErrSquare)
+	}
+	x, y, ok := levinson(t.col, t.row, nil, 0)
+	var u, v []float64
+	if ok {
+		u, v, ok = levinson(t.row, t.col, nil, 0)
+	}
+	dst.reuseAsNonZeroed(n, n)
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	for i := 0; i < n; i++ {
+		dst.set(i, 0, x[i])
+	}
+	for j := 1; j < n; j++ {
+		dst.set(0, j, u[j])
+	}
+	for i := 0; i < n-1; i++ {
+		for j := 0; j < n-1; j++ {
+			dst.set(i+1, j+1, dst.at(i, j)+(x[i+1]*u[j+1]-y[i]*v[j])/x[0])
+		}
+	}
+	return nil
+}
+
+// levinson solves the n×n Toeplitz system T * X = B by Levinson recursion,
+// where T has first column col and first row row. On entry x holds B as a
+// row-major n×nrhs matrix and on return it holds X. The first and last columns
+// of T⁻¹ are returned in f and g. If the recursion breaks down because a
+// leading principal submatrix of T is singular, ok is false.
+func levinson(col, row, x []float64, nrhs int) (f, g []float64, ok bool) {
+	n := len(col)
+	if col[0] == 0 {
+		return nil, nil, false
+	}
+	f = make([]float64, n)
+	g = make([]float64, n)
+	f[0] = 1 / col[0]
+	g[0] = f[0]
+	for j := 0; j < nrhs; j++ {
+		x[j] *= f[0]
+	}
+	for k := 1; k < n; k++ {
+		// f and g solve the order k system with right-hand sides e_0 and
+		// e_{k-1}. Extending them with a zero leaves residuals ef and eb
+		// in the new row and column.
+		var ef, eb float64
+		for i := 0; i < k; i++ {
+			ef += col[k-i] * f[i]
+			eb += row[i+1] * g[i]
+		}
+		d := 1 - ef*eb
+		if d == 0 {
+			return nil, nil, false
+		}
+		// Iterate backwards so that g[i-1] still holds its old value.
+		for i := k; i >= 0; i-- {
+			var fi, gi float64
+			if i < k {
+				fi = f[i]
+			}
+			if i > 0 {
+				gi = g[i-1]
+			}
+			f[i] = (fi - ef*gi) / d
+			g[i] = (gi - eb*fi) / d
+		}
+		for j := 0; j < nrhs; j++ {
+			var ex float64
+			for i := 0; i < k; i++ {
+				ex += col[k-i] * x[i*nrhs+j]
+			}
+			c := x[k*nrhs+j] - ex
+			for i := 0; i < k; i++ {
+				x[i*nrhs+j] += c * g[i]
+			}
+			x[k*nrhs+j] = c * g[k]
+		}
+	}
+	for _, v := range f {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, nil, false
+		}
+	}
+	return f, g, true
+}
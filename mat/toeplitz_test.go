@@ -0,0 +1,152 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// randToeplitz returns a random r×c Toeplitz matrix. If the matrix is square
+// its diagonal is made dominant so that Levinson recursion is stable.
+func randToeplitz(r, c int, sym bool, rnd *rand.Rand) *Toeplitz {
+	col := make([]float64, r)
+	randomSlice(col, rnd)
+	var row []float64
+	if !sym {
+		row = make([]float64, c)
+		randomSlice(row, rnd)
+		row[0] = col[0]
+	}
+	if r == c {
+		col[0] = float64(2 * r)
+		if row != nil {
+			row[0] = col[0]
+		}
+	}
+	return NewToeplitz(col, row)
+}
+
+func TestToeplitz(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		r, c int
+		sym  bool
+	}{
+		{1, 1, false},
+		{2, 2, true},
+		{5, 5, false},
+		{17, 17, true},
+		{3, 100, false},
+		{100, 3, false},
+		{70, 90, false},
+		{70, 70, false},
+		{130, 130, true},
+	} {
+		a := randToeplitz(test.r, test.c, test.sym, rnd)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				want := a.col[0]
+				if i > j {
+					want = a.col[i-j]
+				} else if j > i {
+					want = a.row[j-i]
+				}
+				if got := a.At(i, j); got != want {
+					t.Fatalf("unexpected element at (%d,%d): got %v want %v", i, j, got, want)
+				}
+			}
+		}
+		name := fmt.Sprintf("%d×%d sym=%t", test.r, test.c, test.sym)
+		testStructured(t, name, a, 1e-10, rnd)
+	}
+}
+
+func TestToeplitzSolveTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 3, 10, 40} {
+		for _, bc := range []int{1, 4} {
+			a := randToeplitz(n, n, false, rnd)
+			b := NewDense(n, bc, nil)
+			randomSlice(b.RawMatrix().Data, rnd)
+			for _, trans := range []bool{false, true} {
+				var x Dense
+				err := a.SolveTo(&x, trans, b)
+				if err != nil {
+					t.Errorf("unexpected error for n=%d: %v", n, err)
+					continue
+				}
+				var op Matrix = a
+				if trans {
+					op = a.T()
+				}
+				var got Dense
+				got.Mul(op, &x)
+				if !EqualApprox(&got, b, 1e-10) {
+					t.Errorf("solution does not satisfy system for n=%d bc=%d trans=%t", n, bc, trans)
+				}
+			}
+		}
+	}
+}
+
+func TestToeplitzInverseTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 8, 31} {
+		for _, sym := range []bool{false, true} {
+			a := randToeplitz(n, n, sym, rnd)
+			var got Dense
+			err := a.InverseTo(&got)
+			if err != nil {
+				t.Errorf("unexpected error for n=%d sym=%t: %v", n, sym, err)
+				continue
+			}
+			var want Dense
+			err = want.Inverse(DenseCopyOf(a))
+			if err != nil {
+				t.Fatalf("unexpected error from dense inverse: %v", err)
+			}
+			if !EqualApprox(&got, &want, 1e-12) {
+				t.Errorf("unexpected inverse for n=%d sym=%t:\ngot: %v\nwant:%v", n, sym, Formatted(&got), Formatted(&want))
+			}
+		}
+	}
+}
+
+func TestToeplitzBreakdown(t *testing.T) {
+	t.Parallel()
+	// The matrix is a nonsingular permutation, but its leading 1×1
+	// submatrix is singular.
+	a := NewToeplitz([]float64{0, 1}, nil)
+	b := NewVecDense(2, []float64{1, 2})
+	var x VecDense
+	if _, ok := a.SolveVecTo(&x, false, b).(Condition); !ok {
+		t.Errorf("expected Condition error from SolveVecTo")
+	}
+	var inv Dense
+	if _, ok := a.InverseTo(&inv).(Condition); !ok {
+		t.Errorf("expected Condition error from InverseTo")
+	}
+	// The leading 2×2 submatrix is singular.
+	a = NewToeplitz([]float64{1, 1, 2}, []float64{1, 1, 3})
+	var y VecDense
+	if _, ok := a.SolveVecTo(&y, false, NewVecDense(3, nil)).(Condition); !ok {
+		t.Errorf("expected Condition error from SolveVecTo")
+	}
+
+	if p, _ := panics(func() { NewToeplitz([]float64{1, 2}, []float64{2, 1}) }); !p {
+		t.Errorf("expected panic for mismatched diagonal")
+	}
+	if p, _ := panics(func() { NewToeplitz(nil, nil) }); !p {
+		t.Errorf("expected panic for empty matrix")
+	}
+	if p, _ := panics(func() { NewToeplitz([]float64{1, 2}, []float64{1}).SolveVecTo(&x, false, b) }); !p {
+		t.Errorf("expected panic for non-square solve")
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+var (
+	vandermonde *Vandermonde
+	_           Matrix = vandermonde
+)
+
+// Vandermonde represents an r×c Vandermonde matrix whose rows are the
+// successive powers of a set of nodes, so that element (i, j) is x[i]^j.
+//
+// Solving V * a = b with a square Vandermonde matrix finds the coefficients
+// of the polynomial of degree less than n that interpolates the points
+// (x[i], b[i]). Square systems are solved in O(n²) time using the
+// Björck–Pereyra algorithms, which are often far more accurate than the
+// condition number of V suggests.
+type Vandermonde struct {
+	x []float64
+	c int
+}
+
+// NewVandermonde creates a new len(x)×c Vandermonde matrix with nodes x. The
+// slice is used as the backing data, so changes to its elements will be
+// reflected in the returned matrix.
+//
+// NewVandermonde will panic if x has zero length or if c is not positive.
+func NewVandermonde(x []float64, c int) *Vandermonde {
+	if c <= 0 {
+		if c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if len(x) == 0 {
+		panic(ErrZeroLength)
+	}
+	return &Vandermonde{x: x, c: c}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (v *Vandermonde) Dims() (r, c int) {
+	return len(v.x), v.c
+}
+
+// At returns the element at row i, column j.
+func (v *Vandermonde) At(i, j int) float64 {
+	if uint(i) >= uint(len(v.x)) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(v.c) {
+		panic(ErrColAccess)
+	}
+	p := 1.0
+	for k := 0; k < j; k++ {
+		p *= v.x[i]
+	}
+	return p
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (v *Vandermonde) T() Matrix {
+	return Transpose{v}
+}
+
+// Nodes returns the nodes of the matrix. The returned slice is the backing data
+// of the receiver.
+func (v *Vandermonde) Nodes() []float64 {
+	return v.x
+}
+
+// MulVecTo computes V⋅a or Vᵀ⋅a storing the result into dst. The product V⋅a
+// evaluates the polynomial with coefficients a at each of the nodes.
+func (v *Vandermonde) MulVecTo(dst *VecDense, trans bool, a Vector) {
+	r, c := v.Dims()
+	if trans {
+		r, c = c, r
+	}
+	if a.Len() != c {
+		panic(ErrShape)
+	}
+	ad := vecData(a)
+	y := make([]float64, r)
+	if trans {
+		for i, xi := range v.x {
+			p := ad[i]
+			for j := range y {
+				y[j] += p
+				p *= xi
+			}
+		}
+	} else {
+		for i, xi := range v.x {
+			var sum float64
+			for j := c - 1; j >= 0; j-- {
+				sum = sum*xi + ad[j]
+			}
+			y[i] = sum
+		}
+	}
+	setVecData(dst, y)
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	V * a = b   if trans == false
+//	Vᵀ * a = b  if trans == true
+//
+// for the square Vandermonde matrix V using the Björck–Pereyra algorithms,
+// storing the result into dst.
+//
+// If two nodes are equal V is singular, a Condition error is returned and the
+// contents of dst are undefined.
+//
+// SolveVecTo will panic with ErrSquare if V is not square.
+func (v *Vandermonde) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	n := len(v.x)
+	if v.c != n {
+		panic(ErrSquare)
+	}
+	if b.Len() != n {
+		panic(ErrShape)
+	}
+	x := v.x
+	a := vecData(b)
+	if trans {
+		for k := 0; k < n-1; k++ {
+			for i := n - 1; i > k; i-- {
+				a[i] -= x[k] * a[i-1]
+			}
+		}
+		for k := n - 2; k >= 0; k-- {
+			for i := k + 1; i < n; i++ {
+				d := x[i] - x[i-k-1]
+				if d == 0 {
+					dst.reuseAsNonZeroed(n)
+					return Condition(math.Inf(1))
+				}
+				a[i] /= d
+			}
+			for i := k; i < n-1; i++ {
+				a[i] -= a[i+1]
+			}
+		}
+	} else {
+		// Compute the Newton divided differences and then convert the
+		// Newton form of the polynomial to the monomial basis.
+		for k := 0; k < n-1; k++ {
+			for i := n - 1; i > k; i-- {
+				d := x[i] - x[i-k-1]
+				if d == 0 {
+					dst.reuseAsNonZeroed(n)
+					return Condition(math.Inf(1))
+				}
+				a[i] = (a[i] - a[i-1]) / d
+			}
+		}
+		for k := n - 2; k >= 0; k-- {
+			for i := k; i < n-1; i++ {
+				a[i] -= a[i+1] * x[k]
+			}
+		}
+	}
+	setVecData(dst, a)
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestVandermonde(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ r, c int }{
+		{1, 1},
+		{2, 2},
+		{3, 5},
+		{5, 3},
+		{8, 8},
+		{12, 12},
+	} {
+		// Chebyshev points keep the square systems well conditioned.
+		x := make([]float64, test.r)
+		for i := range x {
+			x[i] = math.Cos(math.Pi * (float64(i) + 0.5) / float64(test.r))
+		}
+		a := NewVandermonde(x, test.c)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				if got, want := a.At(i, j), math.Pow(x[i], float64(j)); math.Abs(got-want) > 1e-15 {
+					t.Fatalf("unexpected element at (%d,%d): got %v want %v", i, j, got, want)
+				}
+			}
+		}
+		testStructured(t, fmt.Sprintf("%d×%d", test.r, test.c), a, 1e-10, rnd)
+	}
+}
+
+func TestVandermondeInterpolate(t *testing.T) {
+	t.Parallel()
+	// The interpolating polynomial of degree 3 through points on
+	// p(x) = 1 - 2x + 3x³ recovers its coefficients.
+	x := []float64{-2, -0.5, 1, 3}
+	b := NewVecDense(len(x), nil)
+	for i, v := range x {
+		b.SetVec(i, 1-2*v+3*v*v*v)
+	}
+	var coef VecDense
+	err := NewVandermonde(x, len(x)).SolveVecTo(&coef, false, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := NewVecDense(4, []float64{1, -2, 0, 3})
+	if !EqualApprox(&coef, want, 1e-14) {
+		t.Errorf("unexpected coefficients: got %v want %v", coef.RawVector().Data, want.RawVector().Data)
+	}
+
+	for _, trans := range []bool{false, true} {
+		var x VecDense
+		err = NewVandermonde([]float64{1, 2, 1}, 3).SolveVecTo(&x, trans, NewVecDense(3, nil))
+		if _, ok := err.(Condition); !ok {
+			t.Errorf("expected Condition error for repeated nodes with trans=%t", trans)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/internal/lapack32"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Cholesky is a symmetric positive definite matrix represented by its
+// Cholesky decomposition.
+//
+// The decomposition can be constructed using the Factorize method. The
+// factorization itself can be extracted using the UTo method, and values
+// can be solved for using the SolveTo and SolveVecTo methods.
+//
+// The factorization is computed in single precision using a blocked
+// algorithm routed through blas32.
+type Cholesky struct {
+	// The chol pointer must never be retained as a pointer outside the Cholesky
+	// struct, either by returning chol outside the struct or by setting it to
+	// a pointer coming from outside. The same prohibition applies to the data
+	// slice within chol.
+	chol *Dense
+	cond float32
+}
+
+// updateCond updates the condition number estimate of the Cholesky
+// decomposition. The estimate is the square of the ratio of the largest to
+// the smallest diagonal element of U, which is a lower bound on the condition
+// number of A in the 2-norm.
+func (c *Cholesky) updateCond() {
+	n := c.chol.mat.Rows
+	lo, hi := float32(math.MaxFloat32), float32(0)
+	for i := 0; i < n; i++ {
+		v := c.chol.at(i, i)
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	r := hi / lo
+	c.cond = r * r
+}
+
+// Factorize calculates the Cholesky decomposition of the matrix A and returns
+// whether the matrix is positive definite. If Factorize returns false, the
+// factorization must not be used.
+func (c *Cholesky) Factorize(a Symmetric) (ok bool) {
+	n := a.SymmetricDim()
+	if c.chol == nil {
+		c.chol = &Dense{}
+	} else {
+		c.chol.Reset()
+	}
+	c.chol.reuseAsNonZeroed(n, n)
+	for i := 0; i < n; i++ {
+		row := c.chol.mat.Data[i*c.chol.mat.Stride : i*c.chol.mat.Stride+n]
+		zero(row[:i])
+		if rs, ok := a.(RawSymmetricer); ok {
+			s := rs.RawSymmetric()
+			copy(row[i:], s.Data[i*s.Stride+i:i*s.Stride+n])
+			continue
+		}
+		for j := i; j < n; j++ {
+			row[j] = a.At(i, j)
+		}
+	}
+	ok = lapack32.Spotrf(n, c.chol.mat.Data, c.chol.mat.Stride)
+	if !ok {
+		c.Reset()
+		return false
+	}
+	c.updateCond()
+	return true
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (c *Cholesky) Reset() {
+	if c.chol != nil {
+		c.chol.Reset()
+	}
+	c.cond = float32(math.Inf(1))
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (c *Cholesky) IsEmpty() bool {
+	return c.chol == nil || c.chol.IsEmpty()
+}
+
+// SymmetricDim implements the Symmetric interface and returns the number of rows
+// in the matrix (this is also the number of columns).
+func (c *Cholesky) SymmetricDim() int {
+	if c.chol == nil {
+		return 0
+	}
+	n, _ := c.chol.Dims()
+	return n
+}
+
+// Cond returns an estimate of the condition number of the factorized matrix.
+// See updateCond for the details of the estimate.
+func (c *Cholesky) Cond() float32 {
+	if c.IsEmpty() {
+		panic(badFact)
+	}
+	return c.cond
+}
+
+// LogDet returns the log of the determinant of the matrix that has been factorized.
+// The logarithm is accumulated in double precision.
+func (c *Cholesky) LogDet() float64 {
+	if c.IsEmpty() {
+		panic(badFact)
+	}
+	var det float64
+	for i := 0; i < c.chol.mat.Rows; i++ {
+		det += 2 * math.Log(float64(c.chol.at(i, i)))
+	}
+	return det
+}
+
+// Det returns the determinant of the matrix that has been factorized.
+func (c *Cholesky) Det() float64 {
+	return math.Exp(c.LogDet())
+}
+
+// UTo stores into dst the n×n upper triangular matrix U from a Cholesky
+// decomposition
+//
+//	A = Uᵀ * U.
+//
+// The elements below the diagonal of dst are set to zero. If dst is empty,
+// it is resized to be an n×n matrix. When dst is non-empty, UTo panics if dst
+// is not n×n.
+func (c *Cholesky) UTo(dst *Dense) {
+	if c.IsEmpty() {
+		panic(badFact)
+	}
+	n := c.chol.mat.Rows
+	dst.reuseAsNonZeroed(n, n)
+	dst.Copy(c.chol)
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition. The result is stored in-place into dst.
+// If the estimated condition number of A exceeds ConditionTolerance, SolveTo
+// returns a mat.Condition error, but the solution is still computed.
+func (c *Cholesky) SolveTo(dst *Dense, b Matrix) error {
+	if c.IsEmpty() {
+		panic(badFact)
+	}
+	n := c.chol.mat.Rows
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(bm, bn)
+	if b != dst {
+		dst.Copy(b)
+	}
+	lapack32.Spotrs(n, bn, c.chol.mat.Data, c.chol.mat.Stride, dst.mat.Data, dst.mat.Stride)
+	if c.cond > ConditionTolerance {
+		return mat.Condition(c.cond)
+	}
+	return nil
+}
+
+// SolveVecTo finds the vector x that solves A * x = b where A is represented
+// by the Cholesky decomposition. The result is stored in-place into
+// dst. See SolveTo for the conditions under which an error is returned.
+func (c *Cholesky) SolveVecTo(dst *VecDense, b Vector) error {
+	if c.IsEmpty() {
+		panic(badFact)
+	}
+	n := c.chol.mat.Rows
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(n)
+	if dst != b {
+		dst.CopyVec(b)
+	}
+	lapack32.Spotrs(n, 1, c.chol.mat.Data, c.chol.mat.Stride, dst.mat.Data, dst.mat.Inc)
+	if c.cond > ConditionTolerance {
+		return mat.Condition(c.cond)
+	}
+	return nil
+}
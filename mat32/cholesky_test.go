@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// randSPD returns a well-conditioned n×n symmetric positive definite matrix.
+func randSPD(n int, rnd *rand.Rand) *SymDense {
+	var s SymDense
+	s.SymOuterK(1, randDense(n, n, rnd))
+	for i := 0; i < n; i++ {
+		s.SetSym(i, i, s.At(i, i)+float32(n))
+	}
+	return &s
+}
+
+func TestCholesky(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 10, 70, 130} {
+		a := randSPD(n, rnd)
+		var a64 mat.SymDense
+		a.To64(&a64)
+
+		var chol Cholesky
+		if !chol.Factorize(a) {
+			t.Errorf("n=%d: unexpected factorization failure", n)
+			continue
+		}
+		var want mat.Cholesky
+		want.Factorize(&a64)
+
+		var u Dense
+		chol.UTo(&u)
+		var wantU mat.TriDense
+		want.UTo(&wantU)
+		if !equalApprox64(&u, &wantU, 1e-4) {
+			t.Errorf("n=%d: unexpected U", n)
+		}
+		if got, want := chol.LogDet(), want.LogDet(); math.Abs(got-want) > 1e-4*math.Abs(want)+1e-4 {
+			t.Errorf("n=%d: unexpected log determinant: got %v, want %v", n, got, want)
+		}
+
+		b := randDense(n, 3, rnd)
+		var x Dense
+		if err := chol.SolveTo(&x, b); err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		}
+		var wantX mat.Dense
+		want.SolveTo(&wantX, to64(b))
+		if !equalApprox64(&x, &wantX, 1e-4) {
+			t.Errorf("n=%d: unexpected solution", n)
+		}
+
+		bv := randVecDense(n, rnd)
+		var xv VecDense
+		if err := chol.SolveVecTo(&xv, bv); err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		}
+		wantX.Reset()
+		want.SolveTo(&wantX, to64(bv))
+		if !equalApprox64(&xv, &wantX, 1e-4) {
+			t.Errorf("n=%d: unexpected vector solution", n)
+		}
+	}
+
+	var chol Cholesky
+	if chol.Factorize(NewSymDense(2, []float32{1, 2, 2, 1})) {
+		t.Error("unexpected success for indefinite matrix")
+	}
+	if !chol.IsEmpty() {
+		t.Error("unexpected non-empty factorization after failure")
+	}
+
+	if !chol.Factorize(NewSymDense(2, []float32{1, 0, 0, 1e-10})) {
+		t.Fatal("unexpected failure for ill-conditioned matrix")
+	}
+	var x Dense
+	if _, ok := chol.SolveTo(&x, NewDense(2, 1, []float32{1, 1})).(mat.Condition); !ok {
+		t.Error("expected Condition error for ill-conditioned matrix")
+	}
+}
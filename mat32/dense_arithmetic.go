@@ -0,0 +1,152 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// Add adds a and b element-wise, placing the result in the receiver. Add
+// will panic if the two matrices do not have the same shape.
+func (m *Dense) Add(a, b Matrix) {
+	m.elementwise(a, b, func(x, y float32) float32 { return x + y })
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape.
+func (m *Dense) Sub(a, b Matrix) {
+	m.elementwise(a, b, func(x, y float32) float32 { return x - y })
+}
+
+// MulElem performs element-wise multiplication of a and b, placing the result
+// in the receiver. MulElem will panic if the two matrices do not have the same
+// shape.
+func (m *Dense) MulElem(a, b Matrix) {
+	m.elementwise(a, b, func(x, y float32) float32 { return x * y })
+}
+
+// elementwise applies fn to the corresponding elements of a and b, placing
+// the result in the receiver.
+func (m *Dense) elementwise(a, b Matrix, fn func(x, y float32) float32) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+	m.reuseAsNonZeroed(ar, ac)
+	a = m.unalias(a)
+	b = m.unalias(b)
+
+	ag, aTrans, aok := rawGeneral(a)
+	bg, bTrans, bok := rawGeneral(b)
+	if aok && bok && aTrans == blas.NoTrans && bTrans == blas.NoTrans {
+		for i := 0; i < ar; i++ {
+			row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+ac]
+			arow := ag.Data[i*ag.Stride : i*ag.Stride+ac]
+			brow := bg.Data[i*bg.Stride : i*bg.Stride+ac]
+			for j := range row {
+				row[j] = fn(arow[j], brow[j])
+			}
+		}
+		return
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			m.set(i, j, fn(a.At(i, j), b.At(i, j)))
+		}
+	}
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+func (m *Dense) Scale(f float32, a Matrix) {
+	ar, ac := a.Dims()
+	m.reuseAsNonZeroed(ar, ac)
+	a = m.unalias(a)
+	if g, trans, ok := rawGeneral(a); ok && trans == blas.NoTrans {
+		for i := 0; i < ar; i++ {
+			row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+ac]
+			for j, v := range g.Data[i*g.Stride : i*g.Stride+ac] {
+				row[j] = f * v
+			}
+		}
+		return
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			m.set(i, j, f*a.At(i, j))
+		}
+	}
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver.
+// If the number of columns in a does not equal the number of rows in b, Mul will panic.
+func (m *Dense) Mul(a, b Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(ErrShape)
+	}
+	m.reuseAsNonZeroed(ar, bc)
+
+	// Compute into temporary storage if the receiver shares data
+	// with either operand.
+	if m.shares(a) || m.shares(b) {
+		var tmp Dense
+		tmp.Mul(a, b)
+		m.Copy(&tmp)
+		return
+	}
+
+	if as, ok := a.(*SymDense); ok {
+		if bg, bTrans, ok := rawGeneral(b); ok && bTrans == blas.NoTrans {
+			blas32.Symm(blas.Left, 1, as.mat, bg, 0, m.mat)
+			return
+		}
+	}
+	if bs, ok := b.(*SymDense); ok {
+		if ag, aTrans, ok := rawGeneral(a); ok && aTrans == blas.NoTrans {
+			blas32.Symm(blas.Right, 1, bs.mat, ag, 0, m.mat)
+			return
+		}
+	}
+
+	ag, aTrans, ok := rawGeneral(a)
+	if !ok {
+		ag, aTrans = DenseCopyOf(a).mat, blas.NoTrans
+	}
+	bg, bTrans, ok := rawGeneral(b)
+	if !ok {
+		bg, bTrans = DenseCopyOf(b).mat, blas.NoTrans
+	}
+	blas32.Gemm(aTrans, bTrans, 1, ag, bg, 0, m.mat)
+}
+
+// shares returns whether a is stored in the same backing array as the
+// receiver.
+func (m *Dense) shares(a Matrix) bool {
+	u, _ := untranspose(a)
+	switch u := u.(type) {
+	case RawMatrixer:
+		return sameBacking(u.RawMatrix().Data, m.mat.Data)
+	case RawVectorer:
+		return sameBacking(u.RawVector().Data, m.mat.Data)
+	case RawSymmetricer:
+		return sameBacking(u.RawSymmetric().Data, m.mat.Data)
+	}
+	return false
+}
+
+// unalias returns a copy of a if it shares backing data with the receiver
+// in a layout that differs from the receiver's, and a otherwise.
+func (m *Dense) unalias(a Matrix) Matrix {
+	if !m.shares(a) {
+		return a
+	}
+	if g, trans, ok := rawGeneral(a); ok && trans == blas.NoTrans && g.Stride == m.mat.Stride && &g.Data[0] == &m.mat.Data[0] {
+		return a
+	}
+	return DenseCopyOf(a)
+}
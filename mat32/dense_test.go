@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// randDense returns an r×c matrix with random elements.
+func randDense(r, c int, rnd *rand.Rand) *Dense {
+	d := NewDense(r, c, nil)
+	for i := range d.mat.Data {
+		d.mat.Data[i] = float32(rnd.NormFloat64())
+	}
+	return d
+}
+
+// to64 returns the double precision representation of a.
+func to64(a Matrix) *mat.Dense {
+	var d mat.Dense
+	DenseCopyOf(a).To64(&d)
+	return &d
+}
+
+// equalApprox64 returns whether the single precision matrix a is within
+// tol of the double precision matrix b.
+func equalApprox64(a Matrix, b mat.Matrix, tol float64) bool {
+	return mat.EqualApprox(to64(a), b, tol)
+}
+
+func TestNewDense(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		r, c int
+		data []float32
+		want error
+	}{
+		{r: 2, c: 3, data: []float32{1, 2, 3, 4, 5, 6}},
+		{r: 2, c: 3},
+		{r: 0, c: 3, want: ErrZeroLength},
+		{r: -1, c: 3, want: ErrNegativeDimension},
+		{r: 2, c: 2, data: []float32{1, 2, 3}, want: ErrShape},
+	} {
+		err := mat.Maybe(func() { NewDense(test.r, test.c, test.data) })
+		if err != nil {
+			err = err.(mat.ErrorStack).Err
+		}
+		if err != test.want {
+			t.Errorf("unexpected error for r=%d c=%d: got %v, want %v", test.r, test.c, err, test.want)
+		}
+	}
+	m := NewDense(2, 3, []float32{1, 2, 3, 4, 5, 6})
+	if v := m.At(1, 2); v != 6 {
+		t.Errorf("unexpected value: got %v, want 6", v)
+	}
+	m.Set(0, 1, -1)
+	if v := m.T().At(1, 0); v != -1 {
+		t.Errorf("unexpected transposed value: got %v, want -1", v)
+	}
+	s := m.Slice(0, 2, 1, 3).(*Dense)
+	if !Equal(s, NewDense(2, 2, []float32{-1, 3, 5, 6})) {
+		t.Errorf("unexpected slice: %v", s.mat.Data)
+	}
+}
+
+func TestDenseConversion(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	a := randDense(4, 3, rnd)
+	var a64 mat.Dense
+	a.To64(&a64)
+	var got Dense
+	got.From64(&a64)
+	if !Equal(&got, a) {
+		t.Errorf("round trip through double precision does not match")
+	}
+	var gotT Dense
+	gotT.From64(a64.T())
+	if !Equal(&gotT, a.T()) {
+		t.Errorf("round trip of transpose through double precision does not match")
+	}
+}
+
+func TestDenseArithmetic(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-5
+	for _, test := range []struct{ m, k, n int }{
+		{m: 1, k: 1, n: 1},
+		{m: 3, k: 4, n: 5},
+		{m: 10, k: 7, n: 2},
+		{m: 50, k: 60, n: 40},
+	} {
+		a := randDense(test.m, test.k, rnd)
+		b := randDense(test.k, test.n, rnd)
+		c := randDense(test.m, test.k, rnd)
+		a64, b64, c64 := to64(a), to64(b), to64(c)
+
+		var got Dense
+		var want mat.Dense
+		got.Mul(a, b)
+		want.Mul(a64, b64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("m=%d k=%d n=%d: unexpected Mul result", test.m, test.k, test.n)
+		}
+
+		got.Reset()
+		want.Reset()
+		got.Mul(a.T(), c)
+		want.Mul(a64.T(), c64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("m=%d k=%d n=%d: unexpected Mul result for transposed operand", test.m, test.k, test.n)
+		}
+
+		for _, op := range []struct {
+			name string
+			fn   func(dst *Dense, a, b Matrix)
+			fn64 func(dst *mat.Dense, a, b mat.Matrix)
+		}{
+			{"Add", (*Dense).Add, (*mat.Dense).Add},
+			{"Sub", (*Dense).Sub, (*mat.Dense).Sub},
+			{"MulElem", (*Dense).MulElem, (*mat.Dense).MulElem},
+		} {
+			got.Reset()
+			want.Reset()
+			op.fn(&got, a, c)
+			op.fn64(&want, a64, c64)
+			if !equalApprox64(&got, &want, tol) {
+				t.Errorf("m=%d k=%d: unexpected %s result", test.m, test.k, op.name)
+			}
+		}
+
+		got.Reset()
+		want.Reset()
+		got.Scale(2.5, a)
+		want.Scale(2.5, a64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("m=%d k=%d: unexpected Scale result", test.m, test.k)
+		}
+	}
+}
+
+func TestDenseAliasing(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-5
+
+	a := randDense(4, 4, rnd)
+	want := to64(a)
+	var want64 mat.Dense
+	want64.Mul(want, want)
+	a.Mul(a, a)
+	if !equalApprox64(a, &want64, tol) {
+		t.Errorf("unexpected Mul result for receiver aliasing operands")
+	}
+
+	a = randDense(4, 4, rnd)
+	want = to64(a)
+	want64.Reset()
+	want64.Add(want, want.T())
+	a.Add(a, a.T())
+	if !equalApprox64(a, &want64, tol) {
+		t.Errorf("unexpected Add result for receiver aliasing a transposed operand")
+	}
+
+	a = randDense(5, 5, rnd)
+	want = to64(a)
+	view := a.Slice(1, 4, 1, 4).(*Dense)
+	want64.Reset()
+	want64.Mul(want.Slice(0, 3, 0, 3), want.Slice(2, 5, 2, 5))
+	view.Mul(a.Slice(0, 3, 0, 3), a.Slice(2, 5, 2, 5))
+	if !equalApprox64(view, &want64, tol) {
+		t.Errorf("unexpected Mul result for receiver overlapping operands")
+	}
+}
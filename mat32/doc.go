@@ -0,0 +1,27 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mat32 provides float32 matrix structures and linear algebra
+// operations on them.
+//
+// mat32 mirrors a subset of the mat package for workloads where memory
+// bandwidth or capacity, rather than accuracy, is the limiting factor. A
+// float32 matrix uses half the memory of the equivalent mat.Dense, and its
+// operations are computed using blas32 and single precision LAPACK routines.
+//
+// mat32 provides:
+//   - Interfaces for Matrix classes (Matrix, Vector, Symmetric)
+//   - Concrete implementations (Dense, VecDense, SymDense)
+//   - Methods for using matrix data (Add, Sub, Scale, Mul)
+//   - Types for constructing and using matrix factorizations (Cholesky, LU, SVD)
+//
+// The conventions of the mat package apply. In particular, matrices are
+// stored in row-major order, empty matrices may be used as the receiver of
+// operations, which will size them appropriately, and dimension mismatches
+// cause a panic with the corresponding mat.Error. Values may be converted to
+// and from double precision with the From64 and To64 methods.
+//
+// Operations where the receiver shares backing data with an operand are
+// computed using temporary storage.
+package mat32 // import "gonum.org/v1/gonum/mat32"
@@ -0,0 +1,206 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/internal/lapack32"
+	"gonum.org/v1/gonum/mat"
+)
+
+// LU is a square matrix decomposition into a lower unit triangular matrix, L,
+// an upper triangular matrix, U, and a permutation matrix, P, such that
+//
+//	A = P * L * U.
+//
+// The factorization is computed in single precision using a blocked algorithm
+// with partial pivoting routed through blas32.
+type LU struct {
+	lu    *Dense
+	swaps []int
+	cond  float32
+	ok    bool // Whether A is nonsingular
+}
+
+// updateCond updates the condition number estimate of the LU decomposition.
+// The estimate is the ratio of the largest to the smallest absolute diagonal
+// element of U, which is a lower bound on the condition number of A in the
+// 2-norm and is infinite if A is singular.
+func (lu *LU) updateCond() {
+	if !lu.ok {
+		lu.cond = float32(math.Inf(1))
+		return
+	}
+	n := lu.lu.mat.Rows
+	lo, hi := float32(math.MaxFloat32), float32(0)
+	for i := 0; i < n; i++ {
+		v := lu.lu.at(i, i)
+		if v < 0 {
+			v = -v
+		}
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	lu.cond = hi / lo
+}
+
+// Factorize computes the LU factorization of the square matrix A and stores
+// the result in the receiver. The LU decomposition will complete regardless
+// of the singularity of a.
+//
+// Factorize will panic if a is not square.
+func (lu *LU) Factorize(a Matrix) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	if lu.lu == nil {
+		lu.lu = NewDense(r, r, nil)
+	} else {
+		lu.lu.Reset()
+		lu.lu.reuseAsNonZeroed(r, r)
+	}
+	lu.lu.Copy(a)
+	lu.swaps = useInt(lu.swaps, r)
+	lu.ok = lapack32.Sgetrf(r, lu.lu.mat.Data, lu.lu.mat.Stride, lu.swaps)
+	lu.updateCond()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of
+// a dimensionally restricted operation.
+func (lu *LU) Reset() {
+	if lu.lu != nil {
+		lu.lu.Reset()
+	}
+	lu.swaps = lu.swaps[:0]
+	lu.ok = false
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (lu *LU) IsEmpty() bool {
+	return lu.lu == nil || lu.lu.IsEmpty()
+}
+
+// Cond returns an estimate of the condition number of the factorized matrix.
+// See updateCond for the details of the estimate.
+func (lu *LU) Cond() float32 {
+	if lu.IsEmpty() {
+		panic(badFact)
+	}
+	return lu.cond
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. The logarithm is accumulated in
+// double precision.
+func (lu *LU) LogDet() (det float64, sign float64) {
+	if lu.IsEmpty() {
+		panic(badFact)
+	}
+	sign = 1
+	for i, p := range lu.swaps {
+		if p != i {
+			sign = -sign
+		}
+		v := float64(lu.lu.at(i, i))
+		if v < 0 {
+			sign = -sign
+		}
+		det += math.Log(math.Abs(v))
+	}
+	return det, sign
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+func (lu *LU) Det() float64 {
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᵀ * X = B  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution matrix X
+// is stored into dst.
+//
+// If A is singular or its estimated condition number exceeds
+// ConditionTolerance, SolveTo returns a mat.Condition error. If A is exactly
+// singular, the solution is not computed.
+func (lu *LU) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if lu.IsEmpty() {
+		panic(badFact)
+	}
+	n := lu.lu.mat.Rows
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	if !lu.ok {
+		return mat.Condition(math.Inf(1))
+	}
+	dst.reuseAsNonZeroed(n, bc)
+	if b != dst {
+		dst.Copy(b)
+	}
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack32.Sgetrs(t, n, bc, lu.lu.mat.Data, lu.lu.mat.Stride, lu.swaps, dst.mat.Data, dst.mat.Stride)
+	if lu.cond > ConditionTolerance {
+		return mat.Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b   if trans == false
+//	Aᵀ * x = b  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution vector x
+// is stored into dst. See SolveTo for the conditions under which an error is
+// returned.
+func (lu *LU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if lu.IsEmpty() {
+		panic(badFact)
+	}
+	n := lu.lu.mat.Rows
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	if !lu.ok {
+		return mat.Condition(math.Inf(1))
+	}
+	dst.reuseAsNonZeroed(n)
+	if dst != b {
+		dst.CopyVec(b)
+	}
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack32.Sgetrs(t, n, 1, lu.lu.mat.Data, lu.lu.mat.Stride, lu.swaps, dst.mat.Data, dst.mat.Inc)
+	if lu.cond > ConditionTolerance {
+		return mat.Condition(lu.cond)
+	}
+	return nil
+}
+
+// useInt returns an int slice with l elements, using i if it
+// has the necessary capacity, otherwise creating a new slice.
+func useInt(i []int, l int) []int {
+	if l <= cap(i) {
+		return i[:l]
+	}
+	return make([]int, l)
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 10, 70, 130} {
+		a := randDense(n, n, rnd)
+		for i := 0; i < n; i++ {
+			a.Set(i, i, a.At(i, i)+float32(n))
+		}
+		a64 := to64(a)
+
+		var lu LU
+		lu.Factorize(a)
+		var want mat.LU
+		want.Factorize(a64)
+
+		if got, want := lu.Det(), want.Det(); math.Abs(got-want) > 1e-4*math.Abs(want) {
+			t.Errorf("n=%d: unexpected determinant: got %v, want %v", n, got, want)
+		}
+
+		for _, trans := range []bool{false, true} {
+			b := randDense(n, 2, rnd)
+			var x Dense
+			if err := lu.SolveTo(&x, trans, b); err != nil {
+				t.Errorf("n=%d trans=%t: unexpected error: %v", n, trans, err)
+			}
+			var wantX mat.Dense
+			want.SolveTo(&wantX, trans, to64(b))
+			if !equalApprox64(&x, &wantX, 1e-4) {
+				t.Errorf("n=%d trans=%t: unexpected solution", n, trans)
+			}
+
+			bv := randVecDense(n, rnd)
+			var xv VecDense
+			if err := lu.SolveVecTo(&xv, trans, bv); err != nil {
+				t.Errorf("n=%d trans=%t: unexpected error: %v", n, trans, err)
+			}
+			wantX.Reset()
+			want.SolveTo(&wantX, trans, to64(bv))
+			if !equalApprox64(&xv, &wantX, 1e-4) {
+				t.Errorf("n=%d trans=%t: unexpected vector solution", n, trans)
+			}
+		}
+	}
+
+	var lu LU
+	lu.Factorize(NewDense(2, 2, []float32{1, 2, 2, 4}))
+	var x Dense
+	err := lu.SolveTo(&x, false, NewDense(2, 1, []float32{1, 1}))
+	if c, ok := err.(mat.Condition); !ok || !math.IsInf(float64(c), 1) {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+	if lu.Det() != 0 {
+		t.Errorf("unexpected determinant for singular matrix: %v", lu.Det())
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/internal/math32"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Errors used by mat32 are the errors of the mat package so that mat.Maybe
+// and mat.Error type assertions apply to both packages.
+var (
+	ErrNegativeDimension = mat.ErrNegativeDimension
+	ErrIndexOutOfRange   = mat.ErrIndexOutOfRange
+	ErrReuseNonEmpty     = mat.ErrReuseNonEmpty
+	ErrRowAccess         = mat.ErrRowAccess
+	ErrColAccess         = mat.ErrColAccess
+	ErrVectorAccess      = mat.ErrVectorAccess
+	ErrZeroLength        = mat.ErrZeroLength
+	ErrSquare            = mat.ErrSquare
+	ErrSingular          = mat.ErrSingular
+	ErrShape             = mat.ErrShape
+)
+
+const (
+	badFact = "mat32: use without successful factorization"
+	badCap  = "mat32: bad capacity"
+)
+
+// ConditionTolerance is the tolerance limit of the condition number. If the
+// condition number is above this value, the matrix is considered singular.
+const ConditionTolerance = 1e7
+
+// Matrix is the basic matrix interface type.
+type Matrix interface {
+	// Dims returns the dimensions of a Matrix.
+	Dims() (r, c int)
+
+	// At returns the value of a matrix element at row i, column j.
+	// It will panic if i or j are out of bounds for the matrix.
+	At(i, j int) float32
+
+	// T returns the transpose of the Matrix. Whether T returns a copy of the
+	// underlying data is implementation dependent.
+	// This method may be implemented using the Transpose type, which
+	// provides an implicit matrix transpose.
+	T() Matrix
+}
+
+// Vector is a vector.
+type Vector interface {
+	Matrix
+	AtVec(int) float32
+	Len() int
+}
+
+// Symmetric represents a symmetric matrix (where the element at {i, j} equals
+// the element at {j, i}). Symmetric matrices are always square.
+type Symmetric interface {
+	Matrix
+	// SymmetricDim returns the number of rows/columns in the matrix.
+	SymmetricDim() int
+}
+
+// RawMatrixer is a type that can return a blas32.General representation of
+// the receiver. Changes to the blas32.General.Data slice will be reflected in
+// the original matrix, changes to the Rows, Cols and Stride fields will not.
+type RawMatrixer interface {
+	RawMatrix() blas32.General
+}
+
+// RawVectorer is a type that can return a blas32.Vector representation of the
+// receiver. Changes to the blas32.Vector.Data slice will be reflected in the
+// original matrix, changes to the Inc field will not.
+type RawVectorer interface {
+	RawVector() blas32.Vector
+}
+
+// RawSymmetricer is a type that can return a blas32.Symmetric representation
+// of the receiver. Changes to the blas32.Symmetric.Data slice will be
+// reflected in the original matrix, changes to the N and Stride fields will
+// not.
+type RawSymmetricer interface {
+	RawSymmetric() blas32.Symmetric
+}
+
+var (
+	_ Matrix       = Transpose{}
+	_ Untransposer = Transpose{}
+)
+
+// Transpose is a type for performing an implicit matrix transpose. It implements
+// the Matrix interface, returning values from the transpose of the matrix within.
+type Transpose struct {
+	Matrix Matrix
+}
+
+// At returns the value of the element at row i and column j of the transposed
+// matrix, that is, row j and column i of the Matrix field.
+func (t Transpose) At(i, j int) float32 {
+	return t.Matrix.At(j, i)
+}
+
+// Dims returns the dimensions of the transposed matrix. The number of rows returned
+// is the number of columns in the Matrix field, and the number of columns is
+// the number of rows in the Matrix field.
+func (t Transpose) Dims() (r, c int) {
+	c, r = t.Matrix.Dims()
+	return r, c
+}
+
+// T performs an implicit transpose by returning the Matrix field.
+func (t Transpose) T() Matrix {
+	return t.Matrix
+}
+
+// Untranspose returns the Matrix field.
+func (t Transpose) Untranspose() Matrix {
+	return t.Matrix
+}
+
+// Untransposer is a type that can undo an implicit transpose.
+type Untransposer interface {
+	// Untranspose returns the underlying Matrix stored for the implicit transpose.
+	Untranspose() Matrix
+}
+
+// untranspose untransposes a matrix if applicable. If a is an Untransposer, then
+// untranspose returns the underlying matrix and true. If it is not, then it returns
+// the input matrix and false.
+func untranspose(a Matrix) (Matrix, bool) {
+	if ut, ok := a.(Untransposer); ok {
+		return ut.Untranspose(), true
+	}
+	return a, false
+}
+
+// rawGeneral returns the blas32.General representation of a and whether a is
+// implicitly transposed. If a does not have a general representation, ok is
+// false.
+func rawGeneral(a Matrix) (g blas32.General, trans blas.Transpose, ok bool) {
+	u, t := untranspose(a)
+	trans = blas.NoTrans
+	if t {
+		trans = blas.Trans
+	}
+	switch u := u.(type) {
+	case RawMatrixer:
+		return u.RawMatrix(), trans, true
+	case RawVectorer:
+		v := u.RawVector()
+		if v.N == 0 {
+			return blas32.General{}, trans, false
+		}
+		return blas32.General{Rows: v.N, Cols: 1, Stride: v.Inc, Data: v.Data}, trans, true
+	}
+	return blas32.General{}, trans, false
+}
+
+// sameBacking returns whether a and b are slices of the same backing array.
+func sameBacking(a, b []float32) bool {
+	if cap(a) == 0 || cap(b) == 0 {
+		return false
+	}
+	return &a[:cap(a)][cap(a)-1] == &b[:cap(b)][cap(b)-1]
+}
+
+// Equal returns whether the matrices a and b have the same size
+// and are element-wise equal.
+func Equal(a, b Matrix) bool {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return false
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			if a.At(i, j) != b.At(i, j) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// EqualApprox returns whether the matrices a and b have the same size and contain all equal
+// elements with tolerance for element-wise equality specified by epsilon. Matrices
+// with non-equal shapes are not equal.
+func EqualApprox(a, b Matrix, epsilon float32) bool {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return false
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			if !equalWithinAbsOrRel(a.At(i, j), b.At(i, j), epsilon, epsilon) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// equalWithinAbsOrRel returns whether a and b are equal to within the
+// absolute or relative tolerances.
+func equalWithinAbsOrRel(a, b, absTol, relTol float32) bool {
+	if a == b {
+		return true
+	}
+	delta := math32.Abs(a - b)
+	if delta <= absTol {
+		return true
+	}
+	return delta/math32.Max(math32.Abs(a), math32.Abs(b)) <= relTol
+}
+
+// Dot returns the sum of the element-wise product of a and b.
+// Dot panics with ErrShape if the vector sizes are unequal.
+func Dot(a, b Vector) float32 {
+	la := a.Len()
+	lb := b.Len()
+	if la != lb {
+		panic(ErrShape)
+	}
+	if la == 0 {
+		return 0
+	}
+	if arv, ok := a.(RawVectorer); ok {
+		if brv, ok := b.(RawVectorer); ok {
+			return blas32.Dot(arv.RawVector(), brv.RawVector())
+		}
+	}
+	var sum float32
+	for i := 0; i < la; i++ {
+		sum += a.AtVec(i) * b.AtVec(i)
+	}
+	return sum
+}
+
+// use returns a float32 slice with l elements, using f if it
+// has the necessary capacity, otherwise creating a new slice.
+func use(f []float32, l int) []float32 {
+	if l <= cap(f) {
+		return f[:l]
+	}
+	return make([]float32, l)
+}
+
+// useZeroed returns a float32 slice with l elements, using f if it
+// has the necessary capacity, otherwise creating a new slice. The
+// elements of the returned slice are guaranteed to be zero.
+func useZeroed(f []float32, l int) []float32 {
+	if l <= cap(f) {
+		f = f[:l]
+		zero(f)
+		return f
+	}
+	return make([]float32, l)
+}
+
+// zero zeros the given slice's elements.
+func zero(f []float32) {
+	for i := range f {
+		f[i] = 0
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/internal/lapack32"
+	"gonum.org/v1/gonum/mat"
+)
+
+const badRcond = "mat32: invalid rcond value"
+
+// SVD is a type for creating and using the Singular Value Decomposition
+// of a matrix.
+//
+// The decomposition is computed in single precision with the one-sided Jacobi
+// method, which computes the singular values to high relative accuracy.
+type SVD struct {
+	kind SVDKind
+
+	s  []float32
+	u  blas32.General
+	vt blas32.General
+}
+
+// SVDKind specifies the treatment of singular vectors during an SVD
+// factorization. Only the thin singular vectors may be computed.
+type SVDKind int
+
+const (
+	// SVDNone specifies that no singular vectors should be computed during
+	// the decomposition.
+	SVDNone SVDKind = 0
+
+	// SVDThinU specifies the thin decomposition for U should be computed.
+	SVDThinU SVDKind = 1 << iota
+	// SVDThinV specifies the thin decomposition for V should be computed.
+	SVDThinV
+
+	// SVDThin is a convenience value for computing both thin vectors.
+	SVDThin SVDKind = SVDThinU | SVDThinV
+)
+
+// succFact returns whether the receiver contains a successful factorization.
+func (svd *SVD) succFact() bool {
+	return len(svd.s) != 0
+}
+
+// Factorize computes the singular value decomposition (SVD) of the input matrix A.
+// The singular values of A are computed in all cases, while the singular
+// vectors are optionally computed depending on the input kind.
+//
+// The full singular value decomposition (kind == SVDThin) deconstructs A as
+//
+//	A = U * Σ * Vᵀ
+//
+// where Σ is a k×k diagonal matrix containing the non-negative singular values
+// of A in decreasing order, U is an m×k matrix and V is an n×k matrix, with
+// k = min(m, n). The columns of U and V are the left and right singular
+// vectors respectively.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, routines that require a successful factorization will panic.
+func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
+	if kind&^SVDThin != 0 {
+		panic("mat32: bad SVDKind")
+	}
+	// kill previous factorization
+	svd.s = svd.s[:0]
+	svd.kind = kind
+
+	m, n := a.Dims()
+	k := min(m, n)
+	wantU := kind&SVDThinU != 0
+	wantV := kind&SVDThinV != 0
+
+	// The Jacobi rotations are applied to the rows of a matrix with no
+	// more rows than columns; this is A when m < n and Aᵀ otherwise.
+	// In the second case the roles of U and V are exchanged.
+	rows, cols := m, n
+	wantQ := wantU
+	var w *Dense
+	if m < n {
+		w = DenseCopyOf(a)
+	} else {
+		w = DenseCopyOf(a.T())
+		rows, cols = n, m
+		wantQ = wantV
+	}
+	s := use(svd.s, k)
+	var q []float32
+	if wantQ {
+		q = make([]float32, rows*rows)
+	}
+	ok = lapack32.Sgesvj(rows, cols, w.mat.Data, w.mat.Stride, s, q, rows)
+	if !ok {
+		return false
+	}
+
+	// The matrix factorized is Q * Σ * W, where the rows of W are
+	// orthonormal.
+	var u, vt blas32.General
+	if m < n {
+		if wantU {
+			u = blas32.General{Rows: m, Cols: m, Stride: m, Data: q}
+		}
+		if wantV {
+			vt = w.mat
+		}
+	} else {
+		if wantV {
+			// V = Q, so Vᵀ is the transpose of Q.
+			vt = blas32.General{Rows: k, Cols: n, Stride: n, Data: make([]float32, n*n)}
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					vt.Data[j*n+i] = q[i*n+j]
+				}
+			}
+		}
+		if wantU {
+			// U = Wᵀ.
+			u = blas32.General{Rows: m, Cols: k, Stride: k, Data: make([]float32, m*k)}
+			for i := 0; i < k; i++ {
+				for j := 0; j < m; j++ {
+					u.Data[j*k+i] = w.mat.Data[i*w.mat.Stride+j]
+				}
+			}
+		}
+	}
+	svd.s = s
+	svd.u = u
+	svd.vt = vt
+	return true
+}
+
+// Kind returns the SVDKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (svd *SVD) Kind() SVDKind {
+	if !svd.succFact() {
+		return -1
+	}
+	return svd.kind
+}
+
+// Rank returns the rank of A based on the count of singular values greater than
+// rcond scaled by the largest singular value.
+// Rank will panic if the receiver does not contain a successful factorization or
+// rcond is negative.
+func (svd *SVD) Rank(rcond float32) int {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	s0 := svd.s[0]
+	for i, v := range svd.s {
+		if v <= rcond*s0 {
+			return i
+		}
+	}
+	return len(svd.s)
+}
+
+// Cond returns the 2-norm condition number for the factorized matrix. Cond will
+// panic if the receiver does not contain a successful factorization.
+func (svd *SVD) Cond() float32 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	return svd.s[0] / svd.s[len(svd.s)-1]
+}
+
+// Values returns the singular values of the factorized matrix in descending order.
+//
+// If the input slice is non-nil, the values will be stored in-place into
+// the slice. In this case, the slice must have length min(m,n), and Values will
+// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
+// slice of the appropriate length will be allocated and returned.
+//
+// Values will panic if the receiver does not contain a successful factorization.
+func (svd *SVD) Values(s []float32) []float32 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if s == nil {
+		s = make([]float32, len(svd.s))
+	}
+	if len(s) != len(svd.s) {
+		panic(mat.ErrSliceLengthMismatch)
+	}
+	copy(s, svd.s)
+	return s
+}
+
+// UTo extracts the matrix U from the singular value decomposition. The first
+// min(m,n) columns are the left singular vectors and correspond to the singular
+// values as returned from SVD.Values.
+//
+// If dst is empty, UTo will resize dst to be m×min(m,n). When dst is
+// non-empty, UTo will panic if dst is not the appropriate size. UTo will also
+// panic if the receiver does not contain a successful factorization, or if U
+// was not computed during factorization.
+func (svd *SVD) UTo(dst *Dense) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if svd.kind&SVDThinU == 0 {
+		panic("mat32: improper SVD kind")
+	}
+	dst.reuseAsNonZeroed(svd.u.Rows, svd.u.Cols)
+	tmp := &Dense{mat: svd.u, capRows: svd.u.Rows, capCols: svd.u.Cols}
+	dst.Copy(tmp)
+}
+
+// VTo extracts the matrix V from the singular value decomposition. The first
+// min(m,n) columns are the right singular vectors and correspond to the singular
+// values as returned from SVD.Values.
+//
+// If dst is empty, VTo will resize dst to be n×min(m,n). When dst is
+// non-empty, VTo will panic if dst is not the appropriate size. VTo will also
+// panic if the receiver does not contain a successful factorization, or if V
+// was not computed during factorization.
+func (svd *SVD) VTo(dst *Dense) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if svd.kind&SVDThinV == 0 {
+		panic("mat32: improper SVD kind")
+	}
+	dst.reuseAsNonZeroed(svd.vt.Cols, svd.vt.Rows)
+	tmp := &Dense{mat: svd.vt, capRows: svd.vt.Rows, capCols: svd.vt.Cols}
+	dst.Copy(tmp.T())
+}
@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestSVD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct{ m, n int }{
+		{m: 1, n: 1},
+		{m: 1, n: 5},
+		{m: 5, n: 1},
+		{m: 4, n: 4},
+		{m: 12, n: 7},
+		{m: 7, n: 12},
+		{m: 40, n: 30},
+	} {
+		m, n := test.m, test.n
+		k := min(m, n)
+		a := randDense(m, n, rnd)
+		var want mat.SVD
+		want.Factorize(to64(a), mat.SVDNone)
+		wantValues := want.Values(nil)
+
+		for _, kind := range []SVDKind{SVDNone, SVDThinU, SVDThinV, SVDThin} {
+			var svd SVD
+			if !svd.Factorize(a, kind) {
+				t.Errorf("m=%d n=%d kind=%d: unexpected failure", m, n, kind)
+				continue
+			}
+			if svd.Kind() != kind {
+				t.Errorf("m=%d n=%d: unexpected kind: got %d, want %d", m, n, svd.Kind(), kind)
+			}
+			values := svd.Values(nil)
+			for i, v := range values {
+				if math.Abs(float64(v)-wantValues[i]) > 1e-5*wantValues[0] {
+					t.Errorf("m=%d n=%d kind=%d: unexpected singular value %d: got %v, want %v", m, n, kind, i, v, wantValues[i])
+				}
+			}
+
+			var u, v Dense
+			if kind&SVDThinU != 0 {
+				svd.UTo(&u)
+				if r, c := u.Dims(); r != m || c != k {
+					t.Errorf("m=%d n=%d kind=%d: unexpected U shape %d×%d", m, n, kind, r, c)
+				}
+				checkOrthonormal(t, &u, "U", m, n, kind)
+			}
+			if kind&SVDThinV != 0 {
+				svd.VTo(&v)
+				if r, c := v.Dims(); r != n || c != k {
+					t.Errorf("m=%d n=%d kind=%d: unexpected V shape %d×%d", m, n, kind, r, c)
+				}
+				checkOrthonormal(t, &v, "V", m, n, kind)
+			}
+			if kind == SVDThin {
+				// Check A = U * Σ * Vᵀ.
+				for j := 0; j < k; j++ {
+					for i := 0; i < m; i++ {
+						u.Set(i, j, u.At(i, j)*values[j])
+					}
+				}
+				var rec Dense
+				rec.Mul(&u, v.T())
+				if !equalApprox64(&rec, to64(a), 1e-4) {
+					t.Errorf("m=%d n=%d: unexpected reconstruction", m, n)
+				}
+			}
+		}
+	}
+}
+
+// checkOrthonormal checks that the columns of q are orthonormal.
+func checkOrthonormal(t *testing.T, q *Dense, name string, m, n int, kind SVDKind) {
+	t.Helper()
+	var qtq Dense
+	qtq.Mul(q.T(), q)
+	_, c := q.Dims()
+	for i := 0; i < c; i++ {
+		for j := 0; j < c; j++ {
+			want := float32(0)
+			if i == j {
+				want = 1
+			}
+			if d := qtq.At(i, j) - want; d > 1e-5 || d < -1e-5 {
+				t.Errorf("m=%d n=%d kind=%d: columns of %s not orthonormal", m, n, kind, name)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	symDense *SymDense
+
+	_ Matrix         = symDense
+	_ Symmetric      = symDense
+	_ RawSymmetricer = symDense
+)
+
+// SymDense is a symmetric matrix that uses dense storage. SymDense
+// matrices are stored in the upper triangle.
+type SymDense struct {
+	mat blas32.Symmetric
+	cap int
+}
+
+// NewSymDense creates a new Symmetric matrix with n rows and columns. If data == nil,
+// a new slice is allocated for the backing slice. If len(data) == n*n, data is
+// used as the backing slice, and changes to the elements of the returned SymDense
+// will be reflected in data. If neither of these is true, NewSymDense will panic.
+// NewSymDense will panic if n is zero.
+//
+// The data must be arranged in row-major order, i.e. the (i*c + j)-th
+// element in the data slice is the {i, j}-th element in the matrix.
+// Only the values in the upper triangular portion of the matrix are used.
+func NewSymDense(n int, data []float32) *SymDense {
+	if n <= 0 {
+		if n == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if data != nil && n*n != len(data) {
+		panic(ErrShape)
+	}
+	if data == nil {
+		data = make([]float32, n*n)
+	}
+	return &SymDense{
+		mat: blas32.Symmetric{
+			N:      n,
+			Stride: n,
+			Data:   data,
+			Uplo:   blas.Upper,
+		},
+		cap: n,
+	}
+}
+
+// reuseAsNonZeroed resizes an empty matrix to a n×n matrix,
+// or checks that a non-empty matrix is n×n.
+func (s *SymDense) reuseAsNonZeroed(n int) {
+	if n == 0 {
+		panic(ErrZeroLength)
+	}
+	if s.mat.N > s.cap {
+		// Panic as a string, not a mat.Error.
+		panic(badCap)
+	}
+	if s.IsEmpty() {
+		s.mat = blas32.Symmetric{
+			N:      n,
+			Stride: n,
+			Data:   use(s.mat.Data, n*n),
+			Uplo:   blas.Upper,
+		}
+		s.cap = n
+		return
+	}
+	if s.mat.Uplo != blas.Upper {
+		panic(badSymTriangle)
+	}
+	if s.mat.N != n {
+		panic(ErrShape)
+	}
+}
+
+const badSymTriangle = "mat32: blas32.Symmetric not upper"
+
+// Dims returns the number of rows and columns in the matrix.
+func (s *SymDense) Dims() (r, c int) {
+	return s.mat.N, s.mat.N
+}
+
+// SymmetricDim implements the Symmetric interface and returns the number of rows
+// and columns in the matrix.
+func (s *SymDense) SymmetricDim() int {
+	return s.mat.N
+}
+
+// T returns the receiver, the transpose of a symmetric matrix.
+func (s *SymDense) T() Matrix {
+	return s
+}
+
+// At returns the element at row i and column j.
+func (s *SymDense) At(i, j int) float32 {
+	if uint(i) >= uint(s.mat.N) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(s.mat.N) {
+		panic(ErrColAccess)
+	}
+	return s.at(i, j)
+}
+
+func (s *SymDense) at(i, j int) float32 {
+	if i > j {
+		i, j = j, i
+	}
+	return s.mat.Data[i*s.mat.Stride+j]
+}
+
+// SetSym sets the elements at (i,j) and (j,i) to the value v.
+func (s *SymDense) SetSym(i, j int, v float32) {
+	if uint(i) >= uint(s.mat.N) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(s.mat.N) {
+		panic(ErrColAccess)
+	}
+	s.set(i, j, v)
+}
+
+func (s *SymDense) set(i, j int, v float32) {
+	if i > j {
+		i, j = j, i
+	}
+	s.mat.Data[i*s.mat.Stride+j] = v
+}
+
+// RawSymmetric returns the matrix as a blas32.Symmetric. The returned
+// value must be stored in upper triangular format.
+func (s *SymDense) RawSymmetric() blas32.Symmetric {
+	return s.mat
+}
+
+// SetRawSymmetric sets the underlying blas32.Symmetric used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in the input.
+//
+// The supplied Symmetric must use blas.Upper storage format.
+func (s *SymDense) SetRawSymmetric(mat blas32.Symmetric) {
+	if mat.Uplo != blas.Upper {
+		panic(badSymTriangle)
+	}
+	s.cap = mat.N
+	s.mat = mat
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (s *SymDense) IsEmpty() bool {
+	// It must be the case that m.Dims() returns
+	// zeros in this case. See comment in Reset().
+	return s.mat.N == 0
+}
+
+// Reset empties the matrix so that it can be reused as the
+// receiver of a dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data.
+func (s *SymDense) Reset() {
+	// N and Stride must be zeroed in unison.
+	s.mat.N, s.mat.Stride = 0, 0
+	s.mat.Data = s.mat.Data[:0]
+}
+
+// Zero sets all of the matrix elements to zero.
+func (s *SymDense) Zero() {
+	for i := 0; i < s.mat.N; i++ {
+		zero(s.mat.Data[i*s.mat.Stride+i : i*s.mat.Stride+s.mat.N])
+	}
+}
+
+// CopySym makes a copy of elements of a into the receiver. It is similar to the
+// built-in copy; it copies as much as the overlap between the two matrices and
+// returns the number of rows and columns it copied.
+func (s *SymDense) CopySym(a Symmetric) int {
+	n := a.SymmetricDim()
+	n = min(n, s.mat.N)
+	if n == 0 || a == Symmetric(s) {
+		return n
+	}
+	if s.sharesSym(a) {
+		a = symDenseCopyOf(a)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.set(i, j, a.At(i, j))
+		}
+	}
+	return n
+}
+
+// AddSym performs an addition and assigns the result to the receiver.
+func (s *SymDense) AddSym(a, b Symmetric) {
+	n := a.SymmetricDim()
+	if n != b.SymmetricDim() {
+		panic(ErrShape)
+	}
+	s.reuseAsNonZeroed(n)
+	if s.sharesSym(a) && a != Symmetric(s) {
+		a = symDenseCopyOf(a)
+	}
+	if s.sharesSym(b) && b != Symmetric(s) {
+		b = symDenseCopyOf(b)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.set(i, j, a.At(i, j)+b.At(i, j))
+		}
+	}
+}
+
+// ScaleSym multiplies the elements of a by f, placing the result in the receiver.
+func (s *SymDense) ScaleSym(f float32, a Symmetric) {
+	n := a.SymmetricDim()
+	s.reuseAsNonZeroed(n)
+	if s.sharesSym(a) && a != Symmetric(s) {
+		a = symDenseCopyOf(a)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.set(i, j, f*a.At(i, j))
+		}
+	}
+}
+
+// SymOuterK calculates the outer product of x with itself and stores
+// the result into the receiver. It is equivalent to the matrix
+// multiplication
+//
+//	s = alpha * x * x'.
+//
+// In order to update an existing matrix, see SymRankK in the mat package.
+func (s *SymDense) SymOuterK(alpha float32, x Matrix) {
+	n, _ := x.Dims()
+	switch {
+	case s.IsEmpty():
+		s.mat = blas32.Symmetric{
+			N:      n,
+			Stride: n,
+			Data:   useZeroed(s.mat.Data, n*n),
+			Uplo:   blas.Upper,
+		}
+		s.cap = n
+	case s.mat.N != n:
+		panic(ErrShape)
+	default:
+		s.Zero()
+	}
+	g, trans, ok := rawGeneral(x)
+	if !ok || sameBacking(g.Data, s.mat.Data) {
+		g, trans = DenseCopyOf(x).mat, blas.NoTrans
+	}
+	blas32.Syrk(trans, alpha, g, 0, s.mat)
+}
+
+// From64 copies the elements of the double precision symmetric matrix a into
+// the receiver, rounding them to single precision. If the receiver is empty,
+// it is resized to the dimension of a, otherwise From64 panics with ErrShape
+// if the dimensions do not match.
+func (s *SymDense) From64(a mat.Symmetric) {
+	n := a.SymmetricDim()
+	s.reuseAsNonZeroed(n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.set(i, j, float32(a.At(i, j)))
+		}
+	}
+}
+
+// To64 copies the elements of the receiver into dst. If dst is empty, it is
+// resized to the dimension of the receiver, otherwise To64 panics with
+// ErrShape if the dimensions do not match.
+func (s *SymDense) To64(dst *mat.SymDense) {
+	n := s.mat.N
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(n)
+	} else if dst.SymmetricDim() != n {
+		panic(ErrShape)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			dst.SetSym(i, j, float64(s.at(i, j)))
+		}
+	}
+}
+
+// sharesSym returns whether a is stored in the same backing array as the
+// receiver.
+func (s *SymDense) sharesSym(a Symmetric) bool {
+	d := Dense{mat: blas32.General{Data: s.mat.Data}}
+	return d.shares(a)
+}
+
+// symDenseCopyOf returns a newly allocated copy of the elements of a.
+func symDenseCopyOf(a Symmetric) *SymDense {
+	n := a.SymmetricDim()
+	s := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.set(i, j, a.At(i, j))
+		}
+	}
+	return s
+}
@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// randSymDense returns an n×n symmetric matrix with random elements.
+func randSymDense(n int, rnd *rand.Rand) *SymDense {
+	s := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.SetSym(i, j, float32(rnd.NormFloat64()))
+		}
+	}
+	return s
+}
+
+func TestSymDense(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-5
+	for _, n := range []int{1, 4, 30} {
+		a := randSymDense(n, rnd)
+		b := randSymDense(n, rnd)
+		var a64, b64 mat.SymDense
+		a.To64(&a64)
+		b.To64(&b64)
+
+		var got SymDense
+		var want mat.SymDense
+		got.AddSym(a, b)
+		want.AddSym(&a64, &b64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected AddSym result", n)
+		}
+
+		got.Reset()
+		want.Reset()
+		got.ScaleSym(-1.5, a)
+		want.ScaleSym(-1.5, &a64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected ScaleSym result", n)
+		}
+
+		for _, x := range []Matrix{randDense(n, n+3, rnd), randDense(n+3, n, rnd).T()} {
+			got.Reset()
+			want.Reset()
+			got.SymOuterK(0.5, x)
+			want.SymOuterK(0.5, to64(x))
+			if !equalApprox64(&got, &want, tol) {
+				t.Errorf("n=%d: unexpected SymOuterK result", n)
+			}
+		}
+
+		c := randDense(n, 3, rnd)
+		var gotMul Dense
+		var wantMul mat.Dense
+		gotMul.Mul(a, c)
+		wantMul.Mul(&a64, to64(c))
+		if !equalApprox64(&gotMul, &wantMul, tol) {
+			t.Errorf("n=%d: unexpected Mul result for symmetric left operand", n)
+		}
+		gotMul.Reset()
+		wantMul.Reset()
+		gotMul.Mul(c.T(), a)
+		wantMul.Mul(to64(c).T(), &a64)
+		if !equalApprox64(&gotMul, &wantMul, tol) {
+			t.Errorf("n=%d: unexpected Mul result for symmetric right operand", n)
+		}
+
+		var back SymDense
+		back.From64(&a64)
+		if !Equal(&back, a) {
+			t.Errorf("n=%d: round trip through double precision does not match", n)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	vector *VecDense
+
+	_ Matrix      = vector
+	_ Vector      = vector
+	_ RawVectorer = vector
+)
+
+// VecDense represents a column vector.
+type VecDense struct {
+	mat blas32.Vector
+	// A BLAS vector can have a negative increment, but allowing this
+	// in the mat32 type complicates a lot of code, and doesn't gain anything.
+	// VecDense must have positive increment in this package.
+}
+
+// NewVecDense creates a new VecDense of length n. If data == nil,
+// a new slice is allocated for the backing slice. If len(data) == n, data is
+// used as the backing slice, and changes to the elements of the returned VecDense
+// will be reflected in data. If neither of these is true, NewVecDense will panic.
+// NewVecDense will panic if n is zero.
+func NewVecDense(n int, data []float32) *VecDense {
+	if n <= 0 {
+		if n == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if len(data) != n && data != nil {
+		panic(ErrShape)
+	}
+	if data == nil {
+		data = make([]float32, n)
+	}
+	return &VecDense{
+		mat: blas32.Vector{
+			N:    n,
+			Inc:  1,
+			Data: data,
+		},
+	}
+}
+
+// reuseAsNonZeroed resizes an empty vector to a r×1 vector,
+// or checks that a non-empty matrix is r×1.
+func (v *VecDense) reuseAsNonZeroed(r int) {
+	if r == 0 {
+		panic(ErrZeroLength)
+	}
+	if v.IsEmpty() {
+		v.mat = blas32.Vector{
+			N:    r,
+			Inc:  1,
+			Data: use(v.mat.Data, r),
+		}
+		return
+	}
+	if r != v.mat.N {
+		panic(ErrShape)
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix. Columns is always 1
+// for a non-Reset vector.
+func (v *VecDense) Dims() (r, c int) {
+	if v.IsEmpty() {
+		return 0, 0
+	}
+	return v.mat.N, 1
+}
+
+// Len returns the length of the vector.
+func (v *VecDense) Len() int {
+	return v.mat.N
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (v *VecDense) T() Matrix {
+	return Transpose{v}
+}
+
+// At returns the element at row i.
+// It panics if i is out of bounds or if j is not zero.
+func (v *VecDense) At(i, j int) float32 {
+	if j != 0 {
+		panic(ErrColAccess)
+	}
+	return v.AtVec(i)
+}
+
+// AtVec returns the element at row i.
+// It panics if i is out of bounds.
+func (v *VecDense) AtVec(i int) float32 {
+	if uint(i) >= uint(v.mat.N) {
+		panic(ErrRowAccess)
+	}
+	return v.mat.Data[i*v.mat.Inc]
+}
+
+// SetVec sets the element at row i to the value val.
+// It panics if i is out of bounds.
+func (v *VecDense) SetVec(i int, val float32) {
+	if uint(i) >= uint(v.mat.N) {
+		panic(ErrVectorAccess)
+	}
+	v.mat.Data[i*v.mat.Inc] = val
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (v *VecDense) IsEmpty() bool {
+	// It must be the case that v.Dims() returns
+	// zeros in this case. See comment in Reset().
+	return v.mat.Inc == 0
+}
+
+// Reset empties the matrix so that it can be reused as the
+// receiver of a dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data.
+func (v *VecDense) Reset() {
+	// No change of Inc or N to 0 may be
+	// made unless both are set to 0.
+	v.mat.Inc = 0
+	v.mat.N = 0
+	v.mat.Data = v.mat.Data[:0]
+}
+
+// Zero sets all of the matrix elements to zero.
+func (v *VecDense) Zero() {
+	for i := 0; i < v.mat.N; i++ {
+		v.mat.Data[v.mat.Inc*i] = 0
+	}
+}
+
+// RawVector returns the underlying blas32.Vector used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in returned blas32.Vector.
+func (v *VecDense) RawVector() blas32.Vector {
+	return v.mat
+}
+
+// SetRawVector sets the underlying blas32.Vector used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in the input.
+//
+// The supplied Vector must not use a negative increment.
+func (v *VecDense) SetRawVector(a blas32.Vector) {
+	if a.Inc < 0 {
+		panic("mat32: negative vector increment")
+	}
+	v.mat = a
+}
+
+// CopyVec makes a copy of elements of a into the receiver. It is similar to
+// the built-in copy; it copies as much as the overlap between the two vectors
+// and returns the number of elements it copied.
+func (v *VecDense) CopyVec(a Vector) int {
+	n := min(v.Len(), a.Len())
+	if v == a {
+		return n
+	}
+	if r, ok := a.(RawVectorer); ok {
+		src := r.RawVector()
+		src.N = n
+		dst := v.mat
+		dst.N = n
+		if sameBacking(src.Data, dst.Data) {
+			src.Data = append([]float32(nil), src.Data[:(n-1)*src.Inc+1]...)
+		}
+		blas32.Copy(src, dst)
+		return n
+	}
+	for i := 0; i < n; i++ {
+		v.setVec(i, a.AtVec(i))
+	}
+	return n
+}
+
+// From64 copies the elements of the double precision vector a into the
+// receiver, rounding them to single precision. If the receiver is empty, it
+// is resized to the length of a, otherwise From64 panics with ErrShape if the
+// lengths do not match.
+func (v *VecDense) From64(a mat.Vector) {
+	v.reuseAsNonZeroed(a.Len())
+	for i := 0; i < v.mat.N; i++ {
+		v.setVec(i, float32(a.AtVec(i)))
+	}
+}
+
+// To64 copies the elements of the receiver into dst. If dst is empty, it is
+// resized to the length of the receiver, otherwise To64 panics with ErrShape
+// if the lengths do not match.
+func (v *VecDense) To64(dst *mat.VecDense) {
+	n := v.Len()
+	if dst.IsEmpty() {
+		dst.ReuseAsVec(n)
+	} else if dst.Len() != n {
+		panic(ErrShape)
+	}
+	for i := 0; i < n; i++ {
+		dst.SetVec(i, float64(v.at(i)))
+	}
+}
+
+// ScaleVec scales the vector a by alpha, placing the result in the receiver.
+func (v *VecDense) ScaleVec(alpha float32, a Vector) {
+	n := a.Len()
+	v.reuseAsNonZeroed(n)
+	a = v.unalias(a)
+	for i := 0; i < n; i++ {
+		v.setVec(i, alpha*a.AtVec(i))
+	}
+}
+
+// AddScaledVec adds the vectors a and alpha*b, placing the result in the receiver.
+func (v *VecDense) AddScaledVec(a Vector, alpha float32, b Vector) {
+	ar := a.Len()
+	if ar != b.Len() {
+		panic(ErrShape)
+	}
+	v.reuseAsNonZeroed(ar)
+	a = v.unalias(a)
+	b = v.unalias(b)
+	if brv, ok := b.(RawVectorer); ok && v != b {
+		if v != a {
+			v.CopyVec(a)
+		}
+		blas32.Axpy(alpha, brv.RawVector(), v.mat)
+		return
+	}
+	for i := 0; i < ar; i++ {
+		v.setVec(i, a.AtVec(i)+alpha*b.AtVec(i))
+	}
+}
+
+// AddVec adds the vectors a and b, placing the result in the receiver.
+func (v *VecDense) AddVec(a, b Vector) {
+	v.AddScaledVec(a, 1, b)
+}
+
+// SubVec subtracts the vector b from a, placing the result in the receiver.
+func (v *VecDense) SubVec(a, b Vector) {
+	v.AddScaledVec(a, -1, b)
+}
+
+// MulVec computes a * b. The result is stored into the receiver.
+// MulVec panics if the number of columns in a does not equal the number of rows in b
+// or if the number of columns in b does not equal 1.
+func (v *VecDense) MulVec(a Matrix, b Vector) {
+	r, c := a.Dims()
+	br, bc := b.Dims()
+	if c != br || bc != 1 {
+		panic(ErrShape)
+	}
+	v.reuseAsNonZeroed(r)
+
+	// Compute into temporary storage if the receiver shares data
+	// with either operand.
+	if v.shares(a) || v.shares(b) {
+		var tmp VecDense
+		tmp.MulVec(a, b)
+		v.CopyVec(&tmp)
+		return
+	}
+
+	bv, ok := b.(RawVectorer)
+	if !ok {
+		var tmp VecDense
+		tmp.reuseAsNonZeroed(br)
+		tmp.CopyVec(b)
+		bv = &tmp
+	}
+	if as, ok := a.(*SymDense); ok {
+		blas32.Symv(1, as.mat, bv.RawVector(), 0, v.mat)
+		return
+	}
+	ag, trans, ok := rawGeneral(a)
+	if !ok {
+		ag, trans = DenseCopyOf(a).mat, blas.NoTrans
+	}
+	blas32.Gemv(trans, 1, ag, bv.RawVector(), 0, v.mat)
+}
+
+// shares returns whether a is stored in the same backing array as the
+// receiver.
+func (v *VecDense) shares(a Matrix) bool {
+	d := Dense{mat: blas32.General{Data: v.mat.Data}}
+	return d.shares(a)
+}
+
+// unalias returns a copy of a if it shares backing data with the receiver
+// in a layout that differs from the receiver's, and a otherwise.
+func (v *VecDense) unalias(a Vector) Vector {
+	if !v.shares(a) {
+		return a
+	}
+	if r, ok := a.(RawVectorer); ok {
+		av := r.RawVector()
+		if av.Inc == v.mat.Inc && &av.Data[0] == &v.mat.Data[0] {
+			return a
+		}
+	}
+	var tmp VecDense
+	tmp.reuseAsNonZeroed(a.Len())
+	for i := 0; i < a.Len(); i++ {
+		tmp.setVec(i, a.AtVec(i))
+	}
+	return &tmp
+}
+
+// setVec sets the element at row i to the value val without bounds checking.
+func (v *VecDense) setVec(i int, val float32) {
+	v.mat.Data[i*v.mat.Inc] = val
+}
+
+// at returns the element at row i without bounds checking.
+func (v *VecDense) at(i int) float32 {
+	return v.mat.Data[i*v.mat.Inc]
+}
@@ -0,0 +1,115 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat32
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// randVecDense returns a vector of length n with random elements.
+func randVecDense(n int, rnd *rand.Rand) *VecDense {
+	v := NewVecDense(n, nil)
+	for i := range v.mat.Data {
+		v.mat.Data[i] = float32(rnd.NormFloat64())
+	}
+	return v
+}
+
+func TestVecDense(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const tol = 1e-5
+	for _, n := range []int{1, 3, 20} {
+		a := randDense(n, n+2, rnd)
+		s := randSymDense(n, rnd)
+		x := randVecDense(n+2, rnd)
+		y := randVecDense(n, rnd)
+		z := randVecDense(n, rnd)
+		var a64, x64, y64, z64 mat.Dense
+		a.To64(&a64)
+		DenseCopyOf(x).To64(&x64)
+		DenseCopyOf(y).To64(&y64)
+		DenseCopyOf(z).To64(&z64)
+
+		var got VecDense
+		var want mat.Dense
+		got.MulVec(a, x)
+		want.Mul(&a64, &x64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected MulVec result", n)
+		}
+
+		got.Reset()
+		want.Reset()
+		got.MulVec(a.T(), y)
+		want.Mul(a64.T(), &y64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected MulVec result for transposed matrix", n)
+		}
+
+		got.Reset()
+		want.Reset()
+		got.MulVec(s, y)
+		want.Mul(to64(s), &y64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected MulVec result for symmetric matrix", n)
+		}
+
+		got.Reset()
+		want.Reset()
+		got.AddScaledVec(y, -2, z)
+		want.Scale(-2, &z64)
+		want.Add(&y64, &want)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected AddScaledVec result", n)
+		}
+
+		got.Reset()
+		want.Reset()
+		got.SubVec(y, z)
+		want.Sub(&y64, &z64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected SubVec result", n)
+		}
+
+		got.Reset()
+		want.Reset()
+		got.ScaleVec(3, y)
+		want.Scale(3, &y64)
+		if !equalApprox64(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected ScaleVec result", n)
+		}
+
+		dot := Dot(y, z)
+		want64 := mat.Dot(y64.ColView(0), z64.ColView(0))
+		if d := float64(dot) - want64; d > tol*float64(n) || d < -tol*float64(n) {
+			t.Errorf("n=%d: unexpected Dot result: got %v, want %v", n, dot, want64)
+		}
+
+		// The receiver may alias the operands.
+		want.Reset()
+		want.Add(&y64, &y64)
+		y.AddVec(y, y)
+		if !equalApprox64(y, &want, tol) {
+			t.Errorf("n=%d: unexpected AddVec result for aliased receiver", n)
+		}
+	}
+}
+
+func TestVecDenseConversion(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	v := randVecDense(5, rnd)
+	var v64 mat.VecDense
+	v.To64(&v64)
+	var got VecDense
+	got.From64(&v64)
+	if !Equal(&got, v) {
+		t.Errorf("round trip through double precision does not match")
+	}
+}
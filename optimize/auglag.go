@@ -0,0 +1,300 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// augLagMaxPenalty is the largest penalty parameter used before
+	// AugmentedLagrangian gives up on reducing the constraint violation.
+	augLagMaxPenalty = 1e20
+	// augLagDecrease is the required factor of decrease of the constraint
+	// violation between iterations to keep the penalty parameter fixed.
+	augLagDecrease = 0.5
+)
+
+// AugmentedLagrangian is a method for nonlinearly constrained minimization
+// that solves a sequence of unconstrained problems.
+// At each iteration it minimizes the Powell-Hestenes-Rockafellar augmented
+// Lagrangian
+//
+//	L(x) = f(x) + λᵀc(x) + ρ/2 |c(x)|² + 1/(2ρ) Σⱼ (max(0, μⱼ+ρhⱼ(x))² - μⱼ²),
+//
+// where λ and μ are the current estimates of the Lagrange multipliers of the
+// equality constraints c and the inequality constraints h, and ρ is the
+// penalty parameter. The subproblems are solved by calling Minimize with
+// Method. After each subproblem the multipliers are updated by
+//
+//	λ ← λ + ρc(x),  μ ← max(0, μ+ρh(x)),
+//
+// and ρ is increased if the constraint violation has not decreased
+// sufficiently.
+//
+// References:
+//   - Birgin, E.G., Martínez, J.M.: Practical Augmented Lagrangian Methods
+//     for Constrained Optimization. SIAM (2014)
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer
+//     (2006), chapter 17
+type AugmentedLagrangian struct {
+	// Method is used to minimize the augmented Lagrangian at each iteration.
+	// Method must be a gradient-based Method that does not use the Hessian.
+	// If Method is nil, BFGS is used.
+	Method Method
+	// InitialPenalty is the initial value of the penalty parameter.
+	// If InitialPenalty is 0 it is defaulted to 10, otherwise it must be
+	// positive.
+	InitialPenalty float64
+	// PenaltyIncrease is the factor by which the penalty parameter is
+	// increased. If PenaltyIncrease is 0 it is defaulted to 10, otherwise it
+	// must be greater than 1.
+	PenaltyIncrease float64
+	// FeasibilityTolerance is the tolerance on the feasibility and
+	// complementarity residuals for convergence. If FeasibilityTolerance is 0
+	// it is defaulted to 1e-8.
+	FeasibilityTolerance float64
+	// OptimalityTolerance is the tolerance on the stationarity residual for
+	// convergence. If OptimalityTolerance is 0 it is defaulted to 1e-6.
+	OptimalityTolerance float64
+}
+
+func (al *AugmentedLagrangian) run(p *ConstrainedProblem, res *ConstrainedResult, settings *Settings, converger Converger, stats *Stats, startTime time.Time) (Status, error) {
+	method := al.Method
+	if method == nil {
+		method = &BFGS{}
+	}
+	rho := al.InitialPenalty
+	if rho == 0 {
+		rho = 10
+	}
+	if rho < 0 {
+		panic("optimize: initial penalty is not positive")
+	}
+	gamma := al.PenaltyIncrease
+	if gamma == 0 {
+		gamma = 10
+	}
+	if gamma <= 1 {
+		panic("optimize: penalty increase factor not greater than 1")
+	}
+	feasTol := al.FeasibilityTolerance
+	if feasTol == 0 {
+		feasTol = 1e-8
+	}
+	optTol := al.OptimalityTolerance
+	if optTol == 0 {
+		optTol = 1e-6
+	}
+
+	dim := len(res.X)
+	e := newAugLagEval(p, dim, res.EqualityMultipliers, res.InequalityMultipliers)
+	e.rho = rho
+	sub := Problem{
+		Func:   e.Func,
+		Grad:   e.Grad,
+		Status: p.Status,
+	}
+
+	x := res.X
+	innerTol := math.Max(optTol, 1e-2)
+	prevViol := math.Inf(1)
+	for {
+		inner := &Settings{
+			GradientThreshold: innerTol,
+			Converger:         NeverTerminate{},
+		}
+		if settings.FuncEvaluations > 0 {
+			inner.FuncEvaluations = settings.FuncEvaluations - stats.FuncEvaluations
+		}
+		if settings.GradEvaluations > 0 {
+			inner.GradEvaluations = settings.GradEvaluations - stats.GradEvaluations
+		}
+		if settings.Runtime > 0 {
+			inner.Runtime = settings.Runtime - time.Since(startTime)
+		}
+		r, err := Minimize(sub, x, inner, method)
+		if r == nil {
+			return Failure, err
+		}
+		stats.FuncEvaluations += r.FuncEvaluations
+		stats.GradEvaluations += r.GradEvaluations
+		switch err.(type) {
+		case ErrFunc, ErrGrad:
+			return Failure, err
+		}
+		// Other failures of the subproblem, for example of the linesearch
+		// close to the minimum, are not fatal and the next iteration
+		// continues from the best location found.
+		copy(x, r.X)
+
+		res.F = p.Func(x)
+		p.Grad(res.Gradient, x)
+		stats.FuncEvaluations++
+		stats.GradEvaluations++
+		e.constraints(x)
+		e.jacobians(x)
+
+		// Measure the constraint violation with respect to the current
+		// multipliers and update the multipliers.
+		var viol float64
+		for i, v := range e.c {
+			viol = math.Max(viol, math.Abs(v))
+			e.lambda[i] += rho * v
+		}
+		for j, v := range e.h {
+			viol = math.Max(viol, math.Abs(math.Min(-v, e.mu[j]/rho)))
+			e.mu[j] = math.Max(0, e.mu[j]+rho*v)
+		}
+		res.KKT = e.kkt(res.Gradient)
+
+		stats.MajorIterations++
+		stats.Runtime = time.Since(startTime)
+		if settings.Recorder != nil {
+			err = settings.Recorder.Record(&res.Location, MajorIteration, stats)
+			if err != nil {
+				return Failure, err
+			}
+		}
+		kkt := res.KKT
+		if kkt.Feasibility <= feasTol && kkt.Complementarity <= feasTol && kkt.Stationarity <= optTol {
+			return Success, nil
+		}
+		if status := converger.Converged(&res.Location); status != NotTerminated {
+			return status, nil
+		}
+		if status := checkIterationLimits(&res.Location, stats, settings); status != NotTerminated {
+			return status, nil
+		}
+		if status, err := checkEvaluationLimits(&p.Problem, stats, settings); status != NotTerminated || err != nil {
+			return status, err
+		}
+
+		if viol > feasTol && viol > augLagDecrease*prevViol {
+			rho *= gamma
+			if rho > augLagMaxPenalty {
+				return Failure, ErrInfeasible
+			}
+			e.rho = rho
+		}
+		prevViol = viol
+		innerTol = math.Max(optTol, innerTol/10)
+	}
+}
+
+// augLagEval evaluates the augmented Lagrangian of a ConstrainedProblem.
+type augLagEval struct {
+	p *ConstrainedProblem
+
+	lambda, mu []float64 // Multiplier estimates
+	rho        float64   // Penalty parameter
+
+	c, h   []float64  // Constraint values
+	jc, jh *mat.Dense // Constraint Jacobians, nil if there are no constraints
+	w      []float64  // Workspace
+}
+
+func newAugLagEval(p *ConstrainedProblem, dim int, lambda, mu []float64) *augLagEval {
+	e := &augLagEval{
+		p:      p,
+		lambda: lambda,
+		mu:     mu,
+		c:      make([]float64, p.NumEquality),
+		h:      make([]float64, p.NumInequality),
+		w:      make([]float64, max(p.NumEquality, p.NumInequality)),
+	}
+	if p.NumEquality > 0 {
+		e.jc = mat.NewDense(p.NumEquality, dim, nil)
+	}
+	if p.NumInequality > 0 {
+		e.jh = mat.NewDense(p.NumInequality, dim, nil)
+	}
+	return e
+}
+
+func (e *augLagEval) constraints(x []float64) {
+	if e.p.NumEquality > 0 {
+		e.p.Equality(e.c, x)
+	}
+	if e.p.NumInequality > 0 {
+		e.p.Inequality(e.h, x)
+	}
+}
+
+func (e *augLagEval) jacobians(x []float64) {
+	if e.jc != nil {
+		e.jc.Zero()
+		e.p.EqualityJac(e.jc, x)
+	}
+	if e.jh != nil {
+		e.jh.Zero()
+		e.p.InequalityJac(e.jh, x)
+	}
+}
+
+func (e *augLagEval) Func(x []float64) float64 {
+	f := e.p.Func(x)
+	e.constraints(x)
+	for i, v := range e.c {
+		f += e.lambda[i]*v + 0.5*e.rho*v*v
+	}
+	for j, v := range e.h {
+		t := math.Max(0, e.mu[j]+e.rho*v)
+		f += (t*t - e.mu[j]*e.mu[j]) / (2 * e.rho)
+	}
+	return f
+}
+
+func (e *augLagEval) Grad(grad, x []float64) {
+	e.p.Grad(grad, x)
+	e.constraints(x)
+	e.jacobians(x)
+	w := e.w[:len(e.c)]
+	for i, v := range e.c {
+		w[i] = e.lambda[i] + e.rho*v
+	}
+	addJacTVec(grad, e.jc, w)
+	w = e.w[:len(e.h)]
+	for j, v := range e.h {
+		w[j] = math.Max(0, e.mu[j]+e.rho*v)
+	}
+	addJacTVec(grad, e.jh, w)
+}
+
+// kkt returns the KKT residuals at the location where the constraints and
+// their Jacobians were last evaluated. grad is the gradient of the objective
+// function at the location.
+func (e *augLagEval) kkt(grad []float64) KKTResidual {
+	lagGrad := make([]float64, len(grad))
+	copy(lagGrad, grad)
+	addJacTVec(lagGrad, e.jc, e.lambda)
+	addJacTVec(lagGrad, e.jh, e.mu)
+
+	var res KKTResidual
+	res.Stationarity = floats.Norm(lagGrad, math.Inf(1))
+	for _, v := range e.c {
+		res.Feasibility = math.Max(res.Feasibility, math.Abs(v))
+	}
+	for j, v := range e.h {
+		res.Feasibility = math.Max(res.Feasibility, v)
+		res.Complementarity = math.Max(res.Complementarity, math.Abs(math.Min(-v, e.mu[j])))
+	}
+	return res
+}
+
+// addJacTVec adds jacᵀ*w to dst. jac may be nil if w is empty.
+func addJacTVec(dst []float64, jac *mat.Dense, w []float64) {
+	if jac == nil {
+		return
+	}
+	d := mat.NewVecDense(len(dst), dst)
+	var t mat.VecDense
+	t.MulVec(jac.T(), mat.NewVecDense(len(w), w))
+	d.AddVec(d, &t)
+}
@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ConstrainedProblem describes a nonlinearly constrained optimization problem
+//
+//	minimize    f(x)
+//	subject to  cᵢ(x) = 0, i = 0, ..., NumEquality-1,
+//	            hⱼ(x) ≤ 0, j = 0, ..., NumInequality-1.
+type ConstrainedProblem struct {
+	// Problem describes the objective function f. Func and Grad must be
+	// non-nil. Problem.Hess is not used.
+	Problem
+
+	// NumEquality is the number of equality constraints.
+	NumEquality int
+	// Equality evaluates the equality constraint functions at x and stores
+	// the result in dst which will have length NumEquality. Equality must
+	// not modify x.
+	Equality func(dst, x []float64)
+	// EqualityJac evaluates the Jacobian of the equality constraint functions
+	// at x and stores the result in dst which will be NumEquality×len(x).
+	// EqualityJac must not modify x.
+	EqualityJac func(dst *mat.Dense, x []float64)
+
+	// NumInequality is the number of inequality constraints.
+	NumInequality int
+	// Inequality evaluates the inequality constraint functions at x and
+	// stores the result in dst which will have length NumInequality.
+	// Inequality must not modify x.
+	Inequality func(dst, x []float64)
+	// InequalityJac evaluates the Jacobian of the inequality constraint
+	// functions at x and stores the result in dst which will be
+	// NumInequality×len(x). InequalityJac must not modify x.
+	InequalityJac func(dst *mat.Dense, x []float64)
+}
+
+// ConstrainedResult represents the answer of a constrained optimization run.
+// The embedded Result contains the optimum location, the objective function
+// value and gradient at the location, the Status at convergence and the
+// statistics of the run.
+type ConstrainedResult struct {
+	Result
+
+	// EqualityMultipliers and InequalityMultipliers are the estimates of the
+	// Lagrange multipliers of the equality and inequality constraints at X.
+	// The inequality multipliers are non-negative.
+	EqualityMultipliers   []float64
+	InequalityMultipliers []float64
+
+	// KKT holds the residuals of the Karush-Kuhn-Tucker conditions at X.
+	KKT KKTResidual
+}
+
+// KKTResidual holds the residuals of the Karush-Kuhn-Tucker optimality
+// conditions of a constrained problem at a location x with equality
+// multipliers λ and inequality multipliers μ ≥ 0.
+type KKTResidual struct {
+	// Stationarity is the infinity norm of the gradient of the Lagrangian
+	//  ∇f(x) + J_c(x)ᵀλ + J_h(x)ᵀμ.
+	Stationarity float64
+	// Feasibility is the largest constraint violation
+	//  max(|cᵢ(x)|, max(hⱼ(x), 0)).
+	Feasibility float64
+	// Complementarity is the largest violation of the complementarity
+	// conditions
+	//  max |min(-hⱼ(x), μⱼ)|.
+	Complementarity float64
+}
+
+// MinimizeConstrained searches for a minimum of the constrained problem p
+// starting from initX using the given method. If method is nil, an
+// AugmentedLagrangian with default parameters is used.
+//
+// The settings are applied to the major iterations of the constrained method,
+// each of which solves a subproblem using Minimize. MajorIterations limits
+// the number of iterations of the constrained method, and the Runtime and
+// evaluation limits apply to the total over all iterations. The Converger and
+// the Recorder are called at every major iteration with the current location
+// and the objective function value and gradient at the location, and the
+// Converger defaults as in Minimize. The other settings are not used.
+//
+// MinimizeConstrained returns a ConstrainedResult and any error that
+// occurred. The Status of the result is Success if the KKT residuals are
+// within the tolerances of the method.
+func MinimizeConstrained(p ConstrainedProblem, initX []float64, settings *Settings, method *AugmentedLagrangian) (*ConstrainedResult, error) {
+	startTime := time.Now()
+	if method == nil {
+		method = &AugmentedLagrangian{}
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	dim := len(initX)
+	if p.Grad == nil {
+		panic("optimize: constrained problem requires Grad")
+	}
+	p.checkConstraints()
+	err := checkOptimization(p.Problem, dim, settings.Recorder)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{}
+	res := &ConstrainedResult{
+		Result: Result{
+			Location: Location{
+				X:        make([]float64, dim),
+				F:        math.Inf(1),
+				Gradient: make([]float64, dim),
+			},
+		},
+		EqualityMultipliers:   make([]float64, p.NumEquality),
+		InequalityMultipliers: make([]float64, p.NumInequality),
+	}
+	copy(res.X, initX)
+
+	if settings.Recorder != nil {
+		err = settings.Recorder.Record(&res.Location, InitIteration, stats)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	converger := settings.Converger
+	if converger == nil {
+		converger = defaultFunctionConverge()
+	}
+	converger.Init(dim)
+
+	res.Status, err = method.run(&p, res, settings, converger, stats, startTime)
+
+	if settings.Recorder != nil && err == nil {
+		err = settings.Recorder.Record(&res.Location, PostIteration, stats)
+	}
+	stats.Runtime = time.Since(startTime)
+	res.Stats = *stats
+	return res, err
+}
+
+func (p *ConstrainedProblem) checkConstraints() {
+	if p.NumEquality < 0 || p.NumInequality < 0 {
+		panic("optimize: negative number of constraints")
+	}
+	if p.NumEquality > 0 && (p.Equality == nil || p.EqualityJac == nil) {
+		panic("optimize: equality constraints not specified")
+	}
+	if p.NumInequality > 0 && (p.Inequality == nil || p.InequalityJac == nil) {
+		panic("optimize: inequality constraints not specified")
+	}
+}
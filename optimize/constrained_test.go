@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+type constrainedTest struct {
+	name string
+	p    ConstrainedProblem
+	x    []float64
+
+	// Known solution, nil if not known.
+	want       []float64
+	wantF      float64
+	wantLambda []float64
+	wantMu     []float64
+}
+
+var constrainedTests = []constrainedTest{
+	{
+		// minimize x0 + x1 subject to x0² + x1² = 2.
+		name: "Circle",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: func(x []float64) float64 { return x[0] + x[1] },
+				Grad: func(grad, x []float64) {
+					grad[0] = 1
+					grad[1] = 1
+				},
+			},
+			NumEquality: 1,
+			Equality: func(dst, x []float64) {
+				dst[0] = x[0]*x[0] + x[1]*x[1] - 2
+			},
+			EqualityJac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, 2*x[0])
+				dst.Set(0, 1, 2*x[1])
+			},
+		},
+		x:          []float64{0.5, -0.2},
+		want:       []float64{-1, -1},
+		wantF:      -2,
+		wantLambda: []float64{0.5},
+	},
+	{
+		// minimize (x0-2)² + (x1-1)² subject to x0² ≤ x1 and x0 + x1 ≤ 2.
+		name: "TwoActiveInequalities",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: func(x []float64) float64 {
+					return (x[0]-2)*(x[0]-2) + (x[1]-1)*(x[1]-1)
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = 2 * (x[0] - 2)
+					grad[1] = 2 * (x[1] - 1)
+				},
+			},
+			NumInequality: 2,
+			Inequality: func(dst, x []float64) {
+				dst[0] = x[0]*x[0] - x[1]
+				dst[1] = x[0] + x[1] - 2
+			},
+			InequalityJac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, 2*x[0])
+				dst.Set(0, 1, -1)
+				dst.Set(1, 0, 1)
+				dst.Set(1, 1, 1)
+			},
+		},
+		x:      []float64{0, 0},
+		want:   []float64{1, 1},
+		wantF:  1,
+		wantMu: []float64{2.0 / 3, 2.0 / 3},
+	},
+	{
+		// Rosenbrock function inside the unit disc with an inactive
+		// linear constraint.
+		name: "RosenbrockDisc",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+				Grad: functions.ExtendedRosenbrock{}.Grad,
+			},
+			NumInequality: 2,
+			Inequality: func(dst, x []float64) {
+				dst[0] = x[0]*x[0] + x[1]*x[1] - 1
+				dst[1] = x[0] - 2
+			},
+			InequalityJac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, 2*x[0])
+				dst.Set(0, 1, 2*x[1])
+				dst.Set(1, 0, 1)
+			},
+		},
+		x:     []float64{0, 0},
+		want:  []float64{0.7864151541684, 0.6176983125233},
+		wantF: 0.04567480871,
+	},
+	{
+		// Problem 71 from Hock and Schittkowski with the bounds written as
+		// inequality constraints.
+		name: "HS071",
+		p: ConstrainedProblem{
+			Problem: Problem{
+				Func: func(x []float64) float64 {
+					return x[0]*x[3]*(x[0]+x[1]+x[2]) + x[2]
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = x[3]*(x[0]+x[1]+x[2]) + x[0]*x[3]
+					grad[1] = x[0] * x[3]
+					grad[2] = x[0]*x[3] + 1
+					grad[3] = x[0] * (x[0] + x[1] + x[2])
+				},
+			},
+			NumEquality: 1,
+			Equality: func(dst, x []float64) {
+				dst[0] = floats.Dot(x, x) - 40
+			},
+			EqualityJac: func(dst *mat.Dense, x []float64) {
+				for j, v := range x {
+					dst.Set(0, j, 2*v)
+				}
+			},
+			NumInequality: 9,
+			Inequality: func(dst, x []float64) {
+				dst[0] = 25 - x[0]*x[1]*x[2]*x[3]
+				for j, v := range x {
+					dst[1+2*j] = 1 - v
+					dst[2+2*j] = v - 5
+				}
+			},
+			InequalityJac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, -x[1]*x[2]*x[3])
+				dst.Set(0, 1, -x[0]*x[2]*x[3])
+				dst.Set(0, 2, -x[0]*x[1]*x[3])
+				dst.Set(0, 3, -x[0]*x[1]*x[2])
+				for j := range x {
+					dst.Set(1+2*j, j, -1)
+					dst.Set(2+2*j, j, 1)
+				}
+			},
+		},
+		x:     []float64{1, 5, 5, 1},
+		want:  []float64{1, 4.742999643, 3.821149984, 1.379408293},
+		wantF: 17.0140173,
+	},
+}
+
+func TestAugmentedLagrangian(t *testing.T) {
+	t.Parallel()
+	for _, method := range []*AugmentedLagrangian{
+		nil,
+		{Method: &LBFGS{}},
+		{InitialPenalty: 1, PenaltyIncrease: 5},
+	} {
+		testConstrained(t, constrainedTests, method)
+	}
+}
+
+func testConstrained(t *testing.T, tests []constrainedTest, method *AugmentedLagrangian) {
+	const (
+		tolX    = 1e-5
+		tolF    = 1e-6
+		tolMult = 1e-5
+	)
+	for _, test := range tests {
+		name := fmt.Sprintf("%s, method=%+v", test.name, method)
+		result, err := MinimizeConstrained(test.p, test.x, nil, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if result.Status != Success {
+			t.Errorf("%s: unexpected status: got %v, want %v", name, result.Status, Success)
+		}
+		if !floats.EqualApprox(result.X, test.want, tolX) {
+			t.Errorf("%s: unexpected minimum location: got %v, want %v", name, result.X, test.want)
+		}
+		if math.Abs(result.F-test.wantF) > tolF {
+			t.Errorf("%s: unexpected minimum value: got %v, want %v", name, result.F, test.wantF)
+		}
+		if f := test.p.Func(result.X); f != result.F {
+			t.Errorf("%s: function value at the minimum location %v not equal to the returned value %v", name, f, result.F)
+		}
+		if test.wantLambda != nil && !floats.EqualApprox(result.EqualityMultipliers, test.wantLambda, tolMult) {
+			t.Errorf("%s: unexpected equality multipliers: got %v, want %v", name, result.EqualityMultipliers, test.wantLambda)
+		}
+		if test.wantMu != nil && !floats.EqualApprox(result.InequalityMultipliers, test.wantMu, tolMult) {
+			t.Errorf("%s: unexpected inequality multipliers: got %v, want %v", name, result.InequalityMultipliers, test.wantMu)
+		}
+		for j, v := range result.InequalityMultipliers {
+			if v < 0 {
+				t.Errorf("%s: negative inequality multiplier %d: %v", name, j, v)
+			}
+		}
+
+		// Check the reported KKT residuals.
+		kkt := result.KKT
+		if kkt.Stationarity > 1e-6 || kkt.Feasibility > 1e-8 || kkt.Complementarity > 1e-8 {
+			t.Errorf("%s: KKT residuals too large: %+v", name, kkt)
+		}
+		n := len(result.X)
+		lagGrad := make([]float64, n)
+		test.p.Grad(lagGrad, result.X)
+		if m := test.p.NumEquality; m > 0 {
+			jac := mat.NewDense(m, n, nil)
+			test.p.EqualityJac(jac, result.X)
+			for i, l := range result.EqualityMultipliers {
+				floats.AddScaled(lagGrad, l, jac.RawRowView(i))
+			}
+		}
+		if m := test.p.NumInequality; m > 0 {
+			jac := mat.NewDense(m, n, nil)
+			test.p.InequalityJac(jac, result.X)
+			for j, mu := range result.InequalityMultipliers {
+				floats.AddScaled(lagGrad, mu, jac.RawRowView(j))
+			}
+		}
+		if got := floats.Norm(lagGrad, math.Inf(1)); math.Abs(got-kkt.Stationarity) > 1e-12 {
+			t.Errorf("%s: stationarity residual mismatch: got %v, want %v", name, kkt.Stationarity, got)
+		}
+	}
+}
+
+func TestAugmentedLagrangianLimits(t *testing.T) {
+	t.Parallel()
+	test := constrainedTests[3]
+
+	settings := &Settings{MajorIterations: 2}
+	result, err := MinimizeConstrained(test.p, test.x, settings, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != IterationLimit {
+		t.Errorf("unexpected status: got %v, want %v", result.Status, IterationLimit)
+	}
+	if result.MajorIterations != 2 {
+		t.Errorf("unexpected number of major iterations: got %v, want 2", result.MajorIterations)
+	}
+
+	// Inconsistent constraints x0 = 1 and x0 ≤ 0.
+	p := ConstrainedProblem{
+		Problem: Problem{
+			Func: functions.ExtendedRosenbrock{}.Func,
+			Grad: functions.ExtendedRosenbrock{}.Grad,
+		},
+		NumEquality: 1,
+		Equality: func(dst, x []float64) {
+			dst[0] = x[0] - 1
+		},
+		EqualityJac: func(dst *mat.Dense, x []float64) {
+			dst.Set(0, 0, 1)
+		},
+		NumInequality: 1,
+		Inequality: func(dst, x []float64) {
+			dst[0] = x[0]
+		},
+		InequalityJac: func(dst *mat.Dense, x []float64) {
+			dst.Set(0, 0, 1)
+		},
+	}
+	result, err = MinimizeConstrained(p, []float64{0, 0}, nil, nil)
+	if err != ErrInfeasible {
+		t.Errorf("unexpected error for infeasible problem: got %v, want %v", err, ErrInfeasible)
+	}
+	if result.Status != Failure {
+		t.Errorf("unexpected status for infeasible problem: got %v, want %v", result.Status, Failure)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Dogleg is a LeastSquaresMethod that computes the steps of Powell's dogleg
+// trust-region method for the Gauss-Newton model of the least-squares
+// problem. The step is the Gauss-Newton step if it lies within the trust
+// region, otherwise it is the point where the path from the Cauchy point to
+// the Gauss-Newton step leaves the trust region. If the Jacobian does not
+// have full rank and the Gauss-Newton step cannot be computed, the Cauchy
+// point restricted to the trust region is used.
+//
+// The radius of the trust region is decreased if the ratio of the actual to
+// the predicted reduction of the cost is small and increased if the ratio is
+// large and the step is at the boundary of the trust region.
+//
+// References:
+//   - Powell, M.J.D.: A hybrid method for nonlinear equations. Numerical
+//     Methods for Nonlinear Algebraic Equations, 87-114 (1970)
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer
+//     (2006), chapters 4 and 10
+type Dogleg struct {
+	// InitialRadius is the initial radius of the trust region. If
+	// InitialRadius is zero, it is defaulted to the maximum of 1 and the norm
+	// of the initial location, otherwise it must be positive.
+	InitialRadius float64
+
+	radius float64
+}
+
+func (d *Dogleg) Init(x []float64, jac *mat.Dense, r []float64) {
+	d.radius = d.InitialRadius
+	if d.radius == 0 {
+		d.radius = math.Max(1, floats.Norm(x, 2))
+	}
+	if d.radius < 0 {
+		panic("optimize: initial trust region radius is not positive")
+	}
+}
+
+func (d *Dogleg) Step(dst []float64, jac *mat.Dense, r []float64) {
+	m, n := jac.Dims()
+	if len(dst) != n || len(r) != m {
+		panic("optimize: unexpected size mismatch")
+	}
+	step := mat.NewVecDense(n, dst)
+	rv := mat.NewVecDense(m, r)
+
+	// Gauss-Newton step.
+	var gn mat.VecDense
+	err := gn.SolveVec(jac, rv)
+	haveGN := err == nil
+	if haveGN {
+		gn.ScaleVec(-1, &gn)
+		if mat.Norm(&gn, 2) <= d.radius {
+			step.CopyVec(&gn)
+			return
+		}
+	}
+
+	// Cauchy point along the steepest descent direction -Jᵀr.
+	var g, jg mat.VecDense
+	g.MulVec(jac.T(), rv)
+	gNorm := mat.Norm(&g, 2)
+	if gNorm == 0 {
+		step.Zero()
+		return
+	}
+	jg.MulVec(jac, &g)
+	jgNorm2 := mat.Dot(&jg, &jg)
+	alpha := math.Inf(1)
+	if jgNorm2 > 0 {
+		alpha = gNorm * gNorm / jgNorm2
+	}
+	if alpha*gNorm >= d.radius {
+		step.ScaleVec(-d.radius/gNorm, &g)
+		return
+	}
+	step.ScaleVec(-alpha, &g)
+	if !haveGN {
+		return
+	}
+
+	// Find τ ∈ [0, 1] such that |sd + τ(gn - sd)| = Δ where sd is the
+	// Cauchy point.
+	var diff mat.VecDense
+	diff.SubVec(&gn, step)
+	a := mat.Dot(&diff, &diff)
+	b := 2 * mat.Dot(step, &diff)
+	c := mat.Dot(step, step) - d.radius*d.radius
+	disc := math.Sqrt(b*b - 4*a*c)
+	var tau float64
+	if b > 0 {
+		tau = -2 * c / (b + disc)
+	} else {
+		tau = (disc - b) / (2 * a)
+	}
+	step.AddScaledVec(step, tau, &diff)
+}
+
+func (d *Dogleg) Update(ratio, stepNorm float64) {
+	switch {
+	case ratio < 0.25:
+		d.radius = 0.25 * stepNorm
+	case ratio > 0.75 && stepNorm >= 0.99*d.radius:
+		d.radius *= 2
+	}
+}
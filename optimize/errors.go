@@ -39,6 +39,16 @@ var (
 	// ErrMissingHess signifies that a Method requires a Hessian function that
 	// is not supplied by Problem.
 	ErrMissingHess = errors.New("optimize: problem does not provide needed Hess function")
+
+	// ErrTrustRegionCollapse signifies that a trust-region method cannot make
+	// further progress because the trust region has become too small to
+	// change the location in floating-point arithmetic.
+	ErrTrustRegionCollapse = errors.New("optimize: trust region too small to make progress")
+
+	// ErrInfeasible signifies that a constrained method could not reduce the
+	// constraint violation to the required tolerance. This may occur if the
+	// constraints are inconsistent.
+	ErrInfeasible = errors.New("optimize: constraint violation could not be reduced")
 )
 
 // ErrFunc is returned when an initial function value is invalid. The error
@@ -19,6 +19,11 @@ func TestBeale(t *testing.T) {
 			F:        4624.453125,
 			Gradient: []float64{8813.25, 6585},
 		},
+		{
+			X:        []float64{2.8, 0.45},
+			F:        0.0083130225,
+			Gradient: []float64{-0.1288076625, 0.1343503},
+		},
 	}
 	testFunction(Beale{}, tests, t)
 }
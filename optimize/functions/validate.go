@@ -10,6 +10,7 @@ import (
 
 	"gonum.org/v1/gonum/diff/fd"
 	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
 )
 
 // function represents an objective function.
@@ -18,7 +19,11 @@ type function interface {
 }
 
 type gradient interface {
-	Grad(grad, x []float64) []float64
+	Grad(grad, x []float64)
+}
+
+type hessian interface {
+	Hess(dst *mat.SymDense, x []float64)
 }
 
 // minimumer is an objective function that can also provide information about
@@ -57,10 +62,11 @@ const (
 	defaultTol       = 1e-12
 	defaultGradTol   = 1e-9
 	defaultFDGradTol = 1e-5
+	defaultFDHessTol = 1e-4
 )
 
-// testFunction checks that the function can evaluate itself (and its gradient)
-// correctly.
+// testFunction checks that the function can evaluate itself (and its gradient
+// and Hessian) correctly.
 func testFunction(f function, ftests []funcTest, t *testing.T) {
 	// Make a copy of tests because we may append to the slice.
 	tests := make([]funcTest, len(ftests))
@@ -69,6 +75,7 @@ func testFunction(f function, ftests []funcTest, t *testing.T) {
 	// Get information about the function.
 	fMinima, isMinimumer := f.(minimumer)
 	fGradient, isGradient := f.(gradient)
+	fHessian, isHessian := f.(hessian)
 
 	// If the function is a Minimumer, append its minima to the tests.
 	if isMinimumer {
@@ -123,5 +130,25 @@ func testFunction(f function, ftests []funcTest, t *testing.T) {
 					i, dist)
 			}
 		}
+
+		// If the function is a Hessian, check that it agrees with the finite
+		// difference Jacobian of the gradient.
+		if isGradient && isHessian {
+			n := len(test.X)
+			fdHess := mat.NewDense(n, n, nil)
+			fd.Jacobian(fdHess, fGradient.Grad, test.X, &fd.JacobianSettings{
+				Formula: fd.Central,
+				Step:    1e-6,
+			})
+			hess := mat.NewSymDense(n, nil)
+			fHessian.Hess(hess, test.X)
+
+			if !mat.EqualApprox(hess, fdHess, defaultFDHessTol) {
+				var diff mat.Dense
+				diff.Sub(hess, fdHess)
+				t.Errorf("Test #%d: Hessian given by Hess is incorrect. |hess - fdHess|_∞ = %v",
+					i, mat.Norm(&diff, math.Inf(1)))
+			}
+		}
 	}
 }
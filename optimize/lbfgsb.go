@@ -0,0 +1,674 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method          = (*LBFGSB)(nil)
+	_ localMethod     = (*LBFGSB)(nil)
+	_ NextDirectioner = (*LBFGSB)(nil)
+)
+
+// LBFGSB implements the limited-memory BFGS method for gradient-based
+// minimization subject to simple bounds on the variables,
+//
+//	Lower[i] <= x[i] <= Upper[i].
+//
+// At each iteration LBFGSB forms a quadratic model of the function using the
+// compact representation of the limited-memory BFGS matrix. It computes the
+// generalized Cauchy point, the first local minimizer of the model along the
+// projected gradient path, and fixes the variables that are at a bound at the
+// Cauchy point. The model is then minimized over the remaining free variables
+// and a line search is performed along the direction to the resulting point.
+// All locations evaluated by LBFGSB are within the bounds. If the initial
+// location lies outside the bounds, it is first projected onto them.
+//
+// LBFGSB terminates with GradientThreshold status when the infinity norm of
+// the projected gradient, P(x-g)-x where P is the projection onto the bounds,
+// drops below GradStopThreshold. With no bounds the projected gradient is
+// equal to the negative gradient.
+//
+// If a line search fails, or evaluates the function 20 times without changing
+// its value beyond rounding, the limited-memory history is discarded and the
+// line search is restarted from the last major iteration along the projected
+// steepest descent direction. If that line search also fails, LBFGSB
+// terminates with Failure status and the error of the line search, or with
+// ErrLinesearcherFailure if the function value did not change.
+//
+// References:
+//   - Byrd, R.H., Lu, P., Nocedal, J., Zhu, C.: A limited memory algorithm for
+//     bound constrained optimization. SIAM Journal on Scientific Computing
+//     16(5) (1995), 1190-1208
+type LBFGSB struct {
+	// Lower and Upper are the lower and upper bounds on the variables.
+	// If Lower or Upper is nil, the variables are not bounded from below or
+	// above, respectively. Otherwise the length of Lower and Upper must
+	// match the dimension of the problem, an element may be infinite to leave
+	// that variable unbounded, and Lower[i] <= Upper[i] must hold for all i.
+	Lower, Upper []float64
+	// Store is the size of the limited-memory storage.
+	// If Store is 0, it will be defaulted to 10.
+	Store int
+	// GradStopThreshold sets the threshold for stopping if the projected
+	// gradient norm gets too small. If GradStopThreshold is 0 it is defaulted
+	// to 1e-12, and if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	ls     *LinesearchMethod
+	mt     *boundedMoreThuente
+	lastOp Operation // Operation returned from the previous call to iterateLocal.
+	boxed  bool      // Indicates that all variables have finite bounds.
+	stalls int       // Number of evaluations without change in the current line search.
+
+	dim  int       // Dimension of the problem
+	x    []float64 // Location at the last major iteration
+	f    float64   // Function value at the last major iteration
+	grad []float64 // Gradient at the last major iteration
+
+	// History, ordered from the oldest to the newest pair.
+	s, y  [][]float64
+	theta float64      // Scaling of the initial matrix θI.
+	sy    []float64    // SᵀY, row-major.
+	chol  mat.Cholesky // Factorization of θSᵀS + L D⁻¹ Lᵀ, see updateMiddle.
+
+	// Workspace
+	xcp    []float64 // Generalized Cauchy point
+	d      []float64 // Projected steepest descent direction
+	t      []float64 // Breakpoints
+	r      []float64 // Reduced gradient at the Cauchy point
+	breaks []int     // Indices of the finite breakpoints
+	c      []float64 // Wᵀ(xcp - x)
+	p      []float64 // Wᵀd
+	wb     []float64 // Row of W
+	mv     []float64 // Product of M with a vector
+}
+
+// machEps is the machine epsilon.
+const machEps = 1.0 / (1 << 53)
+
+// lbfgsbMaxStalls is the maximum number of function evaluations in a line
+// search of LBFGSB that do not change the function value beyond rounding.
+const lbfgsbMaxStalls = 20
+
+// boundedMoreThuente is a MoreThuente linesearcher that terminates successfully
+// when the sufficient decrease condition holds at MaximumStep. LBFGSB sets
+// MaximumStep to the largest step that keeps the iterate within the bounds.
+type boundedMoreThuente struct {
+	MoreThuente
+}
+
+func (b *boundedMoreThuente) Iterate(f, g float64) (Operation, float64, error) {
+	op, step, err := b.MoreThuente.Iterate(f, g)
+	if err == ErrLinesearcherBound {
+		return MajorIteration, step, nil
+	}
+	return op, step, err
+}
+
+func (l *LBFGSB) Status() (Status, error) {
+	return l.status, l.err
+}
+
+func (*LBFGSB) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (l *LBFGSB) Init(dim, tasks int) int {
+	if l.Lower != nil && len(l.Lower) != dim {
+		panic("lbfgsb: lower bound length mismatch")
+	}
+	if l.Upper != nil && len(l.Upper) != dim {
+		panic("lbfgsb: upper bound length mismatch")
+	}
+	for i := 0; i < dim; i++ {
+		lo, hi := l.lower(i), l.upper(i)
+		if math.IsNaN(lo) || math.IsNaN(hi) {
+			panic("lbfgsb: NaN bound")
+		}
+		if lo > hi {
+			panic("lbfgsb: lower bound greater than upper bound")
+		}
+	}
+	l.status = NotTerminated
+	l.err = nil
+	return 1
+}
+
+func (l *LBFGSB) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	// Start from a feasible location. If the projection moves the initial
+	// location, the supplied initial values are no longer valid.
+	x := tasks[0].X
+	for i, v := range x {
+		x[i] = math.Max(l.lower(i), math.Min(v, l.upper(i)))
+		if x[i] != v {
+			tasks[0].Op = NoOperation
+		}
+	}
+	// The gradient norm check of localOptimizer is disabled because the
+	// gradient need not vanish at a bound-constrained minimum. Convergence is
+	// instead checked by LBFGSB using the projected gradient, in which case
+	// the status is already set when run returns.
+	status, err := localOptimizer{}.run(l, math.NaN(), operation, result, tasks)
+	if l.status == NotTerminated {
+		l.status, l.err = status, err
+	}
+	close(operation)
+}
+
+func (l *LBFGSB) initLocal(loc *Location) (Operation, error) {
+	if l.Store == 0 {
+		l.Store = 10
+	}
+	if l.mt == nil {
+		l.mt = &boundedMoreThuente{}
+	}
+	// Use the parameters of the line search of the reference implementation.
+	l.mt.DecreaseFactor = 1e-3
+	l.mt.CurvatureFactor = 0.9
+
+	if l.ls == nil {
+		l.ls = &LinesearchMethod{}
+	}
+	l.ls.Linesearcher = l.mt
+	l.ls.NextDirectioner = l
+
+	l.boxed = true
+	for i := range loc.X {
+		if math.IsInf(l.lower(i), -1) || math.IsInf(l.upper(i), 1) {
+			l.boxed = false
+			break
+		}
+	}
+
+	l.lastOp = MajorIteration
+	if l.converged(loc) {
+		l.status = GradientThreshold
+		return MethodDone, nil
+	}
+	op, err := l.ls.Init(loc)
+	l.project(loc.X)
+	l.lastOp = op
+	return op, err
+}
+
+func (l *LBFGSB) iterateLocal(loc *Location) (Operation, error) {
+	if l.lastOp == MajorIteration && l.converged(loc) {
+		l.status = GradientThreshold
+		return MethodDone, nil
+	}
+	if l.lastOp&FuncEvaluation != 0 && math.Abs(loc.F-l.f) <= 4*machEps*math.Abs(l.f) {
+		l.stalls++
+	}
+	op, err := l.ls.Iterate(loc)
+	if err != nil || l.stalls > lbfgsbMaxStalls {
+		if err == nil {
+			err = ErrLinesearcherFailure
+		}
+		if len(l.s) == 0 {
+			// The line search along the projected steepest descent
+			// direction has failed, so no further progress can be made.
+			return NoOperation, err
+		}
+		// Discard the history and restart the line search from the last
+		// major iteration along the projected steepest descent direction.
+		copy(loc.X, l.x)
+		loc.F = l.f
+		copy(loc.Gradient, l.grad)
+		op, err = l.ls.Init(loc)
+		if err != nil {
+			return NoOperation, err
+		}
+	}
+	if op&(FuncEvaluation|GradEvaluation) != 0 {
+		// The step never exceeds the largest feasible step, but rounding
+		// may place loc.X marginally outside the bounds.
+		l.project(loc.X)
+	}
+	l.lastOp = op
+	return op, err
+}
+
+func (l *LBFGSB) InitDirection(loc *Location, dir []float64) (stepSize float64) {
+	dim := len(loc.X)
+	l.dim = dim
+
+	l.x = resize(l.x, dim)
+	copy(l.x, loc.X)
+	l.f = loc.F
+	l.grad = resize(l.grad, dim)
+	copy(l.grad, loc.Gradient)
+	l.stalls = 0
+
+	l.xcp = resize(l.xcp, dim)
+	l.d = resize(l.d, dim)
+	l.t = resize(l.t, dim)
+	l.r = resize(l.r, dim)
+	l.resetHistory()
+
+	l.direction(loc.X, loc.Gradient, dir)
+	stepMax := l.maxStep(loc.X, dir)
+	stepSize = 1
+	if !l.boxed {
+		stepSize = math.Min(1/floats.Norm(dir, 2), stepMax)
+	}
+	l.mt.MaximumStep = math.Min(stepMax, 1e20)
+	return stepSize
+}
+
+func (l *LBFGSB) NextDirection(loc *Location, dir []float64) (stepSize float64) {
+	if len(loc.X) != l.dim {
+		panic("lbfgsb: unexpected size mismatch")
+	}
+	if len(loc.Gradient) != l.dim {
+		panic("lbfgsb: unexpected size mismatch")
+	}
+	if len(dir) != l.dim {
+		panic("lbfgsb: unexpected size mismatch")
+	}
+
+	// Add the correction pair from the last step to the history if it
+	// satisfies the curvature condition sufficiently well.
+	floats.SubTo(l.x, loc.X, l.x)
+	floats.SubTo(l.grad, loc.Gradient, l.grad)
+	sDotY := floats.Dot(l.x, l.grad)
+	yDotY := floats.Dot(l.grad, l.grad)
+	if sDotY > machEps*yDotY {
+		var s, y []float64
+		if len(l.s) == l.Store {
+			// Reuse the storage of the oldest pair.
+			s, y = l.s[0], l.y[0]
+			copy(l.s, l.s[1:])
+			copy(l.y, l.y[1:])
+			l.s = l.s[:len(l.s)-1]
+			l.y = l.y[:len(l.y)-1]
+		} else {
+			s = make([]float64, l.dim)
+			y = make([]float64, l.dim)
+		}
+		copy(s, l.x)
+		copy(y, l.grad)
+		l.s = append(l.s, s)
+		l.y = append(l.y, y)
+		l.theta = yDotY / sDotY
+		if !l.updateMiddle() {
+			l.resetHistory()
+		}
+	}
+	copy(l.x, loc.X)
+	l.f = loc.F
+	copy(l.grad, loc.Gradient)
+	l.stalls = 0
+
+	l.direction(loc.X, loc.Gradient, dir)
+	if floats.Dot(loc.Gradient, dir) >= 0 && len(l.s) > 0 {
+		// The limited-memory matrix has lost positive definiteness due
+		// to rounding. Discard the history and restart from the
+		// projected steepest descent direction.
+		l.resetHistory()
+		l.direction(loc.X, loc.Gradient, dir)
+	}
+	l.mt.MaximumStep = math.Min(l.maxStep(loc.X, dir), 1e20)
+	return 1
+}
+
+// direction stores in dir the search direction from x to the approximate
+// minimizer of the quadratic model within the bounds.
+func (l *LBFGSB) direction(x, g, dir []float64) {
+	l.cauchyPoint(x, g)
+	l.subspaceMin(x, g, dir)
+	floats.Sub(dir, x)
+}
+
+// cauchyPoint computes the generalized Cauchy point of the quadratic model
+// at x and stores it in l.xcp. It also stores Wᵀ(xcp-x) in l.c.
+//
+// This is Algorithm CP of Byrd et al. (1995).
+func (l *LBFGSB) cauchyPoint(x, g []float64) {
+	k2 := 2 * len(l.s)
+	xcp, d, t := l.xcp, l.d, l.t
+	copy(xcp, x)
+
+	// Compute the breakpoints and the projected steepest descent direction.
+	l.breaks = l.breaks[:0]
+	var f1 float64
+	var nfree int
+	for i, v := range x {
+		t[i] = math.Inf(1)
+		switch {
+		case g[i] < 0:
+			if hi := l.upper(i); !math.IsInf(hi, 1) {
+				t[i] = (v - hi) / g[i]
+			}
+		case g[i] > 0:
+			if lo := l.lower(i); !math.IsInf(lo, -1) {
+				t[i] = (v - lo) / g[i]
+			}
+		}
+		if t[i] == 0 {
+			d[i] = 0
+			continue
+		}
+		d[i] = -g[i]
+		f1 -= g[i] * g[i]
+		if d[i] != 0 {
+			nfree++
+		}
+		if !math.IsInf(t[i], 1) {
+			l.breaks = append(l.breaks, i)
+		}
+	}
+
+	l.c = resize(l.c, k2)
+	l.p = resize(l.p, k2)
+	l.wb = resize(l.wb, k2)
+	l.mv = resize(l.mv, k2)
+	c, p, wb, mv := l.c, l.p, l.wb, l.mv
+	for j := range c {
+		c[j] = 0
+		p[j] = 0
+	}
+	if f1 == 0 {
+		// The projected gradient is zero and x is the Cauchy point.
+		return
+	}
+	sort.Slice(l.breaks, func(a, b int) bool {
+		return t[l.breaks[a]] < t[l.breaks[b]]
+	})
+
+	for i, di := range d {
+		if di != 0 {
+			l.rowW(wb, i)
+			floats.AddScaled(p, di, wb)
+		}
+	}
+	// f1 and f2 are the first and second derivatives of the model along the
+	// current segment of the projected path.
+	l.mulMiddle(mv, p)
+	f2 := -l.theta*f1 - floats.Dot(p, mv)
+	f2Org := f2
+	dtMin := -f1 / f2
+	var tOld float64
+	for _, b := range l.breaks {
+		dt := t[b] - tOld
+		if dtMin < dt {
+			break
+		}
+		// Fix variable b at its bound.
+		if d[b] > 0 {
+			xcp[b] = l.upper(b)
+		} else {
+			xcp[b] = l.lower(b)
+		}
+		zb := xcp[b] - x[b]
+		gb := g[b]
+		floats.AddScaled(c, dt, p)
+		l.rowW(wb, b)
+		l.mulMiddle(mv, c)
+		f1 += dt*f2 + gb*gb + l.theta*gb*zb - gb*floats.Dot(wb, mv)
+		l.mulMiddle(mv, p)
+		f2 -= l.theta*gb*gb + 2*gb*floats.Dot(wb, mv)
+		l.mulMiddle(mv, wb)
+		f2 -= gb * gb * floats.Dot(wb, mv)
+		f2 = math.Max(f2, machEps*f2Org)
+		floats.AddScaled(p, gb, wb)
+		d[b] = 0
+		nfree--
+		dtMin = -f1 / f2
+		tOld = t[b]
+	}
+	if nfree == 0 {
+		dtMin = 0
+	}
+	dtMin = math.Max(dtMin, 0)
+	tOld += dtMin
+	for i, di := range d {
+		if di != 0 {
+			xcp[i] = x[i] + tOld*di
+		}
+	}
+	floats.AddScaled(c, dtMin, p)
+}
+
+// subspaceMin minimizes the quadratic model over the variables that are free
+// at the Cauchy point, backtracks the result into the bounds and stores it in
+// xbar.
+//
+// This is the direct primal method of Byrd et al. (1995), section 5.1.
+func (l *LBFGSB) subspaceMin(x, g, xbar []float64) {
+	copy(xbar, l.xcp)
+
+	k2 := 2 * len(l.s)
+	wb, mv := l.wb, l.mv
+	// v accumulates WᵀZ r and a accumulates WᵀZ ZᵀW, where the columns of Z
+	// span the free variables.
+	v := make([]float64, k2)
+	a := make([]float64, k2*k2)
+	l.mulMiddle(mv, l.c)
+	var nfree int
+	for i, xi := range l.xcp {
+		if xi == l.lower(i) || xi == l.upper(i) {
+			continue
+		}
+		nfree++
+		l.rowW(wb, i)
+		l.r[i] = g[i] + l.theta*(xi-x[i]) - floats.Dot(wb, mv)
+		floats.AddScaled(v, l.r[i], wb)
+		for j := 0; j < k2; j++ {
+			floats.AddScaled(a[j*k2:(j+1)*k2], wb[j], wb[:k2])
+		}
+	}
+	if nfree == 0 {
+		return
+	}
+
+	// The reduced Newton step is computed using the Sherman-Morrison-Woodbury
+	// formula
+	//  d = -r/θ - ZᵀW (I - M WᵀZ ZᵀW / θ)⁻¹ M WᵀZ r / θ².
+	u := make([]float64, k2)
+	if k2 > 0 {
+		l.mulMiddle(u, v)
+		// A is symmetric so its rows are also its columns.
+		n := mat.NewDense(k2, k2, nil)
+		col := make([]float64, k2)
+		for j := 0; j < k2; j++ {
+			l.mulMiddle(col, a[j*k2:(j+1)*k2])
+			for i, v := range col {
+				n.Set(i, j, -v/l.theta)
+			}
+			n.Set(j, j, n.At(j, j)+1)
+		}
+		uVec := mat.NewVecDense(k2, u)
+		err := uVec.SolveVec(n, uVec)
+		if err != nil {
+			// Use the Cauchy point.
+			return
+		}
+	}
+	alpha := 1.0
+	for i, xi := range l.xcp {
+		if xi == l.lower(i) || xi == l.upper(i) {
+			continue
+		}
+		l.rowW(wb, i)
+		di := -l.r[i]/l.theta - floats.Dot(wb, u)/(l.theta*l.theta)
+		l.d[i] = di
+		switch {
+		case di > 0:
+			alpha = math.Min(alpha, (l.upper(i)-xi)/di)
+		case di < 0:
+			alpha = math.Min(alpha, (l.lower(i)-xi)/di)
+		}
+	}
+	for i, xi := range l.xcp {
+		if xi == l.lower(i) || xi == l.upper(i) {
+			continue
+		}
+		xbar[i] = xi + alpha*l.d[i]
+	}
+	l.project(xbar)
+}
+
+// updateMiddle prepares the products with the middle matrix of the compact
+// representation of the limited-memory BFGS matrix
+//
+//	B = θI - W M Wᵀ,
+//
+// where W = [Y θS] and
+//
+//	M = [-D  Lᵀ  ]⁻¹
+//	    [ L  θSᵀS]
+//
+// with D the diagonal and L the strictly lower triangular part of SᵀY. Instead
+// of forming M explicitly, updateMiddle computes the Cholesky factorization
+// of θSᵀS + L D⁻¹ Lᵀ which is used by mulMiddle. updateMiddle returns whether
+// the factorization succeeded.
+func (l *LBFGSB) updateMiddle() bool {
+	k := len(l.s)
+	l.sy = resize(l.sy, k*k)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			l.sy[i*k+j] = floats.Dot(l.s[i], l.y[j])
+		}
+	}
+	t := mat.NewSymDense(k, nil)
+	for i := 0; i < k; i++ {
+		for j := i; j < k; j++ {
+			v := l.theta * floats.Dot(l.s[i], l.s[j])
+			for m := 0; m < min(i, j); m++ {
+				v += l.sy[i*k+m] * l.sy[j*k+m] / l.sy[m*k+m]
+			}
+			t.SetSym(i, j, v)
+		}
+	}
+	return l.chol.Factorize(t)
+}
+
+// resetHistory discards the stored correction pairs.
+func (l *LBFGSB) resetHistory() {
+	l.s = l.s[:0]
+	l.y = l.y[:0]
+	l.theta = 1
+	l.sy = l.sy[:0]
+}
+
+// rowW stores the i-th row of W = [Y θS] in dst.
+func (l *LBFGSB) rowW(dst []float64, i int) {
+	k := len(l.s)
+	for j := 0; j < k; j++ {
+		dst[j] = l.y[j][i]
+		dst[k+j] = l.theta * l.s[j][i]
+	}
+}
+
+// mulMiddle stores M*v in dst. With v = [v1; v2] and the factorization
+// computed by updateMiddle, the product is
+//
+//	w2 = (θSᵀS + L D⁻¹ Lᵀ)⁻¹ (v2 + L D⁻¹ v1),
+//	w1 = D⁻¹ (Lᵀ w2 - v1).
+func (l *LBFGSB) mulMiddle(dst, v []float64) {
+	k := len(l.s)
+	if k == 0 {
+		return
+	}
+	v1, v2 := v[:k], v[k:2*k]
+	w1, w2 := dst[:k], dst[k:2*k]
+	for i := 0; i < k; i++ {
+		sum := v2[i]
+		for j := 0; j < i; j++ {
+			sum += l.sy[i*k+j] * v1[j] / l.sy[j*k+j]
+		}
+		w2[i] = sum
+	}
+	// A Condition error only indicates that the solution may be inaccurate,
+	// which is tolerable for computing a search direction.
+	w2Vec := mat.NewVecDense(k, w2)
+	_ = l.chol.SolveVecTo(w2Vec, w2Vec)
+	for i := 0; i < k; i++ {
+		sum := -v1[i]
+		for j := i + 1; j < k; j++ {
+			sum += l.sy[j*k+i] * w2[j]
+		}
+		w1[i] = sum / l.sy[i*k+i]
+	}
+}
+
+// maxStep returns the largest step along dir from x that stays within the
+// bounds. The returned value is never less than 1 because dir points to a
+// feasible location.
+func (l *LBFGSB) maxStep(x, dir []float64) float64 {
+	step := math.Inf(1)
+	for i, di := range dir {
+		switch {
+		case di > 0:
+			if hi := l.upper(i); !math.IsInf(hi, 1) {
+				step = math.Min(step, (hi-x[i])/di)
+			}
+		case di < 0:
+			if lo := l.lower(i); !math.IsInf(lo, -1) {
+				step = math.Min(step, (lo-x[i])/di)
+			}
+		}
+	}
+	return math.Max(step, 1)
+}
+
+// converged returns whether the projected gradient at loc is below the
+// threshold.
+func (l *LBFGSB) converged(loc *Location) bool {
+	thresh := l.GradStopThreshold
+	if math.IsNaN(thresh) {
+		return false
+	}
+	if thresh == 0 {
+		thresh = defaultGradientAbsTol
+	}
+	var norm float64
+	for i, v := range loc.X {
+		pg := math.Max(l.lower(i), math.Min(v-loc.Gradient[i], l.upper(i))) - v
+		norm = math.Max(norm, math.Abs(pg))
+	}
+	return norm < thresh
+}
+
+// project projects x onto the bounds in place.
+func (l *LBFGSB) project(x []float64) {
+	for i, v := range x {
+		x[i] = math.Max(l.lower(i), math.Min(v, l.upper(i)))
+	}
+}
+
+func (l *LBFGSB) lower(i int) float64 {
+	if l.Lower == nil {
+		return math.Inf(-1)
+	}
+	return l.Lower[i]
+}
+
+func (l *LBFGSB) upper(i int) float64 {
+	if l.Upper == nil {
+		return math.Inf(1)
+	}
+	return l.Upper[i]
+}
+
+func (*LBFGSB) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
@@ -0,0 +1,285 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestLBFGSB(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	tests = append(tests, gradientDescentTests...)
+	tests = append(tests, lbfgsTests...)
+	testLocal(t, tests, &LBFGSB{})
+}
+
+// shiftedQuadratic is f(x) = sum_i (x_i - c_i)^2.
+type shiftedQuadratic []float64
+
+func (c shiftedQuadratic) Func(x []float64) float64 {
+	var f float64
+	for i, v := range x {
+		f += (v - c[i]) * (v - c[i])
+	}
+	return f
+}
+
+func (c shiftedQuadratic) Grad(grad, x []float64) {
+	for i, v := range x {
+		grad[i] = 2 * (v - c[i])
+	}
+}
+
+// tridiagQuadratic is f(x) = 1/2 xᵀAx - sum_i x_i where A is the tridiagonal
+// matrix with 2 on the diagonal and -1 on the off-diagonals.
+type tridiagQuadratic struct{}
+
+func (tridiagQuadratic) Func(x []float64) float64 {
+	var f float64
+	for i, v := range x {
+		ax := 2 * v
+		if i > 0 {
+			ax -= x[i-1]
+		}
+		if i < len(x)-1 {
+			ax -= x[i+1]
+		}
+		f += 0.5*v*ax - v
+	}
+	return f
+}
+
+func (tridiagQuadratic) Grad(grad, x []float64) {
+	for i, v := range x {
+		grad[i] = 2*v - 1
+		if i > 0 {
+			grad[i] -= x[i-1]
+		}
+		if i < len(x)-1 {
+			grad[i] -= x[i+1]
+		}
+	}
+}
+
+func TestLBFGSBBounds(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-6
+	inf := math.Inf(1)
+	for cas, test := range []struct {
+		name         string
+		p            Problem
+		x            []float64
+		lower, upper []float64
+		want         []float64 // nil if not known
+		store        int
+		gradTol      float64
+	}{
+		{
+			name: "Quadratic",
+			p: Problem{
+				Func: shiftedQuadratic{-1, 0.5, 2}.Func,
+				Grad: shiftedQuadratic{-1, 0.5, 2}.Grad,
+			},
+			x:     []float64{0.5, 0.5, 0.5},
+			lower: []float64{0, 0, 0},
+			upper: []float64{1, 1, 1},
+			want:  []float64{0, 0.5, 1},
+		},
+		{
+			name: "QuadraticInfeasibleStart",
+			p: Problem{
+				Func: shiftedQuadratic{-1, 0.5, 2}.Func,
+				Grad: shiftedQuadratic{-1, 0.5, 2}.Grad,
+			},
+			x:     []float64{5, -5, 5},
+			lower: []float64{0, 0, 0},
+			upper: []float64{1, 1, 1},
+			want:  []float64{0, 0.5, 1},
+		},
+		{
+			name: "QuadraticLowerOnly",
+			p: Problem{
+				Func: shiftedQuadratic{-1, 0.5, 2, -3}.Func,
+				Grad: shiftedQuadratic{-1, 0.5, 2, -3}.Grad,
+			},
+			x:     []float64{10, 10, 10, 10},
+			lower: []float64{0, 0, 0, math.Inf(-1)},
+			want:  []float64{0, 0.5, 2, -3},
+		},
+		{
+			name: "QuadraticFixedVariable",
+			p: Problem{
+				Func: shiftedQuadratic{-1, 0.5, 2}.Func,
+				Grad: shiftedQuadratic{-1, 0.5, 2}.Grad,
+			},
+			x:     []float64{0, 0, 0},
+			lower: []float64{-inf, 0.25, -inf},
+			upper: []float64{inf, 0.25, inf},
+			want:  []float64{-1, 0.25, 2},
+		},
+		{
+			name: "RosenbrockActive",
+			p: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+				Grad: functions.ExtendedRosenbrock{}.Grad,
+			},
+			x:     []float64{-1.2, 1},
+			lower: []float64{-2, -2},
+			upper: []float64{0.5, 2},
+			want:  []float64{0.5, 0.25},
+		},
+		{
+			name: "RosenbrockInactive",
+			p: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+				Grad: functions.ExtendedRosenbrock{}.Grad,
+			},
+			x:     []float64{-1.2, 1},
+			lower: []float64{-5, -5},
+			upper: []float64{5, 5},
+			want:  []float64{1, 1},
+		},
+		{
+			name: "ExtendedRosenbrock",
+			p: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+				Grad: functions.ExtendedRosenbrock{}.Grad,
+			},
+			x:       []float64{-1.2, 1, -1.2, 1, -1.2, 1},
+			lower:   []float64{-2, -2, 1.5, -2, -2, -2},
+			upper:   []float64{2, 2, 2, 2, 2, 2},
+			gradTol: 1e-6,
+		},
+		{
+			name: "Tridiagonal",
+			p: Problem{
+				Func: tridiagQuadratic{}.Func,
+				Grad: tridiagQuadratic{}.Grad,
+			},
+			x:     make([]float64, 100),
+			lower: make([]float64, 100),
+			upper: constSlice(100, 10),
+			store: 5,
+		},
+	} {
+		name := fmt.Sprintf("Case %d (%s)", cas, test.name)
+
+		// Check that the function is never evaluated outside the bounds.
+		inBounds := func(x []float64) bool {
+			for i, v := range x {
+				if (test.lower != nil && v < test.lower[i]) || (test.upper != nil && v > test.upper[i]) {
+					return false
+				}
+			}
+			return true
+		}
+		var outside bool
+		p := test.p
+		p.Func = func(x []float64) float64 {
+			if !inBounds(x) {
+				outside = true
+			}
+			return test.p.Func(x)
+		}
+
+		if test.gradTol == 0 {
+			test.gradTol = 1e-8
+		}
+		method := &LBFGSB{
+			Lower:             test.lower,
+			Upper:             test.upper,
+			Store:             test.store,
+			GradStopThreshold: test.gradTol,
+		}
+		settings := &Settings{
+			Converger: NeverTerminate{},
+		}
+		result, err := Minimize(p, test.x, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if result.Status != GradientThreshold {
+			t.Errorf("%s: unexpected status: got %v, want %v", name, result.Status, GradientThreshold)
+		}
+		if outside {
+			t.Errorf("%s: function evaluated outside the bounds", name)
+		}
+		if !inBounds(result.X) {
+			t.Errorf("%s: result outside the bounds: %v", name, result.X)
+		}
+		if test.want != nil && !floats.EqualApprox(result.X, test.want, tol) {
+			t.Errorf("%s: unexpected minimum location: got %v, want %v", name, result.X, test.want)
+		}
+
+		// Check that the projected gradient vanishes at the minimum.
+		g := make([]float64, len(result.X))
+		test.p.Grad(g, result.X)
+		for i, v := range result.X {
+			pg := v - g[i]
+			if test.lower != nil {
+				pg = math.Max(pg, test.lower[i])
+			}
+			if test.upper != nil {
+				pg = math.Min(pg, test.upper[i])
+			}
+			if math.Abs(pg-v) > tol {
+				t.Errorf("%s: projected gradient not zero at index %d: %v", name, i, pg-v)
+			}
+		}
+	}
+}
+
+func constSlice(n int, v float64) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = v
+	}
+	return s
+}
+
+func TestLBFGSBNoProgress(t *testing.T) {
+	t.Parallel()
+	// The line searches along the limited-memory directions stall near
+	// the minimum. LBFGSB must then restart from the projected steepest
+	// descent direction and terminate when that fails too, instead of
+	// evaluating the same location indefinitely.
+	const n = 10
+	x := make([]float64, n)
+	copy(x, []float64{-1.2, 1, -1.2, 1})
+	lower := constSlice(n, -1)
+	upper := constSlice(n, 2)
+	upper[0] = 0.3
+	f := functions.ExtendedRosenbrock{}
+	p := Problem{Func: f.Func, Grad: f.Grad}
+	// The evaluation limit only ensures that the test fails rather than
+	// hangs if LBFGSB does not terminate. The other settings are the
+	// defaults.
+	settings := &Settings{FuncEvaluations: 10000}
+	result, err := Minimize(p, x, settings, &LBFGSB{Lower: lower, Upper: upper})
+	if err != ErrNoProgress && err != ErrLinesearcherFailure {
+		t.Errorf("unexpected error: got %v, want %v or %v", err, ErrNoProgress, ErrLinesearcherFailure)
+	}
+	if result.Status != Failure {
+		t.Errorf("unexpected status: got %v, want %v", result.Status, Failure)
+	}
+
+	// The stall is caused by rounding, so the location must be close to a
+	// stationary point.
+	g := make([]float64, n)
+	f.Grad(g, result.X)
+	for i, v := range result.X {
+		pg := math.Max(lower[i], math.Min(v-g[i], upper[i])) - v
+		if math.Abs(pg) > 1e-6 {
+			t.Errorf("projected gradient not small at index %d: %v", i, pg)
+		}
+	}
+}
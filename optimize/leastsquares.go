@@ -0,0 +1,571 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// leastSquaresStepTol is the size of a trial step relative to the size of the
+// location below which MinimizeLeastSquares terminates with StepConvergence.
+const leastSquaresStepTol = 1e-10
+
+// LeastSquaresProblem describes a nonlinear least-squares problem
+//
+//	minimize    1/2 Σᵢ ρ(rᵢ(x)²)
+//	subject to  Lower ≤ x ≤ Upper,
+//
+// where r is a vector of residual functions and ρ is a loss function.
+type LeastSquaresProblem struct {
+	// NumResiduals is the number of residuals.
+	NumResiduals int
+
+	// Residual evaluates the residuals at x and stores the result in dst
+	// which will have length NumResiduals. Residual must not modify x.
+	Residual func(dst, x []float64)
+
+	// Jacobian evaluates the Jacobian of the residuals at x and stores the
+	// result in dst which will be NumResiduals×len(x). Jacobian must not
+	// modify x. If Jacobian is nil, the Jacobian is approximated by forward
+	// differences using fd.Jacobian.
+	Jacobian func(dst *mat.Dense, x []float64)
+
+	// Lower and Upper are the bounds on the variables. If Lower or Upper is
+	// nil, the variables are unbounded from below or from above respectively.
+	// Otherwise they must have the same length as the initial location and
+	// may contain infinite elements.
+	Lower, Upper []float64
+
+	// Loss is the loss function ρ applied to the squared residuals. If Loss
+	// is nil, ρ(z) = z and the problem is an ordinary least-squares problem.
+	Loss Loss
+
+	// Status reports the status of the objective function being optimized and any
+	// error. This can be used to terminate early, for example when the function is
+	// not able to evaluate itself. The user can use one of the pre-provided Status
+	// constants, or may call NewStatus to create a custom Status value.
+	Status func() (Status, error)
+}
+
+// LeastSquaresResult represents the answer of a least-squares optimization
+// run. The embedded Result contains the optimum location, the cost
+// 1/2 Σᵢ ρ(rᵢ²) and its gradient at the location, the Status at convergence
+// and the statistics of the run.
+type LeastSquaresResult struct {
+	Result
+
+	// Residuals holds the residuals at X.
+	Residuals []float64
+
+	// Covariance is the estimate of the covariance matrix of the parameters
+	// at X
+	//  s² (JᵀJ)⁺,
+	// where J is the Jacobian of the residuals at X, ⁺ denotes the
+	// pseudo-inverse and s² = Σᵢ ρ(rᵢ²) / (NumResiduals - len(X)) estimates
+	// the variance of the residuals. If the problem has a Loss, J is the
+	// rescaled Jacobian described in MinimizeLeastSquares. Covariance is nil
+	// if NumResiduals ≤ len(X).
+	Covariance *mat.SymDense
+}
+
+// LeastSquaresMethod is a method for nonlinear least-squares problems that
+// computes trial steps from the linear model r + J*p of the residuals at the
+// current location.
+type LeastSquaresMethod interface {
+	// Init initializes the method at the initial location x given the
+	// Jacobian and the residuals at x.
+	Init(x []float64, jac *mat.Dense, r []float64)
+
+	// Step stores in dst a trial step from the current location given the
+	// Jacobian and the residuals at the location. The length of dst equals
+	// the number of columns of jac, which is smaller than the problem
+	// dimension when variables are fixed at their bounds.
+	Step(dst []float64, jac *mat.Dense, r []float64)
+
+	// Update updates the method after the last trial step given the ratio of
+	// the actual to the predicted reduction of the cost and the norm of the
+	// step. The step is accepted if ratio is positive.
+	Update(ratio, stepNorm float64)
+}
+
+var (
+	_ LeastSquaresMethod = (*LevenbergMarquardt)(nil)
+	_ LeastSquaresMethod = (*Dogleg)(nil)
+)
+
+// MinimizeLeastSquares searches for a minimum of the least-squares problem p
+// starting from initX using the given method. If method is nil,
+// LevenbergMarquardt with default parameters is used.
+//
+// The initial location is projected onto the bounds. At each iteration the
+// variables that are at a bound with the gradient pointing out of the
+// feasible region are fixed, and the method computes a trial step in the
+// remaining variables. Variables at a bound that the step would move out of
+// the feasible region are fixed as well and the step is recomputed. The step
+// is then projected onto the bounds and accepted if it reduces the cost.
+//
+// If p.Loss is not nil, the residuals and the rows of the Jacobian are
+// rescaled at each location so that the Gauss-Newton model of the rescaled
+// problem has the gradient and approximates the Hessian of the robust cost
+// as described in
+//   - Triggs, B., McLauchlan, P.F., Hartley, R.I., Fitzgibbon, A.W.: Bundle
+//     Adjustment — A Modern Synthesis. Vision Algorithms: Theory and Practice,
+//     LNCS 1883, 298-372 (2000)
+//
+// The settings are applied as in Minimize with the cost as the objective
+// function. Residual evaluations are counted as function evaluations and
+// Jacobian evaluations as gradient evaluations, or as function evaluations
+// if the Jacobian is approximated by finite differences. The gradient
+// threshold is applied to the projected gradient. In addition, the
+// minimization terminates with StepConvergence if the trial step becomes
+// negligible relative to the location.
+//
+// MinimizeLeastSquares returns a LeastSquaresResult and any error that
+// occurred.
+func MinimizeLeastSquares(p LeastSquaresProblem, initX []float64, settings *Settings, method LeastSquaresMethod) (*LeastSquaresResult, error) {
+	startTime := time.Now()
+	if method == nil {
+		method = &LevenbergMarquardt{}
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	dim := len(initX)
+	p.check(dim)
+	if p.Status != nil {
+		_, err := p.Status()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if settings.Recorder != nil {
+		err := settings.Recorder.Init()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ls := newLeastSquares(&p, dim)
+	copy(ls.x, initX)
+	ls.project(ls.x)
+	stats := &Stats{}
+	res := &LeastSquaresResult{
+		Result: Result{
+			Location: Location{
+				X:        ls.x,
+				Gradient: ls.g,
+			},
+		},
+		Residuals: ls.r,
+	}
+	status, err := ls.run(&res.Location, method, settings, stats, startTime)
+	if err == nil {
+		res.Covariance = leastSquaresCovariance(ls.js, res.F)
+	}
+	if settings.Recorder != nil && err == nil {
+		err = settings.Recorder.Record(&res.Location, PostIteration, stats)
+	}
+	stats.Runtime = time.Since(startTime)
+	res.Status = status
+	res.Stats = *stats
+	return res, err
+}
+
+func (p *LeastSquaresProblem) check(dim int) {
+	if p.Residual == nil {
+		panic("optimize: residual function is undefined")
+	}
+	if dim <= 0 {
+		panic("optimize: impossible problem dimension")
+	}
+	if p.NumResiduals <= 0 {
+		panic("optimize: impossible number of residuals")
+	}
+	if p.Lower != nil && len(p.Lower) != dim {
+		panic("optimize: lower bound length mismatch")
+	}
+	if p.Upper != nil && len(p.Upper) != dim {
+		panic("optimize: upper bound length mismatch")
+	}
+	for i := 0; i < dim; i++ {
+		l, u := math.Inf(-1), math.Inf(1)
+		if p.Lower != nil {
+			l = p.Lower[i]
+		}
+		if p.Upper != nil {
+			u = p.Upper[i]
+		}
+		if math.IsNaN(l) || math.IsNaN(u) {
+			panic("optimize: NaN bound")
+		}
+		if l > u {
+			panic("optimize: lower bound greater than upper bound")
+		}
+	}
+}
+
+// leastSquares holds the state of a least-squares minimization.
+type leastSquares struct {
+	p    *LeastSquaresProblem
+	m, n int
+
+	x, r, g []float64  // Current location, residuals and gradient of the cost
+	jac     *mat.Dense // Jacobian at x
+	rs      []float64  // Residuals rescaled by the loss function
+	js      *mat.Dense // Jacobian rescaled by the loss function
+
+	xt, rt  []float64 // Trial location and residuals
+	s, pg   []float64 // Trial step and projected gradient
+	js2     []float64 // Product of the rescaled Jacobian and the trial step
+	free    []int     // Indices of the free variables
+	jf      mat.Dense // Columns of js of the free variables
+	stepBuf []float64 // Step in the free variables
+}
+
+func newLeastSquares(p *LeastSquaresProblem, dim int) *leastSquares {
+	m := p.NumResiduals
+	ls := &leastSquares{
+		p:   p,
+		m:   m,
+		n:   dim,
+		x:   make([]float64, dim),
+		r:   make([]float64, m),
+		g:   make([]float64, dim),
+		jac: mat.NewDense(m, dim, nil),
+		rs:  make([]float64, m),
+		js:  mat.NewDense(m, dim, nil),
+		xt:  make([]float64, dim),
+		rt:  make([]float64, m),
+		s:   make([]float64, dim),
+		pg:  make([]float64, dim),
+		js2: make([]float64, m),
+	}
+	if p.Loss == nil {
+		// Without a loss function the rescaled residuals and Jacobian are
+		// the original ones.
+		ls.rs = ls.r
+		ls.js = ls.jac
+	}
+	return ls
+}
+
+func (ls *leastSquares) run(loc *Location, method LeastSquaresMethod, settings *Settings, stats *Stats, startTime time.Time) (Status, error) {
+	statusProblem := &Problem{Status: ls.p.Status}
+
+	loc.F = ls.cost(ls.r, ls.x)
+	stats.FuncEvaluations++
+	if math.IsInf(loc.F, 1) || math.IsNaN(loc.F) {
+		return Failure, ErrFunc(loc.F)
+	}
+	ls.jacobian(stats)
+	for i, v := range ls.g {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return Failure, ErrGrad{Grad: v, Index: i}
+		}
+	}
+	if settings.Recorder != nil {
+		err := settings.Recorder.Record(loc, InitIteration, stats)
+		if err != nil {
+			return Failure, err
+		}
+	}
+	converger := settings.Converger
+	if converger == nil {
+		converger = defaultFunctionConverge()
+	}
+	converger.Init(ls.n)
+	converger.Converged(loc)
+
+	gradThresh := settings.GradientThreshold
+	if gradThresh == 0 {
+		gradThresh = defaultGradientAbsTol
+	}
+	if ls.projGradNorm() < gradThresh {
+		return GradientThreshold, nil
+	}
+	if status, err := checkEvaluationLimits(statusProblem, stats, settings); status != NotTerminated || err != nil {
+		return status, err
+	}
+
+	initialized := false
+	for {
+		ls.freeVariables()
+		if !initialized {
+			method.Init(ls.x, &ls.jf, ls.rs)
+			initialized = true
+		}
+		// Compute the step in the free variables and fix the variables that
+		// the step would move out of the feasible region.
+		for len(ls.free) > 0 {
+			ls.stepBuf = resize(ls.stepBuf, len(ls.free))
+			method.Step(ls.stepBuf, &ls.jf, ls.rs)
+			if !ls.fixBlocked() {
+				break
+			}
+		}
+		pred := ls.trialStep()
+
+		stepNorm := floats.Norm(ls.s, 2)
+		if !(stepNorm > leastSquaresStepTol*(floats.Norm(ls.x, 2)+leastSquaresStepTol)) {
+			return StepConvergence, nil
+		}
+
+		ft := ls.cost(ls.rt, ls.xt)
+		stats.FuncEvaluations++
+		ratio := -1.0
+		if actual := loc.F - ft; pred > 0 && !math.IsNaN(actual) {
+			ratio = actual / pred
+		}
+		method.Update(ratio, stepNorm)
+
+		if ratio > 0 {
+			copy(ls.x, ls.xt)
+			copy(ls.r, ls.rt)
+			loc.F = ft
+			ls.jacobian(stats)
+
+			stats.MajorIterations++
+			stats.Runtime = time.Since(startTime)
+			if settings.Recorder != nil {
+				err := settings.Recorder.Record(loc, MajorIteration, stats)
+				if err != nil {
+					return Failure, err
+				}
+			}
+			if ls.projGradNorm() < gradThresh {
+				return GradientThreshold, nil
+			}
+			if status := converger.Converged(loc); status != NotTerminated {
+				return status, nil
+			}
+			if status := checkIterationLimits(loc, stats, settings); status != NotTerminated {
+				return status, nil
+			}
+		}
+		if status, err := checkEvaluationLimits(statusProblem, stats, settings); status != NotTerminated || err != nil {
+			return status, err
+		}
+	}
+}
+
+// cost evaluates the residuals at x into r and returns the cost.
+func (ls *leastSquares) cost(r, x []float64) float64 {
+	ls.p.Residual(r, x)
+	var f float64
+	if ls.p.Loss == nil {
+		for _, v := range r {
+			f += v * v
+		}
+	} else {
+		for _, v := range r {
+			rho, _, _ := ls.p.Loss.Loss(v * v)
+			f += rho
+		}
+	}
+	return f / 2
+}
+
+// jacobian evaluates the Jacobian at the current location, rescales the
+// residuals and the Jacobian by the loss function and computes the gradient
+// of the cost.
+func (ls *leastSquares) jacobian(stats *Stats) {
+	if ls.p.Jacobian != nil {
+		ls.jac.Zero()
+		ls.p.Jacobian(ls.jac, ls.x)
+		stats.GradEvaluations++
+	} else {
+		fd.Jacobian(ls.jac, ls.p.Residual, ls.x, &fd.JacobianSettings{
+			OriginValue: ls.r,
+		})
+		stats.FuncEvaluations += ls.n
+	}
+
+	if ls.p.Loss != nil {
+		ls.js.Copy(ls.jac)
+		for i, v := range ls.r {
+			z := v * v
+			_, d1, d2 := ls.p.Loss.Loss(z)
+			// Rows with a negative curvature of the loss are scaled such
+			// that the model remains convex.
+			scale := math.Sqrt(math.Max(d1+2*d2*z, machEps))
+			ls.rs[i] = d1 * v / scale
+			row := ls.js.RawRowView(i)
+			floats.Scale(scale, row)
+		}
+	}
+	g := mat.NewVecDense(ls.n, ls.g)
+	g.MulVec(ls.js.T(), mat.NewVecDense(ls.m, ls.rs))
+}
+
+// project projects x onto the bounds.
+func (ls *leastSquares) project(x []float64) {
+	for i := range x {
+		if ls.p.Lower != nil {
+			x[i] = math.Max(x[i], ls.p.Lower[i])
+		}
+		if ls.p.Upper != nil {
+			x[i] = math.Min(x[i], ls.p.Upper[i])
+		}
+	}
+}
+
+// projGradNorm returns the infinity norm of the projected gradient
+// P(x - g) - x at the current location.
+func (ls *leastSquares) projGradNorm() float64 {
+	for i, v := range ls.x {
+		ls.pg[i] = v - ls.g[i]
+	}
+	ls.project(ls.pg)
+	floats.Sub(ls.pg, ls.x)
+	return floats.Norm(ls.pg, math.Inf(1))
+}
+
+// freeVariables determines the free variables at the current location and
+// stores the corresponding columns of the rescaled Jacobian in ls.jf. A
+// variable is fixed if it is at a bound and the gradient points out of the
+// feasible region.
+func (ls *leastSquares) freeVariables() {
+	ls.free = ls.free[:0]
+	for i, v := range ls.x {
+		if ls.p.Lower != nil && v <= ls.p.Lower[i] && ls.g[i] > 0 {
+			continue
+		}
+		if ls.p.Upper != nil && v >= ls.p.Upper[i] && ls.g[i] < 0 {
+			continue
+		}
+		ls.free = append(ls.free, i)
+	}
+	ls.freeJacobian()
+}
+
+// fixBlocked fixes the free variables that are at a bound and for which the
+// step in ls.stepBuf points out of the feasible region. It returns whether
+// any variable has been fixed.
+func (ls *leastSquares) fixBlocked() bool {
+	var k int
+	for j, i := range ls.free {
+		d := ls.stepBuf[j]
+		if ls.p.Lower != nil && ls.x[i] <= ls.p.Lower[i] && d < 0 {
+			continue
+		}
+		if ls.p.Upper != nil && ls.x[i] >= ls.p.Upper[i] && d > 0 {
+			continue
+		}
+		ls.free[k] = i
+		k++
+	}
+	if k == len(ls.free) {
+		return false
+	}
+	ls.free = ls.free[:k]
+	ls.freeJacobian()
+	return true
+}
+
+// freeJacobian stores the columns of the rescaled Jacobian of the free
+// variables in ls.jf.
+func (ls *leastSquares) freeJacobian() {
+	ls.jf.Reset()
+	if len(ls.free) == 0 {
+		return
+	}
+	ls.jf.ReuseAs(ls.m, len(ls.free))
+	for j, i := range ls.free {
+		for k := 0; k < ls.m; k++ {
+			ls.jf.Set(k, j, ls.js.At(k, i))
+		}
+	}
+}
+
+// trialStep computes the trial location ls.xt from the step in the free
+// variables and returns the reduction of the cost predicted by the linear
+// model of the residuals. The step is projected onto the bounds, or
+// truncated at the first bound if the projected step does not reduce the
+// model.
+func (ls *leastSquares) trialStep() float64 {
+	for i := range ls.s {
+		ls.s[i] = 0
+	}
+	for j, i := range ls.free {
+		ls.s[i] = ls.stepBuf[j]
+	}
+	floats.AddTo(ls.xt, ls.x, ls.s)
+	ls.project(ls.xt)
+	floats.SubTo(ls.s, ls.xt, ls.x)
+	pred := ls.predicted()
+	if pred > 0 {
+		return pred
+	}
+
+	// Truncate the step at the first bound.
+	alpha := 1.0
+	for j, i := range ls.free {
+		d := ls.stepBuf[j]
+		if ls.p.Lower != nil && d < 0 {
+			alpha = math.Min(alpha, (ls.p.Lower[i]-ls.x[i])/d)
+		}
+		if ls.p.Upper != nil && d > 0 {
+			alpha = math.Min(alpha, (ls.p.Upper[i]-ls.x[i])/d)
+		}
+	}
+	for j, i := range ls.free {
+		ls.s[i] = alpha * ls.stepBuf[j]
+	}
+	floats.AddTo(ls.xt, ls.x, ls.s)
+	ls.project(ls.xt)
+	floats.SubTo(ls.s, ls.xt, ls.x)
+	return ls.predicted()
+}
+
+// predicted returns the reduction of the cost predicted by the linear model
+// of the rescaled residuals for the step ls.s,
+//
+//	-(gᵀs + 1/2 |J s|²).
+func (ls *leastSquares) predicted() float64 {
+	js2 := mat.NewVecDense(ls.m, ls.js2)
+	js2.MulVec(ls.js, mat.NewVecDense(ls.n, ls.s))
+	return -(floats.Dot(ls.g, ls.s) + 0.5*floats.Dot(ls.js2, ls.js2))
+}
+
+// leastSquaresCovariance returns the estimate of the covariance matrix of
+// the parameters given the Jacobian and the cost at the solution, or nil if
+// the problem has no more residuals than parameters.
+func leastSquaresCovariance(jac *mat.Dense, cost float64) *mat.SymDense {
+	m, n := jac.Dims()
+	if m <= n {
+		return nil
+	}
+	var svd mat.SVD
+	ok := svd.Factorize(jac, mat.SVDThinV)
+	if !ok {
+		return nil
+	}
+	s := svd.Values(nil)
+	var v mat.Dense
+	svd.VTo(&v)
+
+	// Singular values below tol are treated as zero in the pseudo-inverse.
+	tol := s[0] * float64(m) * machEps
+	variance := 2 * cost / float64(m-n)
+	cov := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			var c float64
+			for k, sk := range s {
+				if sk <= tol {
+					break
+				}
+				c += v.At(i, k) * v.At(j, k) / (sk * sk)
+			}
+			cov.SetSym(i, j, variance*c)
+		}
+	}
+	return cov
+}
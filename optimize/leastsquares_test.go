@@ -0,0 +1,395 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+type leastSquaresTest struct {
+	name string
+	p    LeastSquaresProblem
+	x    []float64
+
+	// Known solution, nil if not known.
+	want  []float64
+	wantF float64
+}
+
+// expFit returns the residuals of the fit of a·exp(b·t) to exact data
+// generated with a = 2 and b = -0.5.
+func expFit() LeastSquaresProblem {
+	const n = 10
+	t := make([]float64, n)
+	y := make([]float64, n)
+	for i := range t {
+		t[i] = float64(i) / 2
+		y[i] = 2 * math.Exp(-0.5*t[i])
+	}
+	return LeastSquaresProblem{
+		NumResiduals: n,
+		Residual: func(dst, x []float64) {
+			for i, ti := range t {
+				dst[i] = x[0]*math.Exp(x[1]*ti) - y[i]
+			}
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			for i, ti := range t {
+				e := math.Exp(x[1] * ti)
+				dst.Set(i, 0, e)
+				dst.Set(i, 1, x[0]*ti*e)
+			}
+		},
+	}
+}
+
+// rosenbrockResiduals returns the Rosenbrock function written as the
+// residuals 10(x1 - x0²) and 1 - x0.
+func rosenbrockResiduals() LeastSquaresProblem {
+	return LeastSquaresProblem{
+		NumResiduals: 2,
+		Residual: func(dst, x []float64) {
+			dst[0] = 10 * (x[1] - x[0]*x[0])
+			dst[1] = 1 - x[0]
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			dst.Set(0, 0, -20*x[0])
+			dst.Set(0, 1, 10)
+			dst.Set(1, 0, -1)
+		},
+	}
+}
+
+// linearFit returns the residuals of the fit of a line a + b·t to the data
+// (t, y) with an offset of 10 added to the observations in outliers.
+func linearFit(t, y []float64, outliers ...int) LeastSquaresProblem {
+	y = append([]float64(nil), y...)
+	for _, i := range outliers {
+		y[i] += 10
+	}
+	return LeastSquaresProblem{
+		NumResiduals: len(t),
+		Residual: func(dst, x []float64) {
+			for i, ti := range t {
+				dst[i] = x[0] + x[1]*ti - y[i]
+			}
+		},
+		Jacobian: func(dst *mat.Dense, x []float64) {
+			for i, ti := range t {
+				dst.Set(i, 0, 1)
+				dst.Set(i, 1, ti)
+			}
+		},
+	}
+}
+
+var (
+	lineT = []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	// lineY is 1 + 0.5·t perturbed by a small noise.
+	lineY = []float64{1.05, 1.42, 2.03, 2.48, 3.01, 3.55, 3.97, 4.46, 5.04, 5.52}
+)
+
+var leastSquaresTests = []leastSquaresTest{
+	{
+		name:  "ExpFit",
+		p:     expFit(),
+		x:     []float64{1, 0},
+		want:  []float64{2, -0.5},
+		wantF: 0,
+	},
+	{
+		name:  "Rosenbrock",
+		p:     rosenbrockResiduals(),
+		x:     []float64{-1.2, 1},
+		want:  []float64{1, 1},
+		wantF: 0,
+	},
+	{
+		name: "RosenbrockBounded",
+		p: func() LeastSquaresProblem {
+			p := rosenbrockResiduals()
+			p.Upper = []float64{0.5, math.Inf(1)}
+			return p
+		}(),
+		x:     []float64{-1.2, 1},
+		want:  []float64{0.5, 0.25},
+		wantF: 0.125,
+	},
+	{
+		name: "RosenbrockInfeasibleStart",
+		p: func() LeastSquaresProblem {
+			p := rosenbrockResiduals()
+			p.Lower = []float64{-2, -2}
+			p.Upper = []float64{0.5, 2}
+			return p
+		}(),
+		x:     []float64{3, 3},
+		want:  []float64{0.5, 0.25},
+		wantF: 0.125,
+	},
+	{
+		name: "ExpFitBounded",
+		p: func() LeastSquaresProblem {
+			p := expFit()
+			p.Upper = []float64{1.5, 0}
+			return p
+		}(),
+		x: []float64{1, 0},
+	},
+	{
+		name: "LinearFit",
+		p:    linearFit(lineT, lineY),
+		x:    []float64{0, 0},
+	},
+}
+
+func TestLeastSquares(t *testing.T) {
+	t.Parallel()
+	for _, method := range []LeastSquaresMethod{
+		nil,
+		&LevenbergMarquardt{InitialDamping: 1},
+		&Dogleg{},
+		&Dogleg{InitialRadius: 0.1},
+	} {
+		testLeastSquares(t, leastSquaresTests, method)
+	}
+}
+
+func testLeastSquares(t *testing.T, tests []leastSquaresTest, method LeastSquaresMethod) {
+	const (
+		tolX = 1e-6
+		tolF = 1e-10
+	)
+	for _, test := range tests {
+		for _, useJac := range []bool{true, false} {
+			name := fmt.Sprintf("%s, method=%T, useJac=%v", test.name, method, useJac)
+			p := test.p
+			if !useJac {
+				p.Jacobian = nil
+			}
+			result, err := MinimizeLeastSquares(p, test.x, nil, method)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if result.Status.Early() {
+				t.Errorf("%s: unexpected early status: %v", name, result.Status)
+			}
+			for i, v := range result.X {
+				if (p.Lower != nil && v < p.Lower[i]) || (p.Upper != nil && v > p.Upper[i]) {
+					t.Errorf("%s: result outside the bounds: %v", name, result.X)
+					break
+				}
+			}
+			if test.want != nil {
+				if !floats.EqualApprox(result.X, test.want, tolX) {
+					t.Errorf("%s: unexpected minimum location: got %v, want %v", name, result.X, test.want)
+				}
+				if math.Abs(result.F-test.wantF) > tolF {
+					t.Errorf("%s: unexpected minimum value: got %v, want %v", name, result.F, test.wantF)
+				}
+			}
+
+			r := make([]float64, p.NumResiduals)
+			p.Residual(r, result.X)
+			if !floats.Equal(r, result.Residuals) {
+				t.Errorf("%s: residuals at the minimum location %v not equal to the returned residuals %v", name, r, result.Residuals)
+			}
+			if f := 0.5 * floats.Dot(r, r); !scalar.EqualWithinAbsOrRel(f, result.F, 1e-14, 1e-14) {
+				t.Errorf("%s: cost at the minimum location %v not equal to the returned value %v", name, f, result.F)
+			}
+
+			// Check that the projected gradient vanishes at the minimum.
+			n := len(result.X)
+			jac := mat.NewDense(p.NumResiduals, n, nil)
+			test.p.Jacobian(jac, result.X)
+			g := mat.NewVecDense(n, nil)
+			g.MulVec(jac.T(), mat.NewVecDense(len(r), r))
+			for i, v := range result.X {
+				pg := v - g.AtVec(i)
+				if p.Lower != nil {
+					pg = math.Max(pg, p.Lower[i])
+				}
+				if p.Upper != nil {
+					pg = math.Min(pg, p.Upper[i])
+				}
+				if math.Abs(pg-v) > 1e-6 {
+					t.Errorf("%s: projected gradient not zero at index %d: %v", name, i, pg-v)
+				}
+			}
+		}
+	}
+}
+
+func TestLeastSquaresCovariance(t *testing.T) {
+	t.Parallel()
+	p := linearFit(lineT, lineY)
+	result, err := MinimizeLeastSquares(p, []float64{0, 0}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Compare with the solution of the linear least-squares problem and the
+	// covariance s² (AᵀA)⁻¹.
+	m := len(lineT)
+	a := mat.NewDense(m, 2, nil)
+	for i, ti := range lineT {
+		a.Set(i, 0, 1)
+		a.Set(i, 1, ti)
+	}
+	var want mat.VecDense
+	err = want.SolveVec(a, mat.NewVecDense(m, lineY))
+	if err != nil {
+		t.Fatalf("unexpected error solving linear problem: %v", err)
+	}
+	if !floats.EqualApprox(result.X, want.RawVector().Data, 1e-10) {
+		t.Errorf("unexpected minimum location: got %v, want %v", result.X, want.RawVector().Data)
+	}
+	var ata mat.SymDense
+	ata.SymOuterK(1, a.T())
+	var wantCov mat.Dense
+	err = wantCov.Inverse(&ata)
+	if err != nil {
+		t.Fatalf("unexpected error inverting AᵀA: %v", err)
+	}
+	wantCov.Scale(floats.Dot(result.Residuals, result.Residuals)/float64(m-2), &wantCov)
+	if result.Covariance == nil {
+		t.Fatal("missing covariance")
+	}
+	if !mat.EqualApprox(result.Covariance, &wantCov, 1e-12) {
+		t.Errorf("unexpected covariance:\ngot:\n%v\nwant:\n%v", mat.Formatted(result.Covariance), mat.Formatted(&wantCov))
+	}
+
+	// The covariance cannot be estimated without redundant residuals.
+	result, err = MinimizeLeastSquares(rosenbrockResiduals(), []float64{-1.2, 1}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Covariance != nil {
+		t.Errorf("unexpected covariance for a square problem: %v", mat.Formatted(result.Covariance))
+	}
+}
+
+func TestLeastSquaresLoss(t *testing.T) {
+	t.Parallel()
+	// Outliers bias the ordinary least-squares fit of the line 1 + 0.5·t.
+	want := []float64{1, 0.5}
+	p := linearFit(lineT, lineY, 2, 7)
+	result, err := MinimizeLeastSquares(p, []float64{0, 0}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if floats.EqualApprox(result.X, want, 0.5) {
+		t.Errorf("ordinary least-squares fit unexpectedly close to the line: %v", result.X)
+	}
+
+	for _, loss := range []Loss{
+		HuberLoss{Scale: 0.1},
+		SoftL1Loss{Scale: 0.1},
+		CauchyLoss{},
+		CauchyLoss{Scale: 0.1},
+	} {
+		for _, method := range []LeastSquaresMethod{&LevenbergMarquardt{}, &Dogleg{}} {
+			name := fmt.Sprintf("loss=%#v, method=%T", loss, method)
+			p.Loss = loss
+			result, err := MinimizeLeastSquares(p, []float64{0, 0}, nil, method)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if result.Status.Early() {
+				t.Errorf("%s: unexpected early status: %v", name, result.Status)
+			}
+			if !floats.EqualApprox(result.X, want, 0.1) {
+				t.Errorf("%s: robust fit not close to the line: got %v, want %v", name, result.X, want)
+			}
+
+			// Check that the gradient of the robust cost vanishes.
+			grad := fd.Gradient(nil, func(x []float64) float64 {
+				r := make([]float64, p.NumResiduals)
+				p.Residual(r, x)
+				var f float64
+				for _, v := range r {
+					rho, _, _ := loss.Loss(v * v)
+					f += rho
+				}
+				return f / 2
+			}, result.X, &fd.Settings{Formula: fd.Central})
+			if !floats.EqualApprox(grad, make([]float64, len(grad)), 1e-5) {
+				t.Errorf("%s: gradient of the robust cost not zero: %v", name, grad)
+			}
+			if !floats.EqualApprox(grad, result.Gradient, 1e-5) {
+				t.Errorf("%s: unexpected gradient: got %v, want %v", name, result.Gradient, grad)
+			}
+		}
+	}
+}
+
+func TestLoss(t *testing.T) {
+	t.Parallel()
+	for _, loss := range []Loss{
+		HuberLoss{},
+		HuberLoss{Scale: 0.5},
+		SoftL1Loss{},
+		SoftL1Loss{Scale: 2},
+		CauchyLoss{},
+		CauchyLoss{Scale: 3},
+	} {
+		rho, d1, _ := loss.Loss(0)
+		if rho != 0 || d1 != 1 {
+			t.Errorf("%#v: unexpected value at zero: got ρ=%v, ρ'=%v, want 0, 1", loss, rho, d1)
+		}
+		for _, z := range []float64{0.1, 0.9, 1.5, 4, 10, 100} {
+			_, d1, d2 := loss.Loss(z)
+			f := func(z float64) float64 {
+				rho, _, _ := loss.Loss(z)
+				return rho
+			}
+			df := func(z float64) float64 {
+				_, d1, _ := loss.Loss(z)
+				return d1
+			}
+			settings := &fd.Settings{Formula: fd.Central}
+			if want := fd.Derivative(f, z, settings); !scalar.EqualWithinAbsOrRel(d1, want, 1e-6, 1e-6) {
+				t.Errorf("%#v: unexpected first derivative at %v: got %v, want %v", loss, z, d1, want)
+			}
+			if want := fd.Derivative(df, z, settings); !scalar.EqualWithinAbsOrRel(d2, want, 1e-6, 1e-6) {
+				t.Errorf("%#v: unexpected second derivative at %v: got %v, want %v", loss, z, d2, want)
+			}
+		}
+	}
+}
+
+func TestLeastSquaresLimits(t *testing.T) {
+	t.Parallel()
+	settings := &Settings{MajorIterations: 3}
+	result, err := MinimizeLeastSquares(rosenbrockResiduals(), []float64{-1.2, 1}, settings, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != IterationLimit {
+		t.Errorf("unexpected status: got %v, want %v", result.Status, IterationLimit)
+	}
+	if result.MajorIterations != 3 {
+		t.Errorf("unexpected number of major iterations: got %v, want 3", result.MajorIterations)
+	}
+
+	p := rosenbrockResiduals()
+	p.Residual = func(dst, x []float64) {
+		dst[0] = math.NaN()
+		dst[1] = 0
+	}
+	_, err = MinimizeLeastSquares(p, []float64{-1.2, 1}, nil, nil)
+	if _, ok := err.(ErrFunc); !ok {
+		t.Errorf("unexpected error for NaN residual: got %v, want ErrFunc", err)
+	}
+}
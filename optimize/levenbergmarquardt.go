@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// LevenbergMarquardt is a LeastSquaresMethod that computes the steps of the
+// Levenberg-Marquardt method
+//
+//	(JᵀJ + λI) p = -Jᵀr,
+//
+// where J and r are the Jacobian and the residuals at the current location
+// and λ > 0 is the damping parameter. The step is computed as the solution of
+// the equivalent linear least-squares problem
+//
+//	minimize |[J; √λ I] p + [r; 0]|
+//
+// using a QR factorization, which avoids forming JᵀJ. The damping parameter
+// is decreased after successful steps and increased after unsuccessful ones
+// following Nielsen.
+//
+// References:
+//   - Madsen, K., Nielsen, H.B., Tingleff, O.: Methods for Non-Linear Least
+//     Squares Problems (2nd ed). Technical University of Denmark (2004)
+//   - Nielsen, H.B.: Damping Parameter in Marquardt's Method. Technical Report
+//     IMM-REP-1999-05, Technical University of Denmark (1999)
+type LevenbergMarquardt struct {
+	// InitialDamping is the initial damping parameter relative to the largest
+	// diagonal element of JᵀJ at the initial location. If InitialDamping is
+	// zero, it is defaulted to 1e-3, otherwise it must be positive.
+	InitialDamping float64
+
+	lambda float64 // Damping parameter
+	nu     float64 // Increase factor of the damping parameter
+
+	qr mat.QR
+}
+
+func (lm *LevenbergMarquardt) Init(x []float64, jac *mat.Dense, r []float64) {
+	tau := lm.InitialDamping
+	if tau == 0 {
+		tau = 1e-3
+	}
+	if tau < 0 {
+		panic("optimize: initial damping is not positive")
+	}
+	m, n := jac.Dims()
+	col := make([]float64, m)
+	var d float64
+	for j := 0; j < n; j++ {
+		mat.Col(col, j, jac)
+		d = math.Max(d, floats.Dot(col, col))
+	}
+	if d == 0 {
+		d = 1
+	}
+	lm.lambda = tau * d
+	lm.nu = 2
+}
+
+func (lm *LevenbergMarquardt) Step(dst []float64, jac *mat.Dense, r []float64) {
+	m, n := jac.Dims()
+	if len(dst) != n || len(r) != m {
+		panic("optimize: unexpected size mismatch")
+	}
+	aug := mat.NewDense(m+n, n, nil)
+	aug.Slice(0, m, 0, n).(*mat.Dense).Copy(jac)
+	sqrtLambda := math.Sqrt(lm.lambda)
+	for j := 0; j < n; j++ {
+		aug.Set(m+j, j, sqrtLambda)
+	}
+	rhs := mat.NewVecDense(m+n, nil)
+	for i, v := range r {
+		rhs.SetVec(i, -v)
+	}
+	lm.qr.Factorize(aug)
+	// The augmented matrix has full column rank for λ > 0, so a Condition
+	// error may only signal a poorly conditioned step which is then rejected
+	// by the trust test.
+	_ = lm.qr.SolveVecTo(mat.NewVecDense(n, dst), false, rhs)
+}
+
+func (lm *LevenbergMarquardt) Update(ratio, stepNorm float64) {
+	if ratio > 0 {
+		lm.lambda *= math.Max(1.0/3, 1-math.Pow(2*ratio-1, 3))
+		lm.nu = 2
+		return
+	}
+	lm.lambda *= lm.nu
+	lm.nu *= 2
+}
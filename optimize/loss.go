@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import "math"
+
+// Loss is a robust loss function ρ applied to the squared residuals of a
+// least-squares problem to reduce the influence of outliers. A loss function
+// must satisfy ρ(0) = 0, ρ'(0) = 1 and ρ'(z) > 0 for z ≥ 0.
+type Loss interface {
+	// Loss returns the value and the first and second derivatives of the
+	// loss function at z ≥ 0.
+	Loss(z float64) (rho, d1, d2 float64)
+}
+
+var (
+	_ Loss = HuberLoss{}
+	_ Loss = SoftL1Loss{}
+	_ Loss = CauchyLoss{}
+)
+
+// HuberLoss is the Huber loss function
+//
+//	ρ(z) = z             if z ≤ C²,
+//	ρ(z) = 2C√z - C²     otherwise,
+//
+// which is quadratic in the residuals smaller than the scale C and linear in
+// the residuals larger than C.
+type HuberLoss struct {
+	// Scale is the scale C of the residuals. If Scale is zero, it is
+	// defaulted to 1.
+	Scale float64
+}
+
+func (l HuberLoss) Loss(z float64) (rho, d1, d2 float64) {
+	c := lossScale(l.Scale)
+	if z <= c*c {
+		return z, 1, 0
+	}
+	sqrtZ := math.Sqrt(z)
+	return 2*c*sqrtZ - c*c, c / sqrtZ, -0.5 * c / (z * sqrtZ)
+}
+
+// SoftL1Loss is the smooth approximation of the absolute value loss
+//
+//	ρ(z) = 2C² (√(1 + z/C²) - 1),
+//
+// where C is the scale of the residuals.
+type SoftL1Loss struct {
+	// Scale is the scale C of the residuals. If Scale is zero, it is
+	// defaulted to 1.
+	Scale float64
+}
+
+func (l SoftL1Loss) Loss(z float64) (rho, d1, d2 float64) {
+	c := lossScale(l.Scale)
+	c2 := c * c
+	t := 1 + z/c2
+	sqrtT := math.Sqrt(t)
+	return 2 * c2 * (sqrtT - 1), 1 / sqrtT, -0.5 / (c2 * t * sqrtT)
+}
+
+// CauchyLoss is the Cauchy (Lorentzian) loss function
+//
+//	ρ(z) = C² ln(1 + z/C²),
+//
+// where C is the scale of the residuals.
+type CauchyLoss struct {
+	// Scale is the scale C of the residuals. If Scale is zero, it is
+	// defaulted to 1.
+	Scale float64
+}
+
+func (l CauchyLoss) Loss(z float64) (rho, d1, d2 float64) {
+	c := lossScale(l.Scale)
+	c2 := c * c
+	t := 1 + z/c2
+	return c2 * math.Log1p(z/c2), 1 / t, -1 / (c2 * t * t)
+}
+
+func lossScale(c float64) float64 {
+	if c == 0 {
+		return 1
+	}
+	if c < 0 {
+		panic("optimize: negative loss scale")
+	}
+	return c
+}
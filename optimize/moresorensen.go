@@ -0,0 +1,223 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// MoreSorensen is a TrustRegionSolver that computes a nearly exact solution
+// of the trust-region subproblem by the method of Moré and Sorensen.
+//
+// The solution satisfies (H + λI)p = -g for some λ ≥ 0 such that H + λI is
+// positive semi-definite and λ(Δ - |p|) = 0. MoreSorensen finds λ by a
+// safeguarded Newton iteration on the secular equation 1/|p(λ)| = 1/Δ, where
+// each iteration uses a Cholesky factorization of H + λI. If the iteration
+// does not converge, in particular in the hard case when g is orthogonal to
+// the eigenvectors of the smallest eigenvalue of H, the subproblem is solved
+// using the eigendecomposition of H.
+//
+// The cost of each iteration is that of a dense Cholesky factorization, so
+// MoreSorensen is suitable for problems of small and moderate dimension.
+//
+// References:
+//   - Moré, J.J., Sorensen, D.C.: Computing a trust region step. SIAM J. Sci.
+//     Stat. Comput. 4(3), 553-572 (1983)
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer
+//     (2006), chapter 4.3
+type MoreSorensen struct {
+	// Tolerance is the relative tolerance on the norm of the step on the
+	// boundary of the trust region. The iteration terminates when
+	// ||p| - Δ| ≤ Tolerance*Δ. If Tolerance is 0 it is defaulted to 0.01,
+	// otherwise it must be in the interval (0, 1).
+	Tolerance float64
+	// MaxIterations is the maximum number of Cholesky factorizations. If
+	// MaxIterations is 0 it is defaulted to 30.
+	MaxIterations int
+
+	a     *mat.SymDense
+	chol  mat.Cholesky
+	eigen mat.EigenSym
+	w     mat.VecDense
+}
+
+func (ms *MoreSorensen) Solve(dst, grad []float64, hess mat.Symmetric, radius float64) {
+	tol := ms.Tolerance
+	if tol == 0 {
+		tol = 0.01
+	}
+	if tol <= 0 || tol >= 1 {
+		panic("optimize: MoreSorensen tolerance not in (0, 1)")
+	}
+	maxIter := ms.MaxIterations
+	if maxIter == 0 {
+		maxIter = 30
+	}
+	dim := len(grad)
+	if len(dst) != dim || hess.SymmetricDim() != dim {
+		panic("optimize: unexpected size mismatch")
+	}
+	ms.a = resizeSymDense(ms.a, dim)
+	g := mat.NewVecDense(dim, grad)
+	p := mat.NewVecDense(dim, dst)
+	gNorm := floats.Norm(grad, 2)
+
+	// Safeguarding bounds on λ. The smallest eigenvalue of H lies between
+	// -‖H‖₁ and the smallest diagonal element of H.
+	var hNorm float64
+	minDiag := math.Inf(1)
+	for i := 0; i < dim; i++ {
+		var sum float64
+		for j := 0; j < dim; j++ {
+			sum += math.Abs(hess.At(i, j))
+		}
+		hNorm = math.Max(hNorm, sum)
+		minDiag = math.Min(minDiag, hess.At(i, i))
+	}
+	lo := math.Max(0, math.Max(-minDiag, gNorm/radius-hNorm))
+	hi := math.Max(0, gNorm/radius+hNorm)
+	lambda := lo
+	safeguard := func() float64 {
+		return math.Max(math.Sqrt(lo*hi), lo+0.01*(hi-lo))
+	}
+
+	for iter := 0; iter < maxIter && hi-lo > machEps*hi; iter++ {
+		ms.a.CopySym(hess)
+		for i := 0; i < dim; i++ {
+			ms.a.SetSym(i, i, hess.At(i, i)+lambda)
+		}
+		if !ms.chol.Factorize(ms.a) {
+			// H + λI is not positive definite, so λ is smaller than minus
+			// the smallest eigenvalue of H.
+			lo = lambda
+			lambda = safeguard()
+			continue
+		}
+		// A Condition error only indicates that the step may be inaccurate,
+		// which is guarded against by the outer trust-region iteration.
+		_ = ms.chol.SolveVecTo(p, g)
+		p.ScaleVec(-1, p)
+		pNorm := mat.Norm(p, 2)
+		if math.IsNaN(pNorm) || math.IsInf(pNorm, 0) {
+			// H + λI is numerically singular.
+			lo = lambda
+			lambda = safeguard()
+			continue
+		}
+		if pNorm <= radius {
+			if lambda == 0 || radius-pNorm <= tol*radius {
+				return
+			}
+			hi = lambda
+		} else {
+			if pNorm-radius <= tol*radius {
+				return
+			}
+			lo = lambda
+		}
+
+		// Newton step for the secular equation. With H + λI = UᵀU and
+		// q = U⁻ᵀp, the step is (|p|/|q|)² (|p| - Δ)/Δ where
+		// |q|² = pᵀ(H + λI)⁻¹p.
+		ms.w.Reset()
+		_ = ms.chol.SolveVecTo(&ms.w, p)
+		lambda += pNorm * pNorm / mat.Dot(p, &ms.w) * (pNorm - radius) / radius
+		if !(lo < lambda && lambda < hi) {
+			lambda = safeguard()
+		}
+	}
+	ms.eigenSolve(dst, grad, hess, radius)
+}
+
+// eigenSolve solves the trust-region subproblem using the eigendecomposition
+// H = QΛQᵀ, which also handles the hard case.
+func (ms *MoreSorensen) eigenSolve(dst, grad []float64, hess mat.Symmetric, radius float64) {
+	dim := len(grad)
+	gNorm := floats.Norm(grad, 2)
+	if !ms.eigen.Factorize(hess, true) {
+		// Fall back to the steepest descent step to the boundary.
+		if gNorm == 0 {
+			for i := range dst {
+				dst[i] = 0
+			}
+			return
+		}
+		floats.ScaleTo(dst, -radius/gNorm, grad)
+		return
+	}
+	vals := ms.eigen.Values(nil)
+	var q mat.Dense
+	ms.eigen.VectorsTo(&q)
+	qg := mat.NewVecDense(dim, nil)
+	qg.MulVec(q.T(), mat.NewVecDense(dim, grad))
+
+	// Eigenvalues within delta of the smallest one are treated as equal to
+	// it, and components of g smaller than small as zero.
+	delta := machEps * float64(dim) * math.Max(math.Abs(vals[0]), math.Abs(vals[dim-1]))
+	small := math.Sqrt(machEps) * gNorm
+	lo := math.Max(0, -vals[0])
+
+	// norm returns |p(λ)| ignoring the components of the eigenvalues for
+	// which λᵢ + λ is not positive.
+	norm := func(lambda float64) float64 {
+		var sum float64
+		for i, v := range vals {
+			if d := v + lambda; d > delta {
+				c := qg.AtVec(i) / d
+				sum += c * c
+			}
+		}
+		return math.Sqrt(sum)
+	}
+	// step stores p(λ) in dst.
+	step := func(lambda float64) {
+		p := mat.NewVecDense(dim, dst)
+		p.Zero()
+		for i, v := range vals {
+			if d := v + lambda; d > delta {
+				p.AddScaledVec(p, -qg.AtVec(i)/d, q.ColView(i))
+			}
+		}
+	}
+
+	if vals[0] > delta && norm(0) <= radius {
+		// Interior solution.
+		step(0)
+		return
+	}
+
+	hard := true
+	for i, v := range vals {
+		if v+lo <= delta && math.Abs(qg.AtVec(i)) > small {
+			hard = false
+			break
+		}
+	}
+	if hard && norm(lo) <= radius {
+		// Hard case. Add a multiple of an eigenvector of the smallest
+		// eigenvalue to reach the boundary.
+		step(lo)
+		z := make([]float64, dim)
+		mat.Col(z, 0, &q)
+		floats.AddScaled(dst, boundaryStep(dst, z, radius), z)
+		return
+	}
+
+	// Find λ > lo such that |p(λ)| = Δ by bisection. The upper bound
+	// satisfies |p(λ)| ≤ |g|/(λ + λ₁) ≤ Δ.
+	a, b := lo, lo+gNorm/radius
+	for k := 0; k < 200 && b-a > machEps*b; k++ {
+		mid := a + (b-a)/2
+		if norm(mid) > radius {
+			a = mid
+		} else {
+			b = mid
+		}
+	}
+	step(b)
+}
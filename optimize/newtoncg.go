@@ -0,0 +1,188 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+var (
+	_ Method      = (*NewtonCG)(nil)
+	_ localMethod = (*NewtonCG)(nil)
+)
+
+// States of the NewtonCG iteration.
+const (
+	newtonCGProduct = iota // Evaluating the gradient for a Hessian-vector product.
+	newtonCGTrial          // Evaluating the function at a trial location.
+	newtonCGAccept         // Evaluating the gradient at an accepted location.
+	newtonCGMajor          // Reported a major iteration.
+)
+
+// NewtonCG implements a Hessian-free trust-region Newton-CG method for
+// gradient-based unconstrained minimization of large problems.
+//
+// NewtonCG is a trust-region method like TrustRegion where the trust-region
+// subproblems are solved by the truncated conjugate gradient method of
+// Steihaug and Toint, see SteihaugCG. The Hessian is never formed; the
+// products of the Hessian with the search directions of the conjugate
+// gradient method are approximated by finite differences of the gradient
+//
+//	H d ≈ (∇f(x + h d) - ∇f(x)) / h,
+//
+// which costs one gradient evaluation per conjugate gradient iteration. If
+// HessVec is not nil, it is used to compute the products exactly instead. The
+// memory cost is O(n) relative to the input dimension.
+//
+// References:
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer
+//     (2006), chapter 7.1
+type NewtonCG struct {
+	// InitialRadius is the initial radius of the trust region. If
+	// InitialRadius is 0 it is defaulted to 1, otherwise it must be positive.
+	InitialRadius float64
+	// MaxRadius is the largest allowed radius of the trust region. If
+	// MaxRadius is 0 it is defaulted to the larger of 1e10 and
+	// InitialRadius, otherwise it must not be smaller than InitialRadius.
+	MaxRadius float64
+	// MaxCGIterations is the maximum number of conjugate gradient iterations
+	// for each trust-region subproblem. If MaxCGIterations is 0 it is
+	// defaulted to the problem dimension.
+	MaxCGIterations int
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+	// HessVec, if not nil, computes the product of the Hessian of the
+	// objective function at x with the vector d and stores the result in
+	// dst. HessVec must not modify d. If HessVec is nil, the products are
+	// approximated by finite differences of the gradient.
+	//
+	// HessVec is called by NewtonCG directly rather than through the
+	// Problem, so its calls are not included in the evaluation counts of
+	// Stats.
+	HessVec func(dst, x, d []float64)
+
+	status Status
+	err    error
+
+	tr    trustRegion
+	cg    steihaug
+	state int
+
+	grad []float64 // Gradient at the location of the last major iteration.
+	h    float64   // Finite difference step of the current product.
+}
+
+func (n *NewtonCG) Status() (Status, error) {
+	return n.status, n.err
+}
+
+func (*NewtonCG) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (n *NewtonCG) Init(dim, tasks int) int {
+	n.status = NotTerminated
+	n.err = nil
+	return 1
+}
+
+func (n *NewtonCG) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	n.status, n.err = localOptimizer{}.run(n, n.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (n *NewtonCG) initLocal(loc *Location) (Operation, error) {
+	if n.MaxCGIterations < 0 {
+		panic("optimize: negative number of conjugate gradient iterations")
+	}
+	n.tr.init(loc, n.InitialRadius, n.MaxRadius)
+	n.grad = resize(n.grad, len(loc.X))
+	copy(n.grad, loc.Gradient)
+	return n.solve(loc)
+}
+
+func (n *NewtonCG) iterateLocal(loc *Location) (Operation, error) {
+	switch n.state {
+	case newtonCGProduct:
+		for i, g := range loc.Gradient {
+			n.cg.hd[i] = (g - n.grad[i]) / n.h
+		}
+		if n.cg.iterate() {
+			return n.trial(loc)
+		}
+		return n.product(loc)
+	case newtonCGTrial:
+		if !n.tr.update(loc) {
+			return n.solve(loc)
+		}
+		n.state = newtonCGAccept
+		return GradEvaluation, nil
+	case newtonCGAccept:
+		copy(n.grad, loc.Gradient)
+		n.state = newtonCGMajor
+		return MajorIteration, nil
+	case newtonCGMajor:
+		return n.solve(loc)
+	default:
+		panic("optimize: unexpected state in NewtonCG")
+	}
+}
+
+// solve starts the conjugate gradient iteration for the trust-region
+// subproblem at the current location.
+func (n *NewtonCG) solve(loc *Location) (Operation, error) {
+	maxIter := n.MaxCGIterations
+	if maxIter == 0 {
+		maxIter = len(loc.X)
+	}
+	if n.cg.init(n.grad, n.tr.radius, maxIter) {
+		return n.trial(loc)
+	}
+	return n.product(loc)
+}
+
+// product computes the product of the Hessian with the current search
+// direction d if HessVec is set and continues the conjugate gradient
+// iteration. Otherwise it requests the evaluation of the gradient at x + h d
+// for the finite difference approximation of the product.
+func (n *NewtonCG) product(loc *Location) (Operation, error) {
+	if n.HessVec == nil {
+		n.h = math.Sqrt(machEps) * (1 + floats.Norm(n.tr.x, 2)) / floats.Norm(n.cg.d, 2)
+		floats.AddScaledTo(loc.X, n.tr.x, n.h, n.cg.d)
+		n.state = newtonCGProduct
+		return GradEvaluation, nil
+	}
+	for {
+		// Pass a copy of x so that HessVec cannot modify the iterate.
+		copy(loc.X, n.tr.x)
+		n.HessVec(n.cg.hd, loc.X, n.cg.d)
+		if n.cg.iterate() {
+			return n.trial(loc)
+		}
+	}
+}
+
+// trial requests the evaluation of the function at the trial location given
+// by the solution of the subproblem.
+func (n *NewtonCG) trial(loc *Location) (Operation, error) {
+	copy(n.tr.step, n.cg.p)
+	n.tr.pred = n.cg.predicted(n.grad)
+	n.state = newtonCGTrial
+	return n.tr.trial(loc)
+}
+
+func (n *NewtonCG) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
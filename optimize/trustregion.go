@@ -0,0 +1,374 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// trustRegionAccept is the smallest ratio of the actual to the predicted
+// reduction of the objective function for which a trial step is accepted.
+const trustRegionAccept = 0.1
+
+// trustRegionRoundoff is the relative size of the changes in the objective
+// function that are considered to be at the level of rounding errors.
+const trustRegionRoundoff = 10 * machEps
+
+var (
+	_ Method      = (*TrustRegion)(nil)
+	_ localMethod = (*TrustRegion)(nil)
+
+	_ TrustRegionSolver = (*SteihaugCG)(nil)
+	_ TrustRegionSolver = (*MoreSorensen)(nil)
+)
+
+// TrustRegionSolver approximately solves the trust-region subproblem
+//
+//	minimize    gᵀp + 1/2 pᵀHp
+//	subject to  |p| ≤ Δ,
+//
+// where g and H are the gradient and the Hessian of the objective function
+// at the current location and Δ is the radius of the trust region.
+type TrustRegionSolver interface {
+	// Solve stores in dst an approximate solution of the trust-region
+	// subproblem with the gradient grad, the Hessian hess and the radius.
+	Solve(dst, grad []float64, hess mat.Symmetric, radius float64)
+}
+
+// TrustRegion implements a trust-region Newton method for Hessian-based
+// unconstrained minimization.
+//
+// At each iteration TrustRegion minimizes the quadratic model of the
+// objective function within a ball of radius Δ around the current location
+// using Solver. The trial step is accepted if the ratio of the actual to the
+// predicted reduction of the function is large enough, and Δ is adapted
+// according to the ratio. Unlike Newton, TrustRegion does not need to modify
+// an indefinite Hessian and can use directions of negative curvature.
+//
+// If the Hessian is not available or is too large to be formed, NewtonCG can
+// be used instead.
+//
+// References:
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer
+//     (2006), chapter 4
+//   - Conn, A.R., Gould, N.I.M., Toint, Ph.L.: Trust-Region Methods. SIAM
+//     (2000)
+type TrustRegion struct {
+	// Solver is used to solve the trust-region subproblems. If Solver is
+	// nil, MoreSorensen is used.
+	Solver TrustRegionSolver
+	// InitialRadius is the initial radius of the trust region. If
+	// InitialRadius is 0 it is defaulted to 1, otherwise it must be positive.
+	InitialRadius float64
+	// MaxRadius is the largest allowed radius of the trust region. If
+	// MaxRadius is 0 it is defaulted to the larger of 1e10 and
+	// InitialRadius, otherwise it must not be smaller than InitialRadius.
+	MaxRadius float64
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	tr     trustRegion
+	lastOp Operation
+	hp     mat.VecDense
+}
+
+func (t *TrustRegion) Status() (Status, error) {
+	return t.status, t.err
+}
+
+func (*TrustRegion) Uses(has Available) (uses Available, err error) {
+	return has.hessian()
+}
+
+func (t *TrustRegion) Init(dim, tasks int) int {
+	t.status = NotTerminated
+	t.err = nil
+	return 1
+}
+
+func (t *TrustRegion) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	t.status, t.err = localOptimizer{}.run(t, t.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (t *TrustRegion) initLocal(loc *Location) (Operation, error) {
+	if t.Solver == nil {
+		t.Solver = &MoreSorensen{}
+	}
+	t.tr.init(loc, t.InitialRadius, t.MaxRadius)
+	return t.nextTrial(loc)
+}
+
+func (t *TrustRegion) iterateLocal(loc *Location) (Operation, error) {
+	switch t.lastOp {
+	case FuncEvaluation:
+		if !t.tr.update(loc) {
+			return t.nextTrial(loc)
+		}
+		// The function has been evaluated at the accepted location, evaluate
+		// the derivatives before declaring a major iteration.
+		t.lastOp = GradEvaluation | HessEvaluation
+		return t.lastOp, nil
+	case GradEvaluation | HessEvaluation:
+		t.lastOp = MajorIteration
+		return t.lastOp, nil
+	case MajorIteration:
+		return t.nextTrial(loc)
+	default:
+		panic("optimize: unexpected operation in TrustRegion")
+	}
+}
+
+// nextTrial solves the trust-region subproblem at the current location and
+// requests the evaluation of the function at the trial location. The
+// gradient and the Hessian in loc are those at the current location because
+// only the function is evaluated at trial locations.
+func (t *TrustRegion) nextTrial(loc *Location) (Operation, error) {
+	tr := &t.tr
+	t.Solver.Solve(tr.step, loc.Gradient, loc.Hessian, tr.radius)
+
+	// Predicted reduction -(gᵀp + 1/2 pᵀHp).
+	dim := len(tr.step)
+	t.hp.Reset()
+	t.hp.MulVec(loc.Hessian, mat.NewVecDense(dim, tr.step))
+	tr.pred = -(floats.Dot(loc.Gradient, tr.step) + 0.5*mat.Dot(mat.NewVecDense(dim, tr.step), &t.hp))
+
+	t.lastOp = FuncEvaluation
+	return tr.trial(loc)
+}
+
+func (t *TrustRegion) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, true}
+}
+
+// trustRegion holds the state of the outer iteration shared by the
+// trust-region methods.
+type trustRegion struct {
+	radius    float64
+	maxRadius float64
+
+	x    []float64 // Location of the last major iteration.
+	f    float64   // Function value at x.
+	step []float64 // Trial step.
+	pred float64   // Reduction of the function predicted by the model.
+}
+
+func (tr *trustRegion) init(loc *Location, initRadius, maxRadius float64) {
+	if initRadius == 0 {
+		initRadius = 1
+	}
+	if initRadius < 0 {
+		panic("optimize: initial trust region radius is not positive")
+	}
+	if maxRadius == 0 {
+		maxRadius = math.Max(1e10, initRadius)
+	}
+	if maxRadius < initRadius {
+		panic("optimize: maximum trust region radius smaller than initial radius")
+	}
+	tr.radius = initRadius
+	tr.maxRadius = maxRadius
+
+	dim := len(loc.X)
+	tr.x = resize(tr.x, dim)
+	copy(tr.x, loc.X)
+	tr.f = loc.F
+	tr.step = resize(tr.step, dim)
+}
+
+// trial stores the trial location x + step in loc.X and returns the
+// operation to evaluate the function there. trial returns an error if the
+// trial location does not differ from x in floating-point arithmetic.
+func (tr *trustRegion) trial(loc *Location) (Operation, error) {
+	floats.AddTo(loc.X, tr.x, tr.step)
+	if floats.Equal(loc.X, tr.x) {
+		return NoOperation, ErrTrustRegionCollapse
+	}
+	return FuncEvaluation, nil
+}
+
+// update adapts the radius of the trust region given the function value at
+// the trial location in loc and returns whether the trial step is accepted.
+// If the step is accepted, the current location is updated.
+func (tr *trustRegion) update(loc *Location) bool {
+	ratio := -1.0
+	actual := tr.f - loc.F
+	switch {
+	case math.IsNaN(actual) || tr.pred <= 0:
+	case math.Abs(actual) <= trustRegionRoundoff*math.Abs(tr.f) && tr.pred <= trustRegionRoundoff*math.Abs(tr.f):
+		// Both reductions are at the level of the rounding errors in f, so
+		// the model cannot be assessed and is trusted.
+		ratio = 1
+	default:
+		ratio = actual / tr.pred
+	}
+	stepNorm := floats.Norm(tr.step, 2)
+	switch {
+	case ratio < 0.25:
+		tr.radius = 0.25 * stepNorm
+	case ratio > 0.75 && stepNorm >= 0.99*tr.radius:
+		tr.radius = math.Min(2*tr.radius, tr.maxRadius)
+	}
+	if ratio <= trustRegionAccept {
+		return false
+	}
+	copy(tr.x, loc.X)
+	tr.f = loc.F
+	return true
+}
+
+// SteihaugCG is a TrustRegionSolver that approximately solves the
+// trust-region subproblem by the truncated conjugate gradient method of
+// Steihaug and Toint. The iteration starts from the zero step and terminates
+// when the residual is small enough, when a direction of negative curvature
+// is found or when the step reaches the boundary of the trust region. It
+// only uses products of the Hessian with vectors.
+//
+// References:
+//   - Steihaug, T.: The conjugate gradient method and trust regions in large
+//     scale optimization. SIAM J. Numer. Anal. 20(3), 626-637 (1983)
+//   - Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer
+//     (2006), chapter 7.1
+type SteihaugCG struct {
+	// MaxIterations is the maximum number of conjugate gradient iterations.
+	// If MaxIterations is 0 it is defaulted to the problem dimension.
+	MaxIterations int
+
+	cg steihaug
+}
+
+func (s *SteihaugCG) Solve(dst, grad []float64, hess mat.Symmetric, radius float64) {
+	dim := len(grad)
+	if len(dst) != dim || hess.SymmetricDim() != dim {
+		panic("optimize: unexpected size mismatch")
+	}
+	maxIter := s.MaxIterations
+	if maxIter == 0 {
+		maxIter = dim
+	}
+	done := s.cg.init(grad, radius, maxIter)
+	for !done {
+		hd := mat.NewVecDense(dim, s.cg.hd)
+		hd.MulVec(hess, mat.NewVecDense(dim, s.cg.d))
+		done = s.cg.iterate()
+	}
+	copy(dst, s.cg.p)
+}
+
+// steihaug holds the state of the Steihaug-Toint conjugate gradient
+// iteration for the trust-region subproblem. The products of the Hessian
+// with the search directions are supplied by the caller, so that the
+// iteration can be suspended while the products are evaluated.
+type steihaug struct {
+	radius  float64
+	tol     float64 // Tolerance on the norm of the residual.
+	maxIter int
+	iter    int
+
+	p  []float64 // Current step.
+	r  []float64 // Residual H*p + g.
+	d  []float64 // Search direction.
+	hd []float64 // Product H*d supplied by the caller.
+	rr float64   // Squared norm of r.
+}
+
+// init initializes the iteration from the zero step for the gradient grad
+// and returns whether the iteration has terminated.
+func (s *steihaug) init(grad []float64, radius float64, maxIter int) bool {
+	dim := len(grad)
+	s.p = resize(s.p, dim)
+	s.r = resize(s.r, dim)
+	s.d = resize(s.d, dim)
+	s.hd = resize(s.hd, dim)
+	for i := range s.p {
+		s.p[i] = 0
+	}
+	copy(s.r, grad)
+	floats.ScaleTo(s.d, -1, grad)
+	s.rr = floats.Dot(grad, grad)
+
+	// Use the forcing sequence of Nocedal and Wright (2006), Algorithm 7.1,
+	// which gives superlinear convergence.
+	gNorm := math.Sqrt(s.rr)
+	s.tol = math.Min(0.5, math.Sqrt(gNorm)) * gNorm
+	s.radius = radius
+	s.maxIter = maxIter
+	s.iter = 0
+	return gNorm == 0
+}
+
+// iterate performs an iteration given s.hd = H*s.d and returns whether the
+// iteration has terminated. The final step is stored in s.p.
+func (s *steihaug) iterate() bool {
+	s.iter++
+	dHd := floats.Dot(s.d, s.hd)
+	if dHd <= 0 {
+		// Direction of non-positive curvature, follow it to the boundary.
+		s.toBoundary()
+		return true
+	}
+	alpha := s.rr / dHd
+	pp := floats.Dot(s.p, s.p)
+	pd := floats.Dot(s.p, s.d)
+	dd := floats.Dot(s.d, s.d)
+	if pp+alpha*(2*pd+alpha*dd) >= s.radius*s.radius {
+		s.toBoundary()
+		return true
+	}
+	floats.AddScaled(s.p, alpha, s.d)
+	floats.AddScaled(s.r, alpha, s.hd)
+	rr := floats.Dot(s.r, s.r)
+	if math.Sqrt(rr) < s.tol || s.iter >= s.maxIter {
+		return true
+	}
+	beta := rr / s.rr
+	s.rr = rr
+	for i, v := range s.r {
+		s.d[i] = -v + beta*s.d[i]
+	}
+	return false
+}
+
+// toBoundary moves the step along the search direction to the boundary of
+// the trust region.
+func (s *steihaug) toBoundary() {
+	tau := boundaryStep(s.p, s.d, s.radius)
+	floats.AddScaled(s.p, tau, s.d)
+	floats.AddScaled(s.r, tau, s.hd)
+}
+
+// predicted returns the reduction of the function predicted by the quadratic
+// model for the current step,
+//
+//	-(gᵀp + 1/2 pᵀHp) = -(gᵀp + pᵀr)/2.
+func (s *steihaug) predicted(grad []float64) float64 {
+	return -0.5 * (floats.Dot(grad, s.p) + floats.Dot(s.p, s.r))
+}
+
+// boundaryStep returns τ ≥ 0 such that |p + τd| = radius, where |p| ≤ radius.
+func boundaryStep(p, d []float64, radius float64) float64 {
+	a := floats.Dot(d, d)
+	b := 2 * floats.Dot(p, d)
+	c := math.Min(0, floats.Dot(p, p)-radius*radius)
+	disc := math.Sqrt(b*b - 4*a*c)
+	if b > 0 {
+		return -2 * c / (b + disc)
+	}
+	return (disc - b) / (2 * a)
+}
@@ -0,0 +1,261 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestTrustRegion(t *testing.T) {
+	t.Parallel()
+	testLocal(t, newtonTests, &TrustRegion{})
+}
+
+func TestTrustRegionSteihaugCG(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	for _, test := range newtonTests {
+		// The function values of Watson are dominated by rounding errors
+		// before the inexact steps reach the gradient threshold.
+		if test.name == "Watson" {
+			continue
+		}
+		tests = append(tests, test)
+	}
+	testLocal(t, tests, &TrustRegion{Solver: &SteihaugCG{}})
+}
+
+func TestNewtonCG(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	tests = append(tests, gradientDescentTests...)
+	tests = append(tests, bfgsTests...)
+	testLocal(t, tests, &NewtonCG{})
+}
+
+func TestNewtonCGHessVec(t *testing.T) {
+	t.Parallel()
+	for _, test := range newtonTests {
+		// See TestTrustRegionSteihaugCG.
+		if test.name == "Watson" {
+			continue
+		}
+		hess := test.p.Hess
+		var h mat.SymDense
+		var calls int
+		hessVec := func(dst, x, d []float64) {
+			calls++
+			h.Reset()
+			h.ReuseAsSym(len(x))
+			hess(&h, x)
+			mat.NewVecDense(len(dst), dst).MulVec(&h, mat.NewVecDense(len(d), d))
+		}
+		testLocal(t, []unconstrainedTest{test}, &NewtonCG{HessVec: hessVec})
+		if calls == 0 {
+			t.Errorf("%s: HessVec not called", test.name)
+		}
+	}
+}
+
+func TestMoreSorensen(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	for cas, test := range trustRegionSubproblems() {
+		prefix := fmt.Sprintf("case %d (%s):", cas, test.name)
+		ms := MoreSorensen{Tolerance: 1e-8}
+		dim := len(test.g)
+		p := make([]float64, dim)
+		ms.Solve(p, test.g, test.h, test.radius)
+
+		pNorm := floats.Norm(p, 2)
+		if pNorm > (1+1e-8)*test.radius {
+			t.Errorf("%v step outside trust region: |p|=%v, radius=%v", prefix, pNorm, test.radius)
+		}
+
+		// The solution satisfies (H + λI)p = -g with λ ≥ 0 and H + λI
+		// positive semi-definite.
+		pv := mat.NewVecDense(dim, p)
+		var hp mat.VecDense
+		hp.MulVec(test.h, pv)
+		var lambda float64
+		if pNorm > 0 {
+			lambda = -(floats.Dot(test.g, p) + mat.Dot(pv, &hp)) / (pNorm * pNorm)
+		}
+		scale := math.Max(1, math.Max(floats.Norm(test.g, 2), mat.Norm(test.h, 2)*pNorm))
+		if lambda < -tol*scale {
+			t.Errorf("%v negative Lagrange multiplier %v", prefix, lambda)
+		}
+		res := make([]float64, dim)
+		for i := range res {
+			res[i] = hp.AtVec(i) + lambda*p[i] + test.g[i]
+		}
+		if r := floats.Norm(res, 2); r > tol*scale {
+			t.Errorf("%v optimality residual too large: %v", prefix, r)
+		}
+		var eigen mat.EigenSym
+		if !eigen.Factorize(test.h, false) {
+			t.Fatalf("%v eigendecomposition failed", prefix)
+		}
+		if smallest := eigen.Values(nil)[0]; smallest+lambda < -tol*scale {
+			t.Errorf("%v H + λI is not positive semi-definite: λ₁=%v, λ=%v", prefix, smallest, lambda)
+		}
+		if lambda > tol*scale && math.Abs(pNorm-test.radius) > 1e-8*test.radius {
+			t.Errorf("%v step not on the boundary: |p|=%v, radius=%v", prefix, pNorm, test.radius)
+		}
+		if test.want != nil {
+			m := quadraticModel(p, test.g, test.h)
+			want := quadraticModel(test.want, test.g, test.h)
+			if math.Abs(m-want) > 1e-6*math.Max(1, math.Abs(want)) {
+				t.Errorf("%v unexpected model value: got %v, want %v", prefix, m, want)
+			}
+		}
+	}
+}
+
+func TestSteihaugCG(t *testing.T) {
+	t.Parallel()
+	for cas, test := range trustRegionSubproblems() {
+		prefix := fmt.Sprintf("case %d (%s):", cas, test.name)
+		var s SteihaugCG
+		dim := len(test.g)
+		p := make([]float64, dim)
+		s.Solve(p, test.g, test.h, test.radius)
+
+		pNorm := floats.Norm(p, 2)
+		if pNorm > (1+1e-12)*test.radius {
+			t.Errorf("%v step outside trust region: |p|=%v, radius=%v", prefix, pNorm, test.radius)
+		}
+
+		// The first iteration reaches the Cauchy point, so the model must be
+		// at least as small as at the Cauchy point.
+		m := quadraticModel(p, test.g, test.h)
+		cauchy := cauchyPoint(test.g, test.h, test.radius)
+		mc := quadraticModel(cauchy, test.g, test.h)
+		if m > mc+1e-12*math.Max(1, math.Abs(mc)) {
+			t.Errorf("%v model larger than at the Cauchy point: got %v, want ≤ %v", prefix, m, mc)
+		}
+	}
+}
+
+type trustRegionSubproblem struct {
+	name   string
+	g      []float64
+	h      *mat.SymDense
+	radius float64
+	want   []float64 // Known solution or nil.
+}
+
+func trustRegionSubproblems() []trustRegionSubproblem {
+	tests := []trustRegionSubproblem{
+		{
+			name:   "interior",
+			g:      []float64{1, -2},
+			h:      mat.NewSymDense(2, []float64{4, 1, 1, 3}),
+			radius: 10,
+			want:   []float64{-5.0 / 11, 9.0 / 11},
+		},
+		{
+			name:   "boundary",
+			g:      []float64{1, 1},
+			h:      mat.NewSymDense(2, []float64{1, 0, 0, 1}),
+			radius: 0.5,
+			want:   []float64{-0.5 / math.Sqrt2, -0.5 / math.Sqrt2},
+		},
+		{
+			name:   "indefinite",
+			g:      []float64{1, 1},
+			h:      mat.NewSymDense(2, []float64{-1, 0, 0, 2}),
+			radius: 1,
+		},
+		{
+			// g is orthogonal to the eigenvector of the smallest eigenvalue,
+			// so the solution is p = (±√(4 - 1/9), -1/3) with λ = 1.
+			name:   "hard case",
+			g:      []float64{0, 1},
+			h:      mat.NewSymDense(2, []float64{-1, 0, 0, 2}),
+			radius: 2,
+			want:   []float64{math.Sqrt(4 - 1.0/9), -1.0 / 3},
+		},
+		{
+			name:   "zero gradient",
+			g:      []float64{0, 0, 0},
+			h:      mat.NewSymDense(3, []float64{2, 0, 0, 0, 1, 0, 0, 0, 3}),
+			radius: 1,
+			want:   []float64{0, 0, 0},
+		},
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dim := range []int{1, 3, 10} {
+		for _, radius := range []float64{0.01, 1, 100} {
+			for _, definite := range []bool{true, false} {
+				g := make([]float64, dim)
+				for i := range g {
+					g[i] = rnd.NormFloat64()
+				}
+				a := mat.NewDense(dim, dim, nil)
+				for i := 0; i < dim; i++ {
+					for j := 0; j < dim; j++ {
+						a.Set(i, j, rnd.NormFloat64())
+					}
+				}
+				h := mat.NewSymDense(dim, nil)
+				if definite {
+					h.SymOuterK(1, a)
+					for i := 0; i < dim; i++ {
+						h.SetSym(i, i, h.At(i, i)+0.1)
+					}
+				} else {
+					for i := 0; i < dim; i++ {
+						for j := i; j < dim; j++ {
+							h.SetSym(i, j, (a.At(i, j)+a.At(j, i))/2)
+						}
+					}
+				}
+				name := "random indefinite"
+				if definite {
+					name = "random definite"
+				}
+				tests = append(tests, trustRegionSubproblem{
+					name:   name,
+					g:      g,
+					h:      h,
+					radius: radius,
+				})
+			}
+		}
+	}
+	return tests
+}
+
+// quadraticModel returns gᵀp + 1/2 pᵀHp.
+func quadraticModel(p, g []float64, h mat.Symmetric) float64 {
+	pv := mat.NewVecDense(len(p), p)
+	return floats.Dot(g, p) + 0.5*mat.Inner(pv, h, pv)
+}
+
+// cauchyPoint returns the minimizer of the quadratic model along the
+// steepest descent direction within the trust region.
+func cauchyPoint(g []float64, h mat.Symmetric, radius float64) []float64 {
+	p := make([]float64, len(g))
+	gNorm := floats.Norm(g, 2)
+	if gNorm == 0 {
+		return p
+	}
+	gv := mat.NewVecDense(len(g), g)
+	gHg := mat.Inner(gv, h, gv)
+	tau := 1.0
+	if gHg > 0 {
+		tau = math.Min(1, gNorm*gNorm*gNorm/(radius*gHg))
+	}
+	floats.ScaleTo(p, -tau*radius/gNorm, g)
+	return p
+}
@@ -17,6 +17,7 @@ import (
 // if the call to PrincipalComponents was successful.
 type PC struct {
 	n, d    int
+	k       int
 	weights []float64
 	svd     *mat.SVD
 	ok      bool
@@ -41,16 +42,54 @@ func (c *PC) PrincipalComponents(a mat.Matrix, weights []float64) (ok bool) {
 
 	c.svd, c.ok = svdFactorizeCentered(c.svd, a, weights)
 	if c.ok {
+		c.k = min(c.n, c.d)
+		c.weights = append(c.weights[:0], weights...)
+	}
+	return c.ok
+}
+
+// PrincipalComponentsTruncated performs a weighted principal components analysis
+// on the matrix of the input data as PrincipalComponents, but only the k
+// components with the largest variance are computed. The components are
+// approximated by a randomized singular value decomposition with the given
+// settings, see mat.SVD.FactorizeRandomized for details. If settings is nil,
+// the default settings are used.
+//
+// PrincipalComponentsTruncated is considerably faster than PrincipalComponents
+// when k is much smaller than min(n, d). After a successful analysis, VectorsTo
+// and VarsTo return k components.
+//
+// PrincipalComponentsTruncated will panic if k is not between 1 and min(n, d),
+// or if weights is not nil and its length does not match the number of
+// observations.
+//
+// PrincipalComponentsTruncated returns whether the analysis was successful.
+func (c *PC) PrincipalComponentsTruncated(a mat.Matrix, weights []float64, k int, settings *mat.RandomizedSVDSettings) (ok bool) {
+	c.n, c.d = a.Dims()
+	if weights != nil && len(weights) != c.n {
+		panic("stat: len(weights) != observations")
+	}
+	if k < 1 || min(c.n, c.d) < k {
+		panic("stat: number of components out of range")
+	}
+
+	if c.svd == nil {
+		c.svd = &mat.SVD{}
+	}
+	c.ok = c.svd.FactorizeRandomized(centerWeighted(a, weights), k, mat.SVDThinV, settings)
+	if c.ok {
+		c.k = k
 		c.weights = append(c.weights[:0], weights...)
 	}
 	return c.ok
 }
 
 // VectorsTo returns the component direction vectors of a principal components
-// analysis. The vectors are returned in the columns of a d×min(n, d) matrix.
+// analysis. The vectors are returned in the columns of a d×k matrix, where k is
+// min(n, d), or the number of requested components for a truncated analysis.
 //
-// If dst is empty, VectorsTo will resize dst to be d×min(n, d). When dst is
-// non-empty, VectorsTo will panic if dst is not d×min(n, d). VectorsTo will also
+// If dst is empty, VectorsTo will resize dst to be d×k. When dst is
+// non-empty, VectorsTo will panic if dst is not d×k. VectorsTo will also
 // panic if the receiver does not contain a successful PC.
 func (c *PC) VectorsTo(dst *mat.Dense) {
 	if !c.ok {
@@ -58,9 +97,9 @@ func (c *PC) VectorsTo(dst *mat.Dense) {
 	}
 
 	if dst.IsEmpty() {
-		dst.ReuseAs(c.d, min(c.n, c.d))
+		dst.ReuseAs(c.d, c.k)
 	} else {
-		if d, n := dst.Dims(); d != c.d || n != min(c.n, c.d) {
+		if d, n := dst.Dims(); d != c.d || n != c.k {
 			panic(mat.ErrShape)
 		}
 	}
@@ -72,12 +111,13 @@ func (c *PC) VectorsTo(dst *mat.Dense) {
 // in descending order.
 // If dst is not nil it is used to store the variances and returned.
 // Vars will panic if the receiver has not successfully performed a principal
-// components analysis or dst is not nil and the length of dst is not min(n, d).
+// components analysis or dst is not nil and the length of dst is not min(n, d),
+// or the number of requested components for a truncated analysis.
 func (c *PC) VarsTo(dst []float64) []float64 {
 	if !c.ok {
 		panic("stat: use of unsuccessful principal components analysis")
 	}
-	if dst != nil && len(dst) != min(c.n, c.d) {
+	if dst != nil && len(dst) != c.k {
 		panic("stat: length of slice does not match analysis")
 	}
 
@@ -280,6 +320,16 @@ func (c *CC) RightTo(dst *mat.Dense, spheredSpace bool) {
 }
 
 func svdFactorizeCentered(work *mat.SVD, m mat.Matrix, weights []float64) (svd *mat.SVD, ok bool) {
+	if work == nil {
+		work = &mat.SVD{}
+	}
+	ok = work.Factorize(centerWeighted(m, weights), mat.SVDThin)
+	return work, ok
+}
+
+// centerWeighted returns a copy of m with the weighted column means subtracted
+// and the rows scaled by the square root of the weights.
+func centerWeighted(m mat.Matrix, weights []float64) *mat.Dense {
 	n, d := m.Dims()
 	centered := mat.NewDense(n, d, nil)
 	col := make([]float64, n)
@@ -291,11 +341,7 @@ func svdFactorizeCentered(work *mat.SVD, m mat.Matrix, weights []float64) (svd *
 	for i, w := range weights {
 		floats.Scale(math.Sqrt(w), centered.RawRowView(i))
 	}
-	if work == nil {
-		work = &mat.SVD{}
-	}
-	ok = work.Factorize(centered, mat.SVDThin)
-	return work, ok
+	return centered
 }
 
 // scaleColsReciSqrt scales the columns of cols
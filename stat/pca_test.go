@@ -6,6 +6,7 @@ package stat
 
 import (
 	"math"
+	"math/rand/v2"
 	"testing"
 
 	"gonum.org/v1/gonum/floats/scalar"
@@ -196,6 +197,69 @@ tests:
 	}
 }
 
+func TestPrincipalComponentsTruncated(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		n, d, k  int
+		weighted bool
+	}{
+		{n: 20, d: 5, k: 1},
+		{n: 50, d: 200, k: 4},
+		{n: 200, d: 50, k: 6, weighted: true},
+	} {
+		// Generate data with a few dominant directions.
+		data := mat.NewDense(test.n, test.d, nil)
+		for i := 0; i < test.n; i++ {
+			for j := 0; j < test.d; j++ {
+				data.Set(i, j, rnd.NormFloat64()*math.Pow(0.6, float64(j)))
+			}
+		}
+		var weights []float64
+		if test.weighted {
+			weights = make([]float64, test.n)
+			for i := range weights {
+				weights[i] = 1 + rnd.Float64()
+			}
+		}
+
+		var full, trunc PC
+		if !full.PrincipalComponents(data, weights) {
+			t.Fatalf("n=%d,d=%d: unexpected PCA failure", test.n, test.d)
+		}
+		ok := trunc.PrincipalComponentsTruncated(data, weights, test.k, &mat.RandomizedSVDSettings{
+			Oversample:      10,
+			PowerIterations: 4,
+			Src:             rand.NewPCG(2, 2),
+		})
+		if !ok {
+			t.Fatalf("n=%d,d=%d,k=%d: unexpected truncated PCA failure", test.n, test.d, test.k)
+		}
+
+		wantVars := full.VarsTo(nil)[:test.k]
+		vars := trunc.VarsTo(nil)
+		if !approxEqual(vars, wantVars, 1e-6) {
+			t.Errorf("n=%d,d=%d,k=%d: unexpected variances got:%v want:%v", test.n, test.d, test.k, vars, wantVars)
+		}
+
+		var wantVecs, vecs mat.Dense
+		full.VectorsTo(&wantVecs)
+		trunc.VectorsTo(&vecs)
+		r, c := vecs.Dims()
+		if r != test.d || c != test.k {
+			t.Errorf("n=%d,d=%d,k=%d: unexpected vector dimensions %d×%d", test.n, test.d, test.k, r, c)
+			continue
+		}
+		// The component directions are unique up to sign.
+		for j := 0; j < test.k; j++ {
+			d := math.Abs(mat.Dot(vecs.ColView(j), wantVecs.ColView(j)))
+			if math.Abs(d-1) > 1e-6 {
+				t.Errorf("n=%d,d=%d,k=%d: component %d mismatch", test.n, test.d, test.k, j)
+			}
+		}
+	}
+}
+
 func approxEqual(a, b []float64, epsilon float64) bool {
 	if len(a) != len(b) {
 		return false